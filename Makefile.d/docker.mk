@@ -39,6 +39,7 @@ docker/build: \
 	docker/build/index-creation \
 	docker/build/index-deletion \
 	docker/build/index-exportation \
+	docker/build/index-migration \
 	docker/build/index-operator \
 	docker/build/index-save \
 	docker/build/manager-index \
@@ -69,6 +70,7 @@ docker/xpanes/build docker/build/xpanes:
 	docker/build/index-creation \
 	docker/build/index-deletion \
 	docker/build/index-exportation \
+	docker/build/index-migration \
 	docker/build/index-operator \
 	docker/build/index-save \
 	docker/build/manager-index \
@@ -445,6 +447,19 @@ docker/build/index-exportation:
 		IMAGE=$(INDEX_EXPORTATION_IMAGE) \
 		docker/build/image
 
+.PHONY: docker/name/index-migration
+## print index-migration image name
+docker/name/index-migration:
+	@echo "$(ORG)/$(INDEX_MIGRATION_IMAGE)"
+
+.PHONY: docker/build/index-migration
+## build index-migration image
+docker/build/index-migration:
+	@$(MAKE) \
+		DOCKERFILE="$(ROOTDIR)/dockers/index/job/migration/Dockerfile" \
+		IMAGE=$(INDEX_MIGRATION_IMAGE) \
+		docker/build/image
+
 .PHONY: docker/name/index-operator
 ## print index-operator image name
 docker/name/index-operator:
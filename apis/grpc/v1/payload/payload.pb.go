@@ -96,6 +96,162 @@ func (Search_AggregationAlgorithm) EnumDescriptor() ([]byte, []int) {
 	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// MultiVectorAggregation is enum of each aggregations of the sub-vector distances of the multi-vector documents.
+type Search_MultiVectorAggregation int32
+
+const (
+	// Same as MaxSim.
+	Search_MultiVectorUnknown Search_MultiVectorAggregation = 0
+	// The sum of the minimum distances of each query vector.
+	Search_MaxSim Search_MultiVectorAggregation = 1
+	// The minimum distance of all query vectors.
+	Search_Max Search_MultiVectorAggregation = 2
+	// The mean of the minimum distances of each query vector.
+	Search_Mean Search_MultiVectorAggregation = 3
+)
+
+// Enum value maps for Search_MultiVectorAggregation.
+var (
+	Search_MultiVectorAggregation_name = map[int32]string{
+		0: "MultiVectorUnknown",
+		1: "MaxSim",
+		2: "Max",
+		3: "Mean",
+	}
+	Search_MultiVectorAggregation_value = map[string]int32{
+		"MultiVectorUnknown": 0,
+		"MaxSim":             1,
+		"Max":                2,
+		"Mean":               3,
+	}
+)
+
+func (x Search_MultiVectorAggregation) Enum() *Search_MultiVectorAggregation {
+	p := new(Search_MultiVectorAggregation)
+	*p = x
+	return p
+}
+
+func (x Search_MultiVectorAggregation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Search_MultiVectorAggregation) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_payload_payload_proto_enumTypes[1].Descriptor()
+}
+
+func (Search_MultiVectorAggregation) Type() protoreflect.EnumType {
+	return &file_v1_payload_payload_proto_enumTypes[1]
+}
+
+func (x Search_MultiVectorAggregation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Search_MultiVectorAggregation.Descriptor instead.
+func (Search_MultiVectorAggregation) EnumDescriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 1}
+}
+
+// Consistency is enum of each consistency levels of the search results against the vectors written to the agents.
+type Search_Consistency int32
+
+const (
+	// The vectors are searched once they are committed to the index.
+	Search_Eventual Search_Consistency = 0
+	// The vectors written but not committed to the index yet are searched as well.
+	Search_ReadYourWrites Search_Consistency = 1
+)
+
+// Enum value maps for Search_Consistency.
+var (
+	Search_Consistency_name = map[int32]string{
+		0: "Eventual",
+		1: "ReadYourWrites",
+	}
+	Search_Consistency_value = map[string]int32{
+		"Eventual":       0,
+		"ReadYourWrites": 1,
+	}
+)
+
+func (x Search_Consistency) Enum() *Search_Consistency {
+	p := new(Search_Consistency)
+	*p = x
+	return p
+}
+
+func (x Search_Consistency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Search_Consistency) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_payload_payload_proto_enumTypes[2].Descriptor()
+}
+
+func (Search_Consistency) Type() protoreflect.EnumType {
+	return &file_v1_payload_payload_proto_enumTypes[2]
+}
+
+func (x Search_Consistency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Search_Consistency.Descriptor instead.
+func (Search_Consistency) EnumDescriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 2}
+}
+
+// Algorithm is enum of each fusion algorithms.
+type Search_Fusion_Algorithm int32
+
+const (
+	Search_Fusion_Disabled Search_Fusion_Algorithm = 0
+	Search_Fusion_RRF      Search_Fusion_Algorithm = 1
+	Search_Fusion_Weighted Search_Fusion_Algorithm = 2
+)
+
+// Enum value maps for Search_Fusion_Algorithm.
+var (
+	Search_Fusion_Algorithm_name = map[int32]string{
+		0: "Disabled",
+		1: "RRF",
+		2: "Weighted",
+	}
+	Search_Fusion_Algorithm_value = map[string]int32{
+		"Disabled": 0,
+		"RRF":      1,
+		"Weighted": 2,
+	}
+)
+
+func (x Search_Fusion_Algorithm) Enum() *Search_Fusion_Algorithm {
+	p := new(Search_Fusion_Algorithm)
+	*p = x
+	return p
+}
+
+func (x Search_Fusion_Algorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Search_Fusion_Algorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_payload_payload_proto_enumTypes[3].Descriptor()
+}
+
+func (Search_Fusion_Algorithm) Type() protoreflect.EnumType {
+	return &file_v1_payload_payload_proto_enumTypes[3]
+}
+
+func (x Search_Fusion_Algorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Search_Fusion_Algorithm.Descriptor instead.
+func (Search_Fusion_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 9, 0}
+}
+
 // Operator is enum of each conditional operator.
 type Remove_Timestamp_Operator int32
 
@@ -147,11 +303,11 @@ func (x Remove_Timestamp_Operator) String() string {
 }
 
 func (Remove_Timestamp_Operator) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_payload_payload_proto_enumTypes[1].Descriptor()
+	return file_v1_payload_payload_proto_enumTypes[4].Descriptor()
 }
 
 func (Remove_Timestamp_Operator) Type() protoreflect.EnumType {
-	return &file_v1_payload_payload_proto_enumTypes[1]
+	return &file_v1_payload_payload_proto_enumTypes[4]
 }
 
 func (x Remove_Timestamp_Operator) Number() protoreflect.EnumNumber {
@@ -459,6 +615,43 @@ func (*Object) Descriptor() ([]byte, []int) {
 	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7}
 }
 
+// Attribute related messages.
+type Attribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attribute) Reset() {
+	*x = Attribute{}
+	mi := &file_v1_payload_payload_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attribute) ProtoMessage() {}
+
+func (x *Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{8}
+}
+
 // Control related messages.
 type Control struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -468,7 +661,7 @@ type Control struct {
 
 func (x *Control) Reset() {
 	*x = Control{}
-	mi := &file_v1_payload_payload_proto_msgTypes[8]
+	mi := &file_v1_payload_payload_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +673,7 @@ func (x *Control) String() string {
 func (*Control) ProtoMessage() {}
 
 func (x *Control) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[8]
+	mi := &file_v1_payload_payload_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +686,7 @@ func (x *Control) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Control.ProtoReflect.Descriptor instead.
 func (*Control) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{8}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{9}
 }
 
 // Discoverer related messages.
@@ -505,7 +698,7 @@ type Discoverer struct {
 
 func (x *Discoverer) Reset() {
 	*x = Discoverer{}
-	mi := &file_v1_payload_payload_proto_msgTypes[9]
+	mi := &file_v1_payload_payload_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -517,7 +710,7 @@ func (x *Discoverer) String() string {
 func (*Discoverer) ProtoMessage() {}
 
 func (x *Discoverer) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[9]
+	mi := &file_v1_payload_payload_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,7 +723,44 @@ func (x *Discoverer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Discoverer.ProtoReflect.Descriptor instead.
 func (*Discoverer) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{9}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10}
+}
+
+// Collection related messages.
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_v1_payload_payload_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{11}
 }
 
 // Info related messages.
@@ -542,7 +772,7 @@ type Info struct {
 
 func (x *Info) Reset() {
 	*x = Info{}
-	mi := &file_v1_payload_payload_proto_msgTypes[10]
+	mi := &file_v1_payload_payload_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -554,7 +784,7 @@ func (x *Info) String() string {
 func (*Info) ProtoMessage() {}
 
 func (x *Info) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[10]
+	mi := &file_v1_payload_payload_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +797,7 @@ func (x *Info) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info.ProtoReflect.Descriptor instead.
 func (*Info) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12}
 }
 
 // Mirror related messages.
@@ -579,7 +809,7 @@ type Mirror struct {
 
 func (x *Mirror) Reset() {
 	*x = Mirror{}
-	mi := &file_v1_payload_payload_proto_msgTypes[11]
+	mi := &file_v1_payload_payload_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -591,7 +821,7 @@ func (x *Mirror) String() string {
 func (*Mirror) ProtoMessage() {}
 
 func (x *Mirror) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[11]
+	mi := &file_v1_payload_payload_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -604,7 +834,7 @@ func (x *Mirror) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mirror.ProtoReflect.Descriptor instead.
 func (*Mirror) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{11}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{13}
 }
 
 type Meta struct {
@@ -615,7 +845,7 @@ type Meta struct {
 
 func (x *Meta) Reset() {
 	*x = Meta{}
-	mi := &file_v1_payload_payload_proto_msgTypes[12]
+	mi := &file_v1_payload_payload_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -627,7 +857,7 @@ func (x *Meta) String() string {
 func (*Meta) ProtoMessage() {}
 
 func (x *Meta) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[12]
+	mi := &file_v1_payload_payload_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +870,7 @@ func (x *Meta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Meta.ProtoReflect.Descriptor instead.
 func (*Meta) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{14}
 }
 
 // Represent an empty message.
@@ -652,7 +882,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_v1_payload_payload_proto_msgTypes[13]
+	mi := &file_v1_payload_payload_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -664,7 +894,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[13]
+	mi := &file_v1_payload_payload_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -677,7 +907,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{13}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{15}
 }
 
 // Represent a search request.
@@ -686,14 +916,18 @@ type Search_Request struct {
 	// The vector to be searched.
 	Vector []float32 `protobuf:"fixed32,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
 	// The configuration of the search request.
-	Config        *Search_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Config *Search_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// The sparse vector to be searched together with the vector.
+	Sparse *Object_SparseVector `protobuf:"bytes,3,opt,name=sparse,proto3" json:"sparse,omitempty"`
+	// The query vectors to be searched for the multi-vector documents.
+	SubVectors    []*Object_SubVector `protobuf:"bytes,4,rep,name=sub_vectors,json=subVectors,proto3" json:"sub_vectors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Search_Request) Reset() {
 	*x = Search_Request{}
-	mi := &file_v1_payload_payload_proto_msgTypes[14]
+	mi := &file_v1_payload_payload_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -705,7 +939,7 @@ func (x *Search_Request) String() string {
 func (*Search_Request) ProtoMessage() {}
 
 func (x *Search_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[14]
+	mi := &file_v1_payload_payload_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,6 +969,20 @@ func (x *Search_Request) GetConfig() *Search_Config {
 	return nil
 }
 
+func (x *Search_Request) GetSparse() *Object_SparseVector {
+	if x != nil {
+		return x.Sparse
+	}
+	return nil
+}
+
+func (x *Search_Request) GetSubVectors() []*Object_SubVector {
+	if x != nil {
+		return x.SubVectors
+	}
+	return nil
+}
+
 // Represent the multiple search request.
 type Search_MultiRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -746,7 +994,7 @@ type Search_MultiRequest struct {
 
 func (x *Search_MultiRequest) Reset() {
 	*x = Search_MultiRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[15]
+	mi := &file_v1_payload_payload_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -758,7 +1006,7 @@ func (x *Search_MultiRequest) String() string {
 func (*Search_MultiRequest) ProtoMessage() {}
 
 func (x *Search_MultiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[15]
+	mi := &file_v1_payload_payload_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -794,7 +1042,7 @@ type Search_IDRequest struct {
 
 func (x *Search_IDRequest) Reset() {
 	*x = Search_IDRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[16]
+	mi := &file_v1_payload_payload_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -806,7 +1054,7 @@ func (x *Search_IDRequest) String() string {
 func (*Search_IDRequest) ProtoMessage() {}
 
 func (x *Search_IDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[16]
+	mi := &file_v1_payload_payload_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,7 +1095,7 @@ type Search_MultiIDRequest struct {
 
 func (x *Search_MultiIDRequest) Reset() {
 	*x = Search_MultiIDRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[17]
+	mi := &file_v1_payload_payload_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +1107,7 @@ func (x *Search_MultiIDRequest) String() string {
 func (*Search_MultiIDRequest) ProtoMessage() {}
 
 func (x *Search_MultiIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[17]
+	mi := &file_v1_payload_payload_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1145,7 @@ type Search_ObjectRequest struct {
 
 func (x *Search_ObjectRequest) Reset() {
 	*x = Search_ObjectRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[18]
+	mi := &file_v1_payload_payload_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -909,7 +1157,7 @@ func (x *Search_ObjectRequest) String() string {
 func (*Search_ObjectRequest) ProtoMessage() {}
 
 func (x *Search_ObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[18]
+	mi := &file_v1_payload_payload_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,7 +1205,7 @@ type Search_MultiObjectRequest struct {
 
 func (x *Search_MultiObjectRequest) Reset() {
 	*x = Search_MultiObjectRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[19]
+	mi := &file_v1_payload_payload_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -969,7 +1217,7 @@ func (x *Search_MultiObjectRequest) String() string {
 func (*Search_MultiObjectRequest) ProtoMessage() {}
 
 func (x *Search_MultiObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[19]
+	mi := &file_v1_payload_payload_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,14 +1266,34 @@ type Search_Config struct {
 	// Search nprobe.
 	Nprobe uint32 `protobuf:"varint,11,opt,name=nprobe,proto3" json:"nprobe,omitempty"`
 	// Search edge size
-	EdgeSize      int32 `protobuf:"varint,12,opt,name=edge_size,json=edgeSize,proto3" json:"edge_size,omitempty"`
+	EdgeSize int32 `protobuf:"varint,12,opt,name=edge_size,json=edgeSize,proto3" json:"edge_size,omitempty"`
+	// Attribute predicate expression to filter the search results.
+	Predicate string `protobuf:"bytes,13,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	// Fusion configuration of the dense and sparse search results.
+	Fusion *Search_Fusion `protobuf:"bytes,14,opt,name=fusion,proto3" json:"fusion,omitempty"`
+	// Aggregation of the sub-vector distances of the multi-vector documents.
+	MultiVectorAggregation Search_MultiVectorAggregation `protobuf:"varint,15,opt,name=multi_vector_aggregation,json=multiVectorAggregation,proto3,enum=payload.v1.Search_MultiVectorAggregation" json:"multi_vector_aggregation,omitempty"`
+	// The collection to be searched. The default collection is searched when it is empty.
+	Collection string `protobuf:"bytes,16,opt,name=collection,proto3" json:"collection,omitempty"`
+	// Whether to return the cursor of the next page of the search results.
+	WithCursor bool `protobuf:"varint,17,opt,name=with_cursor,json=withCursor,proto3" json:"with_cursor,omitempty"`
+	// Re-ranking configuration of the search results by the exact distances.
+	Rerank *Search_Rerank `protobuf:"bytes,18,opt,name=rerank,proto3" json:"rerank,omitempty"`
+	// Maximal marginal relevance configuration to diversify the search results.
+	Mmr *Search_MMR `protobuf:"bytes,19,opt,name=mmr,proto3" json:"mmr,omitempty"`
+	// Consistency level of the search results against the vectors written to the agents.
+	Consistency Search_Consistency `protobuf:"varint,20,opt,name=consistency,proto3,enum=payload.v1.Search_Consistency" json:"consistency,omitempty"`
+	// The distance of the last result of the previous page. Only the results after it are returned.
+	AfterDistance *float32 `protobuf:"fixed32,21,opt,name=after_distance,json=afterDistance,proto3,oneof" json:"after_distance,omitempty"`
+	// The IDs of the results of the after_distance returned in the previous pages.
+	AfterIds      []string `protobuf:"bytes,22,rep,name=after_ids,json=afterIds,proto3" json:"after_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Search_Config) Reset() {
 	*x = Search_Config{}
-	mi := &file_v1_payload_payload_proto_msgTypes[20]
+	mi := &file_v1_payload_payload_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1037,7 +1305,7 @@ func (x *Search_Config) String() string {
 func (*Search_Config) ProtoMessage() {}
 
 func (x *Search_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[20]
+	mi := &file_v1_payload_payload_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,133 +1405,101 @@ func (x *Search_Config) GetEdgeSize() int32 {
 	return 0
 }
 
-// Represent a search response.
-type Search_Response struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The unique request ID.
-	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	// Search results.
-	Results       []*Object_Distance `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Search_Config) GetPredicate() string {
+	if x != nil {
+		return x.Predicate
+	}
+	return ""
 }
 
-func (x *Search_Response) Reset() {
-	*x = Search_Response{}
-	mi := &file_v1_payload_payload_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Search_Config) GetFusion() *Search_Fusion {
+	if x != nil {
+		return x.Fusion
+	}
+	return nil
 }
 
-func (x *Search_Response) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Search_Config) GetMultiVectorAggregation() Search_MultiVectorAggregation {
+	if x != nil {
+		return x.MultiVectorAggregation
+	}
+	return Search_MultiVectorUnknown
 }
 
-func (*Search_Response) ProtoMessage() {}
-
-func (x *Search_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[21]
+func (x *Search_Config) GetCollection() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Collection
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Search_Response.ProtoReflect.Descriptor instead.
-func (*Search_Response) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 7}
+func (x *Search_Config) GetWithCursor() bool {
+	if x != nil {
+		return x.WithCursor
+	}
+	return false
 }
 
-func (x *Search_Response) GetRequestId() string {
+func (x *Search_Config) GetRerank() *Search_Rerank {
 	if x != nil {
-		return x.RequestId
+		return x.Rerank
 	}
-	return ""
+	return nil
 }
 
-func (x *Search_Response) GetResults() []*Object_Distance {
+func (x *Search_Config) GetMmr() *Search_MMR {
 	if x != nil {
-		return x.Results
+		return x.Mmr
 	}
 	return nil
 }
 
-// Represent multiple search responses.
-type Search_Responses struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Represent the multiple search response content.
-	Responses     []*Search_Response `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Search_Responses) Reset() {
-	*x = Search_Responses{}
-	mi := &file_v1_payload_payload_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Search_Responses) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Search_Responses) ProtoMessage() {}
-
-func (x *Search_Responses) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[22]
+func (x *Search_Config) GetConsistency() Search_Consistency {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Consistency
 	}
-	return mi.MessageOf(x)
+	return Search_Eventual
 }
 
-// Deprecated: Use Search_Responses.ProtoReflect.Descriptor instead.
-func (*Search_Responses) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 8}
+func (x *Search_Config) GetAfterDistance() float32 {
+	if x != nil && x.AfterDistance != nil {
+		return *x.AfterDistance
+	}
+	return 0
 }
 
-func (x *Search_Responses) GetResponses() []*Search_Response {
+func (x *Search_Config) GetAfterIds() []string {
 	if x != nil {
-		return x.Responses
+		return x.AfterIds
 	}
 	return nil
 }
 
-// Represent stream search response.
-type Search_StreamResponse struct {
+// Represent the re-ranking configuration of the search results by the exact distances of their vectors.
+type Search_Rerank struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*Search_StreamResponse_Response
-	//	*Search_StreamResponse_Status
-	Payload       isSearch_StreamResponse_Payload `protobuf_oneof:"payload"`
+	// Whether to re-rank the search results.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The ratio of the number of the candidates to be re-ranked to the number of the results. The gateway default is used when it is not set.
+	Ratio         float32 `protobuf:"fixed32,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Search_StreamResponse) Reset() {
-	*x = Search_StreamResponse{}
+func (x *Search_Rerank) Reset() {
+	*x = Search_Rerank{}
 	mi := &file_v1_payload_payload_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Search_StreamResponse) String() string {
+func (x *Search_Rerank) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Search_StreamResponse) ProtoMessage() {}
+func (*Search_Rerank) ProtoMessage() {}
 
-func (x *Search_StreamResponse) ProtoReflect() protoreflect.Message {
+func (x *Search_Rerank) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1275,79 +1511,54 @@ func (x *Search_StreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Search_StreamResponse.ProtoReflect.Descriptor instead.
-func (*Search_StreamResponse) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 9}
-}
-
-func (x *Search_StreamResponse) GetPayload() isSearch_StreamResponse_Payload {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
+// Deprecated: Use Search_Rerank.ProtoReflect.Descriptor instead.
+func (*Search_Rerank) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 7}
 }
 
-func (x *Search_StreamResponse) GetResponse() *Search_Response {
+func (x *Search_Rerank) GetEnabled() bool {
 	if x != nil {
-		if x, ok := x.Payload.(*Search_StreamResponse_Response); ok {
-			return x.Response
-		}
+		return x.Enabled
 	}
-	return nil
+	return false
 }
 
-func (x *Search_StreamResponse) GetStatus() *status.Status {
+func (x *Search_Rerank) GetRatio() float32 {
 	if x != nil {
-		if x, ok := x.Payload.(*Search_StreamResponse_Status); ok {
-			return x.Status
-		}
+		return x.Ratio
 	}
-	return nil
-}
-
-type isSearch_StreamResponse_Payload interface {
-	isSearch_StreamResponse_Payload()
-}
-
-type Search_StreamResponse_Response struct {
-	// Represent the search response.
-	Response *Search_Response `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
-}
-
-type Search_StreamResponse_Status struct {
-	// The RPC error status.
-	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+	return 0
 }
 
-func (*Search_StreamResponse_Response) isSearch_StreamResponse_Payload() {}
-
-func (*Search_StreamResponse_Status) isSearch_StreamResponse_Payload() {}
-
-// Represent the target filter server.
-type Filter_Target struct {
+// Represent the maximal marginal relevance configuration, which diversifies the search results by selecting each
+// result of the highest relevance to the query penalized by the similarity to the results already selected.
+type Search_MMR struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The target hostname.
-	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	// The target port.
-	Port          uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Whether to diversify the search results.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// The weight of the relevance to the query against the diversity, from 0 (diversity only) to 1 (relevance only).
+	// It is 0.5 when it is not set.
+	Lambda *float32 `protobuf:"fixed32,2,opt,name=lambda,proto3,oneof" json:"lambda,omitempty"`
+	// The number of the candidates to select the results from. The gateway default is used when it is not set.
+	Candidates    uint32 `protobuf:"varint,3,opt,name=candidates,proto3" json:"candidates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Filter_Target) Reset() {
-	*x = Filter_Target{}
+func (x *Search_MMR) Reset() {
+	*x = Search_MMR{}
 	mi := &file_v1_payload_payload_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Filter_Target) String() string {
+func (x *Search_MMR) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Filter_Target) ProtoMessage() {}
+func (*Search_MMR) ProtoMessage() {}
 
-func (x *Filter_Target) ProtoReflect() protoreflect.Message {
+func (x *Search_MMR) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1359,48 +1570,61 @@ func (x *Filter_Target) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Filter_Target.ProtoReflect.Descriptor instead.
-func (*Filter_Target) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use Search_MMR.ProtoReflect.Descriptor instead.
+func (*Search_MMR) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 8}
 }
 
-func (x *Filter_Target) GetHost() string {
+func (x *Search_MMR) GetEnabled() bool {
 	if x != nil {
-		return x.Host
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *Filter_Target) GetPort() uint32 {
+func (x *Search_MMR) GetLambda() float32 {
+	if x != nil && x.Lambda != nil {
+		return *x.Lambda
+	}
+	return 0
+}
+
+func (x *Search_MMR) GetCandidates() uint32 {
 	if x != nil {
-		return x.Port
+		return x.Candidates
 	}
 	return 0
 }
 
-// Represent filter configuration.
-type Filter_Config struct {
+// Represent the fusion configuration of the hybrid search.
+type Search_Fusion struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Represent the filter target configuration.
-	Targets       []*Filter_Target `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	// The fusion algorithm.
+	Algorithm Search_Fusion_Algorithm `protobuf:"varint,1,opt,name=algorithm,proto3,enum=payload.v1.Search_Fusion_Algorithm" json:"algorithm,omitempty"`
+	// The weight of the dense search result. 1 is used when it is not set, and 0 excludes the dense search result.
+	DenseWeight *float32 `protobuf:"fixed32,2,opt,name=dense_weight,json=denseWeight,proto3,oneof" json:"dense_weight,omitempty"`
+	// The weight of the sparse search result. 1 is used when it is not set, and 0 excludes the sparse search result.
+	SparseWeight *float32 `protobuf:"fixed32,3,opt,name=sparse_weight,json=sparseWeight,proto3,oneof" json:"sparse_weight,omitempty"`
+	// The rank constant of the reciprocal rank fusion. 60 is used when it is not set.
+	RankConstant  uint32 `protobuf:"varint,4,opt,name=rank_constant,json=rankConstant,proto3" json:"rank_constant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Filter_Config) Reset() {
-	*x = Filter_Config{}
+func (x *Search_Fusion) Reset() {
+	*x = Search_Fusion{}
 	mi := &file_v1_payload_payload_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Filter_Config) String() string {
+func (x *Search_Fusion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Filter_Config) ProtoMessage() {}
+func (*Search_Fusion) ProtoMessage() {}
 
-func (x *Filter_Config) ProtoReflect() protoreflect.Message {
+func (x *Search_Fusion) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1412,43 +1636,72 @@ func (x *Filter_Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Filter_Config.ProtoReflect.Descriptor instead.
-func (*Filter_Config) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use Search_Fusion.ProtoReflect.Descriptor instead.
+func (*Search_Fusion) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 9}
 }
 
-func (x *Filter_Config) GetTargets() []*Filter_Target {
+func (x *Search_Fusion) GetAlgorithm() Search_Fusion_Algorithm {
 	if x != nil {
-		return x.Targets
+		return x.Algorithm
 	}
-	return nil
+	return Search_Fusion_Disabled
 }
 
-// Represent the insert request.
-type Insert_Request struct {
+func (x *Search_Fusion) GetDenseWeight() float32 {
+	if x != nil && x.DenseWeight != nil {
+		return *x.DenseWeight
+	}
+	return 0
+}
+
+func (x *Search_Fusion) GetSparseWeight() float32 {
+	if x != nil && x.SparseWeight != nil {
+		return *x.SparseWeight
+	}
+	return 0
+}
+
+func (x *Search_Fusion) GetRankConstant() uint32 {
+	if x != nil {
+		return x.RankConstant
+	}
+	return 0
+}
+
+// Represent a search response.
+type Search_Response struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The vector to be inserted.
-	Vector *Object_Vector `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
-	// The configuration of the insert request.
-	Config        *Insert_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// The unique request ID.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Search results.
+	Results []*Object_Distance `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	// Whether the results were re-ranked with the full-precision vectors.
+	Reranked bool `protobuf:"varint,3,opt,name=reranked,proto3" json:"reranked,omitempty"`
+	// The cursor of the next page. It is set only when with_cursor of the request config is true and the page is full.
+	NextCursor string `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// The addresses of the agents which were not waited for, since the other agents already covered the results.
+	SkippedAgents []string `protobuf:"bytes,5,rep,name=skipped_agents,json=skippedAgents,proto3" json:"skipped_agents,omitempty"`
+	// Whether the results may be incomplete, since the filtered search reached the max number of the candidates.
+	Partial       bool `protobuf:"varint,6,opt,name=partial,proto3" json:"partial,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Insert_Request) Reset() {
-	*x = Insert_Request{}
+func (x *Search_Response) Reset() {
+	*x = Search_Response{}
 	mi := &file_v1_payload_payload_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Insert_Request) String() string {
+func (x *Search_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Insert_Request) ProtoMessage() {}
+func (*Search_Response) ProtoMessage() {}
 
-func (x *Insert_Request) ProtoReflect() protoreflect.Message {
+func (x *Search_Response) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1460,48 +1713,76 @@ func (x *Insert_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Insert_Request.ProtoReflect.Descriptor instead.
-func (*Insert_Request) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use Search_Response.ProtoReflect.Descriptor instead.
+func (*Search_Response) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 10}
 }
 
-func (x *Insert_Request) GetVector() *Object_Vector {
+func (x *Search_Response) GetRequestId() string {
 	if x != nil {
-		return x.Vector
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *Search_Response) GetResults() []*Object_Distance {
+	if x != nil {
+		return x.Results
 	}
 	return nil
 }
 
-func (x *Insert_Request) GetConfig() *Insert_Config {
+func (x *Search_Response) GetReranked() bool {
 	if x != nil {
-		return x.Config
+		return x.Reranked
+	}
+	return false
+}
+
+func (x *Search_Response) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *Search_Response) GetSkippedAgents() []string {
+	if x != nil {
+		return x.SkippedAgents
 	}
 	return nil
 }
 
-// Represent the multiple insert request.
-type Insert_MultiRequest struct {
+func (x *Search_Response) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+// Represent a request of the next page of the search results.
+type Search_NextRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Represent multiple insert request content.
-	Requests      []*Insert_Request `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// The next_cursor of the previous search response.
+	Cursor        string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Insert_MultiRequest) Reset() {
-	*x = Insert_MultiRequest{}
+func (x *Search_NextRequest) Reset() {
+	*x = Search_NextRequest{}
 	mi := &file_v1_payload_payload_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Insert_MultiRequest) String() string {
+func (x *Search_NextRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Insert_MultiRequest) ProtoMessage() {}
+func (*Search_NextRequest) ProtoMessage() {}
 
-func (x *Insert_MultiRequest) ProtoReflect() protoreflect.Message {
+func (x *Search_NextRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1513,45 +1794,41 @@ func (x *Insert_MultiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Insert_MultiRequest.ProtoReflect.Descriptor instead.
-func (*Insert_MultiRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use Search_NextRequest.ProtoReflect.Descriptor instead.
+func (*Search_NextRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 11}
 }
 
-func (x *Insert_MultiRequest) GetRequests() []*Insert_Request {
+func (x *Search_NextRequest) GetCursor() string {
 	if x != nil {
-		return x.Requests
+		return x.Cursor
 	}
-	return nil
+	return ""
 }
 
-// Represent the insert by binary object request.
-type Insert_ObjectRequest struct {
+// Represent multiple search responses.
+type Search_Responses struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The binary object to be inserted.
-	Object *Object_Blob `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	// The configuration of the insert request.
-	Config *Insert_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	// Filter configurations.
-	Vectorizer    *Filter_Target `protobuf:"bytes,3,opt,name=vectorizer,proto3" json:"vectorizer,omitempty"`
+	// Represent the multiple search response content.
+	Responses     []*Search_Response `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Insert_ObjectRequest) Reset() {
-	*x = Insert_ObjectRequest{}
+func (x *Search_Responses) Reset() {
+	*x = Search_Responses{}
 	mi := &file_v1_payload_payload_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Insert_ObjectRequest) String() string {
+func (x *Search_Responses) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Insert_ObjectRequest) ProtoMessage() {}
+func (*Search_Responses) ProtoMessage() {}
 
-func (x *Insert_ObjectRequest) ProtoReflect() protoreflect.Message {
+func (x *Search_Responses) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1563,55 +1840,44 @@ func (x *Insert_ObjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Insert_ObjectRequest.ProtoReflect.Descriptor instead.
-func (*Insert_ObjectRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{2, 2}
-}
-
-func (x *Insert_ObjectRequest) GetObject() *Object_Blob {
-	if x != nil {
-		return x.Object
-	}
-	return nil
+// Deprecated: Use Search_Responses.ProtoReflect.Descriptor instead.
+func (*Search_Responses) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 12}
 }
 
-func (x *Insert_ObjectRequest) GetConfig() *Insert_Config {
+func (x *Search_Responses) GetResponses() []*Search_Response {
 	if x != nil {
-		return x.Config
+		return x.Responses
 	}
 	return nil
 }
 
-func (x *Insert_ObjectRequest) GetVectorizer() *Filter_Target {
-	if x != nil {
-		return x.Vectorizer
-	}
-	return nil
-}
-
-// Represent the multiple insert by binary object request.
-type Insert_MultiObjectRequest struct {
+// Represent stream search response.
+type Search_StreamResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Represent multiple insert by object content.
-	Requests      []*Insert_ObjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Search_StreamResponse_Response
+	//	*Search_StreamResponse_Status
+	Payload       isSearch_StreamResponse_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Insert_MultiObjectRequest) Reset() {
-	*x = Insert_MultiObjectRequest{}
+func (x *Search_StreamResponse) Reset() {
+	*x = Search_StreamResponse{}
 	mi := &file_v1_payload_payload_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Insert_MultiObjectRequest) String() string {
+func (x *Search_StreamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Insert_MultiObjectRequest) ProtoMessage() {}
+func (*Search_StreamResponse) ProtoMessage() {}
 
-func (x *Insert_MultiObjectRequest) ProtoReflect() protoreflect.Message {
+func (x *Search_StreamResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1623,45 +1889,79 @@ func (x *Insert_MultiObjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Insert_MultiObjectRequest.ProtoReflect.Descriptor instead.
-func (*Insert_MultiObjectRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{2, 3}
+// Deprecated: Use Search_StreamResponse.ProtoReflect.Descriptor instead.
+func (*Search_StreamResponse) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 13}
 }
 
-func (x *Insert_MultiObjectRequest) GetRequests() []*Insert_ObjectRequest {
+func (x *Search_StreamResponse) GetPayload() isSearch_StreamResponse_Payload {
 	if x != nil {
-		return x.Requests
+		return x.Payload
 	}
 	return nil
 }
 
-// Represent insert configurations.
-type Insert_Config struct {
+func (x *Search_StreamResponse) GetResponse() *Search_Response {
+	if x != nil {
+		if x, ok := x.Payload.(*Search_StreamResponse_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *Search_StreamResponse) GetStatus() *status.Status {
+	if x != nil {
+		if x, ok := x.Payload.(*Search_StreamResponse_Status); ok {
+			return x.Status
+		}
+	}
+	return nil
+}
+
+type isSearch_StreamResponse_Payload interface {
+	isSearch_StreamResponse_Payload()
+}
+
+type Search_StreamResponse_Response struct {
+	// Represent the search response.
+	Response *Search_Response `protobuf:"bytes,1,opt,name=response,proto3,oneof"`
+}
+
+type Search_StreamResponse_Status struct {
+	// The RPC error status.
+	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+}
+
+func (*Search_StreamResponse_Response) isSearch_StreamResponse_Payload() {}
+
+func (*Search_StreamResponse_Status) isSearch_StreamResponse_Payload() {}
+
+// Represent a range search request.
+type Search_RangeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// A flag to skip exist check during insert operation.
-	SkipStrictExistCheck bool `protobuf:"varint,1,opt,name=skip_strict_exist_check,json=skipStrictExistCheck,proto3" json:"skip_strict_exist_check,omitempty"`
-	// Filter configurations.
-	Filters *Filter_Config `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
-	// Insert timestamp.
-	Timestamp     int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The vector to be searched.
+	Vector []float32 `protobuf:"fixed32,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	// The configuration of the range search request.
+	Config        *Search_RangeConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Insert_Config) Reset() {
-	*x = Insert_Config{}
+func (x *Search_RangeRequest) Reset() {
+	*x = Search_RangeRequest{}
 	mi := &file_v1_payload_payload_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Insert_Config) String() string {
+func (x *Search_RangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Insert_Config) ProtoMessage() {}
+func (*Search_RangeRequest) ProtoMessage() {}
 
-func (x *Insert_Config) ProtoReflect() protoreflect.Message {
+func (x *Search_RangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1673,57 +1973,62 @@ func (x *Insert_Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Insert_Config.ProtoReflect.Descriptor instead.
-func (*Insert_Config) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{2, 4}
-}
-
-func (x *Insert_Config) GetSkipStrictExistCheck() bool {
-	if x != nil {
-		return x.SkipStrictExistCheck
-	}
-	return false
+// Deprecated: Use Search_RangeRequest.ProtoReflect.Descriptor instead.
+func (*Search_RangeRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 14}
 }
 
-func (x *Insert_Config) GetFilters() *Filter_Config {
+func (x *Search_RangeRequest) GetVector() []float32 {
 	if x != nil {
-		return x.Filters
+		return x.Vector
 	}
 	return nil
 }
 
-func (x *Insert_Config) GetTimestamp() int64 {
+func (x *Search_RangeRequest) GetConfig() *Search_RangeConfig {
 	if x != nil {
-		return x.Timestamp
+		return x.Config
 	}
-	return 0
+	return nil
 }
 
-// Represent the update request.
-type Update_Request struct {
+// Represent range search configuration.
+type Search_RangeConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The vector to be updated.
-	Vector *Object_Vector `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
-	// The configuration of the update request.
-	Config        *Update_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// Unique request ID.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Search radius. Every vector within the radius is returned.
+	Radius float32 `protobuf:"fixed32,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	// Search coefficient.
+	Epsilon float32 `protobuf:"fixed32,3,opt,name=epsilon,proto3" json:"epsilon,omitempty"`
+	// The maximum number of results in a page. The default page size is used when it is 0.
+	PageSize uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The cursor of the page to be returned. The first page is returned when it is empty.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Search timeout in nanoseconds.
+	Timeout int64 `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Attribute predicate expression to filter the search results.
+	Predicate string `protobuf:"bytes,7,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	// The collection to be searched. The default collection is searched when it is empty.
+	Collection    string `protobuf:"bytes,8,opt,name=collection,proto3" json:"collection,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Update_Request) Reset() {
-	*x = Update_Request{}
+func (x *Search_RangeConfig) Reset() {
+	*x = Search_RangeConfig{}
 	mi := &file_v1_payload_payload_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Update_Request) String() string {
+func (x *Search_RangeConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Update_Request) ProtoMessage() {}
+func (*Search_RangeConfig) ProtoMessage() {}
 
-func (x *Update_Request) ProtoReflect() protoreflect.Message {
+func (x *Search_RangeConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1735,99 +2040,97 @@ func (x *Update_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Update_Request.ProtoReflect.Descriptor instead.
-func (*Update_Request) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use Search_RangeConfig.ProtoReflect.Descriptor instead.
+func (*Search_RangeConfig) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 15}
 }
 
-func (x *Update_Request) GetVector() *Object_Vector {
+func (x *Search_RangeConfig) GetRequestId() string {
 	if x != nil {
-		return x.Vector
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-func (x *Update_Request) GetConfig() *Update_Config {
+func (x *Search_RangeConfig) GetRadius() float32 {
 	if x != nil {
-		return x.Config
+		return x.Radius
 	}
-	return nil
+	return 0
 }
 
-// Represent the multiple update request.
-type Update_MultiRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Represent the multiple update request content.
-	Requests      []*Update_Request `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Search_RangeConfig) GetEpsilon() float32 {
+	if x != nil {
+		return x.Epsilon
+	}
+	return 0
 }
 
-func (x *Update_MultiRequest) Reset() {
-	*x = Update_MultiRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Search_RangeConfig) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *Update_MultiRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Search_RangeConfig) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
 }
 
-func (*Update_MultiRequest) ProtoMessage() {}
-
-func (x *Update_MultiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[32]
+func (x *Search_RangeConfig) GetTimeout() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Timeout
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Update_MultiRequest.ProtoReflect.Descriptor instead.
-func (*Update_MultiRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 1}
+func (x *Search_RangeConfig) GetPredicate() string {
+	if x != nil {
+		return x.Predicate
+	}
+	return ""
 }
 
-func (x *Update_MultiRequest) GetRequests() []*Update_Request {
+func (x *Search_RangeConfig) GetCollection() string {
 	if x != nil {
-		return x.Requests
+		return x.Collection
 	}
-	return nil
+	return ""
 }
 
-// Represent the update binary object request.
-type Update_ObjectRequest struct {
+// Represent a page of the range search results.
+type Search_RangeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The binary object to be updated.
-	Object *Object_Blob `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	// The configuration of the update request.
-	Config *Update_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	// Filter target.
-	Vectorizer    *Filter_Target `protobuf:"bytes,3,opt,name=vectorizer,proto3" json:"vectorizer,omitempty"`
+	// The unique request ID.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Search results sorted by the distance and the ID.
+	Results []*Object_Distance `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	// The cursor of the next page. It is empty when there is no more result.
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// Whether the results may be incomplete, since the range search reached the max number of the results.
+	Partial       bool `protobuf:"varint,4,opt,name=partial,proto3" json:"partial,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Update_ObjectRequest) Reset() {
-	*x = Update_ObjectRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[33]
+func (x *Search_RangeResponse) Reset() {
+	*x = Search_RangeResponse{}
+	mi := &file_v1_payload_payload_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Update_ObjectRequest) String() string {
+func (x *Search_RangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Update_ObjectRequest) ProtoMessage() {}
+func (*Search_RangeResponse) ProtoMessage() {}
 
-func (x *Update_ObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[33]
+func (x *Search_RangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1838,56 +2141,65 @@ func (x *Update_ObjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Update_ObjectRequest.ProtoReflect.Descriptor instead.
-func (*Update_ObjectRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 2}
+// Deprecated: Use Search_RangeResponse.ProtoReflect.Descriptor instead.
+func (*Search_RangeResponse) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{0, 16}
 }
 
-func (x *Update_ObjectRequest) GetObject() *Object_Blob {
+func (x *Search_RangeResponse) GetRequestId() string {
 	if x != nil {
-		return x.Object
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-func (x *Update_ObjectRequest) GetConfig() *Update_Config {
+func (x *Search_RangeResponse) GetResults() []*Object_Distance {
 	if x != nil {
-		return x.Config
+		return x.Results
 	}
 	return nil
 }
 
-func (x *Update_ObjectRequest) GetVectorizer() *Filter_Target {
+func (x *Search_RangeResponse) GetNextCursor() string {
 	if x != nil {
-		return x.Vectorizer
+		return x.NextCursor
 	}
-	return nil
+	return ""
 }
 
-// Represent the multiple update binary object request.
-type Update_MultiObjectRequest struct {
+func (x *Search_RangeResponse) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+// Represent the target filter server.
+type Filter_Target struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Represent the multiple update object request content.
-	Requests      []*Update_ObjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// The target hostname.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// The target port.
+	Port          uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Update_MultiObjectRequest) Reset() {
-	*x = Update_MultiObjectRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[34]
+func (x *Filter_Target) Reset() {
+	*x = Filter_Target{}
+	mi := &file_v1_payload_payload_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Update_MultiObjectRequest) String() string {
+func (x *Filter_Target) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Update_MultiObjectRequest) ProtoMessage() {}
+func (*Filter_Target) ProtoMessage() {}
 
-func (x *Update_MultiObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[34]
+func (x *Filter_Target) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1898,113 +2210,49 @@ func (x *Update_MultiObjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Update_MultiObjectRequest.ProtoReflect.Descriptor instead.
-func (*Update_MultiObjectRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 3}
+// Deprecated: Use Filter_Target.ProtoReflect.Descriptor instead.
+func (*Filter_Target) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{1, 0}
 }
 
-func (x *Update_MultiObjectRequest) GetRequests() []*Update_ObjectRequest {
+func (x *Filter_Target) GetHost() string {
 	if x != nil {
-		return x.Requests
+		return x.Host
 	}
-	return nil
+	return ""
 }
 
-// Represent a vector meta data.
-type Update_TimestampRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The vector ID.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// timestamp represents when this vector inserted.
-	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// force represents forcefully update the timestamp.
-	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Update_TimestampRequest) Reset() {
-	*x = Update_TimestampRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[35]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Update_TimestampRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Update_TimestampRequest) ProtoMessage() {}
-
-func (x *Update_TimestampRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[35]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Update_TimestampRequest.ProtoReflect.Descriptor instead.
-func (*Update_TimestampRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 4}
-}
-
-func (x *Update_TimestampRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Update_TimestampRequest) GetTimestamp() int64 {
+func (x *Filter_Target) GetPort() uint32 {
 	if x != nil {
-		return x.Timestamp
+		return x.Port
 	}
 	return 0
 }
 
-func (x *Update_TimestampRequest) GetForce() bool {
-	if x != nil {
-		return x.Force
-	}
-	return false
-}
-
-// Represent the update configuration.
-type Update_Config struct {
+// Represent filter configuration.
+type Filter_Config struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// A flag to skip exist check during update operation.
-	SkipStrictExistCheck bool `protobuf:"varint,1,opt,name=skip_strict_exist_check,json=skipStrictExistCheck,proto3" json:"skip_strict_exist_check,omitempty"`
-	// Filter configuration.
-	Filters *Filter_Config `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
-	// Update timestamp.
-	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// A flag to disable balanced update (split remove -> insert operation)
-	// during update operation.
-	DisableBalancedUpdate bool `protobuf:"varint,4,opt,name=disable_balanced_update,json=disableBalancedUpdate,proto3" json:"disable_balanced_update,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// Represent the filter target configuration.
+	Targets       []*Filter_Target `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Update_Config) Reset() {
-	*x = Update_Config{}
-	mi := &file_v1_payload_payload_proto_msgTypes[36]
+func (x *Filter_Config) Reset() {
+	*x = Filter_Config{}
+	mi := &file_v1_payload_payload_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Update_Config) String() string {
+func (x *Filter_Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Update_Config) ProtoMessage() {}
+func (*Filter_Config) ProtoMessage() {}
 
-func (x *Update_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[36]
+func (x *Filter_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2015,65 +2263,44 @@ func (x *Update_Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Update_Config.ProtoReflect.Descriptor instead.
-func (*Update_Config) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 5}
-}
-
-func (x *Update_Config) GetSkipStrictExistCheck() bool {
-	if x != nil {
-		return x.SkipStrictExistCheck
-	}
-	return false
+// Deprecated: Use Filter_Config.ProtoReflect.Descriptor instead.
+func (*Filter_Config) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{1, 1}
 }
 
-func (x *Update_Config) GetFilters() *Filter_Config {
+func (x *Filter_Config) GetTargets() []*Filter_Target {
 	if x != nil {
-		return x.Filters
+		return x.Targets
 	}
 	return nil
 }
 
-func (x *Update_Config) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-func (x *Update_Config) GetDisableBalancedUpdate() bool {
-	if x != nil {
-		return x.DisableBalancedUpdate
-	}
-	return false
-}
-
-// Represent the upsert request.
-type Upsert_Request struct {
+// Represent the insert request.
+type Insert_Request struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The vector to be upserted.
+	// The vector to be inserted.
 	Vector *Object_Vector `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
-	// The configuration of the upsert request.
-	Config        *Upsert_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// The configuration of the insert request.
+	Config        *Insert_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Upsert_Request) Reset() {
-	*x = Upsert_Request{}
-	mi := &file_v1_payload_payload_proto_msgTypes[37]
+func (x *Insert_Request) Reset() {
+	*x = Insert_Request{}
+	mi := &file_v1_payload_payload_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Upsert_Request) String() string {
+func (x *Insert_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Upsert_Request) ProtoMessage() {}
+func (*Insert_Request) ProtoMessage() {}
 
-func (x *Upsert_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[37]
+func (x *Insert_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2084,49 +2311,49 @@ func (x *Upsert_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Upsert_Request.ProtoReflect.Descriptor instead.
-func (*Upsert_Request) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use Insert_Request.ProtoReflect.Descriptor instead.
+func (*Insert_Request) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{2, 0}
 }
 
-func (x *Upsert_Request) GetVector() *Object_Vector {
+func (x *Insert_Request) GetVector() *Object_Vector {
 	if x != nil {
 		return x.Vector
 	}
 	return nil
 }
 
-func (x *Upsert_Request) GetConfig() *Upsert_Config {
+func (x *Insert_Request) GetConfig() *Insert_Config {
 	if x != nil {
 		return x.Config
 	}
 	return nil
 }
 
-// Represent mthe ultiple upsert request.
-type Upsert_MultiRequest struct {
+// Represent the multiple insert request.
+type Insert_MultiRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Represent the multiple upsert request content.
-	Requests      []*Upsert_Request `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// Represent multiple insert request content.
+	Requests      []*Insert_Request `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Upsert_MultiRequest) Reset() {
-	*x = Upsert_MultiRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[38]
+func (x *Insert_MultiRequest) Reset() {
+	*x = Insert_MultiRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Upsert_MultiRequest) String() string {
+func (x *Insert_MultiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Upsert_MultiRequest) ProtoMessage() {}
+func (*Insert_MultiRequest) ProtoMessage() {}
 
-func (x *Upsert_MultiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[38]
+func (x *Insert_MultiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2137,46 +2364,46 @@ func (x *Upsert_MultiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Upsert_MultiRequest.ProtoReflect.Descriptor instead.
-func (*Upsert_MultiRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{4, 1}
+// Deprecated: Use Insert_MultiRequest.ProtoReflect.Descriptor instead.
+func (*Insert_MultiRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{2, 1}
 }
 
-func (x *Upsert_MultiRequest) GetRequests() []*Upsert_Request {
+func (x *Insert_MultiRequest) GetRequests() []*Insert_Request {
 	if x != nil {
 		return x.Requests
 	}
 	return nil
 }
 
-// Represent the upsert binary object request.
-type Upsert_ObjectRequest struct {
+// Represent the insert by binary object request.
+type Insert_ObjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The binary object to be upserted.
+	// The binary object to be inserted.
 	Object *Object_Blob `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	// The configuration of the upsert request.
-	Config *Upsert_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
-	// Filter target.
+	// The configuration of the insert request.
+	Config *Insert_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// Filter configurations.
 	Vectorizer    *Filter_Target `protobuf:"bytes,3,opt,name=vectorizer,proto3" json:"vectorizer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Upsert_ObjectRequest) Reset() {
-	*x = Upsert_ObjectRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[39]
+func (x *Insert_ObjectRequest) Reset() {
+	*x = Insert_ObjectRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Upsert_ObjectRequest) String() string {
+func (x *Insert_ObjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Upsert_ObjectRequest) ProtoMessage() {}
+func (*Insert_ObjectRequest) ProtoMessage() {}
 
-func (x *Upsert_ObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[39]
+func (x *Insert_ObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,56 +2414,56 @@ func (x *Upsert_ObjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Upsert_ObjectRequest.ProtoReflect.Descriptor instead.
-func (*Upsert_ObjectRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{4, 2}
+// Deprecated: Use Insert_ObjectRequest.ProtoReflect.Descriptor instead.
+func (*Insert_ObjectRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{2, 2}
 }
 
-func (x *Upsert_ObjectRequest) GetObject() *Object_Blob {
+func (x *Insert_ObjectRequest) GetObject() *Object_Blob {
 	if x != nil {
 		return x.Object
 	}
 	return nil
 }
 
-func (x *Upsert_ObjectRequest) GetConfig() *Upsert_Config {
+func (x *Insert_ObjectRequest) GetConfig() *Insert_Config {
 	if x != nil {
 		return x.Config
 	}
 	return nil
 }
 
-func (x *Upsert_ObjectRequest) GetVectorizer() *Filter_Target {
+func (x *Insert_ObjectRequest) GetVectorizer() *Filter_Target {
 	if x != nil {
 		return x.Vectorizer
 	}
 	return nil
 }
 
-// Represent the multiple upsert binary object request.
-type Upsert_MultiObjectRequest struct {
+// Represent the multiple insert by binary object request.
+type Insert_MultiObjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Represent the multiple upsert object request content.
-	Requests      []*Upsert_ObjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// Represent multiple insert by object content.
+	Requests      []*Insert_ObjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Upsert_MultiObjectRequest) Reset() {
-	*x = Upsert_MultiObjectRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[40]
+func (x *Insert_MultiObjectRequest) Reset() {
+	*x = Insert_MultiObjectRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Upsert_MultiObjectRequest) String() string {
+func (x *Insert_MultiObjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Upsert_MultiObjectRequest) ProtoMessage() {}
+func (*Insert_MultiObjectRequest) ProtoMessage() {}
 
-func (x *Upsert_MultiObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[40]
+func (x *Insert_MultiObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2247,49 +2474,50 @@ func (x *Upsert_MultiObjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Upsert_MultiObjectRequest.ProtoReflect.Descriptor instead.
-func (*Upsert_MultiObjectRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{4, 3}
+// Deprecated: Use Insert_MultiObjectRequest.ProtoReflect.Descriptor instead.
+func (*Insert_MultiObjectRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{2, 3}
 }
 
-func (x *Upsert_MultiObjectRequest) GetRequests() []*Upsert_ObjectRequest {
+func (x *Insert_MultiObjectRequest) GetRequests() []*Insert_ObjectRequest {
 	if x != nil {
 		return x.Requests
 	}
 	return nil
 }
 
-// Represent the upsert configuration.
-type Upsert_Config struct {
+// Represent insert configurations.
+type Insert_Config struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// A flag to skip exist check during upsert operation.
+	// A flag to skip exist check during insert operation.
 	SkipStrictExistCheck bool `protobuf:"varint,1,opt,name=skip_strict_exist_check,json=skipStrictExistCheck,proto3" json:"skip_strict_exist_check,omitempty"`
-	// Filter configuration.
+	// Filter configurations.
 	Filters *Filter_Config `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
-	// Upsert timestamp.
+	// Insert timestamp.
 	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// A flag to disable balanced update (split remove -> insert operation)
-	// during update operation.
-	DisableBalancedUpdate bool `protobuf:"varint,4,opt,name=disable_balanced_update,json=disableBalancedUpdate,proto3" json:"disable_balanced_update,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// The attributes of the vector to be inserted.
+	Attributes map[string]*Attribute_Value `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The collection to insert the vector into. The default collection is used when it is empty.
+	Collection    string `protobuf:"bytes,5,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Upsert_Config) Reset() {
-	*x = Upsert_Config{}
-	mi := &file_v1_payload_payload_proto_msgTypes[41]
+func (x *Insert_Config) Reset() {
+	*x = Insert_Config{}
+	mi := &file_v1_payload_payload_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Upsert_Config) String() string {
+func (x *Insert_Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Upsert_Config) ProtoMessage() {}
+func (*Insert_Config) ProtoMessage() {}
 
-func (x *Upsert_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[41]
+func (x *Insert_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2300,65 +2528,72 @@ func (x *Upsert_Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Upsert_Config.ProtoReflect.Descriptor instead.
-func (*Upsert_Config) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{4, 4}
+// Deprecated: Use Insert_Config.ProtoReflect.Descriptor instead.
+func (*Insert_Config) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{2, 4}
 }
 
-func (x *Upsert_Config) GetSkipStrictExistCheck() bool {
+func (x *Insert_Config) GetSkipStrictExistCheck() bool {
 	if x != nil {
 		return x.SkipStrictExistCheck
 	}
 	return false
 }
 
-func (x *Upsert_Config) GetFilters() *Filter_Config {
+func (x *Insert_Config) GetFilters() *Filter_Config {
 	if x != nil {
 		return x.Filters
 	}
 	return nil
 }
 
-func (x *Upsert_Config) GetTimestamp() int64 {
+func (x *Insert_Config) GetTimestamp() int64 {
 	if x != nil {
 		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *Upsert_Config) GetDisableBalancedUpdate() bool {
+func (x *Insert_Config) GetAttributes() map[string]*Attribute_Value {
 	if x != nil {
-		return x.DisableBalancedUpdate
+		return x.Attributes
 	}
-	return false
+	return nil
 }
 
-// Represent the remove request.
-type Remove_Request struct {
+func (x *Insert_Config) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+// Represent the update request.
+type Update_Request struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The object ID to be removed.
-	Id *Object_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The configuration of the remove request.
-	Config        *Remove_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// The vector to be updated.
+	Vector *Object_Vector `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
+	// The configuration of the update request.
+	Config        *Update_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Remove_Request) Reset() {
-	*x = Remove_Request{}
-	mi := &file_v1_payload_payload_proto_msgTypes[42]
+func (x *Update_Request) Reset() {
+	*x = Update_Request{}
+	mi := &file_v1_payload_payload_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Remove_Request) String() string {
+func (x *Update_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Remove_Request) ProtoMessage() {}
+func (*Update_Request) ProtoMessage() {}
 
-func (x *Remove_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[42]
+func (x *Update_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2369,49 +2604,49 @@ func (x *Remove_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Remove_Request.ProtoReflect.Descriptor instead.
-func (*Remove_Request) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use Update_Request.ProtoReflect.Descriptor instead.
+func (*Update_Request) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 0}
 }
 
-func (x *Remove_Request) GetId() *Object_ID {
+func (x *Update_Request) GetVector() *Object_Vector {
 	if x != nil {
-		return x.Id
+		return x.Vector
 	}
 	return nil
 }
 
-func (x *Remove_Request) GetConfig() *Remove_Config {
+func (x *Update_Request) GetConfig() *Update_Config {
 	if x != nil {
 		return x.Config
 	}
 	return nil
 }
 
-// Represent the multiple remove request.
-type Remove_MultiRequest struct {
+// Represent the multiple update request.
+type Update_MultiRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Represent the multiple remove request content.
-	Requests      []*Remove_Request `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	// Represent the multiple update request content.
+	Requests      []*Update_Request `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Remove_MultiRequest) Reset() {
-	*x = Remove_MultiRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[43]
+func (x *Update_MultiRequest) Reset() {
+	*x = Update_MultiRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Remove_MultiRequest) String() string {
+func (x *Update_MultiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Remove_MultiRequest) ProtoMessage() {}
+func (*Update_MultiRequest) ProtoMessage() {}
 
-func (x *Remove_MultiRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[43]
+func (x *Update_MultiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2422,43 +2657,46 @@ func (x *Remove_MultiRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Remove_MultiRequest.ProtoReflect.Descriptor instead.
-func (*Remove_MultiRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{5, 1}
+// Deprecated: Use Update_MultiRequest.ProtoReflect.Descriptor instead.
+func (*Update_MultiRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 1}
 }
 
-func (x *Remove_MultiRequest) GetRequests() []*Remove_Request {
+func (x *Update_MultiRequest) GetRequests() []*Update_Request {
 	if x != nil {
 		return x.Requests
 	}
 	return nil
 }
 
-// Represent the remove request based on timestamp.
-type Remove_TimestampRequest struct {
+// Represent the update binary object request.
+type Update_ObjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The timestamp comparison list. If more than one is specified, the `AND`
-	// search is applied.
-	Timestamps    []*Remove_Timestamp `protobuf:"bytes,1,rep,name=timestamps,proto3" json:"timestamps,omitempty"`
+	// The binary object to be updated.
+	Object *Object_Blob `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// The configuration of the update request.
+	Config *Update_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// Filter target.
+	Vectorizer    *Filter_Target `protobuf:"bytes,3,opt,name=vectorizer,proto3" json:"vectorizer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Remove_TimestampRequest) Reset() {
-	*x = Remove_TimestampRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[44]
+func (x *Update_ObjectRequest) Reset() {
+	*x = Update_ObjectRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Remove_TimestampRequest) String() string {
+func (x *Update_ObjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Remove_TimestampRequest) ProtoMessage() {}
+func (*Update_ObjectRequest) ProtoMessage() {}
 
-func (x *Remove_TimestampRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[44]
+func (x *Update_ObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2469,44 +2707,56 @@ func (x *Remove_TimestampRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Remove_TimestampRequest.ProtoReflect.Descriptor instead.
-func (*Remove_TimestampRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{5, 2}
+// Deprecated: Use Update_ObjectRequest.ProtoReflect.Descriptor instead.
+func (*Update_ObjectRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 2}
 }
 
-func (x *Remove_TimestampRequest) GetTimestamps() []*Remove_Timestamp {
+func (x *Update_ObjectRequest) GetObject() *Object_Blob {
 	if x != nil {
-		return x.Timestamps
+		return x.Object
 	}
 	return nil
 }
 
-// Represent the timestamp comparison.
-type Remove_Timestamp struct {
+func (x *Update_ObjectRequest) GetConfig() *Update_Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Update_ObjectRequest) GetVectorizer() *Filter_Target {
+	if x != nil {
+		return x.Vectorizer
+	}
+	return nil
+}
+
+// Represent the multiple update binary object request.
+type Update_MultiObjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The timestamp.
-	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// The conditional operator.
-	Operator      Remove_Timestamp_Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=payload.v1.Remove_Timestamp_Operator" json:"operator,omitempty"`
+	// Represent the multiple update object request content.
+	Requests      []*Update_ObjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Remove_Timestamp) Reset() {
-	*x = Remove_Timestamp{}
-	mi := &file_v1_payload_payload_proto_msgTypes[45]
+func (x *Update_MultiObjectRequest) Reset() {
+	*x = Update_MultiObjectRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Remove_Timestamp) String() string {
+func (x *Update_MultiObjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Remove_Timestamp) ProtoMessage() {}
+func (*Update_MultiObjectRequest) ProtoMessage() {}
 
-func (x *Remove_Timestamp) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[45]
+func (x *Update_MultiObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2517,51 +2767,48 @@ func (x *Remove_Timestamp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Remove_Timestamp.ProtoReflect.Descriptor instead.
-func (*Remove_Timestamp) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{5, 3}
-}
-
-func (x *Remove_Timestamp) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
+// Deprecated: Use Update_MultiObjectRequest.ProtoReflect.Descriptor instead.
+func (*Update_MultiObjectRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 3}
 }
 
-func (x *Remove_Timestamp) GetOperator() Remove_Timestamp_Operator {
+func (x *Update_MultiObjectRequest) GetRequests() []*Update_ObjectRequest {
 	if x != nil {
-		return x.Operator
+		return x.Requests
 	}
-	return Remove_Timestamp_EQ
+	return nil
 }
 
-// Represent the remove configuration.
-type Remove_Config struct {
+// Represent a vector meta data.
+type Update_TimestampRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// A flag to skip exist check during upsert operation.
-	SkipStrictExistCheck bool `protobuf:"varint,1,opt,name=skip_strict_exist_check,json=skipStrictExistCheck,proto3" json:"skip_strict_exist_check,omitempty"`
-	// Remove timestamp.
-	Timestamp     int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The vector ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// timestamp represents when this vector inserted.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// force represents forcefully update the timestamp.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// The collection to update the timestamp in. The default collection is used when it is empty.
+	Collection    string `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Remove_Config) Reset() {
-	*x = Remove_Config{}
-	mi := &file_v1_payload_payload_proto_msgTypes[46]
+func (x *Update_TimestampRequest) Reset() {
+	*x = Update_TimestampRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Remove_Config) String() string {
+func (x *Update_TimestampRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Remove_Config) ProtoMessage() {}
+func (*Update_TimestampRequest) ProtoMessage() {}
 
-func (x *Remove_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[46]
+func (x *Update_TimestampRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2572,46 +2819,74 @@ func (x *Remove_Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Remove_Config.ProtoReflect.Descriptor instead.
-func (*Remove_Config) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{5, 4}
+// Deprecated: Use Update_TimestampRequest.ProtoReflect.Descriptor instead.
+func (*Update_TimestampRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 4}
 }
 
-func (x *Remove_Config) GetSkipStrictExistCheck() bool {
+func (x *Update_TimestampRequest) GetId() string {
 	if x != nil {
-		return x.SkipStrictExistCheck
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *Remove_Config) GetTimestamp() int64 {
+func (x *Update_TimestampRequest) GetTimestamp() int64 {
 	if x != nil {
 		return x.Timestamp
 	}
 	return 0
 }
 
-type Flush_Request struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Update_TimestampRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
 }
 
-func (x *Flush_Request) Reset() {
-	*x = Flush_Request{}
-	mi := &file_v1_payload_payload_proto_msgTypes[47]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Update_TimestampRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
 }
 
-func (x *Flush_Request) String() string {
+// Represent the update configuration.
+type Update_Config struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A flag to skip exist check during update operation.
+	SkipStrictExistCheck bool `protobuf:"varint,1,opt,name=skip_strict_exist_check,json=skipStrictExistCheck,proto3" json:"skip_strict_exist_check,omitempty"`
+	// Filter configuration.
+	Filters *Filter_Config `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	// Update timestamp.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// A flag to disable balanced update (split remove -> insert operation)
+	// during update operation.
+	DisableBalancedUpdate bool `protobuf:"varint,4,opt,name=disable_balanced_update,json=disableBalancedUpdate,proto3" json:"disable_balanced_update,omitempty"`
+	// The attributes of the vector to be updated.
+	Attributes map[string]*Attribute_Value `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The collection to update the vector in. The default collection is used when it is empty.
+	Collection    string `protobuf:"bytes,6,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Update_Config) Reset() {
+	*x = Update_Config{}
+	mi := &file_v1_payload_payload_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Update_Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Flush_Request) ProtoMessage() {}
+func (*Update_Config) ProtoMessage() {}
 
-func (x *Flush_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[47]
+func (x *Update_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2622,36 +2897,78 @@ func (x *Flush_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Flush_Request.ProtoReflect.Descriptor instead.
-func (*Flush_Request) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use Update_Config.ProtoReflect.Descriptor instead.
+func (*Update_Config) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{3, 5}
 }
 
-// Represent a request to fetch raw vector.
-type Object_VectorRequest struct {
+func (x *Update_Config) GetSkipStrictExistCheck() bool {
+	if x != nil {
+		return x.SkipStrictExistCheck
+	}
+	return false
+}
+
+func (x *Update_Config) GetFilters() *Filter_Config {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *Update_Config) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Update_Config) GetDisableBalancedUpdate() bool {
+	if x != nil {
+		return x.DisableBalancedUpdate
+	}
+	return false
+}
+
+func (x *Update_Config) GetAttributes() map[string]*Attribute_Value {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Update_Config) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+// Represent the upsert request.
+type Upsert_Request struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The vector ID to be fetched.
-	Id *Object_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Filter configurations.
-	Filters       *Filter_Config `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	// The vector to be upserted.
+	Vector *Object_Vector `protobuf:"bytes,1,opt,name=vector,proto3" json:"vector,omitempty"`
+	// The configuration of the upsert request.
+	Config        *Upsert_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_VectorRequest) Reset() {
-	*x = Object_VectorRequest{}
+func (x *Upsert_Request) Reset() {
+	*x = Upsert_Request{}
 	mi := &file_v1_payload_payload_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_VectorRequest) String() string {
+func (x *Upsert_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_VectorRequest) ProtoMessage() {}
+func (*Upsert_Request) ProtoMessage() {}
 
-func (x *Object_VectorRequest) ProtoReflect() protoreflect.Message {
+func (x *Upsert_Request) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2663,50 +2980,48 @@ func (x *Object_VectorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_VectorRequest.ProtoReflect.Descriptor instead.
-func (*Object_VectorRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use Upsert_Request.ProtoReflect.Descriptor instead.
+func (*Upsert_Request) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{4, 0}
 }
 
-func (x *Object_VectorRequest) GetId() *Object_ID {
+func (x *Upsert_Request) GetVector() *Object_Vector {
 	if x != nil {
-		return x.Id
+		return x.Vector
 	}
 	return nil
 }
 
-func (x *Object_VectorRequest) GetFilters() *Filter_Config {
+func (x *Upsert_Request) GetConfig() *Upsert_Config {
 	if x != nil {
-		return x.Filters
+		return x.Config
 	}
 	return nil
 }
 
-// Represent the ID and distance pair.
-type Object_Distance struct {
+// Represent mthe ultiple upsert request.
+type Upsert_MultiRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The vector ID.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The distance.
-	Distance      float32 `protobuf:"fixed32,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	// Represent the multiple upsert request content.
+	Requests      []*Upsert_Request `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_Distance) Reset() {
-	*x = Object_Distance{}
+func (x *Upsert_MultiRequest) Reset() {
+	*x = Upsert_MultiRequest{}
 	mi := &file_v1_payload_payload_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_Distance) String() string {
+func (x *Upsert_MultiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_Distance) ProtoMessage() {}
+func (*Upsert_MultiRequest) ProtoMessage() {}
 
-func (x *Object_Distance) ProtoReflect() protoreflect.Message {
+func (x *Upsert_MultiRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2718,51 +3033,45 @@ func (x *Object_Distance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_Distance.ProtoReflect.Descriptor instead.
-func (*Object_Distance) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 1}
-}
-
-func (x *Object_Distance) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use Upsert_MultiRequest.ProtoReflect.Descriptor instead.
+func (*Upsert_MultiRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{4, 1}
 }
 
-func (x *Object_Distance) GetDistance() float32 {
+func (x *Upsert_MultiRequest) GetRequests() []*Upsert_Request {
 	if x != nil {
-		return x.Distance
+		return x.Requests
 	}
-	return 0
+	return nil
 }
 
-// Represent stream response of distances.
-type Object_StreamDistance struct {
+// Represent the upsert binary object request.
+type Upsert_ObjectRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*Object_StreamDistance_Distance
-	//	*Object_StreamDistance_Status
-	Payload       isObject_StreamDistance_Payload `protobuf_oneof:"payload"`
+	// The binary object to be upserted.
+	Object *Object_Blob `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// The configuration of the upsert request.
+	Config *Upsert_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// Filter target.
+	Vectorizer    *Filter_Target `protobuf:"bytes,3,opt,name=vectorizer,proto3" json:"vectorizer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_StreamDistance) Reset() {
-	*x = Object_StreamDistance{}
+func (x *Upsert_ObjectRequest) Reset() {
+	*x = Upsert_ObjectRequest{}
 	mi := &file_v1_payload_payload_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_StreamDistance) String() string {
+func (x *Upsert_ObjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_StreamDistance) ProtoMessage() {}
+func (*Upsert_ObjectRequest) ProtoMessage() {}
 
-func (x *Object_StreamDistance) ProtoReflect() protoreflect.Message {
+func (x *Upsert_ObjectRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2774,76 +3083,55 @@ func (x *Object_StreamDistance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_StreamDistance.ProtoReflect.Descriptor instead.
-func (*Object_StreamDistance) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 2}
+// Deprecated: Use Upsert_ObjectRequest.ProtoReflect.Descriptor instead.
+func (*Upsert_ObjectRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{4, 2}
 }
 
-func (x *Object_StreamDistance) GetPayload() isObject_StreamDistance_Payload {
+func (x *Upsert_ObjectRequest) GetObject() *Object_Blob {
 	if x != nil {
-		return x.Payload
+		return x.Object
 	}
 	return nil
 }
 
-func (x *Object_StreamDistance) GetDistance() *Object_Distance {
+func (x *Upsert_ObjectRequest) GetConfig() *Upsert_Config {
 	if x != nil {
-		if x, ok := x.Payload.(*Object_StreamDistance_Distance); ok {
-			return x.Distance
-		}
+		return x.Config
 	}
 	return nil
 }
 
-func (x *Object_StreamDistance) GetStatus() *status.Status {
+func (x *Upsert_ObjectRequest) GetVectorizer() *Filter_Target {
 	if x != nil {
-		if x, ok := x.Payload.(*Object_StreamDistance_Status); ok {
-			return x.Status
-		}
+		return x.Vectorizer
 	}
 	return nil
 }
 
-type isObject_StreamDistance_Payload interface {
-	isObject_StreamDistance_Payload()
-}
-
-type Object_StreamDistance_Distance struct {
-	// The distance.
-	Distance *Object_Distance `protobuf:"bytes,1,opt,name=distance,proto3,oneof"`
-}
-
-type Object_StreamDistance_Status struct {
-	// The RPC error status.
-	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
-}
-
-func (*Object_StreamDistance_Distance) isObject_StreamDistance_Payload() {}
-
-func (*Object_StreamDistance_Status) isObject_StreamDistance_Payload() {}
-
-// Represent the vector ID.
-type Object_ID struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+// Represent the multiple upsert binary object request.
+type Upsert_MultiObjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Represent the multiple upsert object request content.
+	Requests      []*Upsert_ObjectRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_ID) Reset() {
-	*x = Object_ID{}
+func (x *Upsert_MultiObjectRequest) Reset() {
+	*x = Upsert_MultiObjectRequest{}
 	mi := &file_v1_payload_payload_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_ID) String() string {
+func (x *Upsert_MultiObjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_ID) ProtoMessage() {}
+func (*Upsert_MultiObjectRequest) ProtoMessage() {}
 
-func (x *Object_ID) ProtoReflect() protoreflect.Message {
+func (x *Upsert_MultiObjectRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2855,40 +3143,52 @@ func (x *Object_ID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_ID.ProtoReflect.Descriptor instead.
-func (*Object_ID) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 3}
+// Deprecated: Use Upsert_MultiObjectRequest.ProtoReflect.Descriptor instead.
+func (*Upsert_MultiObjectRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{4, 3}
 }
 
-func (x *Object_ID) GetId() string {
+func (x *Upsert_MultiObjectRequest) GetRequests() []*Upsert_ObjectRequest {
 	if x != nil {
-		return x.Id
+		return x.Requests
 	}
-	return ""
-}
-
-// Represent multiple vector IDs.
-type Object_IDs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *Object_IDs) Reset() {
-	*x = Object_IDs{}
+// Represent the upsert configuration.
+type Upsert_Config struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A flag to skip exist check during upsert operation.
+	SkipStrictExistCheck bool `protobuf:"varint,1,opt,name=skip_strict_exist_check,json=skipStrictExistCheck,proto3" json:"skip_strict_exist_check,omitempty"`
+	// Filter configuration.
+	Filters *Filter_Config `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	// Upsert timestamp.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// A flag to disable balanced update (split remove -> insert operation)
+	// during update operation.
+	DisableBalancedUpdate bool `protobuf:"varint,4,opt,name=disable_balanced_update,json=disableBalancedUpdate,proto3" json:"disable_balanced_update,omitempty"`
+	// The attributes of the vector to be upserted.
+	Attributes map[string]*Attribute_Value `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The collection to upsert the vector into. The default collection is used when it is empty.
+	Collection    string `protobuf:"bytes,6,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Upsert_Config) Reset() {
+	*x = Upsert_Config{}
 	mi := &file_v1_payload_payload_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_IDs) String() string {
+func (x *Upsert_Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_IDs) ProtoMessage() {}
+func (*Upsert_Config) ProtoMessage() {}
 
-func (x *Object_IDs) ProtoReflect() protoreflect.Message {
+func (x *Upsert_Config) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_payload_payload_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2900,46 +3200,79 @@ func (x *Object_IDs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_IDs.ProtoReflect.Descriptor instead.
-func (*Object_IDs) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 4}
+// Deprecated: Use Upsert_Config.ProtoReflect.Descriptor instead.
+func (*Upsert_Config) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{4, 4}
 }
 
-func (x *Object_IDs) GetIds() []string {
+func (x *Upsert_Config) GetSkipStrictExistCheck() bool {
 	if x != nil {
-		return x.Ids
+		return x.SkipStrictExistCheck
+	}
+	return false
+}
+
+func (x *Upsert_Config) GetFilters() *Filter_Config {
+	if x != nil {
+		return x.Filters
 	}
 	return nil
 }
 
-// Represent a vector.
-type Object_Vector struct {
+func (x *Upsert_Config) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Upsert_Config) GetDisableBalancedUpdate() bool {
+	if x != nil {
+		return x.DisableBalancedUpdate
+	}
+	return false
+}
+
+func (x *Upsert_Config) GetAttributes() map[string]*Attribute_Value {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Upsert_Config) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+// Represent the remove request.
+type Remove_Request struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The vector ID.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The vector.
-	Vector []float32 `protobuf:"fixed32,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
-	// timestamp represents when this vector inserted.
-	Timestamp     int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The object ID to be removed.
+	Id *Object_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The configuration of the remove request.
+	Config        *Remove_Config `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_Vector) Reset() {
-	*x = Object_Vector{}
-	mi := &file_v1_payload_payload_proto_msgTypes[53]
+func (x *Remove_Request) Reset() {
+	*x = Remove_Request{}
+	mi := &file_v1_payload_payload_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_Vector) String() string {
+func (x *Remove_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_Vector) ProtoMessage() {}
+func (*Remove_Request) ProtoMessage() {}
 
-func (x *Object_Vector) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[53]
+func (x *Remove_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2950,56 +3283,96 @@ func (x *Object_Vector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_Vector.ProtoReflect.Descriptor instead.
-func (*Object_Vector) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 5}
+// Deprecated: Use Remove_Request.ProtoReflect.Descriptor instead.
+func (*Remove_Request) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{5, 0}
 }
 
-func (x *Object_Vector) GetId() string {
+func (x *Remove_Request) GetId() *Object_ID {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return nil
 }
 
-func (x *Object_Vector) GetVector() []float32 {
+func (x *Remove_Request) GetConfig() *Remove_Config {
 	if x != nil {
-		return x.Vector
+		return x.Config
 	}
 	return nil
 }
 
-func (x *Object_Vector) GetTimestamp() int64 {
+// Represent the multiple remove request.
+type Remove_MultiRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Represent the multiple remove request content.
+	Requests      []*Remove_Request `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Remove_MultiRequest) Reset() {
+	*x = Remove_MultiRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Remove_MultiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Remove_MultiRequest) ProtoMessage() {}
+
+func (x *Remove_MultiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[55]
 	if x != nil {
-		return x.Timestamp
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// Represent a request to fetch vector meta data.
-type Object_TimestampRequest struct {
+// Deprecated: Use Remove_MultiRequest.ProtoReflect.Descriptor instead.
+func (*Remove_MultiRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *Remove_MultiRequest) GetRequests() []*Remove_Request {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// Represent the remove request based on timestamp.
+type Remove_TimestampRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The vector ID to be fetched.
-	Id            *Object_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The timestamp comparison list. If more than one is specified, the `AND`
+	// search is applied.
+	Timestamps    []*Remove_Timestamp `protobuf:"bytes,1,rep,name=timestamps,proto3" json:"timestamps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_TimestampRequest) Reset() {
-	*x = Object_TimestampRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[54]
+func (x *Remove_TimestampRequest) Reset() {
+	*x = Remove_TimestampRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_TimestampRequest) String() string {
+func (x *Remove_TimestampRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_TimestampRequest) ProtoMessage() {}
+func (*Remove_TimestampRequest) ProtoMessage() {}
 
-func (x *Object_TimestampRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[54]
+func (x *Remove_TimestampRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3010,44 +3383,44 @@ func (x *Object_TimestampRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_TimestampRequest.ProtoReflect.Descriptor instead.
-func (*Object_TimestampRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 6}
+// Deprecated: Use Remove_TimestampRequest.ProtoReflect.Descriptor instead.
+func (*Remove_TimestampRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{5, 2}
 }
 
-func (x *Object_TimestampRequest) GetId() *Object_ID {
+func (x *Remove_TimestampRequest) GetTimestamps() []*Remove_Timestamp {
 	if x != nil {
-		return x.Id
+		return x.Timestamps
 	}
 	return nil
 }
 
-// Represent a vector meta data.
-type Object_Timestamp struct {
+// Represent the timestamp comparison.
+type Remove_Timestamp struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The vector ID.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// timestamp represents when this vector inserted.
-	Timestamp     int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The timestamp.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The conditional operator.
+	Operator      Remove_Timestamp_Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=payload.v1.Remove_Timestamp_Operator" json:"operator,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_Timestamp) Reset() {
-	*x = Object_Timestamp{}
-	mi := &file_v1_payload_payload_proto_msgTypes[55]
+func (x *Remove_Timestamp) Reset() {
+	*x = Remove_Timestamp{}
+	mi := &file_v1_payload_payload_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_Timestamp) String() string {
+func (x *Remove_Timestamp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_Timestamp) ProtoMessage() {}
+func (*Remove_Timestamp) ProtoMessage() {}
 
-func (x *Object_Timestamp) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[55]
+func (x *Remove_Timestamp) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,48 +3431,1156 @@ func (x *Object_Timestamp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_Timestamp.ProtoReflect.Descriptor instead.
-func (*Object_Timestamp) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 7}
+// Deprecated: Use Remove_Timestamp.ProtoReflect.Descriptor instead.
+func (*Remove_Timestamp) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{5, 3}
 }
 
-func (x *Object_Timestamp) GetId() string {
+func (x *Remove_Timestamp) GetTimestamp() int64 {
 	if x != nil {
-		return x.Id
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *Object_Timestamp) GetTimestamp() int64 {
+func (x *Remove_Timestamp) GetOperator() Remove_Timestamp_Operator {
 	if x != nil {
-		return x.Timestamp
+		return x.Operator
 	}
-	return 0
+	return Remove_Timestamp_EQ
 }
 
-// Represent multiple vectors.
-type Object_Vectors struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Vectors       []*Object_Vector       `protobuf:"bytes,1,rep,name=vectors,proto3" json:"vectors,omitempty"`
+// Represent the remove configuration.
+type Remove_Config struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A flag to skip exist check during upsert operation.
+	SkipStrictExistCheck bool `protobuf:"varint,1,opt,name=skip_strict_exist_check,json=skipStrictExistCheck,proto3" json:"skip_strict_exist_check,omitempty"`
+	// Remove timestamp.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The collection to remove the vector from. The default collection is used when it is empty.
+	Collection    string `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Remove_Config) Reset() {
+	*x = Remove_Config{}
+	mi := &file_v1_payload_payload_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Remove_Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Remove_Config) ProtoMessage() {}
+
+func (x *Remove_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Remove_Config.ProtoReflect.Descriptor instead.
+func (*Remove_Config) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{5, 4}
+}
+
+func (x *Remove_Config) GetSkipStrictExistCheck() bool {
+	if x != nil {
+		return x.SkipStrictExistCheck
+	}
+	return false
+}
+
+func (x *Remove_Config) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Remove_Config) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+type Flush_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Flush_Request) Reset() {
+	*x = Flush_Request{}
+	mi := &file_v1_payload_payload_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Flush_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flush_Request) ProtoMessage() {}
+
+func (x *Flush_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flush_Request.ProtoReflect.Descriptor instead.
+func (*Flush_Request) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{6, 0}
+}
+
+// Represent a request to fetch raw vector.
+type Object_VectorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The vector ID to be fetched.
+	Id *Object_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Filter configurations.
+	Filters *Filter_Config `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	// The collection to fetch the vector from. The default collection is used when it is empty.
+	Collection    string `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_VectorRequest) Reset() {
+	*x = Object_VectorRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_VectorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_VectorRequest) ProtoMessage() {}
+
+func (x *Object_VectorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_VectorRequest.ProtoReflect.Descriptor instead.
+func (*Object_VectorRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *Object_VectorRequest) GetId() *Object_ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Object_VectorRequest) GetFilters() *Filter_Config {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *Object_VectorRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+// Represent the ID and distance pair.
+type Object_Distance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The vector ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The distance. It is the negated fused score when the fusion is enabled.
+	Distance float32 `protobuf:"fixed32,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	// The dense and sparse scores of the hybrid search result.
+	Hybrid        *Object_HybridScore `protobuf:"bytes,3,opt,name=hybrid,proto3" json:"hybrid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_Distance) Reset() {
+	*x = Object_Distance{}
+	mi := &file_v1_payload_payload_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_Distance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_Distance) ProtoMessage() {}
+
+func (x *Object_Distance) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_Distance.ProtoReflect.Descriptor instead.
+func (*Object_Distance) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *Object_Distance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Object_Distance) GetDistance() float32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *Object_Distance) GetHybrid() *Object_HybridScore {
+	if x != nil {
+		return x.Hybrid
+	}
+	return nil
+}
+
+// Represent the dense and sparse scores of the hybrid search result.
+type Object_HybridScore struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rank in the dense search results. 0 means it is not found by the dense search.
+	DenseRank uint32 `protobuf:"varint,1,opt,name=dense_rank,json=denseRank,proto3" json:"dense_rank,omitempty"`
+	// The distance of the dense search.
+	DenseDistance float32 `protobuf:"fixed32,2,opt,name=dense_distance,json=denseDistance,proto3" json:"dense_distance,omitempty"`
+	// The rank in the sparse search results. 0 means it is not found by the sparse search.
+	SparseRank uint32 `protobuf:"varint,3,opt,name=sparse_rank,json=sparseRank,proto3" json:"sparse_rank,omitempty"`
+	// The inner product score of the sparse search.
+	SparseScore   float32 `protobuf:"fixed32,4,opt,name=sparse_score,json=sparseScore,proto3" json:"sparse_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_HybridScore) Reset() {
+	*x = Object_HybridScore{}
+	mi := &file_v1_payload_payload_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_HybridScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_HybridScore) ProtoMessage() {}
+
+func (x *Object_HybridScore) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_HybridScore.ProtoReflect.Descriptor instead.
+func (*Object_HybridScore) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 2}
+}
+
+func (x *Object_HybridScore) GetDenseRank() uint32 {
+	if x != nil {
+		return x.DenseRank
+	}
+	return 0
+}
+
+func (x *Object_HybridScore) GetDenseDistance() float32 {
+	if x != nil {
+		return x.DenseDistance
+	}
+	return 0
+}
+
+func (x *Object_HybridScore) GetSparseRank() uint32 {
+	if x != nil {
+		return x.SparseRank
+	}
+	return 0
+}
+
+func (x *Object_HybridScore) GetSparseScore() float32 {
+	if x != nil {
+		return x.SparseScore
+	}
+	return 0
+}
+
+// Represent stream response of distances.
+type Object_StreamDistance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Object_StreamDistance_Distance
+	//	*Object_StreamDistance_Status
+	Payload       isObject_StreamDistance_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_StreamDistance) Reset() {
+	*x = Object_StreamDistance{}
+	mi := &file_v1_payload_payload_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_StreamDistance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_StreamDistance) ProtoMessage() {}
+
+func (x *Object_StreamDistance) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_StreamDistance.ProtoReflect.Descriptor instead.
+func (*Object_StreamDistance) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 3}
+}
+
+func (x *Object_StreamDistance) GetPayload() isObject_StreamDistance_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Object_StreamDistance) GetDistance() *Object_Distance {
+	if x != nil {
+		if x, ok := x.Payload.(*Object_StreamDistance_Distance); ok {
+			return x.Distance
+		}
+	}
+	return nil
+}
+
+func (x *Object_StreamDistance) GetStatus() *status.Status {
+	if x != nil {
+		if x, ok := x.Payload.(*Object_StreamDistance_Status); ok {
+			return x.Status
+		}
+	}
+	return nil
+}
+
+type isObject_StreamDistance_Payload interface {
+	isObject_StreamDistance_Payload()
+}
+
+type Object_StreamDistance_Distance struct {
+	// The distance.
+	Distance *Object_Distance `protobuf:"bytes,1,opt,name=distance,proto3,oneof"`
+}
+
+type Object_StreamDistance_Status struct {
+	// The RPC error status.
+	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+}
+
+func (*Object_StreamDistance_Distance) isObject_StreamDistance_Payload() {}
+
+func (*Object_StreamDistance_Status) isObject_StreamDistance_Payload() {}
+
+// Represent the vector ID.
+type Object_ID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_ID) Reset() {
+	*x = Object_ID{}
+	mi := &file_v1_payload_payload_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_ID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_ID) ProtoMessage() {}
+
+func (x *Object_ID) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_ID.ProtoReflect.Descriptor instead.
+func (*Object_ID) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 4}
+}
+
+func (x *Object_ID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Represent multiple vector IDs.
+type Object_IDs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_IDs) Reset() {
+	*x = Object_IDs{}
+	mi := &file_v1_payload_payload_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_IDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_IDs) ProtoMessage() {}
+
+func (x *Object_IDs) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_IDs.ProtoReflect.Descriptor instead.
+func (*Object_IDs) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 5}
+}
+
+func (x *Object_IDs) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Represent a vector.
+type Object_Vector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The vector ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The vector.
+	Vector []float32 `protobuf:"fixed32,2,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	// timestamp represents when this vector inserted.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The sparse vector.
+	Sparse *Object_SparseVector `protobuf:"bytes,4,opt,name=sparse,proto3" json:"sparse,omitempty"`
+	// The sub-vectors of the multi-vector document.
+	SubVectors []*Object_SubVector `protobuf:"bytes,5,rep,name=sub_vectors,json=subVectors,proto3" json:"sub_vectors,omitempty"`
+	// The attributes of the vector.
+	Attributes    map[string]*Attribute_Value `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_Vector) Reset() {
+	*x = Object_Vector{}
+	mi := &file_v1_payload_payload_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_Vector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_Vector) ProtoMessage() {}
+
+func (x *Object_Vector) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_Vector.ProtoReflect.Descriptor instead.
+func (*Object_Vector) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 6}
+}
+
+func (x *Object_Vector) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Object_Vector) GetVector() []float32 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+func (x *Object_Vector) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Object_Vector) GetSparse() *Object_SparseVector {
+	if x != nil {
+		return x.Sparse
+	}
+	return nil
+}
+
+func (x *Object_Vector) GetSubVectors() []*Object_SubVector {
+	if x != nil {
+		return x.SubVectors
+	}
+	return nil
+}
+
+func (x *Object_Vector) GetAttributes() map[string]*Attribute_Value {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// Represent a sub-vector of the multi-vector document.
+type Object_SubVector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The vector.
+	Vector        []float32 `protobuf:"fixed32,1,rep,packed,name=vector,proto3" json:"vector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_SubVector) Reset() {
+	*x = Object_SubVector{}
+	mi := &file_v1_payload_payload_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_SubVector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_SubVector) ProtoMessage() {}
+
+func (x *Object_SubVector) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_SubVector.ProtoReflect.Descriptor instead.
+func (*Object_SubVector) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 7}
+}
+
+func (x *Object_SubVector) GetVector() []float32 {
+	if x != nil {
+		return x.Vector
+	}
+	return nil
+}
+
+// Represent a sparse vector.
+type Object_SparseVector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The dimension indices of the non-zero values.
+	Indices []uint32 `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	// The non-zero values.
+	Values        []float32 `protobuf:"fixed32,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_SparseVector) Reset() {
+	*x = Object_SparseVector{}
+	mi := &file_v1_payload_payload_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_SparseVector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_SparseVector) ProtoMessage() {}
+
+func (x *Object_SparseVector) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_SparseVector.ProtoReflect.Descriptor instead.
+func (*Object_SparseVector) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 8}
+}
+
+func (x *Object_SparseVector) GetIndices() []uint32 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *Object_SparseVector) GetValues() []float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Represent a request to fetch vector meta data.
+type Object_TimestampRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The vector ID to be fetched.
+	Id *Object_ID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The collection to fetch the vector meta data from. The default collection is used when it is empty.
+	Collection    string `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_TimestampRequest) Reset() {
+	*x = Object_TimestampRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_TimestampRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_TimestampRequest) ProtoMessage() {}
+
+func (x *Object_TimestampRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_TimestampRequest.ProtoReflect.Descriptor instead.
+func (*Object_TimestampRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 9}
+}
+
+func (x *Object_TimestampRequest) GetId() *Object_ID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Object_TimestampRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+// Represent a vector meta data.
+type Object_Timestamp struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The vector ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// timestamp represents when this vector inserted.
+	Timestamp     int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_Timestamp) Reset() {
+	*x = Object_Timestamp{}
+	mi := &file_v1_payload_payload_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_Timestamp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_Timestamp) ProtoMessage() {}
+
+func (x *Object_Timestamp) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_Timestamp.ProtoReflect.Descriptor instead.
+func (*Object_Timestamp) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 10}
+}
+
+func (x *Object_Timestamp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Object_Timestamp) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// Represent multiple vectors.
+type Object_Vectors struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vectors       []*Object_Vector       `protobuf:"bytes,1,rep,name=vectors,proto3" json:"vectors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_Vectors) Reset() {
+	*x = Object_Vectors{}
+	mi := &file_v1_payload_payload_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_Vectors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_Vectors) ProtoMessage() {}
+
+func (x *Object_Vectors) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_Vectors.ProtoReflect.Descriptor instead.
+func (*Object_Vectors) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 11}
+}
+
+func (x *Object_Vectors) GetVectors() []*Object_Vector {
+	if x != nil {
+		return x.Vectors
+	}
+	return nil
+}
+
+// Represent stream response of the vector.
+type Object_StreamVector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Object_StreamVector_Vector
+	//	*Object_StreamVector_Status
+	Payload       isObject_StreamVector_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_StreamVector) Reset() {
+	*x = Object_StreamVector{}
+	mi := &file_v1_payload_payload_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_StreamVector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_StreamVector) ProtoMessage() {}
+
+func (x *Object_StreamVector) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_StreamVector.ProtoReflect.Descriptor instead.
+func (*Object_StreamVector) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 12}
+}
+
+func (x *Object_StreamVector) GetPayload() isObject_StreamVector_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Object_StreamVector) GetVector() *Object_Vector {
+	if x != nil {
+		if x, ok := x.Payload.(*Object_StreamVector_Vector); ok {
+			return x.Vector
+		}
+	}
+	return nil
+}
+
+func (x *Object_StreamVector) GetStatus() *status.Status {
+	if x != nil {
+		if x, ok := x.Payload.(*Object_StreamVector_Status); ok {
+			return x.Status
+		}
+	}
+	return nil
+}
+
+type isObject_StreamVector_Payload interface {
+	isObject_StreamVector_Payload()
+}
+
+type Object_StreamVector_Vector struct {
+	// The vector.
+	Vector *Object_Vector `protobuf:"bytes,1,opt,name=vector,proto3,oneof"`
+}
+
+type Object_StreamVector_Status struct {
+	// The RPC error status.
+	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+}
+
+func (*Object_StreamVector_Vector) isObject_StreamVector_Payload() {}
+
+func (*Object_StreamVector_Status) isObject_StreamVector_Payload() {}
+
+// Represent reshape vector.
+type Object_ReshapeVector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The binary object.
+	Object []byte `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// The new shape.
+	Shape         []int32 `protobuf:"varint,2,rep,packed,name=shape,proto3" json:"shape,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_ReshapeVector) Reset() {
+	*x = Object_ReshapeVector{}
+	mi := &file_v1_payload_payload_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_ReshapeVector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_ReshapeVector) ProtoMessage() {}
+
+func (x *Object_ReshapeVector) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_ReshapeVector.ProtoReflect.Descriptor instead.
+func (*Object_ReshapeVector) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 13}
+}
+
+func (x *Object_ReshapeVector) GetObject() []byte {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *Object_ReshapeVector) GetShape() []int32 {
+	if x != nil {
+		return x.Shape
+	}
+	return nil
+}
+
+// Represent the binary object.
+type Object_Blob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The object ID.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The binary object.
+	Object        []byte `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_Blob) Reset() {
+	*x = Object_Blob{}
+	mi := &file_v1_payload_payload_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_Blob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_Blob) ProtoMessage() {}
+
+func (x *Object_Blob) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_Blob.ProtoReflect.Descriptor instead.
+func (*Object_Blob) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 14}
+}
+
+func (x *Object_Blob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Object_Blob) GetObject() []byte {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+// Represent stream response of binary objects.
+type Object_StreamBlob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Object_StreamBlob_Blob
+	//	*Object_StreamBlob_Status
+	Payload       isObject_StreamBlob_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Object_StreamBlob) Reset() {
+	*x = Object_StreamBlob{}
+	mi := &file_v1_payload_payload_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Object_StreamBlob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Object_StreamBlob) ProtoMessage() {}
+
+func (x *Object_StreamBlob) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Object_StreamBlob.ProtoReflect.Descriptor instead.
+func (*Object_StreamBlob) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 15}
+}
+
+func (x *Object_StreamBlob) GetPayload() isObject_StreamBlob_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Object_StreamBlob) GetBlob() *Object_Blob {
+	if x != nil {
+		if x, ok := x.Payload.(*Object_StreamBlob_Blob); ok {
+			return x.Blob
+		}
+	}
+	return nil
+}
+
+func (x *Object_StreamBlob) GetStatus() *status.Status {
+	if x != nil {
+		if x, ok := x.Payload.(*Object_StreamBlob_Status); ok {
+			return x.Status
+		}
+	}
+	return nil
+}
+
+type isObject_StreamBlob_Payload interface {
+	isObject_StreamBlob_Payload()
+}
+
+type Object_StreamBlob_Blob struct {
+	// The binary object.
+	Blob *Object_Blob `protobuf:"bytes,1,opt,name=blob,proto3,oneof"`
+}
+
+type Object_StreamBlob_Status struct {
+	// The RPC error status.
+	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+}
+
+func (*Object_StreamBlob_Blob) isObject_StreamBlob_Payload() {}
+
+func (*Object_StreamBlob_Status) isObject_StreamBlob_Payload() {}
+
+// Represent the vector location.
+type Object_Location struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the location.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The UUID of the vector.
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The IP list.
+	Ips           []string `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_Vectors) Reset() {
-	*x = Object_Vectors{}
-	mi := &file_v1_payload_payload_proto_msgTypes[56]
+func (x *Object_Location) Reset() {
+	*x = Object_Location{}
+	mi := &file_v1_payload_payload_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_Vectors) String() string {
+func (x *Object_Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_Vectors) ProtoMessage() {}
+func (*Object_Location) ProtoMessage() {}
 
-func (x *Object_Vectors) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[56]
+func (x *Object_Location) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3110,45 +4591,59 @@ func (x *Object_Vectors) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_Vectors.ProtoReflect.Descriptor instead.
-func (*Object_Vectors) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 8}
+// Deprecated: Use Object_Location.ProtoReflect.Descriptor instead.
+func (*Object_Location) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 16}
 }
 
-func (x *Object_Vectors) GetVectors() []*Object_Vector {
+func (x *Object_Location) GetName() string {
 	if x != nil {
-		return x.Vectors
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Object_Location) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Object_Location) GetIps() []string {
+	if x != nil {
+		return x.Ips
 	}
 	return nil
 }
 
-// Represent stream response of the vector.
-type Object_StreamVector struct {
+// Represent the stream response of the vector location.
+type Object_StreamLocation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Payload:
 	//
-	//	*Object_StreamVector_Vector
-	//	*Object_StreamVector_Status
-	Payload       isObject_StreamVector_Payload `protobuf_oneof:"payload"`
+	//	*Object_StreamLocation_Location
+	//	*Object_StreamLocation_Status
+	Payload       isObject_StreamLocation_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_StreamVector) Reset() {
-	*x = Object_StreamVector{}
-	mi := &file_v1_payload_payload_proto_msgTypes[57]
+func (x *Object_StreamLocation) Reset() {
+	*x = Object_StreamLocation{}
+	mi := &file_v1_payload_payload_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_StreamVector) String() string {
+func (x *Object_StreamLocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_StreamVector) ProtoMessage() {}
+func (*Object_StreamLocation) ProtoMessage() {}
 
-func (x *Object_StreamVector) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[57]
+func (x *Object_StreamLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3159,80 +4654,77 @@ func (x *Object_StreamVector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_StreamVector.ProtoReflect.Descriptor instead.
-func (*Object_StreamVector) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 9}
+// Deprecated: Use Object_StreamLocation.ProtoReflect.Descriptor instead.
+func (*Object_StreamLocation) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 17}
 }
 
-func (x *Object_StreamVector) GetPayload() isObject_StreamVector_Payload {
+func (x *Object_StreamLocation) GetPayload() isObject_StreamLocation_Payload {
 	if x != nil {
 		return x.Payload
 	}
 	return nil
 }
 
-func (x *Object_StreamVector) GetVector() *Object_Vector {
+func (x *Object_StreamLocation) GetLocation() *Object_Location {
 	if x != nil {
-		if x, ok := x.Payload.(*Object_StreamVector_Vector); ok {
-			return x.Vector
+		if x, ok := x.Payload.(*Object_StreamLocation_Location); ok {
+			return x.Location
 		}
 	}
 	return nil
 }
 
-func (x *Object_StreamVector) GetStatus() *status.Status {
+func (x *Object_StreamLocation) GetStatus() *status.Status {
 	if x != nil {
-		if x, ok := x.Payload.(*Object_StreamVector_Status); ok {
+		if x, ok := x.Payload.(*Object_StreamLocation_Status); ok {
 			return x.Status
 		}
 	}
 	return nil
 }
 
-type isObject_StreamVector_Payload interface {
-	isObject_StreamVector_Payload()
+type isObject_StreamLocation_Payload interface {
+	isObject_StreamLocation_Payload()
 }
 
-type Object_StreamVector_Vector struct {
-	// The vector.
-	Vector *Object_Vector `protobuf:"bytes,1,opt,name=vector,proto3,oneof"`
+type Object_StreamLocation_Location struct {
+	// The vector location.
+	Location *Object_Location `protobuf:"bytes,1,opt,name=location,proto3,oneof"`
 }
 
-type Object_StreamVector_Status struct {
+type Object_StreamLocation_Status struct {
 	// The RPC error status.
 	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
 }
 
-func (*Object_StreamVector_Vector) isObject_StreamVector_Payload() {}
+func (*Object_StreamLocation_Location) isObject_StreamLocation_Payload() {}
 
-func (*Object_StreamVector_Status) isObject_StreamVector_Payload() {}
+func (*Object_StreamLocation_Status) isObject_StreamLocation_Payload() {}
 
-// Represent reshape vector.
-type Object_ReshapeVector struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The binary object.
-	Object []byte `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
-	// The new shape.
-	Shape         []int32 `protobuf:"varint,2,rep,packed,name=shape,proto3" json:"shape,omitempty"`
+// Represent multiple vector locations.
+type Object_Locations struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locations     []*Object_Location     `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_ReshapeVector) Reset() {
-	*x = Object_ReshapeVector{}
-	mi := &file_v1_payload_payload_proto_msgTypes[58]
+func (x *Object_Locations) Reset() {
+	*x = Object_Locations{}
+	mi := &file_v1_payload_payload_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_ReshapeVector) String() string {
+func (x *Object_Locations) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_ReshapeVector) ProtoMessage() {}
+func (*Object_Locations) ProtoMessage() {}
 
-func (x *Object_ReshapeVector) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[58]
+func (x *Object_Locations) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3243,51 +4735,40 @@ func (x *Object_ReshapeVector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_ReshapeVector.ProtoReflect.Descriptor instead.
-func (*Object_ReshapeVector) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 10}
-}
-
-func (x *Object_ReshapeVector) GetObject() []byte {
-	if x != nil {
-		return x.Object
-	}
-	return nil
+// Deprecated: Use Object_Locations.ProtoReflect.Descriptor instead.
+func (*Object_Locations) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 18}
 }
 
-func (x *Object_ReshapeVector) GetShape() []int32 {
+func (x *Object_Locations) GetLocations() []*Object_Location {
 	if x != nil {
-		return x.Shape
+		return x.Locations
 	}
 	return nil
 }
 
-// Represent the binary object.
-type Object_Blob struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The object ID.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The binary object.
-	Object        []byte `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+// Represent the list object vector stream request and response.
+type Object_List struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_Blob) Reset() {
-	*x = Object_Blob{}
-	mi := &file_v1_payload_payload_proto_msgTypes[59]
+func (x *Object_List) Reset() {
+	*x = Object_List{}
+	mi := &file_v1_payload_payload_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_Blob) String() string {
+func (x *Object_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_Blob) ProtoMessage() {}
+func (*Object_List) ProtoMessage() {}
 
-func (x *Object_Blob) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[59]
+func (x *Object_List) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3298,52 +4779,34 @@ func (x *Object_Blob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_Blob.ProtoReflect.Descriptor instead.
-func (*Object_Blob) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 11}
-}
-
-func (x *Object_Blob) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Object_Blob) GetObject() []byte {
-	if x != nil {
-		return x.Object
-	}
-	return nil
+// Deprecated: Use Object_List.ProtoReflect.Descriptor instead.
+func (*Object_List) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 19}
 }
 
-// Represent stream response of binary objects.
-type Object_StreamBlob struct {
+type Object_List_Request struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*Object_StreamBlob_Blob
-	//	*Object_StreamBlob_Status
-	Payload       isObject_StreamBlob_Payload `protobuf_oneof:"payload"`
+	// The collection to be listed. The default collection is listed when it is empty.
+	Collection    string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_StreamBlob) Reset() {
-	*x = Object_StreamBlob{}
-	mi := &file_v1_payload_payload_proto_msgTypes[60]
+func (x *Object_List_Request) Reset() {
+	*x = Object_List_Request{}
+	mi := &file_v1_payload_payload_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_StreamBlob) String() string {
+func (x *Object_List_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_StreamBlob) ProtoMessage() {}
+func (*Object_List_Request) ProtoMessage() {}
 
-func (x *Object_StreamBlob) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[60]
+func (x *Object_List_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3354,82 +4817,44 @@ func (x *Object_StreamBlob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_StreamBlob.ProtoReflect.Descriptor instead.
-func (*Object_StreamBlob) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 12}
-}
-
-func (x *Object_StreamBlob) GetPayload() isObject_StreamBlob_Payload {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
-
-func (x *Object_StreamBlob) GetBlob() *Object_Blob {
-	if x != nil {
-		if x, ok := x.Payload.(*Object_StreamBlob_Blob); ok {
-			return x.Blob
-		}
-	}
-	return nil
+// Deprecated: Use Object_List_Request.ProtoReflect.Descriptor instead.
+func (*Object_List_Request) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 19, 0}
 }
 
-func (x *Object_StreamBlob) GetStatus() *status.Status {
+func (x *Object_List_Request) GetCollection() string {
 	if x != nil {
-		if x, ok := x.Payload.(*Object_StreamBlob_Status); ok {
-			return x.Status
-		}
+		return x.Collection
 	}
-	return nil
-}
-
-type isObject_StreamBlob_Payload interface {
-	isObject_StreamBlob_Payload()
-}
-
-type Object_StreamBlob_Blob struct {
-	// The binary object.
-	Blob *Object_Blob `protobuf:"bytes,1,opt,name=blob,proto3,oneof"`
-}
-
-type Object_StreamBlob_Status struct {
-	// The RPC error status.
-	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+	return ""
 }
 
-func (*Object_StreamBlob_Blob) isObject_StreamBlob_Payload() {}
-
-func (*Object_StreamBlob_Status) isObject_StreamBlob_Payload() {}
-
-// Represent the vector location.
-type Object_Location struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the location.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The UUID of the vector.
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// The IP list.
-	Ips           []string `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
+type Object_List_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*Object_List_Response_Vector
+	//	*Object_List_Response_Status
+	Payload       isObject_List_Response_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_Location) Reset() {
-	*x = Object_Location{}
-	mi := &file_v1_payload_payload_proto_msgTypes[61]
+func (x *Object_List_Response) Reset() {
+	*x = Object_List_Response{}
+	mi := &file_v1_payload_payload_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_Location) String() string {
+func (x *Object_List_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_Location) ProtoMessage() {}
+func (*Object_List_Response) ProtoMessage() {}
 
-func (x *Object_Location) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[61]
+func (x *Object_List_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3440,59 +4865,83 @@ func (x *Object_Location) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_Location.ProtoReflect.Descriptor instead.
-func (*Object_Location) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 13}
+// Deprecated: Use Object_List_Response.ProtoReflect.Descriptor instead.
+func (*Object_List_Response) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 19, 1}
 }
 
-func (x *Object_Location) GetName() string {
+func (x *Object_List_Response) GetPayload() isObject_List_Response_Payload {
 	if x != nil {
-		return x.Name
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *Object_Location) GetUuid() string {
+func (x *Object_List_Response) GetVector() *Object_Vector {
 	if x != nil {
-		return x.Uuid
+		if x, ok := x.Payload.(*Object_List_Response_Vector); ok {
+			return x.Vector
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *Object_Location) GetIps() []string {
+func (x *Object_List_Response) GetStatus() *status.Status {
 	if x != nil {
-		return x.Ips
+		if x, ok := x.Payload.(*Object_List_Response_Status); ok {
+			return x.Status
+		}
 	}
 	return nil
 }
 
-// Represent the stream response of the vector location.
-type Object_StreamLocation struct {
+type isObject_List_Response_Payload interface {
+	isObject_List_Response_Payload()
+}
+
+type Object_List_Response_Vector struct {
+	// The vector
+	Vector *Object_Vector `protobuf:"bytes,1,opt,name=vector,proto3,oneof"`
+}
+
+type Object_List_Response_Status struct {
+	// The RPC error status.
+	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+}
+
+func (*Object_List_Response_Vector) isObject_List_Response_Payload() {}
+
+func (*Object_List_Response_Status) isObject_List_Response_Payload() {}
+
+// Represent a typed attribute value.
+type Attribute_Value struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
+	// Types that are valid to be assigned to Value:
 	//
-	//	*Object_StreamLocation_Location
-	//	*Object_StreamLocation_Status
-	Payload       isObject_StreamLocation_Payload `protobuf_oneof:"payload"`
+	//	*Attribute_Value_StringValue
+	//	*Attribute_Value_IntValue
+	//	*Attribute_Value_FloatValue
+	//	*Attribute_Value_BoolValue
+	Value         isAttribute_Value_Value `protobuf_oneof:"value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_StreamLocation) Reset() {
-	*x = Object_StreamLocation{}
-	mi := &file_v1_payload_payload_proto_msgTypes[62]
+func (x *Attribute_Value) Reset() {
+	*x = Attribute_Value{}
+	mi := &file_v1_payload_payload_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_StreamLocation) String() string {
+func (x *Attribute_Value) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_StreamLocation) ProtoMessage() {}
+func (*Attribute_Value) ProtoMessage() {}
 
-func (x *Object_StreamLocation) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[62]
+func (x *Attribute_Value) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3503,77 +4952,110 @@ func (x *Object_StreamLocation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_StreamLocation.ProtoReflect.Descriptor instead.
-func (*Object_StreamLocation) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 14}
+// Deprecated: Use Attribute_Value.ProtoReflect.Descriptor instead.
+func (*Attribute_Value) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{8, 0}
 }
 
-func (x *Object_StreamLocation) GetPayload() isObject_StreamLocation_Payload {
+func (x *Attribute_Value) GetValue() isAttribute_Value_Value {
 	if x != nil {
-		return x.Payload
+		return x.Value
 	}
 	return nil
 }
 
-func (x *Object_StreamLocation) GetLocation() *Object_Location {
+func (x *Attribute_Value) GetStringValue() string {
 	if x != nil {
-		if x, ok := x.Payload.(*Object_StreamLocation_Location); ok {
-			return x.Location
+		if x, ok := x.Value.(*Attribute_Value_StringValue); ok {
+			return x.StringValue
 		}
 	}
-	return nil
+	return ""
 }
 
-func (x *Object_StreamLocation) GetStatus() *status.Status {
+func (x *Attribute_Value) GetIntValue() int64 {
 	if x != nil {
-		if x, ok := x.Payload.(*Object_StreamLocation_Status); ok {
-			return x.Status
+		if x, ok := x.Value.(*Attribute_Value_IntValue); ok {
+			return x.IntValue
 		}
 	}
-	return nil
+	return 0
 }
 
-type isObject_StreamLocation_Payload interface {
-	isObject_StreamLocation_Payload()
+func (x *Attribute_Value) GetFloatValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*Attribute_Value_FloatValue); ok {
+			return x.FloatValue
+		}
+	}
+	return 0
 }
 
-type Object_StreamLocation_Location struct {
-	// The vector location.
-	Location *Object_Location `protobuf:"bytes,1,opt,name=location,proto3,oneof"`
+func (x *Attribute_Value) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*Attribute_Value_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
 }
 
-type Object_StreamLocation_Status struct {
-	// The RPC error status.
-	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+type isAttribute_Value_Value interface {
+	isAttribute_Value_Value()
 }
 
-func (*Object_StreamLocation_Location) isObject_StreamLocation_Payload() {}
+type Attribute_Value_StringValue struct {
+	// The string value.
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
 
-func (*Object_StreamLocation_Status) isObject_StreamLocation_Payload() {}
+type Attribute_Value_IntValue struct {
+	// The integer value.
+	IntValue int64 `protobuf:"varint,2,opt,name=int_value,json=intValue,proto3,oneof"`
+}
 
-// Represent multiple vector locations.
-type Object_Locations struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Locations     []*Object_Location     `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+type Attribute_Value_FloatValue struct {
+	// The floating point value.
+	FloatValue float64 `protobuf:"fixed64,3,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type Attribute_Value_BoolValue struct {
+	// The boolean value.
+	BoolValue bool `protobuf:"varint,4,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+func (*Attribute_Value_StringValue) isAttribute_Value_Value() {}
+
+func (*Attribute_Value_IntValue) isAttribute_Value_Value() {}
+
+func (*Attribute_Value_FloatValue) isAttribute_Value_Value() {}
+
+func (*Attribute_Value_BoolValue) isAttribute_Value_Value() {}
+
+// Represent the create index request.
+type Control_CreateIndexRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pool size of the create index operation.
+	PoolSize      uint32 `protobuf:"varint,1,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_Locations) Reset() {
-	*x = Object_Locations{}
-	mi := &file_v1_payload_payload_proto_msgTypes[63]
+func (x *Control_CreateIndexRequest) Reset() {
+	*x = Control_CreateIndexRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_Locations) String() string {
+func (x *Control_CreateIndexRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_Locations) ProtoMessage() {}
+func (*Control_CreateIndexRequest) ProtoMessage() {}
 
-func (x *Object_Locations) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[63]
+func (x *Control_CreateIndexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3584,40 +5066,46 @@ func (x *Object_Locations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_Locations.ProtoReflect.Descriptor instead.
-func (*Object_Locations) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 15}
+// Deprecated: Use Control_CreateIndexRequest.ProtoReflect.Descriptor instead.
+func (*Control_CreateIndexRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{9, 0}
 }
 
-func (x *Object_Locations) GetLocations() []*Object_Location {
+func (x *Control_CreateIndexRequest) GetPoolSize() uint32 {
 	if x != nil {
-		return x.Locations
+		return x.PoolSize
 	}
-	return nil
+	return 0
 }
 
-// Represent the list object vector stream request and response.
-type Object_List struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// Represent the dicoverer request.
+type Discoverer_Request struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The agent name to be discovered.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The namespace to be discovered.
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The node to be discovered.
+	Node          string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_List) Reset() {
-	*x = Object_List{}
-	mi := &file_v1_payload_payload_proto_msgTypes[64]
+func (x *Discoverer_Request) Reset() {
+	*x = Discoverer_Request{}
+	mi := &file_v1_payload_payload_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_List) String() string {
+func (x *Discoverer_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_List) ProtoMessage() {}
+func (*Discoverer_Request) ProtoMessage() {}
 
-func (x *Object_List) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[64]
+func (x *Discoverer_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3628,32 +5116,62 @@ func (x *Object_List) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_List.ProtoReflect.Descriptor instead.
-func (*Object_List) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 16}
+// Deprecated: Use Discoverer_Request.ProtoReflect.Descriptor instead.
+func (*Discoverer_Request) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0}
 }
 
-type Object_List_Request struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *Discoverer_Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Discoverer_Request) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Discoverer_Request) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+// Represent the collection configuration.
+type Collection_Config struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The collection name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The dimension of the vectors in the collection. The dimension of the default collection is used when it is 0.
+	Dimension int32 `protobuf:"varint,2,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	// The distance type of the collection. The distance type of the default collection is used when it is empty.
+	DistanceType string `protobuf:"bytes,3,opt,name=distance_type,json=distanceType,proto3" json:"distance_type,omitempty"`
+	// The object type of the collection. The object type of the default collection is used when it is empty.
+	ObjectType    string `protobuf:"bytes,4,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_List_Request) Reset() {
-	*x = Object_List_Request{}
-	mi := &file_v1_payload_payload_proto_msgTypes[65]
+func (x *Collection_Config) Reset() {
+	*x = Collection_Config{}
+	mi := &file_v1_payload_payload_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_List_Request) String() string {
+func (x *Collection_Config) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_List_Request) ProtoMessage() {}
+func (*Collection_Config) ProtoMessage() {}
 
-func (x *Object_List_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[65]
+func (x *Collection_Config) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3664,37 +5182,63 @@ func (x *Object_List_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_List_Request.ProtoReflect.Descriptor instead.
-func (*Object_List_Request) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 16, 0}
+// Deprecated: Use Collection_Config.ProtoReflect.Descriptor instead.
+func (*Collection_Config) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{11, 0}
 }
 
-type Object_List_Response struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Payload:
-	//
-	//	*Object_List_Response_Vector
-	//	*Object_List_Response_Status
-	Payload       isObject_List_Response_Payload `protobuf_oneof:"payload"`
+func (x *Collection_Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection_Config) GetDimension() int32 {
+	if x != nil {
+		return x.Dimension
+	}
+	return 0
+}
+
+func (x *Collection_Config) GetDistanceType() string {
+	if x != nil {
+		return x.DistanceType
+	}
+	return ""
+}
+
+func (x *Collection_Config) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+// Represent the create collection request.
+type Collection_CreateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The configuration of the collection to be created.
+	Config        *Collection_Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_List_Response) Reset() {
-	*x = Object_List_Response{}
-	mi := &file_v1_payload_payload_proto_msgTypes[66]
+func (x *Collection_CreateRequest) Reset() {
+	*x = Collection_CreateRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Object_List_Response) String() string {
+func (x *Collection_CreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Object_List_Response) ProtoMessage() {}
+func (*Collection_CreateRequest) ProtoMessage() {}
 
-func (x *Object_List_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[66]
+func (x *Collection_CreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3705,78 +5249,92 @@ func (x *Object_List_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Object_List_Response.ProtoReflect.Descriptor instead.
-func (*Object_List_Response) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{7, 16, 1}
+// Deprecated: Use Collection_CreateRequest.ProtoReflect.Descriptor instead.
+func (*Collection_CreateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{11, 1}
 }
 
-func (x *Object_List_Response) GetPayload() isObject_List_Response_Payload {
+func (x *Collection_CreateRequest) GetConfig() *Collection_Config {
 	if x != nil {
-		return x.Payload
+		return x.Config
 	}
 	return nil
 }
 
-func (x *Object_List_Response) GetVector() *Object_Vector {
-	if x != nil {
-		if x, ok := x.Payload.(*Object_List_Response_Vector); ok {
-			return x.Vector
-		}
-	}
-	return nil
+// Represent the drop collection request.
+type Collection_DropRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The collection name to be dropped.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Object_List_Response) GetStatus() *status.Status {
-	if x != nil {
-		if x, ok := x.Payload.(*Object_List_Response_Status); ok {
-			return x.Status
-		}
-	}
-	return nil
+func (x *Collection_DropRequest) Reset() {
+	*x = Collection_DropRequest{}
+	mi := &file_v1_payload_payload_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type isObject_List_Response_Payload interface {
-	isObject_List_Response_Payload()
+func (x *Collection_DropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Object_List_Response_Vector struct {
-	// The vector
-	Vector *Object_Vector `protobuf:"bytes,1,opt,name=vector,proto3,oneof"`
-}
+func (*Collection_DropRequest) ProtoMessage() {}
 
-type Object_List_Response_Status struct {
-	// The RPC error status.
-	Status *status.Status `protobuf:"bytes,2,opt,name=status,proto3,oneof"`
+func (x *Collection_DropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*Object_List_Response_Vector) isObject_List_Response_Payload() {}
+// Deprecated: Use Collection_DropRequest.ProtoReflect.Descriptor instead.
+func (*Collection_DropRequest) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{11, 2}
+}
 
-func (*Object_List_Response_Status) isObject_List_Response_Payload() {}
+func (x *Collection_DropRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-// Represent the create index request.
-type Control_CreateIndexRequest struct {
+// Represent the switch of the requests from a collection to another collection, or the fence of the write requests to a collection.
+type Collection_Switch struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The pool size of the create index operation.
-	PoolSize      uint32 `protobuf:"varint,1,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	// The collection name whose requests are switched or fenced. The default collection is used when it is empty.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The collection name which serves the requests. The switch is removed when it is empty.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// Whether the write requests to the collection are rejected. It is valid only when the target is empty.
+	Fence         bool `protobuf:"varint,3,opt,name=fence,proto3" json:"fence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Control_CreateIndexRequest) Reset() {
-	*x = Control_CreateIndexRequest{}
-	mi := &file_v1_payload_payload_proto_msgTypes[67]
+func (x *Collection_Switch) Reset() {
+	*x = Collection_Switch{}
+	mi := &file_v1_payload_payload_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Control_CreateIndexRequest) String() string {
+func (x *Collection_Switch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Control_CreateIndexRequest) ProtoMessage() {}
+func (*Collection_Switch) ProtoMessage() {}
 
-func (x *Control_CreateIndexRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[67]
+func (x *Collection_Switch) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3787,46 +5345,58 @@ func (x *Control_CreateIndexRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Control_CreateIndexRequest.ProtoReflect.Descriptor instead.
-func (*Control_CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use Collection_Switch.ProtoReflect.Descriptor instead.
+func (*Collection_Switch) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{11, 3}
 }
 
-func (x *Control_CreateIndexRequest) GetPoolSize() uint32 {
+func (x *Collection_Switch) GetName() string {
 	if x != nil {
-		return x.PoolSize
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-// Represent the dicoverer request.
-type Discoverer_Request struct {
+func (x *Collection_Switch) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Collection_Switch) GetFence() bool {
+	if x != nil {
+		return x.Fence
+	}
+	return false
+}
+
+// Represent the list of the collections.
+type Collection_List struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The agent name to be discovered.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The namespace to be discovered.
-	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	// The node to be discovered.
-	Node          string `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	// The configurations of the collections.
+	Collections []*Collection_Config `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	// The switches and the fences of the collections.
+	Switches      []*Collection_Switch `protobuf:"bytes,2,rep,name=switches,proto3" json:"switches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Discoverer_Request) Reset() {
-	*x = Discoverer_Request{}
-	mi := &file_v1_payload_payload_proto_msgTypes[68]
+func (x *Collection_List) Reset() {
+	*x = Collection_List{}
+	mi := &file_v1_payload_payload_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Discoverer_Request) String() string {
+func (x *Collection_List) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Discoverer_Request) ProtoMessage() {}
+func (*Collection_List) ProtoMessage() {}
 
-func (x *Discoverer_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[68]
+func (x *Collection_List) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_payload_payload_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3837,30 +5407,23 @@ func (x *Discoverer_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Discoverer_Request.ProtoReflect.Descriptor instead.
-func (*Discoverer_Request) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{9, 0}
-}
-
-func (x *Discoverer_Request) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use Collection_List.ProtoReflect.Descriptor instead.
+func (*Collection_List) Descriptor() ([]byte, []int) {
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{11, 4}
 }
 
-func (x *Discoverer_Request) GetNamespace() string {
+func (x *Collection_List) GetCollections() []*Collection_Config {
 	if x != nil {
-		return x.Namespace
+		return x.Collections
 	}
-	return ""
+	return nil
 }
 
-func (x *Discoverer_Request) GetNode() string {
+func (x *Collection_List) GetSwitches() []*Collection_Switch {
 	if x != nil {
-		return x.Node
+		return x.Switches
 	}
-	return ""
+	return nil
 }
 
 // Represent the index information messages.
@@ -3872,7 +5435,7 @@ type Info_Index struct {
 
 func (x *Info_Index) Reset() {
 	*x = Info_Index{}
-	mi := &file_v1_payload_payload_proto_msgTypes[69]
+	mi := &file_v1_payload_payload_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3884,7 +5447,7 @@ func (x *Info_Index) String() string {
 func (*Info_Index) ProtoMessage() {}
 
 func (x *Info_Index) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[69]
+	mi := &file_v1_payload_payload_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3897,7 +5460,7 @@ func (x *Info_Index) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Index.ProtoReflect.Descriptor instead.
 func (*Info_Index) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0}
 }
 
 // Represent the resource stats
@@ -3913,7 +5476,7 @@ type Info_ResourceStats struct {
 
 func (x *Info_ResourceStats) Reset() {
 	*x = Info_ResourceStats{}
-	mi := &file_v1_payload_payload_proto_msgTypes[70]
+	mi := &file_v1_payload_payload_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3925,7 +5488,7 @@ func (x *Info_ResourceStats) String() string {
 func (*Info_ResourceStats) ProtoMessage() {}
 
 func (x *Info_ResourceStats) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[70]
+	mi := &file_v1_payload_payload_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3938,7 +5501,7 @@ func (x *Info_ResourceStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_ResourceStats.ProtoReflect.Descriptor instead.
 func (*Info_ResourceStats) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 1}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 1}
 }
 
 func (x *Info_ResourceStats) GetName() string {
@@ -3978,7 +5541,7 @@ type Info_CgroupStats struct {
 
 func (x *Info_CgroupStats) Reset() {
 	*x = Info_CgroupStats{}
-	mi := &file_v1_payload_payload_proto_msgTypes[71]
+	mi := &file_v1_payload_payload_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3990,7 +5553,7 @@ func (x *Info_CgroupStats) String() string {
 func (*Info_CgroupStats) ProtoMessage() {}
 
 func (x *Info_CgroupStats) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[71]
+	mi := &file_v1_payload_payload_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4003,7 +5566,7 @@ func (x *Info_CgroupStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_CgroupStats.ProtoReflect.Descriptor instead.
 func (*Info_CgroupStats) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 2}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 2}
 }
 
 func (x *Info_CgroupStats) GetCpuLimitCores() float64 {
@@ -4057,7 +5620,7 @@ type Info_Pod struct {
 
 func (x *Info_Pod) Reset() {
 	*x = Info_Pod{}
-	mi := &file_v1_payload_payload_proto_msgTypes[72]
+	mi := &file_v1_payload_payload_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4069,7 +5632,7 @@ func (x *Info_Pod) String() string {
 func (*Info_Pod) ProtoMessage() {}
 
 func (x *Info_Pod) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[72]
+	mi := &file_v1_payload_payload_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4082,7 +5645,7 @@ func (x *Info_Pod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Pod.ProtoReflect.Descriptor instead.
 func (*Info_Pod) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 3}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 3}
 }
 
 func (x *Info_Pod) GetAppName() string {
@@ -4155,7 +5718,7 @@ type Info_Node struct {
 
 func (x *Info_Node) Reset() {
 	*x = Info_Node{}
-	mi := &file_v1_payload_payload_proto_msgTypes[73]
+	mi := &file_v1_payload_payload_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4167,7 +5730,7 @@ func (x *Info_Node) String() string {
 func (*Info_Node) ProtoMessage() {}
 
 func (x *Info_Node) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[73]
+	mi := &file_v1_payload_payload_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4180,7 +5743,7 @@ func (x *Info_Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Node.ProtoReflect.Descriptor instead.
 func (*Info_Node) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 4}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 4}
 }
 
 func (x *Info_Node) GetName() string {
@@ -4246,7 +5809,7 @@ type Info_Service struct {
 
 func (x *Info_Service) Reset() {
 	*x = Info_Service{}
-	mi := &file_v1_payload_payload_proto_msgTypes[74]
+	mi := &file_v1_payload_payload_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4258,7 +5821,7 @@ func (x *Info_Service) String() string {
 func (*Info_Service) ProtoMessage() {}
 
 func (x *Info_Service) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[74]
+	mi := &file_v1_payload_payload_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4271,7 +5834,7 @@ func (x *Info_Service) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Service.ProtoReflect.Descriptor instead.
 func (*Info_Service) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 5}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 5}
 }
 
 func (x *Info_Service) GetName() string {
@@ -4329,7 +5892,7 @@ type Info_ServicePort struct {
 
 func (x *Info_ServicePort) Reset() {
 	*x = Info_ServicePort{}
-	mi := &file_v1_payload_payload_proto_msgTypes[75]
+	mi := &file_v1_payload_payload_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4341,7 +5904,7 @@ func (x *Info_ServicePort) String() string {
 func (*Info_ServicePort) ProtoMessage() {}
 
 func (x *Info_ServicePort) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[75]
+	mi := &file_v1_payload_payload_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4354,7 +5917,7 @@ func (x *Info_ServicePort) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_ServicePort.ProtoReflect.Descriptor instead.
 func (*Info_ServicePort) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 6}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 6}
 }
 
 func (x *Info_ServicePort) GetName() string {
@@ -4381,7 +5944,7 @@ type Info_Labels struct {
 
 func (x *Info_Labels) Reset() {
 	*x = Info_Labels{}
-	mi := &file_v1_payload_payload_proto_msgTypes[76]
+	mi := &file_v1_payload_payload_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4393,7 +5956,7 @@ func (x *Info_Labels) String() string {
 func (*Info_Labels) ProtoMessage() {}
 
 func (x *Info_Labels) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[76]
+	mi := &file_v1_payload_payload_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4406,7 +5969,7 @@ func (x *Info_Labels) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Labels.ProtoReflect.Descriptor instead.
 func (*Info_Labels) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 7}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 7}
 }
 
 func (x *Info_Labels) GetLabels() map[string]string {
@@ -4426,7 +5989,7 @@ type Info_Annotations struct {
 
 func (x *Info_Annotations) Reset() {
 	*x = Info_Annotations{}
-	mi := &file_v1_payload_payload_proto_msgTypes[77]
+	mi := &file_v1_payload_payload_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4438,7 +6001,7 @@ func (x *Info_Annotations) String() string {
 func (*Info_Annotations) ProtoMessage() {}
 
 func (x *Info_Annotations) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[77]
+	mi := &file_v1_payload_payload_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4451,7 +6014,7 @@ func (x *Info_Annotations) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Annotations.ProtoReflect.Descriptor instead.
 func (*Info_Annotations) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 8}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 8}
 }
 
 func (x *Info_Annotations) GetAnnotations() map[string]string {
@@ -4476,7 +6039,7 @@ type Info_CPU struct {
 
 func (x *Info_CPU) Reset() {
 	*x = Info_CPU{}
-	mi := &file_v1_payload_payload_proto_msgTypes[78]
+	mi := &file_v1_payload_payload_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4488,7 +6051,7 @@ func (x *Info_CPU) String() string {
 func (*Info_CPU) ProtoMessage() {}
 
 func (x *Info_CPU) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[78]
+	mi := &file_v1_payload_payload_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4501,7 +6064,7 @@ func (x *Info_CPU) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_CPU.ProtoReflect.Descriptor instead.
 func (*Info_CPU) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 9}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 9}
 }
 
 func (x *Info_CPU) GetLimit() float64 {
@@ -4540,7 +6103,7 @@ type Info_Memory struct {
 
 func (x *Info_Memory) Reset() {
 	*x = Info_Memory{}
-	mi := &file_v1_payload_payload_proto_msgTypes[79]
+	mi := &file_v1_payload_payload_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4552,7 +6115,7 @@ func (x *Info_Memory) String() string {
 func (*Info_Memory) ProtoMessage() {}
 
 func (x *Info_Memory) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[79]
+	mi := &file_v1_payload_payload_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4565,7 +6128,7 @@ func (x *Info_Memory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Memory.ProtoReflect.Descriptor instead.
 func (*Info_Memory) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 10}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 10}
 }
 
 func (x *Info_Memory) GetLimit() float64 {
@@ -4600,7 +6163,7 @@ type Info_Pods struct {
 
 func (x *Info_Pods) Reset() {
 	*x = Info_Pods{}
-	mi := &file_v1_payload_payload_proto_msgTypes[80]
+	mi := &file_v1_payload_payload_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4612,7 +6175,7 @@ func (x *Info_Pods) String() string {
 func (*Info_Pods) ProtoMessage() {}
 
 func (x *Info_Pods) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[80]
+	mi := &file_v1_payload_payload_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4625,7 +6188,7 @@ func (x *Info_Pods) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Pods.ProtoReflect.Descriptor instead.
 func (*Info_Pods) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 11}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 11}
 }
 
 func (x *Info_Pods) GetPods() []*Info_Pod {
@@ -4646,7 +6209,7 @@ type Info_Nodes struct {
 
 func (x *Info_Nodes) Reset() {
 	*x = Info_Nodes{}
-	mi := &file_v1_payload_payload_proto_msgTypes[81]
+	mi := &file_v1_payload_payload_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4658,7 +6221,7 @@ func (x *Info_Nodes) String() string {
 func (*Info_Nodes) ProtoMessage() {}
 
 func (x *Info_Nodes) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[81]
+	mi := &file_v1_payload_payload_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4671,7 +6234,7 @@ func (x *Info_Nodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Nodes.ProtoReflect.Descriptor instead.
 func (*Info_Nodes) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 12}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 12}
 }
 
 func (x *Info_Nodes) GetNodes() []*Info_Node {
@@ -4692,7 +6255,7 @@ type Info_Services struct {
 
 func (x *Info_Services) Reset() {
 	*x = Info_Services{}
-	mi := &file_v1_payload_payload_proto_msgTypes[82]
+	mi := &file_v1_payload_payload_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4704,7 +6267,7 @@ func (x *Info_Services) String() string {
 func (*Info_Services) ProtoMessage() {}
 
 func (x *Info_Services) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[82]
+	mi := &file_v1_payload_payload_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4717,7 +6280,7 @@ func (x *Info_Services) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Services.ProtoReflect.Descriptor instead.
 func (*Info_Services) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 13}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 13}
 }
 
 func (x *Info_Services) GetServices() []*Info_Service {
@@ -4737,7 +6300,7 @@ type Info_IPs struct {
 
 func (x *Info_IPs) Reset() {
 	*x = Info_IPs{}
-	mi := &file_v1_payload_payload_proto_msgTypes[83]
+	mi := &file_v1_payload_payload_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4749,7 +6312,7 @@ func (x *Info_IPs) String() string {
 func (*Info_IPs) ProtoMessage() {}
 
 func (x *Info_IPs) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[83]
+	mi := &file_v1_payload_payload_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4762,7 +6325,7 @@ func (x *Info_IPs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_IPs.ProtoReflect.Descriptor instead.
 func (*Info_IPs) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 14}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 14}
 }
 
 func (x *Info_IPs) GetIp() []string {
@@ -4782,14 +6345,22 @@ type Info_Index_Count struct {
 	// The indexing index count.
 	Indexing bool `protobuf:"varint,3,opt,name=indexing,proto3" json:"indexing,omitempty"`
 	// The saving index count.
-	Saving        bool `protobuf:"varint,4,opt,name=saving,proto3" json:"saving,omitempty"`
+	Saving bool `protobuf:"varint,4,opt,name=saving,proto3" json:"saving,omitempty"`
+	// The index count for each named collection.
+	Collections map[string]*Info_Index_Count `protobuf:"bytes,5,rep,name=collections,proto3" json:"collections,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Whether the index is being flushed.
+	Flushing bool `protobuf:"varint,6,opt,name=flushing,proto3" json:"flushing,omitempty"`
+	// Whether the index is being loaded.
+	Loading bool `protobuf:"varint,7,opt,name=loading,proto3" json:"loading,omitempty"`
+	// The number of the broken index backups.
+	BrokenIndex   uint64 `protobuf:"varint,8,opt,name=broken_index,json=brokenIndex,proto3" json:"broken_index,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Info_Index_Count) Reset() {
 	*x = Info_Index_Count{}
-	mi := &file_v1_payload_payload_proto_msgTypes[84]
+	mi := &file_v1_payload_payload_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4801,7 +6372,7 @@ func (x *Info_Index_Count) String() string {
 func (*Info_Index_Count) ProtoMessage() {}
 
 func (x *Info_Index_Count) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[84]
+	mi := &file_v1_payload_payload_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4814,7 +6385,7 @@ func (x *Info_Index_Count) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Index_Count.ProtoReflect.Descriptor instead.
 func (*Info_Index_Count) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0, 0}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0, 0}
 }
 
 func (x *Info_Index_Count) GetStored() uint32 {
@@ -4845,6 +6416,34 @@ func (x *Info_Index_Count) GetSaving() bool {
 	return false
 }
 
+func (x *Info_Index_Count) GetCollections() map[string]*Info_Index_Count {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *Info_Index_Count) GetFlushing() bool {
+	if x != nil {
+		return x.Flushing
+	}
+	return false
+}
+
+func (x *Info_Index_Count) GetLoading() bool {
+	if x != nil {
+		return x.Loading
+	}
+	return false
+}
+
+func (x *Info_Index_Count) GetBrokenIndex() uint64 {
+	if x != nil {
+		return x.BrokenIndex
+	}
+	return 0
+}
+
 // Represent the index count for each Agents message.
 type Info_Index_Detail struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -4860,7 +6459,7 @@ type Info_Index_Detail struct {
 
 func (x *Info_Index_Detail) Reset() {
 	*x = Info_Index_Detail{}
-	mi := &file_v1_payload_payload_proto_msgTypes[85]
+	mi := &file_v1_payload_payload_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4872,7 +6471,7 @@ func (x *Info_Index_Detail) String() string {
 func (*Info_Index_Detail) ProtoMessage() {}
 
 func (x *Info_Index_Detail) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[85]
+	mi := &file_v1_payload_payload_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4885,7 +6484,7 @@ func (x *Info_Index_Detail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Index_Detail.ProtoReflect.Descriptor instead.
 func (*Info_Index_Detail) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0, 1}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0, 1}
 }
 
 func (x *Info_Index_Detail) GetCounts() map[string]*Info_Index_Count {
@@ -4918,7 +6517,7 @@ type Info_Index_UUID struct {
 
 func (x *Info_Index_UUID) Reset() {
 	*x = Info_Index_UUID{}
-	mi := &file_v1_payload_payload_proto_msgTypes[86]
+	mi := &file_v1_payload_payload_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4930,7 +6529,7 @@ func (x *Info_Index_UUID) String() string {
 func (*Info_Index_UUID) ProtoMessage() {}
 
 func (x *Info_Index_UUID) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[86]
+	mi := &file_v1_payload_payload_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4943,7 +6542,7 @@ func (x *Info_Index_UUID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Index_UUID.ProtoReflect.Descriptor instead.
 func (*Info_Index_UUID) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0, 2}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0, 2}
 }
 
 // Represents index Statistics
@@ -4988,7 +6587,7 @@ type Info_Index_Statistics struct {
 
 func (x *Info_Index_Statistics) Reset() {
 	*x = Info_Index_Statistics{}
-	mi := &file_v1_payload_payload_proto_msgTypes[87]
+	mi := &file_v1_payload_payload_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5000,7 +6599,7 @@ func (x *Info_Index_Statistics) String() string {
 func (*Info_Index_Statistics) ProtoMessage() {}
 
 func (x *Info_Index_Statistics) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[87]
+	mi := &file_v1_payload_payload_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5013,7 +6612,7 @@ func (x *Info_Index_Statistics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Index_Statistics.ProtoReflect.Descriptor instead.
 func (*Info_Index_Statistics) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0, 3}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0, 3}
 }
 
 func (x *Info_Index_Statistics) GetValid() bool {
@@ -5258,7 +6857,7 @@ type Info_Index_StatisticsDetail struct {
 
 func (x *Info_Index_StatisticsDetail) Reset() {
 	*x = Info_Index_StatisticsDetail{}
-	mi := &file_v1_payload_payload_proto_msgTypes[88]
+	mi := &file_v1_payload_payload_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5270,7 +6869,7 @@ func (x *Info_Index_StatisticsDetail) String() string {
 func (*Info_Index_StatisticsDetail) ProtoMessage() {}
 
 func (x *Info_Index_StatisticsDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[88]
+	mi := &file_v1_payload_payload_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5283,7 +6882,7 @@ func (x *Info_Index_StatisticsDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Index_StatisticsDetail.ProtoReflect.Descriptor instead.
 func (*Info_Index_StatisticsDetail) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0, 4}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0, 4}
 }
 
 func (x *Info_Index_StatisticsDetail) GetDetails() map[string]*Info_Index_Statistics {
@@ -5337,7 +6936,7 @@ type Info_Index_Property struct {
 
 func (x *Info_Index_Property) Reset() {
 	*x = Info_Index_Property{}
-	mi := &file_v1_payload_payload_proto_msgTypes[89]
+	mi := &file_v1_payload_payload_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5349,7 +6948,7 @@ func (x *Info_Index_Property) String() string {
 func (*Info_Index_Property) ProtoMessage() {}
 
 func (x *Info_Index_Property) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[89]
+	mi := &file_v1_payload_payload_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5362,7 +6961,7 @@ func (x *Info_Index_Property) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Index_Property.ProtoReflect.Descriptor instead.
 func (*Info_Index_Property) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0, 5}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0, 5}
 }
 
 func (x *Info_Index_Property) GetDimension() int32 {
@@ -5620,7 +7219,7 @@ type Info_Index_PropertyDetail struct {
 
 func (x *Info_Index_PropertyDetail) Reset() {
 	*x = Info_Index_PropertyDetail{}
-	mi := &file_v1_payload_payload_proto_msgTypes[90]
+	mi := &file_v1_payload_payload_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5632,7 +7231,7 @@ func (x *Info_Index_PropertyDetail) String() string {
 func (*Info_Index_PropertyDetail) ProtoMessage() {}
 
 func (x *Info_Index_PropertyDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[90]
+	mi := &file_v1_payload_payload_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5645,7 +7244,7 @@ func (x *Info_Index_PropertyDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Index_PropertyDetail.ProtoReflect.Descriptor instead.
 func (*Info_Index_PropertyDetail) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0, 6}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0, 6}
 }
 
 func (x *Info_Index_PropertyDetail) GetDetails() map[string]*Info_Index_Property {
@@ -5665,7 +7264,7 @@ type Info_Index_UUID_Committed struct {
 
 func (x *Info_Index_UUID_Committed) Reset() {
 	*x = Info_Index_UUID_Committed{}
-	mi := &file_v1_payload_payload_proto_msgTypes[92]
+	mi := &file_v1_payload_payload_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5677,7 +7276,7 @@ func (x *Info_Index_UUID_Committed) String() string {
 func (*Info_Index_UUID_Committed) ProtoMessage() {}
 
 func (x *Info_Index_UUID_Committed) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[92]
+	mi := &file_v1_payload_payload_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5690,7 +7289,7 @@ func (x *Info_Index_UUID_Committed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Index_UUID_Committed.ProtoReflect.Descriptor instead.
 func (*Info_Index_UUID_Committed) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0, 2, 0}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0, 2, 0}
 }
 
 func (x *Info_Index_UUID_Committed) GetUuid() string {
@@ -5710,7 +7309,7 @@ type Info_Index_UUID_Uncommitted struct {
 
 func (x *Info_Index_UUID_Uncommitted) Reset() {
 	*x = Info_Index_UUID_Uncommitted{}
-	mi := &file_v1_payload_payload_proto_msgTypes[93]
+	mi := &file_v1_payload_payload_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5722,7 +7321,7 @@ func (x *Info_Index_UUID_Uncommitted) String() string {
 func (*Info_Index_UUID_Uncommitted) ProtoMessage() {}
 
 func (x *Info_Index_UUID_Uncommitted) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[93]
+	mi := &file_v1_payload_payload_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5735,7 +7334,7 @@ func (x *Info_Index_UUID_Uncommitted) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Info_Index_UUID_Uncommitted.ProtoReflect.Descriptor instead.
 func (*Info_Index_UUID_Uncommitted) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{10, 0, 2, 1}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0, 2, 1}
 }
 
 func (x *Info_Index_UUID_Uncommitted) GetUuid() string {
@@ -5758,7 +7357,7 @@ type Mirror_Target struct {
 
 func (x *Mirror_Target) Reset() {
 	*x = Mirror_Target{}
-	mi := &file_v1_payload_payload_proto_msgTypes[98]
+	mi := &file_v1_payload_payload_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5770,7 +7369,7 @@ func (x *Mirror_Target) String() string {
 func (*Mirror_Target) ProtoMessage() {}
 
 func (x *Mirror_Target) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[98]
+	mi := &file_v1_payload_payload_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5783,7 +7382,7 @@ func (x *Mirror_Target) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mirror_Target.ProtoReflect.Descriptor instead.
 func (*Mirror_Target) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{11, 0}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *Mirror_Target) GetHost() string {
@@ -5811,7 +7410,7 @@ type Mirror_Targets struct {
 
 func (x *Mirror_Targets) Reset() {
 	*x = Mirror_Targets{}
-	mi := &file_v1_payload_payload_proto_msgTypes[99]
+	mi := &file_v1_payload_payload_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5823,7 +7422,7 @@ func (x *Mirror_Targets) String() string {
 func (*Mirror_Targets) ProtoMessage() {}
 
 func (x *Mirror_Targets) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[99]
+	mi := &file_v1_payload_payload_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5836,7 +7435,7 @@ func (x *Mirror_Targets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Mirror_Targets.ProtoReflect.Descriptor instead.
 func (*Mirror_Targets) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{11, 1}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{13, 1}
 }
 
 func (x *Mirror_Targets) GetTargets() []*Mirror_Target {
@@ -5855,7 +7454,7 @@ type Meta_Key struct {
 
 func (x *Meta_Key) Reset() {
 	*x = Meta_Key{}
-	mi := &file_v1_payload_payload_proto_msgTypes[100]
+	mi := &file_v1_payload_payload_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5867,7 +7466,7 @@ func (x *Meta_Key) String() string {
 func (*Meta_Key) ProtoMessage() {}
 
 func (x *Meta_Key) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[100]
+	mi := &file_v1_payload_payload_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5880,7 +7479,7 @@ func (x *Meta_Key) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Meta_Key.ProtoReflect.Descriptor instead.
 func (*Meta_Key) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 0}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *Meta_Key) GetKey() string {
@@ -5899,7 +7498,7 @@ type Meta_Value struct {
 
 func (x *Meta_Value) Reset() {
 	*x = Meta_Value{}
-	mi := &file_v1_payload_payload_proto_msgTypes[101]
+	mi := &file_v1_payload_payload_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5911,7 +7510,7 @@ func (x *Meta_Value) String() string {
 func (*Meta_Value) ProtoMessage() {}
 
 func (x *Meta_Value) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[101]
+	mi := &file_v1_payload_payload_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5924,7 +7523,7 @@ func (x *Meta_Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Meta_Value.ProtoReflect.Descriptor instead.
 func (*Meta_Value) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 1}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{14, 1}
 }
 
 func (x *Meta_Value) GetValue() *anypb.Any {
@@ -5944,7 +7543,7 @@ type Meta_KeyValue struct {
 
 func (x *Meta_KeyValue) Reset() {
 	*x = Meta_KeyValue{}
-	mi := &file_v1_payload_payload_proto_msgTypes[102]
+	mi := &file_v1_payload_payload_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5956,7 +7555,7 @@ func (x *Meta_KeyValue) String() string {
 func (*Meta_KeyValue) ProtoMessage() {}
 
 func (x *Meta_KeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_payload_payload_proto_msgTypes[102]
+	mi := &file_v1_payload_payload_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5969,7 +7568,7 @@ func (x *Meta_KeyValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Meta_KeyValue.ProtoReflect.Descriptor instead.
 func (*Meta_KeyValue) Descriptor() ([]byte, []int) {
-	return file_v1_payload_payload_proto_rawDescGZIP(), []int{12, 2}
+	return file_v1_payload_payload_proto_rawDescGZIP(), []int{14, 2}
 }
 
 func (x *Meta_KeyValue) GetKey() *Meta_Key {
@@ -5991,11 +7590,14 @@ var File_v1_payload_payload_proto protoreflect.FileDescriptor
 const file_v1_payload_payload_proto_rawDesc = "" +
 	"\n" +
 	"\x18v1/payload/payload.proto\x12\n" +
-	"payload.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x17google/rpc/status.proto\"\xd5\v\n" +
-	"\x06Search\x1a^\n" +
+	"payload.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x17google/rpc/status.proto\"\xea\x1a\n" +
+	"\x06Search\x1a\xd6\x01\n" +
 	"\aRequest\x12 \n" +
 	"\x06vector\x18\x01 \x03(\x02B\b\xbaH\x05\x92\x01\x02\b\x02R\x06vector\x121\n" +
-	"\x06config\x18\x02 \x01(\v2\x19.payload.v1.Search.ConfigR\x06config\x1aF\n" +
+	"\x06config\x18\x02 \x01(\v2\x19.payload.v1.Search.ConfigR\x06config\x127\n" +
+	"\x06sparse\x18\x03 \x01(\v2\x1f.payload.v1.Object.SparseVectorR\x06sparse\x12=\n" +
+	"\vsub_vectors\x18\x04 \x03(\v2\x1c.payload.v1.Object.SubVectorR\n" +
+	"subVectors\x1aF\n" +
 	"\fMultiRequest\x126\n" +
 	"\brequests\x18\x01 \x03(\v2\x1a.payload.v1.Search.RequestR\brequests\x1aN\n" +
 	"\tIDRequest\x12\x0e\n" +
@@ -6010,7 +7612,7 @@ const file_v1_payload_payload_proto_rawDesc = "" +
 	"vectorizer\x18\x03 \x01(\v2\x19.payload.v1.Filter.TargetR\n" +
 	"vectorizer\x1aR\n" +
 	"\x12MultiObjectRequest\x12<\n" +
-	"\brequests\x18\x01 \x03(\v2 .payload.v1.Search.ObjectRequestR\brequests\x1a\xfc\x03\n" +
+	"\brequests\x18\x01 \x03(\v2 .payload.v1.Search.ObjectRequestR\brequests\x1a\xee\a\n" +
 	"\x06Config\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x19\n" +
@@ -6025,29 +7627,112 @@ const file_v1_payload_payload_proto_rawDesc = "" +
 	"\x05ratio\x18\n" +
 	" \x01(\v2\x1b.google.protobuf.FloatValueR\x05ratio\x12\x16\n" +
 	"\x06nprobe\x18\v \x01(\rR\x06nprobe\x12\x1b\n" +
-	"\tedge_size\x18\f \x01(\x05R\bedgeSize\x1a`\n" +
+	"\tedge_size\x18\f \x01(\x05R\bedgeSize\x12\x1c\n" +
+	"\tpredicate\x18\r \x01(\tR\tpredicate\x121\n" +
+	"\x06fusion\x18\x0e \x01(\v2\x19.payload.v1.Search.FusionR\x06fusion\x12c\n" +
+	"\x18multi_vector_aggregation\x18\x0f \x01(\x0e2).payload.v1.Search.MultiVectorAggregationR\x16multiVectorAggregation\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x10 \x01(\tR\n" +
+	"collection\x12\x1f\n" +
+	"\vwith_cursor\x18\x11 \x01(\bR\n" +
+	"withCursor\x121\n" +
+	"\x06rerank\x18\x12 \x01(\v2\x19.payload.v1.Search.RerankR\x06rerank\x12(\n" +
+	"\x03mmr\x18\x13 \x01(\v2\x16.payload.v1.Search.MMRR\x03mmr\x12@\n" +
+	"\vconsistency\x18\x14 \x01(\x0e2\x1e.payload.v1.Search.ConsistencyR\vconsistency\x12*\n" +
+	"\x0eafter_distance\x18\x15 \x01(\x02H\x00R\rafterDistance\x88\x01\x01\x12\x1b\n" +
+	"\tafter_ids\x18\x16 \x03(\tR\bafterIdsB\x11\n" +
+	"\x0f_after_distance\x1aD\n" +
+	"\x06Rerank\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12 \n" +
+	"\x05ratio\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00R\x05ratio\x1ax\n" +
+	"\x03MMR\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12,\n" +
+	"\x06lambda\x18\x02 \x01(\x02B\x0f\xbaH\f\n" +
+	"\n" +
+	"\x1d\x00\x00\x80?-\x00\x00\x00\x00H\x00R\x06lambda\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"candidates\x18\x03 \x01(\rR\n" +
+	"candidatesB\t\n" +
+	"\a_lambda\x1a\xaf\x02\n" +
+	"\x06Fusion\x12A\n" +
+	"\talgorithm\x18\x01 \x01(\x0e2#.payload.v1.Search.Fusion.AlgorithmR\talgorithm\x122\n" +
+	"\fdense_weight\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00H\x00R\vdenseWeight\x88\x01\x01\x124\n" +
+	"\rsparse_weight\x18\x03 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05-\x00\x00\x00\x00H\x01R\fsparseWeight\x88\x01\x01\x12#\n" +
+	"\rrank_constant\x18\x04 \x01(\rR\frankConstant\"0\n" +
+	"\tAlgorithm\x12\f\n" +
+	"\bDisabled\x10\x00\x12\a\n" +
+	"\x03RRF\x10\x01\x12\f\n" +
+	"\bWeighted\x10\x02B\x0f\n" +
+	"\r_dense_weightB\x10\n" +
+	"\x0e_sparse_weight\x1a\xde\x01\n" +
 	"\bResponse\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x125\n" +
-	"\aresults\x18\x02 \x03(\v2\x1b.payload.v1.Object.DistanceR\aresults\x1aF\n" +
+	"\aresults\x18\x02 \x03(\v2\x1b.payload.v1.Object.DistanceR\aresults\x12\x1a\n" +
+	"\breranked\x18\x03 \x01(\bR\breranked\x12\x1f\n" +
+	"\vnext_cursor\x18\x04 \x01(\tR\n" +
+	"nextCursor\x12%\n" +
+	"\x0eskipped_agents\x18\x05 \x03(\tR\rskippedAgents\x12\x18\n" +
+	"\apartial\x18\x06 \x01(\bR\apartial\x1a.\n" +
+	"\vNextRequest\x12\x1f\n" +
+	"\x06cursor\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06cursor\x1aF\n" +
 	"\tResponses\x129\n" +
 	"\tresponses\x18\x01 \x03(\v2\x1b.payload.v1.Search.ResponseR\tresponses\x1a\x84\x01\n" +
 	"\x0eStreamResponse\x129\n" +
 	"\bresponse\x18\x01 \x01(\v2\x1b.payload.v1.Search.ResponseH\x00R\bresponse\x12,\n" +
 	"\x06status\x18\x02 \x01(\v2\x12.google.rpc.StatusH\x00R\x06statusB\t\n" +
-	"\apayload\"k\n" +
+	"\apayload\x1ah\n" +
+	"\fRangeRequest\x12 \n" +
+	"\x06vector\x18\x01 \x03(\x02B\b\xbaH\x05\x92\x01\x02\b\x02R\x06vector\x126\n" +
+	"\x06config\x18\x02 \x01(\v2\x1e.payload.v1.Search.RangeConfigR\x06config\x1a\xf7\x01\n" +
+	"\vRangeConfig\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\"\n" +
+	"\x06radius\x18\x02 \x01(\x02B\n" +
+	"\xbaH\a\n" +
+	"\x05%\x00\x00\x00\x00R\x06radius\x12\x18\n" +
+	"\aepsilon\x18\x03 \x01(\x02R\aepsilon\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x18\n" +
+	"\atimeout\x18\x06 \x01(\x03R\atimeout\x12\x1c\n" +
+	"\tpredicate\x18\a \x01(\tR\tpredicate\x12\x1e\n" +
+	"\n" +
+	"collection\x18\b \x01(\tR\n" +
+	"collection\x1a\xa0\x01\n" +
+	"\rRangeResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x125\n" +
+	"\aresults\x18\x02 \x03(\v2\x1b.payload.v1.Object.DistanceR\aresults\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\x12\x18\n" +
+	"\apartial\x18\x04 \x01(\bR\apartial\"k\n" +
 	"\x14AggregationAlgorithm\x12\v\n" +
 	"\aUnknown\x10\x00\x12\x13\n" +
 	"\x0fConcurrentQueue\x10\x01\x12\r\n" +
 	"\tSortSlice\x10\x02\x12\x11\n" +
 	"\rSortPoolSlice\x10\x03\x12\x0f\n" +
-	"\vPairingHeap\x10\x04\"y\n" +
+	"\vPairingHeap\x10\x04\"O\n" +
+	"\x16MultiVectorAggregation\x12\x16\n" +
+	"\x12MultiVectorUnknown\x10\x00\x12\n" +
+	"\n" +
+	"\x06MaxSim\x10\x01\x12\a\n" +
+	"\x03Max\x10\x02\x12\b\n" +
+	"\x04Mean\x10\x03\"/\n" +
+	"\vConsistency\x12\f\n" +
+	"\bEventual\x10\x00\x12\x12\n" +
+	"\x0eReadYourWrites\x10\x01\"y\n" +
 	"\x06Filter\x1a0\n" +
 	"\x06Target\x12\x12\n" +
 	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\rR\x04port\x1a=\n" +
 	"\x06Config\x123\n" +
-	"\atargets\x18\x01 \x03(\v2\x19.payload.v1.Filter.TargetR\atargets\"\xe5\x04\n" +
+	"\atargets\x18\x01 \x03(\v2\x19.payload.v1.Filter.TargetR\atargets\"\xac\x06\n" +
 	"\x06Insert\x1ay\n" +
 	"\aRequest\x12;\n" +
 	"\x06vector\x18\x01 \x01(\v2\x19.payload.v1.Object.VectorB\b\xbaH\x05\x92\x01\x02\b\x02R\x06vector\x121\n" +
@@ -6061,11 +7746,20 @@ const file_v1_payload_payload_proto_rawDesc = "" +
 	"vectorizer\x18\x03 \x01(\v2\x19.payload.v1.Filter.TargetR\n" +
 	"vectorizer\x1aR\n" +
 	"\x12MultiObjectRequest\x12<\n" +
-	"\brequests\x18\x01 \x03(\v2 .payload.v1.Insert.ObjectRequestR\brequests\x1a\x92\x01\n" +
+	"\brequests\x18\x01 \x03(\v2 .payload.v1.Insert.ObjectRequestR\brequests\x1a\xd9\x02\n" +
 	"\x06Config\x125\n" +
 	"\x17skip_strict_exist_check\x18\x01 \x01(\bR\x14skipStrictExistCheck\x123\n" +
 	"\afilters\x18\x02 \x01(\v2\x19.payload.v1.Filter.ConfigR\afilters\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"\xfe\x05\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12I\n" +
+	"\n" +
+	"attributes\x18\x04 \x03(\v2).payload.v1.Insert.Config.AttributesEntryR\n" +
+	"attributes\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x05 \x01(\tR\n" +
+	"collection\x1aZ\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
+	"\x05value\x18\x02 \x01(\v2\x1b.payload.v1.Attribute.ValueR\x05value:\x028\x01\"\xe5\a\n" +
 	"\x06Update\x1ay\n" +
 	"\aRequest\x12;\n" +
 	"\x06vector\x18\x01 \x01(\v2\x19.payload.v1.Object.VectorB\b\xbaH\x05\x92\x01\x02\b\x02R\x06vector\x121\n" +
@@ -6079,16 +7773,28 @@ const file_v1_payload_payload_proto_rawDesc = "" +
 	"vectorizer\x18\x03 \x01(\v2\x19.payload.v1.Filter.TargetR\n" +
 	"vectorizer\x1aR\n" +
 	"\x12MultiObjectRequest\x12<\n" +
-	"\brequests\x18\x01 \x03(\v2 .payload.v1.Update.ObjectRequestR\brequests\x1a_\n" +
+	"\brequests\x18\x01 \x03(\v2 .payload.v1.Update.ObjectRequestR\brequests\x1a\x7f\n" +
 	"\x10TimestampRequest\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1c\n" +
 	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x14\n" +
-	"\x05force\x18\x03 \x01(\bR\x05force\x1a\xca\x01\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x04 \x01(\tR\n" +
+	"collection\x1a\x91\x03\n" +
 	"\x06Config\x125\n" +
 	"\x17skip_strict_exist_check\x18\x01 \x01(\bR\x14skipStrictExistCheck\x123\n" +
 	"\afilters\x18\x02 \x01(\v2\x19.payload.v1.Filter.ConfigR\afilters\x12\x1c\n" +
 	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x126\n" +
-	"\x17disable_balanced_update\x18\x04 \x01(\bR\x15disableBalancedUpdate\"\x9d\x05\n" +
+	"\x17disable_balanced_update\x18\x04 \x01(\bR\x15disableBalancedUpdate\x12I\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2).payload.v1.Update.Config.AttributesEntryR\n" +
+	"attributes\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x06 \x01(\tR\n" +
+	"collection\x1aZ\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
+	"\x05value\x18\x02 \x01(\v2\x1b.payload.v1.Attribute.ValueR\x05value:\x028\x01\"\xe4\x06\n" +
 	"\x06Upsert\x1ay\n" +
 	"\aRequest\x12;\n" +
 	"\x06vector\x18\x01 \x01(\v2\x19.payload.v1.Object.VectorB\b\xbaH\x05\x92\x01\x02\b\x02R\x06vector\x121\n" +
@@ -6102,12 +7808,21 @@ const file_v1_payload_payload_proto_rawDesc = "" +
 	"vectorizer\x18\x03 \x01(\v2\x19.payload.v1.Filter.TargetR\n" +
 	"vectorizer\x1aR\n" +
 	"\x12MultiObjectRequest\x12<\n" +
-	"\brequests\x18\x01 \x03(\v2 .payload.v1.Upsert.ObjectRequestR\brequests\x1a\xca\x01\n" +
+	"\brequests\x18\x01 \x03(\v2 .payload.v1.Upsert.ObjectRequestR\brequests\x1a\x91\x03\n" +
 	"\x06Config\x125\n" +
 	"\x17skip_strict_exist_check\x18\x01 \x01(\bR\x14skipStrictExistCheck\x123\n" +
 	"\afilters\x18\x02 \x01(\v2\x19.payload.v1.Filter.ConfigR\afilters\x12\x1c\n" +
 	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x126\n" +
-	"\x17disable_balanced_update\x18\x04 \x01(\bR\x15disableBalancedUpdate\"\x91\x04\n" +
+	"\x17disable_balanced_update\x18\x04 \x01(\bR\x15disableBalancedUpdate\x12I\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2).payload.v1.Upsert.Config.AttributesEntryR\n" +
+	"attributes\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x06 \x01(\tR\n" +
+	"collection\x1aZ\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
+	"\x05value\x18\x02 \x01(\v2\x1b.payload.v1.Attribute.ValueR\x05value:\x028\x01\"\xb1\x04\n" +
 	"\x06Remove\x1ac\n" +
 	"\aRequest\x12%\n" +
 	"\x02id\x18\x01 \x01(\v2\x15.payload.v1.Object.IDR\x02id\x121\n" +
@@ -6127,19 +7842,33 @@ const file_v1_payload_payload_proto_rawDesc = "" +
 	"\x02GE\x10\x02\x12\x06\n" +
 	"\x02GT\x10\x03\x12\x06\n" +
 	"\x02LE\x10\x04\x12\x06\n" +
-	"\x02LT\x10\x05\x1a]\n" +
+	"\x02LT\x10\x05\x1a}\n" +
 	"\x06Config\x125\n" +
 	"\x17skip_strict_exist_check\x18\x01 \x01(\bR\x14skipStrictExistCheck\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"\x12\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x04 \x01(\tR\n" +
+	"collection\"\x12\n" +
 	"\x05Flush\x1a\t\n" +
-	"\aRequest\"\xb1\v\n" +
-	"\x06Object\x1au\n" +
+	"\aRequest\"\xf5\x10\n" +
+	"\x06Object\x1a\x95\x01\n" +
 	"\rVectorRequest\x12/\n" +
 	"\x02id\x18\x01 \x01(\v2\x15.payload.v1.Object.IDB\b\xbaH\x05\x92\x01\x02\b\x02R\x02id\x123\n" +
-	"\afilters\x18\x02 \x01(\v2\x19.payload.v1.Filter.ConfigR\afilters\x1a6\n" +
+	"\afilters\x18\x02 \x01(\v2\x19.payload.v1.Filter.ConfigR\afilters\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x03 \x01(\tR\n" +
+	"collection\x1an\n" +
 	"\bDistance\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\bdistance\x18\x02 \x01(\x02R\bdistance\x1a\x84\x01\n" +
+	"\bdistance\x18\x02 \x01(\x02R\bdistance\x126\n" +
+	"\x06hybrid\x18\x03 \x01(\v2\x1e.payload.v1.Object.HybridScoreR\x06hybrid\x1a\x97\x01\n" +
+	"\vHybridScore\x12\x1d\n" +
+	"\n" +
+	"dense_rank\x18\x01 \x01(\rR\tdenseRank\x12%\n" +
+	"\x0edense_distance\x18\x02 \x01(\x02R\rdenseDistance\x12\x1f\n" +
+	"\vsparse_rank\x18\x03 \x01(\rR\n" +
+	"sparseRank\x12!\n" +
+	"\fsparse_score\x18\x04 \x01(\x02R\vsparseScore\x1a\x84\x01\n" +
 	"\x0eStreamDistance\x129\n" +
 	"\bdistance\x18\x01 \x01(\v2\x1b.payload.v1.Object.DistanceH\x00R\bdistance\x12,\n" +
 	"\x06status\x18\x02 \x01(\v2\x12.google.rpc.StatusH\x00R\x06statusB\t\n" +
@@ -6147,13 +7876,30 @@ const file_v1_payload_payload_proto_rawDesc = "" +
 	"\x02ID\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x1a\x17\n" +
 	"\x03IDs\x12\x10\n" +
-	"\x03ids\x18\x01 \x03(\tR\x03ids\x1aa\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x1a\x80\x03\n" +
 	"\x06Vector\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12 \n" +
 	"\x06vector\x18\x02 \x03(\x02B\b\xbaH\x05\x92\x01\x02\b\x02R\x06vector\x12\x1c\n" +
-	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x1aC\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x127\n" +
+	"\x06sparse\x18\x04 \x01(\v2\x1f.payload.v1.Object.SparseVectorR\x06sparse\x12=\n" +
+	"\vsub_vectors\x18\x05 \x03(\v2\x1c.payload.v1.Object.SubVectorR\n" +
+	"subVectors\x12I\n" +
+	"\n" +
+	"attributes\x18\x06 \x03(\v2).payload.v1.Object.Vector.AttributesEntryR\n" +
+	"attributes\x1aZ\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x121\n" +
+	"\x05value\x18\x02 \x01(\v2\x1b.payload.v1.Attribute.ValueR\x05value:\x028\x01\x1a-\n" +
+	"\tSubVector\x12 \n" +
+	"\x06vector\x18\x01 \x03(\x02B\b\xbaH\x05\x92\x01\x02\b\x02R\x06vector\x1a@\n" +
+	"\fSparseVector\x12\x18\n" +
+	"\aindices\x18\x01 \x03(\rR\aindices\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\x02R\x06values\x1ac\n" +
 	"\x10TimestampRequest\x12/\n" +
-	"\x02id\x18\x01 \x01(\v2\x15.payload.v1.Object.IDB\b\xbaH\x05\x92\x01\x02\b\x02R\x02id\x1aB\n" +
+	"\x02id\x18\x01 \x01(\v2\x15.payload.v1.Object.IDB\b\xbaH\x05\x92\x01\x02\b\x02R\x02id\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x02 \x01(\tR\n" +
+	"collection\x1aB\n" +
 	"\tTimestamp\x12\x17\n" +
 	"\x02id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x02id\x12\x1c\n" +
 	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x1a>\n" +
@@ -6183,13 +7929,25 @@ const file_v1_payload_payload_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\v2\x12.google.rpc.StatusH\x00R\x06statusB\t\n" +
 	"\apayload\x1aF\n" +
 	"\tLocations\x129\n" +
-	"\tlocations\x18\x01 \x03(\v2\x1b.payload.v1.Object.LocationR\tlocations\x1a\x8b\x01\n" +
-	"\x04List\x1a\t\n" +
-	"\aRequest\x1ax\n" +
+	"\tlocations\x18\x01 \x03(\v2\x1b.payload.v1.Object.LocationR\tlocations\x1a\xab\x01\n" +
+	"\x04List\x1a)\n" +
+	"\aRequest\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\tR\n" +
+	"collection\x1ax\n" +
 	"\bResponse\x123\n" +
 	"\x06vector\x18\x01 \x01(\v2\x19.payload.v1.Object.VectorH\x00R\x06vector\x12,\n" +
 	"\x06status\x18\x02 \x01(\v2\x12.google.rpc.StatusH\x00R\x06statusB\t\n" +
-	"\apayload\"E\n" +
+	"\apayload\"\xa6\x01\n" +
+	"\tAttribute\x1a\x98\x01\n" +
+	"\x05Value\x12#\n" +
+	"\fstring_value\x18\x01 \x01(\tH\x00R\vstringValue\x12\x1d\n" +
+	"\tint_value\x18\x02 \x01(\x03H\x00R\bintValue\x12!\n" +
+	"\vfloat_value\x18\x03 \x01(\x01H\x00R\n" +
+	"floatValue\x12\x1f\n" +
+	"\n" +
+	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\a\n" +
+	"\x05value\"E\n" +
 	"\aControl\x1a:\n" +
 	"\x12CreateIndexRequest\x12$\n" +
 	"\tpool_size\x18\x01 \x01(\rB\a\xbaH\x04*\x02(\x00R\bpoolSize\"f\n" +
@@ -6198,14 +7956,40 @@ const file_v1_payload_payload_proto_rawDesc = "" +
 	"\aRequest\x12\x1b\n" +
 	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12\x1c\n" +
 	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x12\n" +
-	"\x04node\x18\x03 \x01(\tR\x04node\"\xaf.\n" +
-	"\x04Info\x1a\xb2 \n" +
-	"\x05Index\x1au\n" +
+	"\x04node\x18\x03 \x01(\tR\x04node\"\xe6\x03\n" +
+	"\n" +
+	"Collection\x1a\x92\x01\n" +
+	"\x06Config\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x12%\n" +
+	"\tdimension\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\tdimension\x12#\n" +
+	"\rdistance_type\x18\x03 \x01(\tR\fdistanceType\x12\x1f\n" +
+	"\vobject_type\x18\x04 \x01(\tR\n" +
+	"objectType\x1aF\n" +
+	"\rCreateRequest\x125\n" +
+	"\x06config\x18\x01 \x01(\v2\x1d.payload.v1.Collection.ConfigR\x06config\x1a*\n" +
+	"\vDropRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x1aJ\n" +
+	"\x06Switch\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x14\n" +
+	"\x05fence\x18\x03 \x01(\bR\x05fence\x1a\x82\x01\n" +
+	"\x04List\x12?\n" +
+	"\vcollections\x18\x01 \x03(\v2\x1d.payload.v1.Collection.ConfigR\vcollections\x129\n" +
+	"\bswitches\x18\x02 \x03(\v2\x1d.payload.v1.Collection.SwitchR\bswitches\"\xb80\n" +
+	"\x04Info\x1a\xbb\"\n" +
+	"\x05Index\x1a\xfd\x02\n" +
 	"\x05Count\x12\x16\n" +
 	"\x06stored\x18\x01 \x01(\rR\x06stored\x12 \n" +
 	"\vuncommitted\x18\x02 \x01(\rR\vuncommitted\x12\x1a\n" +
 	"\bindexing\x18\x03 \x01(\bR\bindexing\x12\x16\n" +
-	"\x06saving\x18\x04 \x01(\bR\x06saving\x1a\xdf\x01\n" +
+	"\x06saving\x18\x04 \x01(\bR\x06saving\x12O\n" +
+	"\vcollections\x18\x05 \x03(\v2-.payload.v1.Info.Index.Count.CollectionsEntryR\vcollections\x12\x1a\n" +
+	"\bflushing\x18\x06 \x01(\bR\bflushing\x12\x18\n" +
+	"\aloading\x18\a \x01(\bR\aloading\x12!\n" +
+	"\fbroken_index\x18\b \x01(\x04R\vbrokenIndex\x1a\\\n" +
+	"\x10CollectionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.payload.v1.Info.Index.CountR\x05value:\x028\x01\x1a\xdf\x01\n" +
 	"\x06Detail\x12A\n" +
 	"\x06counts\x18\x01 \x03(\v2).payload.v1.Info.Index.Detail.CountsEntryR\x06counts\x12\x18\n" +
 	"\areplica\x18\x02 \x01(\rR\areplica\x12\x1f\n" +
@@ -6399,209 +8183,261 @@ func file_v1_payload_payload_proto_rawDescGZIP() []byte {
 	return file_v1_payload_payload_proto_rawDescData
 }
 
-var file_v1_payload_payload_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_v1_payload_payload_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
+var file_v1_payload_payload_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_v1_payload_payload_proto_msgTypes = make([]protoimpl.MessageInfo, 126)
 var file_v1_payload_payload_proto_goTypes = []any{
 	(Search_AggregationAlgorithm)(0),    // 0: payload.v1.Search.AggregationAlgorithm
-	(Remove_Timestamp_Operator)(0),      // 1: payload.v1.Remove.Timestamp.Operator
-	(*Search)(nil),                      // 2: payload.v1.Search
-	(*Filter)(nil),                      // 3: payload.v1.Filter
-	(*Insert)(nil),                      // 4: payload.v1.Insert
-	(*Update)(nil),                      // 5: payload.v1.Update
-	(*Upsert)(nil),                      // 6: payload.v1.Upsert
-	(*Remove)(nil),                      // 7: payload.v1.Remove
-	(*Flush)(nil),                       // 8: payload.v1.Flush
-	(*Object)(nil),                      // 9: payload.v1.Object
-	(*Control)(nil),                     // 10: payload.v1.Control
-	(*Discoverer)(nil),                  // 11: payload.v1.Discoverer
-	(*Info)(nil),                        // 12: payload.v1.Info
-	(*Mirror)(nil),                      // 13: payload.v1.Mirror
-	(*Meta)(nil),                        // 14: payload.v1.Meta
-	(*Empty)(nil),                       // 15: payload.v1.Empty
-	(*Search_Request)(nil),              // 16: payload.v1.Search.Request
-	(*Search_MultiRequest)(nil),         // 17: payload.v1.Search.MultiRequest
-	(*Search_IDRequest)(nil),            // 18: payload.v1.Search.IDRequest
-	(*Search_MultiIDRequest)(nil),       // 19: payload.v1.Search.MultiIDRequest
-	(*Search_ObjectRequest)(nil),        // 20: payload.v1.Search.ObjectRequest
-	(*Search_MultiObjectRequest)(nil),   // 21: payload.v1.Search.MultiObjectRequest
-	(*Search_Config)(nil),               // 22: payload.v1.Search.Config
-	(*Search_Response)(nil),             // 23: payload.v1.Search.Response
-	(*Search_Responses)(nil),            // 24: payload.v1.Search.Responses
-	(*Search_StreamResponse)(nil),       // 25: payload.v1.Search.StreamResponse
-	(*Filter_Target)(nil),               // 26: payload.v1.Filter.Target
-	(*Filter_Config)(nil),               // 27: payload.v1.Filter.Config
-	(*Insert_Request)(nil),              // 28: payload.v1.Insert.Request
-	(*Insert_MultiRequest)(nil),         // 29: payload.v1.Insert.MultiRequest
-	(*Insert_ObjectRequest)(nil),        // 30: payload.v1.Insert.ObjectRequest
-	(*Insert_MultiObjectRequest)(nil),   // 31: payload.v1.Insert.MultiObjectRequest
-	(*Insert_Config)(nil),               // 32: payload.v1.Insert.Config
-	(*Update_Request)(nil),              // 33: payload.v1.Update.Request
-	(*Update_MultiRequest)(nil),         // 34: payload.v1.Update.MultiRequest
-	(*Update_ObjectRequest)(nil),        // 35: payload.v1.Update.ObjectRequest
-	(*Update_MultiObjectRequest)(nil),   // 36: payload.v1.Update.MultiObjectRequest
-	(*Update_TimestampRequest)(nil),     // 37: payload.v1.Update.TimestampRequest
-	(*Update_Config)(nil),               // 38: payload.v1.Update.Config
-	(*Upsert_Request)(nil),              // 39: payload.v1.Upsert.Request
-	(*Upsert_MultiRequest)(nil),         // 40: payload.v1.Upsert.MultiRequest
-	(*Upsert_ObjectRequest)(nil),        // 41: payload.v1.Upsert.ObjectRequest
-	(*Upsert_MultiObjectRequest)(nil),   // 42: payload.v1.Upsert.MultiObjectRequest
-	(*Upsert_Config)(nil),               // 43: payload.v1.Upsert.Config
-	(*Remove_Request)(nil),              // 44: payload.v1.Remove.Request
-	(*Remove_MultiRequest)(nil),         // 45: payload.v1.Remove.MultiRequest
-	(*Remove_TimestampRequest)(nil),     // 46: payload.v1.Remove.TimestampRequest
-	(*Remove_Timestamp)(nil),            // 47: payload.v1.Remove.Timestamp
-	(*Remove_Config)(nil),               // 48: payload.v1.Remove.Config
-	(*Flush_Request)(nil),               // 49: payload.v1.Flush.Request
-	(*Object_VectorRequest)(nil),        // 50: payload.v1.Object.VectorRequest
-	(*Object_Distance)(nil),             // 51: payload.v1.Object.Distance
-	(*Object_StreamDistance)(nil),       // 52: payload.v1.Object.StreamDistance
-	(*Object_ID)(nil),                   // 53: payload.v1.Object.ID
-	(*Object_IDs)(nil),                  // 54: payload.v1.Object.IDs
-	(*Object_Vector)(nil),               // 55: payload.v1.Object.Vector
-	(*Object_TimestampRequest)(nil),     // 56: payload.v1.Object.TimestampRequest
-	(*Object_Timestamp)(nil),            // 57: payload.v1.Object.Timestamp
-	(*Object_Vectors)(nil),              // 58: payload.v1.Object.Vectors
-	(*Object_StreamVector)(nil),         // 59: payload.v1.Object.StreamVector
-	(*Object_ReshapeVector)(nil),        // 60: payload.v1.Object.ReshapeVector
-	(*Object_Blob)(nil),                 // 61: payload.v1.Object.Blob
-	(*Object_StreamBlob)(nil),           // 62: payload.v1.Object.StreamBlob
-	(*Object_Location)(nil),             // 63: payload.v1.Object.Location
-	(*Object_StreamLocation)(nil),       // 64: payload.v1.Object.StreamLocation
-	(*Object_Locations)(nil),            // 65: payload.v1.Object.Locations
-	(*Object_List)(nil),                 // 66: payload.v1.Object.List
-	(*Object_List_Request)(nil),         // 67: payload.v1.Object.List.Request
-	(*Object_List_Response)(nil),        // 68: payload.v1.Object.List.Response
-	(*Control_CreateIndexRequest)(nil),  // 69: payload.v1.Control.CreateIndexRequest
-	(*Discoverer_Request)(nil),          // 70: payload.v1.Discoverer.Request
-	(*Info_Index)(nil),                  // 71: payload.v1.Info.Index
-	(*Info_ResourceStats)(nil),          // 72: payload.v1.Info.ResourceStats
-	(*Info_CgroupStats)(nil),            // 73: payload.v1.Info.CgroupStats
-	(*Info_Pod)(nil),                    // 74: payload.v1.Info.Pod
-	(*Info_Node)(nil),                   // 75: payload.v1.Info.Node
-	(*Info_Service)(nil),                // 76: payload.v1.Info.Service
-	(*Info_ServicePort)(nil),            // 77: payload.v1.Info.ServicePort
-	(*Info_Labels)(nil),                 // 78: payload.v1.Info.Labels
-	(*Info_Annotations)(nil),            // 79: payload.v1.Info.Annotations
-	(*Info_CPU)(nil),                    // 80: payload.v1.Info.CPU
-	(*Info_Memory)(nil),                 // 81: payload.v1.Info.Memory
-	(*Info_Pods)(nil),                   // 82: payload.v1.Info.Pods
-	(*Info_Nodes)(nil),                  // 83: payload.v1.Info.Nodes
-	(*Info_Services)(nil),               // 84: payload.v1.Info.Services
-	(*Info_IPs)(nil),                    // 85: payload.v1.Info.IPs
-	(*Info_Index_Count)(nil),            // 86: payload.v1.Info.Index.Count
-	(*Info_Index_Detail)(nil),           // 87: payload.v1.Info.Index.Detail
-	(*Info_Index_UUID)(nil),             // 88: payload.v1.Info.Index.UUID
-	(*Info_Index_Statistics)(nil),       // 89: payload.v1.Info.Index.Statistics
-	(*Info_Index_StatisticsDetail)(nil), // 90: payload.v1.Info.Index.StatisticsDetail
-	(*Info_Index_Property)(nil),         // 91: payload.v1.Info.Index.Property
-	(*Info_Index_PropertyDetail)(nil),   // 92: payload.v1.Info.Index.PropertyDetail
-	nil,                                 // 93: payload.v1.Info.Index.Detail.CountsEntry
-	(*Info_Index_UUID_Committed)(nil),   // 94: payload.v1.Info.Index.UUID.Committed
-	(*Info_Index_UUID_Uncommitted)(nil), // 95: payload.v1.Info.Index.UUID.Uncommitted
-	nil,                                 // 96: payload.v1.Info.Index.StatisticsDetail.DetailsEntry
-	nil,                                 // 97: payload.v1.Info.Index.PropertyDetail.DetailsEntry
-	nil,                                 // 98: payload.v1.Info.Labels.LabelsEntry
-	nil,                                 // 99: payload.v1.Info.Annotations.AnnotationsEntry
-	(*Mirror_Target)(nil),               // 100: payload.v1.Mirror.Target
-	(*Mirror_Targets)(nil),              // 101: payload.v1.Mirror.Targets
-	(*Meta_Key)(nil),                    // 102: payload.v1.Meta.Key
-	(*Meta_Value)(nil),                  // 103: payload.v1.Meta.Value
-	(*Meta_KeyValue)(nil),               // 104: payload.v1.Meta.KeyValue
-	(*wrapperspb.FloatValue)(nil),       // 105: google.protobuf.FloatValue
-	(*status.Status)(nil),               // 106: google.rpc.Status
-	(*anypb.Any)(nil),                   // 107: google.protobuf.Any
+	(Search_MultiVectorAggregation)(0),  // 1: payload.v1.Search.MultiVectorAggregation
+	(Search_Consistency)(0),             // 2: payload.v1.Search.Consistency
+	(Search_Fusion_Algorithm)(0),        // 3: payload.v1.Search.Fusion.Algorithm
+	(Remove_Timestamp_Operator)(0),      // 4: payload.v1.Remove.Timestamp.Operator
+	(*Search)(nil),                      // 5: payload.v1.Search
+	(*Filter)(nil),                      // 6: payload.v1.Filter
+	(*Insert)(nil),                      // 7: payload.v1.Insert
+	(*Update)(nil),                      // 8: payload.v1.Update
+	(*Upsert)(nil),                      // 9: payload.v1.Upsert
+	(*Remove)(nil),                      // 10: payload.v1.Remove
+	(*Flush)(nil),                       // 11: payload.v1.Flush
+	(*Object)(nil),                      // 12: payload.v1.Object
+	(*Attribute)(nil),                   // 13: payload.v1.Attribute
+	(*Control)(nil),                     // 14: payload.v1.Control
+	(*Discoverer)(nil),                  // 15: payload.v1.Discoverer
+	(*Collection)(nil),                  // 16: payload.v1.Collection
+	(*Info)(nil),                        // 17: payload.v1.Info
+	(*Mirror)(nil),                      // 18: payload.v1.Mirror
+	(*Meta)(nil),                        // 19: payload.v1.Meta
+	(*Empty)(nil),                       // 20: payload.v1.Empty
+	(*Search_Request)(nil),              // 21: payload.v1.Search.Request
+	(*Search_MultiRequest)(nil),         // 22: payload.v1.Search.MultiRequest
+	(*Search_IDRequest)(nil),            // 23: payload.v1.Search.IDRequest
+	(*Search_MultiIDRequest)(nil),       // 24: payload.v1.Search.MultiIDRequest
+	(*Search_ObjectRequest)(nil),        // 25: payload.v1.Search.ObjectRequest
+	(*Search_MultiObjectRequest)(nil),   // 26: payload.v1.Search.MultiObjectRequest
+	(*Search_Config)(nil),               // 27: payload.v1.Search.Config
+	(*Search_Rerank)(nil),               // 28: payload.v1.Search.Rerank
+	(*Search_MMR)(nil),                  // 29: payload.v1.Search.MMR
+	(*Search_Fusion)(nil),               // 30: payload.v1.Search.Fusion
+	(*Search_Response)(nil),             // 31: payload.v1.Search.Response
+	(*Search_NextRequest)(nil),          // 32: payload.v1.Search.NextRequest
+	(*Search_Responses)(nil),            // 33: payload.v1.Search.Responses
+	(*Search_StreamResponse)(nil),       // 34: payload.v1.Search.StreamResponse
+	(*Search_RangeRequest)(nil),         // 35: payload.v1.Search.RangeRequest
+	(*Search_RangeConfig)(nil),          // 36: payload.v1.Search.RangeConfig
+	(*Search_RangeResponse)(nil),        // 37: payload.v1.Search.RangeResponse
+	(*Filter_Target)(nil),               // 38: payload.v1.Filter.Target
+	(*Filter_Config)(nil),               // 39: payload.v1.Filter.Config
+	(*Insert_Request)(nil),              // 40: payload.v1.Insert.Request
+	(*Insert_MultiRequest)(nil),         // 41: payload.v1.Insert.MultiRequest
+	(*Insert_ObjectRequest)(nil),        // 42: payload.v1.Insert.ObjectRequest
+	(*Insert_MultiObjectRequest)(nil),   // 43: payload.v1.Insert.MultiObjectRequest
+	(*Insert_Config)(nil),               // 44: payload.v1.Insert.Config
+	nil,                                 // 45: payload.v1.Insert.Config.AttributesEntry
+	(*Update_Request)(nil),              // 46: payload.v1.Update.Request
+	(*Update_MultiRequest)(nil),         // 47: payload.v1.Update.MultiRequest
+	(*Update_ObjectRequest)(nil),        // 48: payload.v1.Update.ObjectRequest
+	(*Update_MultiObjectRequest)(nil),   // 49: payload.v1.Update.MultiObjectRequest
+	(*Update_TimestampRequest)(nil),     // 50: payload.v1.Update.TimestampRequest
+	(*Update_Config)(nil),               // 51: payload.v1.Update.Config
+	nil,                                 // 52: payload.v1.Update.Config.AttributesEntry
+	(*Upsert_Request)(nil),              // 53: payload.v1.Upsert.Request
+	(*Upsert_MultiRequest)(nil),         // 54: payload.v1.Upsert.MultiRequest
+	(*Upsert_ObjectRequest)(nil),        // 55: payload.v1.Upsert.ObjectRequest
+	(*Upsert_MultiObjectRequest)(nil),   // 56: payload.v1.Upsert.MultiObjectRequest
+	(*Upsert_Config)(nil),               // 57: payload.v1.Upsert.Config
+	nil,                                 // 58: payload.v1.Upsert.Config.AttributesEntry
+	(*Remove_Request)(nil),              // 59: payload.v1.Remove.Request
+	(*Remove_MultiRequest)(nil),         // 60: payload.v1.Remove.MultiRequest
+	(*Remove_TimestampRequest)(nil),     // 61: payload.v1.Remove.TimestampRequest
+	(*Remove_Timestamp)(nil),            // 62: payload.v1.Remove.Timestamp
+	(*Remove_Config)(nil),               // 63: payload.v1.Remove.Config
+	(*Flush_Request)(nil),               // 64: payload.v1.Flush.Request
+	(*Object_VectorRequest)(nil),        // 65: payload.v1.Object.VectorRequest
+	(*Object_Distance)(nil),             // 66: payload.v1.Object.Distance
+	(*Object_HybridScore)(nil),          // 67: payload.v1.Object.HybridScore
+	(*Object_StreamDistance)(nil),       // 68: payload.v1.Object.StreamDistance
+	(*Object_ID)(nil),                   // 69: payload.v1.Object.ID
+	(*Object_IDs)(nil),                  // 70: payload.v1.Object.IDs
+	(*Object_Vector)(nil),               // 71: payload.v1.Object.Vector
+	(*Object_SubVector)(nil),            // 72: payload.v1.Object.SubVector
+	(*Object_SparseVector)(nil),         // 73: payload.v1.Object.SparseVector
+	(*Object_TimestampRequest)(nil),     // 74: payload.v1.Object.TimestampRequest
+	(*Object_Timestamp)(nil),            // 75: payload.v1.Object.Timestamp
+	(*Object_Vectors)(nil),              // 76: payload.v1.Object.Vectors
+	(*Object_StreamVector)(nil),         // 77: payload.v1.Object.StreamVector
+	(*Object_ReshapeVector)(nil),        // 78: payload.v1.Object.ReshapeVector
+	(*Object_Blob)(nil),                 // 79: payload.v1.Object.Blob
+	(*Object_StreamBlob)(nil),           // 80: payload.v1.Object.StreamBlob
+	(*Object_Location)(nil),             // 81: payload.v1.Object.Location
+	(*Object_StreamLocation)(nil),       // 82: payload.v1.Object.StreamLocation
+	(*Object_Locations)(nil),            // 83: payload.v1.Object.Locations
+	(*Object_List)(nil),                 // 84: payload.v1.Object.List
+	nil,                                 // 85: payload.v1.Object.Vector.AttributesEntry
+	(*Object_List_Request)(nil),         // 86: payload.v1.Object.List.Request
+	(*Object_List_Response)(nil),        // 87: payload.v1.Object.List.Response
+	(*Attribute_Value)(nil),             // 88: payload.v1.Attribute.Value
+	(*Control_CreateIndexRequest)(nil),  // 89: payload.v1.Control.CreateIndexRequest
+	(*Discoverer_Request)(nil),          // 90: payload.v1.Discoverer.Request
+	(*Collection_Config)(nil),           // 91: payload.v1.Collection.Config
+	(*Collection_CreateRequest)(nil),    // 92: payload.v1.Collection.CreateRequest
+	(*Collection_DropRequest)(nil),      // 93: payload.v1.Collection.DropRequest
+	(*Collection_Switch)(nil),           // 94: payload.v1.Collection.Switch
+	(*Collection_List)(nil),             // 95: payload.v1.Collection.List
+	(*Info_Index)(nil),                  // 96: payload.v1.Info.Index
+	(*Info_ResourceStats)(nil),          // 97: payload.v1.Info.ResourceStats
+	(*Info_CgroupStats)(nil),            // 98: payload.v1.Info.CgroupStats
+	(*Info_Pod)(nil),                    // 99: payload.v1.Info.Pod
+	(*Info_Node)(nil),                   // 100: payload.v1.Info.Node
+	(*Info_Service)(nil),                // 101: payload.v1.Info.Service
+	(*Info_ServicePort)(nil),            // 102: payload.v1.Info.ServicePort
+	(*Info_Labels)(nil),                 // 103: payload.v1.Info.Labels
+	(*Info_Annotations)(nil),            // 104: payload.v1.Info.Annotations
+	(*Info_CPU)(nil),                    // 105: payload.v1.Info.CPU
+	(*Info_Memory)(nil),                 // 106: payload.v1.Info.Memory
+	(*Info_Pods)(nil),                   // 107: payload.v1.Info.Pods
+	(*Info_Nodes)(nil),                  // 108: payload.v1.Info.Nodes
+	(*Info_Services)(nil),               // 109: payload.v1.Info.Services
+	(*Info_IPs)(nil),                    // 110: payload.v1.Info.IPs
+	(*Info_Index_Count)(nil),            // 111: payload.v1.Info.Index.Count
+	(*Info_Index_Detail)(nil),           // 112: payload.v1.Info.Index.Detail
+	(*Info_Index_UUID)(nil),             // 113: payload.v1.Info.Index.UUID
+	(*Info_Index_Statistics)(nil),       // 114: payload.v1.Info.Index.Statistics
+	(*Info_Index_StatisticsDetail)(nil), // 115: payload.v1.Info.Index.StatisticsDetail
+	(*Info_Index_Property)(nil),         // 116: payload.v1.Info.Index.Property
+	(*Info_Index_PropertyDetail)(nil),   // 117: payload.v1.Info.Index.PropertyDetail
+	nil,                                 // 118: payload.v1.Info.Index.Count.CollectionsEntry
+	nil,                                 // 119: payload.v1.Info.Index.Detail.CountsEntry
+	(*Info_Index_UUID_Committed)(nil),   // 120: payload.v1.Info.Index.UUID.Committed
+	(*Info_Index_UUID_Uncommitted)(nil), // 121: payload.v1.Info.Index.UUID.Uncommitted
+	nil,                                 // 122: payload.v1.Info.Index.StatisticsDetail.DetailsEntry
+	nil,                                 // 123: payload.v1.Info.Index.PropertyDetail.DetailsEntry
+	nil,                                 // 124: payload.v1.Info.Labels.LabelsEntry
+	nil,                                 // 125: payload.v1.Info.Annotations.AnnotationsEntry
+	(*Mirror_Target)(nil),               // 126: payload.v1.Mirror.Target
+	(*Mirror_Targets)(nil),              // 127: payload.v1.Mirror.Targets
+	(*Meta_Key)(nil),                    // 128: payload.v1.Meta.Key
+	(*Meta_Value)(nil),                  // 129: payload.v1.Meta.Value
+	(*Meta_KeyValue)(nil),               // 130: payload.v1.Meta.KeyValue
+	(*wrapperspb.FloatValue)(nil),       // 131: google.protobuf.FloatValue
+	(*status.Status)(nil),               // 132: google.rpc.Status
+	(*anypb.Any)(nil),                   // 133: google.protobuf.Any
 }
 var file_v1_payload_payload_proto_depIdxs = []int32{
-	22,  // 0: payload.v1.Search.Request.config:type_name -> payload.v1.Search.Config
-	16,  // 1: payload.v1.Search.MultiRequest.requests:type_name -> payload.v1.Search.Request
-	22,  // 2: payload.v1.Search.IDRequest.config:type_name -> payload.v1.Search.Config
-	18,  // 3: payload.v1.Search.MultiIDRequest.requests:type_name -> payload.v1.Search.IDRequest
-	22,  // 4: payload.v1.Search.ObjectRequest.config:type_name -> payload.v1.Search.Config
-	26,  // 5: payload.v1.Search.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
-	20,  // 6: payload.v1.Search.MultiObjectRequest.requests:type_name -> payload.v1.Search.ObjectRequest
-	27,  // 7: payload.v1.Search.Config.ingress_filters:type_name -> payload.v1.Filter.Config
-	27,  // 8: payload.v1.Search.Config.egress_filters:type_name -> payload.v1.Filter.Config
-	0,   // 9: payload.v1.Search.Config.aggregation_algorithm:type_name -> payload.v1.Search.AggregationAlgorithm
-	105, // 10: payload.v1.Search.Config.ratio:type_name -> google.protobuf.FloatValue
-	51,  // 11: payload.v1.Search.Response.results:type_name -> payload.v1.Object.Distance
-	23,  // 12: payload.v1.Search.Responses.responses:type_name -> payload.v1.Search.Response
-	23,  // 13: payload.v1.Search.StreamResponse.response:type_name -> payload.v1.Search.Response
-	106, // 14: payload.v1.Search.StreamResponse.status:type_name -> google.rpc.Status
-	26,  // 15: payload.v1.Filter.Config.targets:type_name -> payload.v1.Filter.Target
-	55,  // 16: payload.v1.Insert.Request.vector:type_name -> payload.v1.Object.Vector
-	32,  // 17: payload.v1.Insert.Request.config:type_name -> payload.v1.Insert.Config
-	28,  // 18: payload.v1.Insert.MultiRequest.requests:type_name -> payload.v1.Insert.Request
-	61,  // 19: payload.v1.Insert.ObjectRequest.object:type_name -> payload.v1.Object.Blob
-	32,  // 20: payload.v1.Insert.ObjectRequest.config:type_name -> payload.v1.Insert.Config
-	26,  // 21: payload.v1.Insert.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
-	30,  // 22: payload.v1.Insert.MultiObjectRequest.requests:type_name -> payload.v1.Insert.ObjectRequest
-	27,  // 23: payload.v1.Insert.Config.filters:type_name -> payload.v1.Filter.Config
-	55,  // 24: payload.v1.Update.Request.vector:type_name -> payload.v1.Object.Vector
-	38,  // 25: payload.v1.Update.Request.config:type_name -> payload.v1.Update.Config
-	33,  // 26: payload.v1.Update.MultiRequest.requests:type_name -> payload.v1.Update.Request
-	61,  // 27: payload.v1.Update.ObjectRequest.object:type_name -> payload.v1.Object.Blob
-	38,  // 28: payload.v1.Update.ObjectRequest.config:type_name -> payload.v1.Update.Config
-	26,  // 29: payload.v1.Update.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
-	35,  // 30: payload.v1.Update.MultiObjectRequest.requests:type_name -> payload.v1.Update.ObjectRequest
-	27,  // 31: payload.v1.Update.Config.filters:type_name -> payload.v1.Filter.Config
-	55,  // 32: payload.v1.Upsert.Request.vector:type_name -> payload.v1.Object.Vector
-	43,  // 33: payload.v1.Upsert.Request.config:type_name -> payload.v1.Upsert.Config
-	39,  // 34: payload.v1.Upsert.MultiRequest.requests:type_name -> payload.v1.Upsert.Request
-	61,  // 35: payload.v1.Upsert.ObjectRequest.object:type_name -> payload.v1.Object.Blob
-	43,  // 36: payload.v1.Upsert.ObjectRequest.config:type_name -> payload.v1.Upsert.Config
-	26,  // 37: payload.v1.Upsert.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
-	41,  // 38: payload.v1.Upsert.MultiObjectRequest.requests:type_name -> payload.v1.Upsert.ObjectRequest
-	27,  // 39: payload.v1.Upsert.Config.filters:type_name -> payload.v1.Filter.Config
-	53,  // 40: payload.v1.Remove.Request.id:type_name -> payload.v1.Object.ID
-	48,  // 41: payload.v1.Remove.Request.config:type_name -> payload.v1.Remove.Config
-	44,  // 42: payload.v1.Remove.MultiRequest.requests:type_name -> payload.v1.Remove.Request
-	47,  // 43: payload.v1.Remove.TimestampRequest.timestamps:type_name -> payload.v1.Remove.Timestamp
-	1,   // 44: payload.v1.Remove.Timestamp.operator:type_name -> payload.v1.Remove.Timestamp.Operator
-	53,  // 45: payload.v1.Object.VectorRequest.id:type_name -> payload.v1.Object.ID
-	27,  // 46: payload.v1.Object.VectorRequest.filters:type_name -> payload.v1.Filter.Config
-	51,  // 47: payload.v1.Object.StreamDistance.distance:type_name -> payload.v1.Object.Distance
-	106, // 48: payload.v1.Object.StreamDistance.status:type_name -> google.rpc.Status
-	53,  // 49: payload.v1.Object.TimestampRequest.id:type_name -> payload.v1.Object.ID
-	55,  // 50: payload.v1.Object.Vectors.vectors:type_name -> payload.v1.Object.Vector
-	55,  // 51: payload.v1.Object.StreamVector.vector:type_name -> payload.v1.Object.Vector
-	106, // 52: payload.v1.Object.StreamVector.status:type_name -> google.rpc.Status
-	61,  // 53: payload.v1.Object.StreamBlob.blob:type_name -> payload.v1.Object.Blob
-	106, // 54: payload.v1.Object.StreamBlob.status:type_name -> google.rpc.Status
-	63,  // 55: payload.v1.Object.StreamLocation.location:type_name -> payload.v1.Object.Location
-	106, // 56: payload.v1.Object.StreamLocation.status:type_name -> google.rpc.Status
-	63,  // 57: payload.v1.Object.Locations.locations:type_name -> payload.v1.Object.Location
-	55,  // 58: payload.v1.Object.List.Response.vector:type_name -> payload.v1.Object.Vector
-	106, // 59: payload.v1.Object.List.Response.status:type_name -> google.rpc.Status
-	73,  // 60: payload.v1.Info.ResourceStats.cgroup_stats:type_name -> payload.v1.Info.CgroupStats
-	80,  // 61: payload.v1.Info.Pod.cpu:type_name -> payload.v1.Info.CPU
-	81,  // 62: payload.v1.Info.Pod.memory:type_name -> payload.v1.Info.Memory
-	75,  // 63: payload.v1.Info.Pod.node:type_name -> payload.v1.Info.Node
-	80,  // 64: payload.v1.Info.Node.cpu:type_name -> payload.v1.Info.CPU
-	81,  // 65: payload.v1.Info.Node.memory:type_name -> payload.v1.Info.Memory
-	82,  // 66: payload.v1.Info.Node.Pods:type_name -> payload.v1.Info.Pods
-	77,  // 67: payload.v1.Info.Service.ports:type_name -> payload.v1.Info.ServicePort
-	78,  // 68: payload.v1.Info.Service.labels:type_name -> payload.v1.Info.Labels
-	79,  // 69: payload.v1.Info.Service.annotations:type_name -> payload.v1.Info.Annotations
-	98,  // 70: payload.v1.Info.Labels.labels:type_name -> payload.v1.Info.Labels.LabelsEntry
-	99,  // 71: payload.v1.Info.Annotations.annotations:type_name -> payload.v1.Info.Annotations.AnnotationsEntry
-	74,  // 72: payload.v1.Info.Pods.pods:type_name -> payload.v1.Info.Pod
-	75,  // 73: payload.v1.Info.Nodes.nodes:type_name -> payload.v1.Info.Node
-	76,  // 74: payload.v1.Info.Services.services:type_name -> payload.v1.Info.Service
-	93,  // 75: payload.v1.Info.Index.Detail.counts:type_name -> payload.v1.Info.Index.Detail.CountsEntry
-	96,  // 76: payload.v1.Info.Index.StatisticsDetail.details:type_name -> payload.v1.Info.Index.StatisticsDetail.DetailsEntry
-	97,  // 77: payload.v1.Info.Index.PropertyDetail.details:type_name -> payload.v1.Info.Index.PropertyDetail.DetailsEntry
-	86,  // 78: payload.v1.Info.Index.Detail.CountsEntry.value:type_name -> payload.v1.Info.Index.Count
-	89,  // 79: payload.v1.Info.Index.StatisticsDetail.DetailsEntry.value:type_name -> payload.v1.Info.Index.Statistics
-	91,  // 80: payload.v1.Info.Index.PropertyDetail.DetailsEntry.value:type_name -> payload.v1.Info.Index.Property
-	100, // 81: payload.v1.Mirror.Targets.targets:type_name -> payload.v1.Mirror.Target
-	107, // 82: payload.v1.Meta.Value.value:type_name -> google.protobuf.Any
-	102, // 83: payload.v1.Meta.KeyValue.key:type_name -> payload.v1.Meta.Key
-	103, // 84: payload.v1.Meta.KeyValue.value:type_name -> payload.v1.Meta.Value
-	85,  // [85:85] is the sub-list for method output_type
-	85,  // [85:85] is the sub-list for method input_type
-	85,  // [85:85] is the sub-list for extension type_name
-	85,  // [85:85] is the sub-list for extension extendee
-	0,   // [0:85] is the sub-list for field type_name
+	27,  // 0: payload.v1.Search.Request.config:type_name -> payload.v1.Search.Config
+	73,  // 1: payload.v1.Search.Request.sparse:type_name -> payload.v1.Object.SparseVector
+	72,  // 2: payload.v1.Search.Request.sub_vectors:type_name -> payload.v1.Object.SubVector
+	21,  // 3: payload.v1.Search.MultiRequest.requests:type_name -> payload.v1.Search.Request
+	27,  // 4: payload.v1.Search.IDRequest.config:type_name -> payload.v1.Search.Config
+	23,  // 5: payload.v1.Search.MultiIDRequest.requests:type_name -> payload.v1.Search.IDRequest
+	27,  // 6: payload.v1.Search.ObjectRequest.config:type_name -> payload.v1.Search.Config
+	38,  // 7: payload.v1.Search.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
+	25,  // 8: payload.v1.Search.MultiObjectRequest.requests:type_name -> payload.v1.Search.ObjectRequest
+	39,  // 9: payload.v1.Search.Config.ingress_filters:type_name -> payload.v1.Filter.Config
+	39,  // 10: payload.v1.Search.Config.egress_filters:type_name -> payload.v1.Filter.Config
+	0,   // 11: payload.v1.Search.Config.aggregation_algorithm:type_name -> payload.v1.Search.AggregationAlgorithm
+	131, // 12: payload.v1.Search.Config.ratio:type_name -> google.protobuf.FloatValue
+	30,  // 13: payload.v1.Search.Config.fusion:type_name -> payload.v1.Search.Fusion
+	1,   // 14: payload.v1.Search.Config.multi_vector_aggregation:type_name -> payload.v1.Search.MultiVectorAggregation
+	28,  // 15: payload.v1.Search.Config.rerank:type_name -> payload.v1.Search.Rerank
+	29,  // 16: payload.v1.Search.Config.mmr:type_name -> payload.v1.Search.MMR
+	2,   // 17: payload.v1.Search.Config.consistency:type_name -> payload.v1.Search.Consistency
+	3,   // 18: payload.v1.Search.Fusion.algorithm:type_name -> payload.v1.Search.Fusion.Algorithm
+	66,  // 19: payload.v1.Search.Response.results:type_name -> payload.v1.Object.Distance
+	31,  // 20: payload.v1.Search.Responses.responses:type_name -> payload.v1.Search.Response
+	31,  // 21: payload.v1.Search.StreamResponse.response:type_name -> payload.v1.Search.Response
+	132, // 22: payload.v1.Search.StreamResponse.status:type_name -> google.rpc.Status
+	36,  // 23: payload.v1.Search.RangeRequest.config:type_name -> payload.v1.Search.RangeConfig
+	66,  // 24: payload.v1.Search.RangeResponse.results:type_name -> payload.v1.Object.Distance
+	38,  // 25: payload.v1.Filter.Config.targets:type_name -> payload.v1.Filter.Target
+	71,  // 26: payload.v1.Insert.Request.vector:type_name -> payload.v1.Object.Vector
+	44,  // 27: payload.v1.Insert.Request.config:type_name -> payload.v1.Insert.Config
+	40,  // 28: payload.v1.Insert.MultiRequest.requests:type_name -> payload.v1.Insert.Request
+	79,  // 29: payload.v1.Insert.ObjectRequest.object:type_name -> payload.v1.Object.Blob
+	44,  // 30: payload.v1.Insert.ObjectRequest.config:type_name -> payload.v1.Insert.Config
+	38,  // 31: payload.v1.Insert.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
+	42,  // 32: payload.v1.Insert.MultiObjectRequest.requests:type_name -> payload.v1.Insert.ObjectRequest
+	39,  // 33: payload.v1.Insert.Config.filters:type_name -> payload.v1.Filter.Config
+	45,  // 34: payload.v1.Insert.Config.attributes:type_name -> payload.v1.Insert.Config.AttributesEntry
+	88,  // 35: payload.v1.Insert.Config.AttributesEntry.value:type_name -> payload.v1.Attribute.Value
+	71,  // 36: payload.v1.Update.Request.vector:type_name -> payload.v1.Object.Vector
+	51,  // 37: payload.v1.Update.Request.config:type_name -> payload.v1.Update.Config
+	46,  // 38: payload.v1.Update.MultiRequest.requests:type_name -> payload.v1.Update.Request
+	79,  // 39: payload.v1.Update.ObjectRequest.object:type_name -> payload.v1.Object.Blob
+	51,  // 40: payload.v1.Update.ObjectRequest.config:type_name -> payload.v1.Update.Config
+	38,  // 41: payload.v1.Update.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
+	48,  // 42: payload.v1.Update.MultiObjectRequest.requests:type_name -> payload.v1.Update.ObjectRequest
+	39,  // 43: payload.v1.Update.Config.filters:type_name -> payload.v1.Filter.Config
+	52,  // 44: payload.v1.Update.Config.attributes:type_name -> payload.v1.Update.Config.AttributesEntry
+	88,  // 45: payload.v1.Update.Config.AttributesEntry.value:type_name -> payload.v1.Attribute.Value
+	71,  // 46: payload.v1.Upsert.Request.vector:type_name -> payload.v1.Object.Vector
+	57,  // 47: payload.v1.Upsert.Request.config:type_name -> payload.v1.Upsert.Config
+	53,  // 48: payload.v1.Upsert.MultiRequest.requests:type_name -> payload.v1.Upsert.Request
+	79,  // 49: payload.v1.Upsert.ObjectRequest.object:type_name -> payload.v1.Object.Blob
+	57,  // 50: payload.v1.Upsert.ObjectRequest.config:type_name -> payload.v1.Upsert.Config
+	38,  // 51: payload.v1.Upsert.ObjectRequest.vectorizer:type_name -> payload.v1.Filter.Target
+	55,  // 52: payload.v1.Upsert.MultiObjectRequest.requests:type_name -> payload.v1.Upsert.ObjectRequest
+	39,  // 53: payload.v1.Upsert.Config.filters:type_name -> payload.v1.Filter.Config
+	58,  // 54: payload.v1.Upsert.Config.attributes:type_name -> payload.v1.Upsert.Config.AttributesEntry
+	88,  // 55: payload.v1.Upsert.Config.AttributesEntry.value:type_name -> payload.v1.Attribute.Value
+	69,  // 56: payload.v1.Remove.Request.id:type_name -> payload.v1.Object.ID
+	63,  // 57: payload.v1.Remove.Request.config:type_name -> payload.v1.Remove.Config
+	59,  // 58: payload.v1.Remove.MultiRequest.requests:type_name -> payload.v1.Remove.Request
+	62,  // 59: payload.v1.Remove.TimestampRequest.timestamps:type_name -> payload.v1.Remove.Timestamp
+	4,   // 60: payload.v1.Remove.Timestamp.operator:type_name -> payload.v1.Remove.Timestamp.Operator
+	69,  // 61: payload.v1.Object.VectorRequest.id:type_name -> payload.v1.Object.ID
+	39,  // 62: payload.v1.Object.VectorRequest.filters:type_name -> payload.v1.Filter.Config
+	67,  // 63: payload.v1.Object.Distance.hybrid:type_name -> payload.v1.Object.HybridScore
+	66,  // 64: payload.v1.Object.StreamDistance.distance:type_name -> payload.v1.Object.Distance
+	132, // 65: payload.v1.Object.StreamDistance.status:type_name -> google.rpc.Status
+	73,  // 66: payload.v1.Object.Vector.sparse:type_name -> payload.v1.Object.SparseVector
+	72,  // 67: payload.v1.Object.Vector.sub_vectors:type_name -> payload.v1.Object.SubVector
+	85,  // 68: payload.v1.Object.Vector.attributes:type_name -> payload.v1.Object.Vector.AttributesEntry
+	69,  // 69: payload.v1.Object.TimestampRequest.id:type_name -> payload.v1.Object.ID
+	71,  // 70: payload.v1.Object.Vectors.vectors:type_name -> payload.v1.Object.Vector
+	71,  // 71: payload.v1.Object.StreamVector.vector:type_name -> payload.v1.Object.Vector
+	132, // 72: payload.v1.Object.StreamVector.status:type_name -> google.rpc.Status
+	79,  // 73: payload.v1.Object.StreamBlob.blob:type_name -> payload.v1.Object.Blob
+	132, // 74: payload.v1.Object.StreamBlob.status:type_name -> google.rpc.Status
+	81,  // 75: payload.v1.Object.StreamLocation.location:type_name -> payload.v1.Object.Location
+	132, // 76: payload.v1.Object.StreamLocation.status:type_name -> google.rpc.Status
+	81,  // 77: payload.v1.Object.Locations.locations:type_name -> payload.v1.Object.Location
+	88,  // 78: payload.v1.Object.Vector.AttributesEntry.value:type_name -> payload.v1.Attribute.Value
+	71,  // 79: payload.v1.Object.List.Response.vector:type_name -> payload.v1.Object.Vector
+	132, // 80: payload.v1.Object.List.Response.status:type_name -> google.rpc.Status
+	91,  // 81: payload.v1.Collection.CreateRequest.config:type_name -> payload.v1.Collection.Config
+	91,  // 82: payload.v1.Collection.List.collections:type_name -> payload.v1.Collection.Config
+	94,  // 83: payload.v1.Collection.List.switches:type_name -> payload.v1.Collection.Switch
+	98,  // 84: payload.v1.Info.ResourceStats.cgroup_stats:type_name -> payload.v1.Info.CgroupStats
+	105, // 85: payload.v1.Info.Pod.cpu:type_name -> payload.v1.Info.CPU
+	106, // 86: payload.v1.Info.Pod.memory:type_name -> payload.v1.Info.Memory
+	100, // 87: payload.v1.Info.Pod.node:type_name -> payload.v1.Info.Node
+	105, // 88: payload.v1.Info.Node.cpu:type_name -> payload.v1.Info.CPU
+	106, // 89: payload.v1.Info.Node.memory:type_name -> payload.v1.Info.Memory
+	107, // 90: payload.v1.Info.Node.Pods:type_name -> payload.v1.Info.Pods
+	102, // 91: payload.v1.Info.Service.ports:type_name -> payload.v1.Info.ServicePort
+	103, // 92: payload.v1.Info.Service.labels:type_name -> payload.v1.Info.Labels
+	104, // 93: payload.v1.Info.Service.annotations:type_name -> payload.v1.Info.Annotations
+	124, // 94: payload.v1.Info.Labels.labels:type_name -> payload.v1.Info.Labels.LabelsEntry
+	125, // 95: payload.v1.Info.Annotations.annotations:type_name -> payload.v1.Info.Annotations.AnnotationsEntry
+	99,  // 96: payload.v1.Info.Pods.pods:type_name -> payload.v1.Info.Pod
+	100, // 97: payload.v1.Info.Nodes.nodes:type_name -> payload.v1.Info.Node
+	101, // 98: payload.v1.Info.Services.services:type_name -> payload.v1.Info.Service
+	118, // 99: payload.v1.Info.Index.Count.collections:type_name -> payload.v1.Info.Index.Count.CollectionsEntry
+	119, // 100: payload.v1.Info.Index.Detail.counts:type_name -> payload.v1.Info.Index.Detail.CountsEntry
+	122, // 101: payload.v1.Info.Index.StatisticsDetail.details:type_name -> payload.v1.Info.Index.StatisticsDetail.DetailsEntry
+	123, // 102: payload.v1.Info.Index.PropertyDetail.details:type_name -> payload.v1.Info.Index.PropertyDetail.DetailsEntry
+	111, // 103: payload.v1.Info.Index.Count.CollectionsEntry.value:type_name -> payload.v1.Info.Index.Count
+	111, // 104: payload.v1.Info.Index.Detail.CountsEntry.value:type_name -> payload.v1.Info.Index.Count
+	114, // 105: payload.v1.Info.Index.StatisticsDetail.DetailsEntry.value:type_name -> payload.v1.Info.Index.Statistics
+	116, // 106: payload.v1.Info.Index.PropertyDetail.DetailsEntry.value:type_name -> payload.v1.Info.Index.Property
+	126, // 107: payload.v1.Mirror.Targets.targets:type_name -> payload.v1.Mirror.Target
+	133, // 108: payload.v1.Meta.Value.value:type_name -> google.protobuf.Any
+	128, // 109: payload.v1.Meta.KeyValue.key:type_name -> payload.v1.Meta.Key
+	129, // 110: payload.v1.Meta.KeyValue.value:type_name -> payload.v1.Meta.Value
+	111, // [111:111] is the sub-list for method output_type
+	111, // [111:111] is the sub-list for method input_type
+	111, // [111:111] is the sub-list for extension type_name
+	111, // [111:111] is the sub-list for extension extendee
+	0,   // [0:111] is the sub-list for field type_name
 }
 
 func init() { file_v1_payload_payload_proto_init() }
@@ -6609,37 +8445,46 @@ func file_v1_payload_payload_proto_init() {
 	if File_v1_payload_payload_proto != nil {
 		return
 	}
-	file_v1_payload_payload_proto_msgTypes[23].OneofWrappers = []any{
+	file_v1_payload_payload_proto_msgTypes[22].OneofWrappers = []any{}
+	file_v1_payload_payload_proto_msgTypes[24].OneofWrappers = []any{}
+	file_v1_payload_payload_proto_msgTypes[25].OneofWrappers = []any{}
+	file_v1_payload_payload_proto_msgTypes[29].OneofWrappers = []any{
 		(*Search_StreamResponse_Response)(nil),
 		(*Search_StreamResponse_Status)(nil),
 	}
-	file_v1_payload_payload_proto_msgTypes[50].OneofWrappers = []any{
+	file_v1_payload_payload_proto_msgTypes[63].OneofWrappers = []any{
 		(*Object_StreamDistance_Distance)(nil),
 		(*Object_StreamDistance_Status)(nil),
 	}
-	file_v1_payload_payload_proto_msgTypes[57].OneofWrappers = []any{
+	file_v1_payload_payload_proto_msgTypes[72].OneofWrappers = []any{
 		(*Object_StreamVector_Vector)(nil),
 		(*Object_StreamVector_Status)(nil),
 	}
-	file_v1_payload_payload_proto_msgTypes[60].OneofWrappers = []any{
+	file_v1_payload_payload_proto_msgTypes[75].OneofWrappers = []any{
 		(*Object_StreamBlob_Blob)(nil),
 		(*Object_StreamBlob_Status)(nil),
 	}
-	file_v1_payload_payload_proto_msgTypes[62].OneofWrappers = []any{
+	file_v1_payload_payload_proto_msgTypes[77].OneofWrappers = []any{
 		(*Object_StreamLocation_Location)(nil),
 		(*Object_StreamLocation_Status)(nil),
 	}
-	file_v1_payload_payload_proto_msgTypes[66].OneofWrappers = []any{
+	file_v1_payload_payload_proto_msgTypes[82].OneofWrappers = []any{
 		(*Object_List_Response_Vector)(nil),
 		(*Object_List_Response_Status)(nil),
 	}
+	file_v1_payload_payload_proto_msgTypes[83].OneofWrappers = []any{
+		(*Attribute_Value_StringValue)(nil),
+		(*Attribute_Value_IntValue)(nil),
+		(*Attribute_Value_FloatValue)(nil),
+		(*Attribute_Value_BoolValue)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_payload_payload_proto_rawDesc), len(file_v1_payload_payload_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   103,
+			NumEnums:      5,
+			NumMessages:   126,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
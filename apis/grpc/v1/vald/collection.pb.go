@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: v1/vald/collection.proto
+
+package vald
+
+import (
+	payload "github.com/vdaas/vald/apis/grpc/v1/payload"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_v1_vald_collection_proto protoreflect.FileDescriptor
+
+const file_v1_vald_collection_proto_rawDesc = "" +
+	"\n" +
+	"\x18v1/vald/collection.proto\x12\avald.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x18v1/payload/payload.proto2\x98\x03\n" +
+	"\n" +
+	"Collection\x12c\n" +
+	"\x10CreateCollection\x12$.payload.v1.Collection.CreateRequest\x1a\x11.payload.v1.Empty\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/collection\x12c\n" +
+	"\x0eDropCollection\x12\".payload.v1.Collection.DropRequest\x1a\x11.payload.v1.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/collection/{name}\x12[\n" +
+	"\x0fListCollections\x12\x11.payload.v1.Empty\x1a\x1b.payload.v1.Collection.List\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/collection/list\x12c\n" +
+	"\x10SwitchCollection\x12\x1d.payload.v1.Collection.Switch\x1a\x11.payload.v1.Empty\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/collection/switchBW\n" +
+	"\x1aorg.vdaas.vald.api.v1.valdB\x0eValdCollectionP\x01Z'github.com/vdaas/vald/apis/grpc/v1/valdb\x06proto3"
+
+var file_v1_vald_collection_proto_goTypes = []any{
+	(*payload.Collection_CreateRequest)(nil), // 0: payload.v1.Collection.CreateRequest
+	(*payload.Collection_DropRequest)(nil),   // 1: payload.v1.Collection.DropRequest
+	(*payload.Empty)(nil),                    // 2: payload.v1.Empty
+	(*payload.Collection_Switch)(nil),        // 3: payload.v1.Collection.Switch
+	(*payload.Collection_List)(nil),          // 4: payload.v1.Collection.List
+}
+var file_v1_vald_collection_proto_depIdxs = []int32{
+	0, // 0: vald.v1.Collection.CreateCollection:input_type -> payload.v1.Collection.CreateRequest
+	1, // 1: vald.v1.Collection.DropCollection:input_type -> payload.v1.Collection.DropRequest
+	2, // 2: vald.v1.Collection.ListCollections:input_type -> payload.v1.Empty
+	3, // 3: vald.v1.Collection.SwitchCollection:input_type -> payload.v1.Collection.Switch
+	2, // 4: vald.v1.Collection.CreateCollection:output_type -> payload.v1.Empty
+	2, // 5: vald.v1.Collection.DropCollection:output_type -> payload.v1.Empty
+	4, // 6: vald.v1.Collection.ListCollections:output_type -> payload.v1.Collection.List
+	2, // 7: vald.v1.Collection.SwitchCollection:output_type -> payload.v1.Empty
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_v1_vald_collection_proto_init() }
+func file_v1_vald_collection_proto_init() {
+	if File_v1_vald_collection_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v1_vald_collection_proto_rawDesc), len(file_v1_vald_collection_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_vald_collection_proto_goTypes,
+		DependencyIndexes: file_v1_vald_collection_proto_depIdxs,
+	}.Build()
+	File_v1_vald_collection_proto = out.File
+	file_v1_vald_collection_proto_goTypes = nil
+	file_v1_vald_collection_proto_depIdxs = nil
+}
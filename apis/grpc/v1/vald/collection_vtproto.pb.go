@@ -0,0 +1,340 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go-vtproto. DO NOT EDIT.
+// protoc-gen-go-vtproto version: v0.6.0
+// source: v1/vald/collection.proto
+
+package vald
+
+import (
+	context "context"
+	payload "github.com/vdaas/vald/apis/grpc/v1/payload"
+	codes "github.com/vdaas/vald/internal/net/grpc/codes"
+	status "github.com/vdaas/vald/internal/net/grpc/status"
+	grpc "google.golang.org/grpc"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CollectionClient is the client API for Collection service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CollectionClient interface {
+	// Overview
+	// CreateCollection RPC is the method to create a new named collection.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  6   | ALREADY_EXISTS    |
+	// |  13  | INTERNAL          |
+	CreateCollection(ctx context.Context, in *payload.Collection_CreateRequest, opts ...grpc.CallOption) (*payload.Empty, error)
+	// Overview
+	// DropCollection RPC is the method to drop a named collection and all vectors in it.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  5   | NOT_FOUND         |
+	// |  13  | INTERNAL          |
+	DropCollection(ctx context.Context, in *payload.Collection_DropRequest, opts ...grpc.CallOption) (*payload.Empty, error)
+	// Overview
+	// ListCollections RPC is the method to get the configurations of the named collections.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  13  | INTERNAL          |
+	ListCollections(ctx context.Context, in *payload.Empty, opts ...grpc.CallOption) (*payload.Collection_List, error)
+	// Overview
+	// SwitchCollection RPC is the method to switch the requests of a collection to another collection, or to fence the write requests to a collection.
+	// The requests for the collection are served by the target collection after the switch, and the write requests to the fenced collection are rejected until the fence is removed.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  5   | NOT_FOUND         |
+	// |  13  | INTERNAL          |
+	SwitchCollection(ctx context.Context, in *payload.Collection_Switch, opts ...grpc.CallOption) (*payload.Empty, error)
+}
+
+type collectionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCollectionClient(cc grpc.ClientConnInterface) CollectionClient {
+	return &collectionClient{cc}
+}
+
+func (c *collectionClient) CreateCollection(
+	ctx context.Context, in *payload.Collection_CreateRequest, opts ...grpc.CallOption,
+) (*payload.Empty, error) {
+	out := new(payload.Empty)
+	err := c.cc.Invoke(ctx, "/vald.v1.Collection/CreateCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionClient) DropCollection(
+	ctx context.Context, in *payload.Collection_DropRequest, opts ...grpc.CallOption,
+) (*payload.Empty, error) {
+	out := new(payload.Empty)
+	err := c.cc.Invoke(ctx, "/vald.v1.Collection/DropCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionClient) ListCollections(
+	ctx context.Context, in *payload.Empty, opts ...grpc.CallOption,
+) (*payload.Collection_List, error) {
+	out := new(payload.Collection_List)
+	err := c.cc.Invoke(ctx, "/vald.v1.Collection/ListCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionClient) SwitchCollection(
+	ctx context.Context, in *payload.Collection_Switch, opts ...grpc.CallOption,
+) (*payload.Empty, error) {
+	out := new(payload.Empty)
+	err := c.cc.Invoke(ctx, "/vald.v1.Collection/SwitchCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollectionServer is the server API for Collection service.
+// All implementations must embed UnimplementedCollectionServer
+// for forward compatibility
+type CollectionServer interface {
+	// Overview
+	// CreateCollection RPC is the method to create a new named collection.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  6   | ALREADY_EXISTS    |
+	// |  13  | INTERNAL          |
+	CreateCollection(context.Context, *payload.Collection_CreateRequest) (*payload.Empty, error)
+	// Overview
+	// DropCollection RPC is the method to drop a named collection and all vectors in it.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  5   | NOT_FOUND         |
+	// |  13  | INTERNAL          |
+	DropCollection(context.Context, *payload.Collection_DropRequest) (*payload.Empty, error)
+	// Overview
+	// ListCollections RPC is the method to get the configurations of the named collections.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  13  | INTERNAL          |
+	ListCollections(context.Context, *payload.Empty) (*payload.Collection_List, error)
+	// Overview
+	// SwitchCollection RPC is the method to switch the requests of a collection to another collection, or to fence the write requests to a collection.
+	// The requests for the collection are served by the target collection after the switch, and the write requests to the fenced collection are rejected until the fence is removed.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  5   | NOT_FOUND         |
+	// |  13  | INTERNAL          |
+	SwitchCollection(context.Context, *payload.Collection_Switch) (*payload.Empty, error)
+	mustEmbedUnimplementedCollectionServer()
+}
+
+// UnimplementedCollectionServer must be embedded to have forward compatible implementations.
+type UnimplementedCollectionServer struct {
+}
+
+func (UnimplementedCollectionServer) CreateCollection(
+	context.Context, *payload.Collection_CreateRequest,
+) (*payload.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+
+func (UnimplementedCollectionServer) DropCollection(
+	context.Context, *payload.Collection_DropRequest,
+) (*payload.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropCollection not implemented")
+}
+
+func (UnimplementedCollectionServer) ListCollections(
+	context.Context, *payload.Empty,
+) (*payload.Collection_List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+
+func (UnimplementedCollectionServer) SwitchCollection(
+	context.Context, *payload.Collection_Switch,
+) (*payload.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchCollection not implemented")
+}
+
+func (UnimplementedCollectionServer) mustEmbedUnimplementedCollectionServer() {}
+
+// UnsafeCollectionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CollectionServer will
+// result in compilation errors.
+type UnsafeCollectionServer interface {
+	mustEmbedUnimplementedCollectionServer()
+}
+
+func RegisterCollectionServer(s grpc.ServiceRegistrar, srv CollectionServer) {
+	s.RegisterService(&Collection_ServiceDesc, srv)
+}
+
+func _Collection_CreateCollection_Handler(
+	srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	in := new(payload.Collection_CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vald.v1.Collection/CreateCollection",
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(CollectionServer).CreateCollection(ctx, req.(*payload.Collection_CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collection_DropCollection_Handler(
+	srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	in := new(payload.Collection_DropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServer).DropCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vald.v1.Collection/DropCollection",
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(CollectionServer).DropCollection(ctx, req.(*payload.Collection_DropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collection_ListCollections_Handler(
+	srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	in := new(payload.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vald.v1.Collection/ListCollections",
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(CollectionServer).ListCollections(ctx, req.(*payload.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Collection_SwitchCollection_Handler(
+	srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	in := new(payload.Collection_Switch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServer).SwitchCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vald.v1.Collection/SwitchCollection",
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(CollectionServer).SwitchCollection(ctx, req.(*payload.Collection_Switch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Collection_ServiceDesc is the grpc.ServiceDesc for Collection service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Collection_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "vald.v1.Collection",
+	HandlerType: (*CollectionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCollection",
+			Handler:    _Collection_CreateCollection_Handler,
+		},
+		{
+			MethodName: "DropCollection",
+			Handler:    _Collection_DropCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _Collection_ListCollections_Handler,
+		},
+		{
+			MethodName: "SwitchCollection",
+			Handler:    _Collection_SwitchCollection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/vald/collection.proto",
+}
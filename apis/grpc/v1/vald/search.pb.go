@@ -41,7 +41,7 @@ var File_v1_vald_search_proto protoreflect.FileDescriptor
 
 const file_v1_vald_search_proto_rawDesc = "" +
 	"\n" +
-	"\x14v1/vald/search.proto\x12\avald.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x18v1/payload/payload.proto2\xd0\t\n" +
+	"\x14v1/vald/search.proto\x12\avald.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x18v1/payload/payload.proto2\xfc\v\n" +
 	"\x06Search\x12U\n" +
 	"\x06Search\x12\x1a.payload.v1.Search.Request\x1a\x1b.payload.v1.Search.Response\"\x12\x82\xd3\xe4\x93\x02\f:\x01*\"\a/search\x12^\n" +
 	"\n" +
@@ -56,7 +56,11 @@ const file_v1_vald_search_proto_rawDesc = "" +
 	"\x12StreamLinearSearch\x12\x1a.payload.v1.Search.Request\x1a!.payload.v1.Search.StreamResponse\"\x00(\x010\x01\x12_\n" +
 	"\x16StreamLinearSearchByID\x12\x1c.payload.v1.Search.IDRequest\x1a!.payload.v1.Search.StreamResponse\"\x00(\x010\x01\x12u\n" +
 	"\x11MultiLinearSearch\x12\x1f.payload.v1.Search.MultiRequest\x1a\x1c.payload.v1.Search.Responses\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/linearsearch/multiple\x12~\n" +
-	"\x15MultiLinearSearchByID\x12!.payload.v1.Search.MultiIDRequest\x1a\x1c.payload.v1.Search.Responses\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/linearsearch/id/multipleBS\n" +
+	"\x15MultiLinearSearchByID\x12!.payload.v1.Search.MultiIDRequest\x1a\x1c.payload.v1.Search.Responses\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/linearsearch/id/multiple\x12j\n" +
+	"\vRangeSearch\x12\x1f.payload.v1.Search.RangeRequest\x1a .payload.v1.Search.RangeResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/search/range\x12Z\n" +
+	"\x11StreamRangeSearch\x12\x1f.payload.v1.Search.RangeRequest\x1a .payload.v1.Search.RangeResponse\"\x000\x01\x12b\n" +
+	"\n" +
+	"SearchNext\x12\x1e.payload.v1.Search.NextRequest\x1a\x1b.payload.v1.Search.Response\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/search/nextBS\n" +
 	"\x1aorg.vdaas.vald.api.v1.valdB\n" +
 	"ValdSearchP\x01Z'github.com/vdaas/vald/apis/grpc/v1/valdb\x06proto3"
 
@@ -65,9 +69,12 @@ var file_v1_vald_search_proto_goTypes = []any{
 	(*payload.Search_IDRequest)(nil),      // 1: payload.v1.Search.IDRequest
 	(*payload.Search_MultiRequest)(nil),   // 2: payload.v1.Search.MultiRequest
 	(*payload.Search_MultiIDRequest)(nil), // 3: payload.v1.Search.MultiIDRequest
-	(*payload.Search_Response)(nil),       // 4: payload.v1.Search.Response
-	(*payload.Search_StreamResponse)(nil), // 5: payload.v1.Search.StreamResponse
-	(*payload.Search_Responses)(nil),      // 6: payload.v1.Search.Responses
+	(*payload.Search_RangeRequest)(nil),   // 4: payload.v1.Search.RangeRequest
+	(*payload.Search_NextRequest)(nil),    // 5: payload.v1.Search.NextRequest
+	(*payload.Search_Response)(nil),       // 6: payload.v1.Search.Response
+	(*payload.Search_StreamResponse)(nil), // 7: payload.v1.Search.StreamResponse
+	(*payload.Search_Responses)(nil),      // 8: payload.v1.Search.Responses
+	(*payload.Search_RangeResponse)(nil),  // 9: payload.v1.Search.RangeResponse
 }
 var file_v1_vald_search_proto_depIdxs = []int32{
 	0,  // 0: vald.v1.Search.Search:input_type -> payload.v1.Search.Request
@@ -82,20 +89,26 @@ var file_v1_vald_search_proto_depIdxs = []int32{
 	1,  // 9: vald.v1.Search.StreamLinearSearchByID:input_type -> payload.v1.Search.IDRequest
 	2,  // 10: vald.v1.Search.MultiLinearSearch:input_type -> payload.v1.Search.MultiRequest
 	3,  // 11: vald.v1.Search.MultiLinearSearchByID:input_type -> payload.v1.Search.MultiIDRequest
-	4,  // 12: vald.v1.Search.Search:output_type -> payload.v1.Search.Response
-	4,  // 13: vald.v1.Search.SearchByID:output_type -> payload.v1.Search.Response
-	5,  // 14: vald.v1.Search.StreamSearch:output_type -> payload.v1.Search.StreamResponse
-	5,  // 15: vald.v1.Search.StreamSearchByID:output_type -> payload.v1.Search.StreamResponse
-	6,  // 16: vald.v1.Search.MultiSearch:output_type -> payload.v1.Search.Responses
-	6,  // 17: vald.v1.Search.MultiSearchByID:output_type -> payload.v1.Search.Responses
-	4,  // 18: vald.v1.Search.LinearSearch:output_type -> payload.v1.Search.Response
-	4,  // 19: vald.v1.Search.LinearSearchByID:output_type -> payload.v1.Search.Response
-	5,  // 20: vald.v1.Search.StreamLinearSearch:output_type -> payload.v1.Search.StreamResponse
-	5,  // 21: vald.v1.Search.StreamLinearSearchByID:output_type -> payload.v1.Search.StreamResponse
-	6,  // 22: vald.v1.Search.MultiLinearSearch:output_type -> payload.v1.Search.Responses
-	6,  // 23: vald.v1.Search.MultiLinearSearchByID:output_type -> payload.v1.Search.Responses
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
+	4,  // 12: vald.v1.Search.RangeSearch:input_type -> payload.v1.Search.RangeRequest
+	4,  // 13: vald.v1.Search.StreamRangeSearch:input_type -> payload.v1.Search.RangeRequest
+	5,  // 14: vald.v1.Search.SearchNext:input_type -> payload.v1.Search.NextRequest
+	6,  // 15: vald.v1.Search.Search:output_type -> payload.v1.Search.Response
+	6,  // 16: vald.v1.Search.SearchByID:output_type -> payload.v1.Search.Response
+	7,  // 17: vald.v1.Search.StreamSearch:output_type -> payload.v1.Search.StreamResponse
+	7,  // 18: vald.v1.Search.StreamSearchByID:output_type -> payload.v1.Search.StreamResponse
+	8,  // 19: vald.v1.Search.MultiSearch:output_type -> payload.v1.Search.Responses
+	8,  // 20: vald.v1.Search.MultiSearchByID:output_type -> payload.v1.Search.Responses
+	6,  // 21: vald.v1.Search.LinearSearch:output_type -> payload.v1.Search.Response
+	6,  // 22: vald.v1.Search.LinearSearchByID:output_type -> payload.v1.Search.Response
+	7,  // 23: vald.v1.Search.StreamLinearSearch:output_type -> payload.v1.Search.StreamResponse
+	7,  // 24: vald.v1.Search.StreamLinearSearchByID:output_type -> payload.v1.Search.StreamResponse
+	8,  // 25: vald.v1.Search.MultiLinearSearch:output_type -> payload.v1.Search.Responses
+	8,  // 26: vald.v1.Search.MultiLinearSearchByID:output_type -> payload.v1.Search.Responses
+	9,  // 27: vald.v1.Search.RangeSearch:output_type -> payload.v1.Search.RangeResponse
+	9,  // 28: vald.v1.Search.StreamRangeSearch:output_type -> payload.v1.Search.RangeResponse
+	6,  // 29: vald.v1.Search.SearchNext:output_type -> payload.v1.Search.Response
+	15, // [15:30] is the sub-list for method output_type
+	0,  // [0:15] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
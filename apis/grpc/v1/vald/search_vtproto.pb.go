@@ -375,6 +375,68 @@ type SearchClient interface {
 	// | NOT_FOUND         | The Requested ID is not inserted on the target Vald cluster, or the search result is insufficient to the required result length. | Send a request with another vector or set min_num to a smaller value.                    |
 	// | INTERNAL          | Target Vald cluster or network route has some critical error.                                                                    | Check target Vald cluster first and check network route including ingress as second.     |
 	MultiLinearSearchByID(ctx context.Context, in *payload.Search_MultiIDRequest, opts ...grpc.CallOption) (*payload.Search_Responses, error)
+	// Overview
+	// RangeSearch RPC is the method to search every vector within the radius from the request vector.<br>
+	// The results are sorted by the distance and returned page by page. Send the `next_cursor` of the response as the `cursor` of the next request to get the next page.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  10  | ABORTED           |
+	// |  13  | INTERNAL          |
+	// ---
+	// Troubleshooting
+	// The request process may not be completed when the response code is NOT `0 (OK)`.
+	//
+	// Here are some common reasons and how to resolve each error.
+	//
+	// | name              | common reason                                                                                                                          | how to resolve                                                                           |
+	// | :---------------- | :------------------------------------------------------------------------------------------------------------------------------------- | :--------------------------------------------------------------------------------------- |
+	// | CANCELLED         | Executed cancel() of rpc from client/server-side or network problems between client and server.                                        | Check the code, especially around timeout and connection management, and fix if needed.  |
+	// | INVALID_ARGUMENT  | The Dimension of the request vector is NOT the same as Vald Agent's config, the cursor is invalid, or some request payload is invalid. | Check Agent config, request payload, and fix request payload or Agent config.            |
+	// | DEADLINE_EXCEEDED | The RPC timeout setting is too short on the client/server side.                                                                        | Check the gRPC timeout setting on both the client and server sides and fix it if needed. |
+	// | INTERNAL          | Target Vald cluster or network route has some critical error.                                                                          | Check target Vald cluster first and check network route including ingress as second.     |
+	RangeSearch(ctx context.Context, in *payload.Search_RangeRequest, opts ...grpc.CallOption) (*payload.Search_RangeResponse, error)
+	// Overview
+	// StreamRangeSearch RPC is the method to search every vector within the radius from the request vector using the [server streaming RPC](https://grpc.io/docs/what-is-grpc/core-concepts/#server-streaming-rpc).<br>
+	// The results are sorted by the distance and sent page by page until every result is sent.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  10  | ABORTED           |
+	// |  13  | INTERNAL          |
+	StreamRangeSearch(ctx context.Context, in *payload.Search_RangeRequest, opts ...grpc.CallOption) (Search_StreamRangeSearchClient, error)
+	// Overview
+	// SearchNext RPC is the method to get the next page of the search results.<br>
+	// Send the `next_cursor` of the Search or SearchByID response requested with `with_cursor` to get the page following it. The page has the same number of results as the first page, and each page follows the last result of the previous page in the distance order.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  5   | NOT_FOUND         |
+	// |  10  | ABORTED           |
+	// |  13  | INTERNAL          |
+	// ---
+	// Troubleshooting
+	// The request process may not be completed when the response code is NOT `0 (OK)`.
+	//
+	// Here are some common reasons and how to resolve each error.
+	//
+	// | name              | common reason                                                                                   | how to resolve                                                                           |
+	// | :---------------- | :---------------------------------------------------------------------------------------------- | :--------------------------------------------------------------------------------------- |
+	// | CANCELLED         | Executed cancel() of rpc from client/server-side or network problems between client and server. | Check the code, especially around timeout and connection management, and fix if needed.  |
+	// | INVALID_ARGUMENT  | The cursor is invalid.                                                                          | Send the `next_cursor` of the previous response as it is.                                |
+	// | DEADLINE_EXCEEDED | The RPC timeout setting is too short on the client/server side.                                 | Check the gRPC timeout setting on both the client and server sides and fix it if needed. |
+	// | NOT_FOUND         | There is no more search result.                                                                 | Stop requesting the next page.                                                           |
+	// | INTERNAL          | Target Vald cluster or network route has some critical error.                                   | Check target Vald cluster first and check network route including ingress as second.     |
+	SearchNext(ctx context.Context, in *payload.Search_NextRequest, opts ...grpc.CallOption) (*payload.Search_Response, error)
 }
 
 type searchClient struct {
@@ -605,6 +667,62 @@ func (c *searchClient) MultiLinearSearchByID(
 	return out, nil
 }
 
+func (c *searchClient) RangeSearch(
+	ctx context.Context, in *payload.Search_RangeRequest, opts ...grpc.CallOption,
+) (*payload.Search_RangeResponse, error) {
+	out := new(payload.Search_RangeResponse)
+	err := c.cc.Invoke(ctx, "/vald.v1.Search/RangeSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *searchClient) StreamRangeSearch(
+	ctx context.Context, in *payload.Search_RangeRequest, opts ...grpc.CallOption,
+) (Search_StreamRangeSearchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Search_ServiceDesc.Streams[4], "/vald.v1.Search/StreamRangeSearch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &searchStreamRangeSearchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Search_StreamRangeSearchClient interface {
+	Recv() (*payload.Search_RangeResponse, error)
+	grpc.ClientStream
+}
+
+type searchStreamRangeSearchClient struct {
+	grpc.ClientStream
+}
+
+func (x *searchStreamRangeSearchClient) Recv() (*payload.Search_RangeResponse, error) {
+	m := new(payload.Search_RangeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *searchClient) SearchNext(
+	ctx context.Context, in *payload.Search_NextRequest, opts ...grpc.CallOption,
+) (*payload.Search_Response, error) {
+	out := new(payload.Search_Response)
+	err := c.cc.Invoke(ctx, "/vald.v1.Search/SearchNext", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SearchServer is the server API for Search service.
 // All implementations must embed UnimplementedSearchServer
 // for forward compatibility
@@ -944,6 +1062,68 @@ type SearchServer interface {
 	// | NOT_FOUND         | The Requested ID is not inserted on the target Vald cluster, or the search result is insufficient to the required result length. | Send a request with another vector or set min_num to a smaller value.                    |
 	// | INTERNAL          | Target Vald cluster or network route has some critical error.                                                                    | Check target Vald cluster first and check network route including ingress as second.     |
 	MultiLinearSearchByID(context.Context, *payload.Search_MultiIDRequest) (*payload.Search_Responses, error)
+	// Overview
+	// RangeSearch RPC is the method to search every vector within the radius from the request vector.<br>
+	// The results are sorted by the distance and returned page by page. Send the `next_cursor` of the response as the `cursor` of the next request to get the next page.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  10  | ABORTED           |
+	// |  13  | INTERNAL          |
+	// ---
+	// Troubleshooting
+	// The request process may not be completed when the response code is NOT `0 (OK)`.
+	//
+	// Here are some common reasons and how to resolve each error.
+	//
+	// | name              | common reason                                                                                                                          | how to resolve                                                                           |
+	// | :---------------- | :------------------------------------------------------------------------------------------------------------------------------------- | :--------------------------------------------------------------------------------------- |
+	// | CANCELLED         | Executed cancel() of rpc from client/server-side or network problems between client and server.                                        | Check the code, especially around timeout and connection management, and fix if needed.  |
+	// | INVALID_ARGUMENT  | The Dimension of the request vector is NOT the same as Vald Agent's config, the cursor is invalid, or some request payload is invalid. | Check Agent config, request payload, and fix request payload or Agent config.            |
+	// | DEADLINE_EXCEEDED | The RPC timeout setting is too short on the client/server side.                                                                        | Check the gRPC timeout setting on both the client and server sides and fix it if needed. |
+	// | INTERNAL          | Target Vald cluster or network route has some critical error.                                                                          | Check target Vald cluster first and check network route including ingress as second.     |
+	RangeSearch(context.Context, *payload.Search_RangeRequest) (*payload.Search_RangeResponse, error)
+	// Overview
+	// StreamRangeSearch RPC is the method to search every vector within the radius from the request vector using the [server streaming RPC](https://grpc.io/docs/what-is-grpc/core-concepts/#server-streaming-rpc).<br>
+	// The results are sorted by the distance and sent page by page until every result is sent.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  10  | ABORTED           |
+	// |  13  | INTERNAL          |
+	StreamRangeSearch(*payload.Search_RangeRequest, Search_StreamRangeSearchServer) error
+	// Overview
+	// SearchNext RPC is the method to get the next page of the search results.<br>
+	// Send the `next_cursor` of the Search or SearchByID response requested with `with_cursor` to get the page following it. The page has the same number of results as the first page, and each page follows the last result of the previous page in the distance order.
+	// ---
+	// Status Code
+	// |  0   | OK                |
+	// |  1   | CANCELLED         |
+	// |  3   | INVALID_ARGUMENT  |
+	// |  4   | DEADLINE_EXCEEDED |
+	// |  5   | NOT_FOUND         |
+	// |  10  | ABORTED           |
+	// |  13  | INTERNAL          |
+	// ---
+	// Troubleshooting
+	// The request process may not be completed when the response code is NOT `0 (OK)`.
+	//
+	// Here are some common reasons and how to resolve each error.
+	//
+	// | name              | common reason                                                                                   | how to resolve                                                                           |
+	// | :---------------- | :---------------------------------------------------------------------------------------------- | :--------------------------------------------------------------------------------------- |
+	// | CANCELLED         | Executed cancel() of rpc from client/server-side or network problems between client and server. | Check the code, especially around timeout and connection management, and fix if needed.  |
+	// | INVALID_ARGUMENT  | The cursor is invalid.                                                                          | Send the `next_cursor` of the previous response as it is.                                |
+	// | DEADLINE_EXCEEDED | The RPC timeout setting is too short on the client/server side.                                 | Check the gRPC timeout setting on both the client and server sides and fix it if needed. |
+	// | NOT_FOUND         | There is no more search result.                                                                 | Stop requesting the next page.                                                           |
+	// | INTERNAL          | Target Vald cluster or network route has some critical error.                                   | Check target Vald cluster first and check network route including ingress as second.     |
+	SearchNext(context.Context, *payload.Search_NextRequest) (*payload.Search_Response, error)
 	mustEmbedUnimplementedSearchServer()
 }
 
@@ -1013,6 +1193,25 @@ func (UnimplementedSearchServer) MultiLinearSearchByID(
 ) (*payload.Search_Responses, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiLinearSearchByID not implemented")
 }
+
+func (UnimplementedSearchServer) RangeSearch(
+	context.Context, *payload.Search_RangeRequest,
+) (*payload.Search_RangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RangeSearch not implemented")
+}
+
+func (UnimplementedSearchServer) StreamRangeSearch(
+	*payload.Search_RangeRequest, Search_StreamRangeSearchServer,
+) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRangeSearch not implemented")
+}
+
+func (UnimplementedSearchServer) SearchNext(
+	context.Context, *payload.Search_NextRequest,
+) (*payload.Search_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchNext not implemented")
+}
+
 func (UnimplementedSearchServer) mustEmbedUnimplementedSearchServer() {}
 
 // UnsafeSearchServer may be embedded to opt out of forward compatibility for this service.
@@ -1290,6 +1489,67 @@ func _Search_MultiLinearSearchByID_Handler(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Search_RangeSearch_Handler(
+	srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	in := new(payload.Search_RangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).RangeSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vald.v1.Search/RangeSearch",
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(SearchServer).RangeSearch(ctx, req.(*payload.Search_RangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Search_StreamRangeSearch_Handler(srv any, stream grpc.ServerStream) error {
+	m := new(payload.Search_RangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SearchServer).StreamRangeSearch(m, &searchStreamRangeSearchServer{stream})
+}
+
+type Search_StreamRangeSearchServer interface {
+	Send(*payload.Search_RangeResponse) error
+	grpc.ServerStream
+}
+
+type searchStreamRangeSearchServer struct {
+	grpc.ServerStream
+}
+
+func (x *searchStreamRangeSearchServer) Send(m *payload.Search_RangeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Search_SearchNext_Handler(
+	srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	in := new(payload.Search_NextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).SearchNext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vald.v1.Search/SearchNext",
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(SearchServer).SearchNext(ctx, req.(*payload.Search_NextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Search_ServiceDesc is the grpc.ServiceDesc for Search service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1329,6 +1589,14 @@ var Search_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MultiLinearSearchByID",
 			Handler:    _Search_MultiLinearSearchByID_Handler,
 		},
+		{
+			MethodName: "RangeSearch",
+			Handler:    _Search_RangeSearch_Handler,
+		},
+		{
+			MethodName: "SearchNext",
+			Handler:    _Search_SearchNext_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1355,6 +1623,11 @@ var Search_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StreamRangeSearch",
+			Handler:       _Search_StreamRangeSearch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "v1/vald/search.proto",
 }
@@ -18,6 +18,7 @@ package vald
 import grpc "google.golang.org/grpc"
 
 type Server interface {
+	CollectionServer
 	FlushServer
 	IndexServer
 	InsertServer
@@ -34,6 +35,7 @@ type ServerWithFilter interface {
 }
 
 type UnimplementedValdServer struct {
+	UnimplementedCollectionServer
 	UnimplementedFlushServer
 	UnimplementedIndexServer
 	UnimplementedInsertServer
@@ -50,6 +52,7 @@ type UnimplementedValdServerWithFilter struct {
 }
 
 type Client interface {
+	CollectionClient
 	FlushClient
 	IndexClient
 	InsertClient
@@ -68,15 +71,16 @@ type ClientWithFilter interface {
 const PackageName = "vald.v1"
 
 const (
-	FilterRPCServiceName = "Filter"
-	FlushRPCServiceName  = "Flush"
-	IndexRPCServiceName  = "Index"
-	InsertRPCServiceName = "Insert"
-	ObjectRPCServiceName = "Object"
-	RemoveRPCServiceName = "Remove"
-	SearchRPCServiceName = "Search"
-	UpdateRPCServiceName = "Update"
-	UpsertRPCServiceName = "Upsert"
+	CollectionRPCServiceName = "Collection"
+	FilterRPCServiceName     = "Filter"
+	FlushRPCServiceName      = "Flush"
+	IndexRPCServiceName      = "Index"
+	InsertRPCServiceName     = "Insert"
+	ObjectRPCServiceName     = "Object"
+	RemoveRPCServiceName     = "Remove"
+	SearchRPCServiceName     = "Search"
+	UpdateRPCServiceName     = "Update"
+	UpsertRPCServiceName     = "Upsert"
 )
 
 const (
@@ -120,6 +124,9 @@ const (
 	MultiLinearSearchObjectRPCName  = "MultiLinearSearchObject"
 	StreamLinearSearchObjectRPCName = "StreamLinearSearchObject"
 	StreamSearchObjectRPCName       = "StreamSearchObject"
+	RangeSearchRPCName              = "RangeSearch"
+	StreamRangeSearchRPCName        = "StreamRangeSearch"
+	SearchNextRPCName               = "SearchNext"
 
 	RemoveRPCName            = "Remove"
 	StreamRemoveRPCName      = "StreamRemove"
@@ -128,6 +135,11 @@ const (
 
 	FlushRPCName = "Flush"
 
+	CreateCollectionRPCName = "CreateCollection"
+	DropCollectionRPCName   = "DropCollection"
+	ListCollectionsRPCName  = "ListCollections"
+	SwitchCollectionRPCName = "SwitchCollection"
+
 	ExistsRPCName           = "Exists"
 	GetObjectRPCName        = "GetObject"
 	GetTimestampRPCName     = "GetTimestamp"
@@ -142,6 +154,7 @@ const (
 )
 
 type client struct {
+	CollectionClient
 	FlushClient
 	IndexClient
 	InsertClient
@@ -153,6 +166,7 @@ type client struct {
 }
 
 func RegisterValdServer(s *grpc.Server, srv Server) {
+	RegisterCollectionServer(s, srv)
 	RegisterFlushServer(s, srv)
 	RegisterIndexServer(s, srv)
 	RegisterInsertServer(s, srv)
@@ -170,13 +184,14 @@ func RegisterValdServerWithFilter(s *grpc.Server, srv ServerWithFilter) {
 
 func NewValdClient(conn *grpc.ClientConn) Client {
 	return &client{
-		FlushClient:  NewFlushClient(conn),
-		IndexClient:  NewIndexClient(conn),
-		InsertClient: NewInsertClient(conn),
-		ObjectClient: NewObjectClient(conn),
-		RemoveClient: NewRemoveClient(conn),
-		SearchClient: NewSearchClient(conn),
-		UpdateClient: NewUpdateClient(conn),
-		UpsertClient: NewUpsertClient(conn),
+		CollectionClient: NewCollectionClient(conn),
+		FlushClient:      NewFlushClient(conn),
+		IndexClient:      NewIndexClient(conn),
+		InsertClient:     NewInsertClient(conn),
+		ObjectClient:     NewObjectClient(conn),
+		RemoveClient:     NewRemoveClient(conn),
+		SearchClient:     NewSearchClient(conn),
+		UpdateClient:     NewUpdateClient(conn),
+		UpsertClient:     NewUpsertClient(conn),
 	}
 }
@@ -347,6 +347,20 @@ servers:
       interceptors: []
       {{- end }}
       enable_reflection: {{ default .default.servers.grpc.server.grpc.enable_reflection .Values.servers.grpc.server.grpc.enable_reflection }}
+      {{- if .Values.servers.grpc.server.grpc.quota }}
+      quota:
+        {{- toYaml .Values.servers.grpc.server.grpc.quota | nindent 8 }}
+      {{- else if .default.servers.grpc.server.grpc.quota }}
+      quota:
+        {{- toYaml .default.servers.grpc.server.grpc.quota | nindent 8 }}
+      {{- end }}
+      {{- if .Values.servers.grpc.server.grpc.priority }}
+      priority:
+        {{- toYaml .Values.servers.grpc.server.grpc.priority | nindent 8 }}
+      {{- else if .default.servers.grpc.server.grpc.priority }}
+      priority:
+        {{- toYaml .default.servers.grpc.server.grpc.priority | nindent 8 }}
+      {{- end }}
       {{- else }}
       {{- toYaml .default.servers.grpc.server.grpc | nindent 6 }}
       {{- end }}
@@ -73,6 +73,7 @@ type BlobStorage struct {
 	// bucket name
 	Bucket       string        `json:"bucket,omitempty"`
 	CloudStorage *CloudStorage `json:"cloud_storage,omitempty"`
+	File         *File         `json:"file,omitempty"`
 	S3           *S3           `json:"s3,omitempty"`
 
 	// storage type
@@ -124,11 +125,14 @@ type Config struct {
 	Client                *config.GRPCClient `json:"client,omitempty"`
 	Compress              *Compress          `json:"compress,omitempty"`
 	RestoreBackoff        *config.Backoff    `json:"restore_backoff,omitempty"`
+	Versioning            *Versioning        `json:"versioning,omitempty"`
+	Encryption            *Encryption        `json:"encryption,omitempty"`
 	AutoBackupDuration    string             `json:"auto_backup_duration,omitempty"`
 	Filename              string             `json:"filename,omitempty"`
 	FilenameSuffix        string             `json:"filename_suffix,omitempty"`
 	PostStopTimeout       string             `json:"post_stop_timeout,omitempty"`
 	AutoBackupEnabled     bool               `json:"auto_backup_enabled,omitempty"`
+	DeltaBackupEnabled    bool               `json:"delta_backup_enabled,omitempty"`
 	RestoreBackoffEnabled bool               `json:"restore_backoff_enabled,omitempty"`
 	WatchEnabled          bool               `json:"watch_enabled,omitempty"`
 }
@@ -180,12 +184,34 @@ type EgressFilter struct {
 	ObjectFilters []string `json:"object_filters,omitempty"`
 }
 
+// Encryption.
+type Encryption struct {
+	// id of the key to encrypt the new backups. empty uses the last key in the key file. required for the plugin provider
+	KeyID string `json:"key_id,omitempty"`
+	// path of the key file for the keyfile provider. keep the rotated keys in the file to restore the old backups
+	Keyfile string `json:"keyfile,omitempty"`
+	// provider of the key encryption keys
+	Provider string `json:"provider,omitempty"`
+	// command and arguments of the plugin provider which wraps and unwraps the data keys
+	PluginCommand []string `json:"plugin_command,omitempty"`
+	// restore the backups uploaded before the encryption is enabled as they are. the unencrypted backups fail to restore when it is false
+	AllowUnencryptedRestore bool `json:"allow_unencrypted_restore,omitempty"`
+	// encrypt the backups with AES-GCM envelope encryption before they are uploaded
+	Enabled bool `json:"enabled,omitempty"`
+}
+
 // EnvItems.
 type EnvItems struct{}
 
 // Fields k8s field selectors for pod discovery.
 type Fields struct{}
 
+// File.
+type File struct {
+	// root directory of the buckets for the file storage type. the objects are stored under `<path>/<bucket>`
+	Path string `json:"path,omitempty"`
+}
+
 // Filter.
 type Filter struct {
 	ServerConfig                  *ServerConfig                     `json:"server_config,omitempty"`
@@ -846,6 +872,18 @@ type Values struct {
 	Manager     *Manager     `json:"manager,omitempty"`
 }
 
+// Versioning.
+type Versioning struct {
+	// generations older than this duration are deleted. empty keeps all generations
+	MaxAge string `json:"max_age,omitempty"`
+	// generation id restored by the initContainer. `latest` restores the newest generation with valid metadata
+	RestoreGeneration string `json:"restore_generation,omitempty"`
+	// number of the newest generations to keep. 0 keeps all generations
+	MaxGenerations int `json:"max_generations,omitempty"`
+	// upload every backup as a new immutable generation listed in the manifest instead of overwriting the backup file
+	Enabled bool `json:"enabled,omitempty"`
+}
+
 // VolumeMountsItems.
 type VolumeMountsItems struct{}
 
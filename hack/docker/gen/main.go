@@ -763,6 +763,10 @@ func main() {
 			AppName:    "index-exportation",
 			PackageDir: "index/job/exportation",
 		},
+		vald + "-index-migration": {
+			AppName:    "index-migration",
+			PackageDir: "index/job/migration",
+		},
 		vald + "-" + readreplicaRotate: {
 			AppName:    readreplicaRotate,
 			PackageDir: "index/job/readreplica/rotate",
@@ -0,0 +1,121 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package distance implements the distance functions of the dense vectors by the distance types of the agents,
+// so that the distances computed out of the agents, e.g., by the re-ranking of the LB gateway, are comparable to theirs.
+package distance
+
+import (
+	"math"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/strings"
+)
+
+// Type represents the distance type.
+type Type int
+
+const (
+	// L1 is l1 norm.
+	L1 Type = iota
+	// L2 is l2 norm.
+	L2
+	// NormalizedL2 is l2 norm of the normalized vectors.
+	NormalizedL2
+	// Angle is angle distance.
+	Angle
+	// Cosine is cosine distance.
+	Cosine
+	// InnerProduct is the negative inner product, which is smaller for the closer vectors.
+	InnerProduct
+)
+
+// Parse returns the distance type of the name used by the agent configuration.
+// The distance types of the non-float vectors and the hyperbolic spaces are not supported.
+func Parse(dt string) (Type, error) {
+	switch strings.TrimForCompare(dt) {
+	case "l1":
+		return L1, nil
+	case "l2":
+		return L2, nil
+	case "normalizedl2", "norml2", "nol2", "nl2":
+		return NormalizedL2, nil
+	case "angle", "ang", "normalizedangle", "normalizedang", "normang", "nang", "nangle":
+		return Angle, nil
+	case "cosine", "cos", "normalizedcosine", "normalizedcos", "normcos", "ncos", "ncosine":
+		return Cosine, nil
+	case "dotproduct", "dotp", "dproduct", "dp", "innerproduct", "innerp", "iproduct", "ip":
+		return InnerProduct, nil
+	}
+	return 0, errors.Wrapf(errors.ErrUnsupportedDistanceType, "distance type %s", dt)
+}
+
+// Distance returns the distance between x and y. The extra elements of the longer vector are ignored.
+func (t Type) Distance(x, y []float32) float32 {
+	n := min(len(x), len(y))
+	x, y = x[:n], y[:n]
+	switch t {
+	case L1:
+		var d float64
+		for i := range x {
+			d += math.Abs(float64(x[i] - y[i]))
+		}
+		return float32(d)
+	case L2:
+		var d float64
+		for i := range x {
+			v := float64(x[i] - y[i])
+			d += v * v
+		}
+		return float32(math.Sqrt(d))
+	}
+	var dot, nx, ny float64
+	for i := range x {
+		dot += float64(x[i]) * float64(y[i])
+		nx += float64(x[i]) * float64(x[i])
+		ny += float64(y[i]) * float64(y[i])
+	}
+	if t == InnerProduct {
+		return float32(-dot)
+	}
+	cos := 0.0
+	if nx > 0 && ny > 0 {
+		cos = max(-1, min(1, dot/math.Sqrt(nx*ny)))
+	}
+	switch t {
+	case NormalizedL2:
+		// the squared distance of the unit vectors is 2 * (1 - cos).
+		return float32(math.Sqrt(2 * (1 - cos)))
+	case Angle:
+		return float32(math.Acos(cos))
+	}
+	return float32(1 - cos)
+}
+
+// Similarity returns the similarity of the distance d of the type, which is larger for the closer vectors.
+// It is the cosine for the normalized distance types, the inner product for InnerProduct, and 1 / (1 + d) in (0, 1]
+// for L1 and L2, so the similarities are comparable regardless of the sign and the scale of the distances.
+func (t Type) Similarity(d float32) float32 {
+	switch t {
+	case L1, L2:
+		return 1 / (1 + d)
+	case NormalizedL2:
+		return 1 - d*d/2
+	case Angle:
+		return float32(math.Cos(float64(d)))
+	case Cosine:
+		return 1 - d
+	}
+	return -d
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package distance
+
+import (
+	"math"
+	"testing"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestType_Distance(t *testing.T) {
+	type test struct {
+		name string
+		dt   string
+		x, y []float32
+		want float32
+	}
+	tests := []test{
+		{
+			name: "return l1 distance",
+			dt:   "l1",
+			x:    []float32{1, 2},
+			y:    []float32{4, -2},
+			want: 7,
+		},
+		{
+			name: "return l2 distance",
+			dt:   "l2",
+			x:    []float32{1, 2},
+			y:    []float32{4, -2},
+			want: 5,
+		},
+		{
+			name: "return l2 distance of the normalized vectors",
+			dt:   "normalizedl2",
+			x:    []float32{2, 0},
+			y:    []float32{0, 3},
+			want: float32(math.Sqrt2),
+		},
+		{
+			name: "return angle distance",
+			dt:   "ang",
+			x:    []float32{1, 0},
+			y:    []float32{0, 2},
+			want: math.Pi / 2,
+		},
+		{
+			name: "return cosine distance",
+			dt:   "normalizedcosine",
+			x:    []float32{1, 0},
+			y:    []float32{-3, 0},
+			want: 2,
+		},
+		{
+			name: "return the negative inner product",
+			dt:   "ip",
+			x:    []float32{1, 2},
+			y:    []float32{3, 4},
+			want: -11,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			typ, err := Parse(test.dt)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if got := typ.Distance(test.x, test.y); math.Abs(float64(got-test.want)) > 1e-6 {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestType_Similarity(t *testing.T) {
+	x, y := []float32{1, 0}, []float32{1, 1}
+	tests := []struct {
+		name string
+		dt   Type
+		want float32
+	}{
+		{
+			name: "return 1 / (1 + d) of l2 distance",
+			dt:   L2,
+			want: 0.5,
+		},
+		{
+			name: "return the cosine of l2 distance of the normalized vectors",
+			dt:   NormalizedL2,
+			want: float32(math.Sqrt2 / 2),
+		},
+		{
+			name: "return the cosine of angle distance",
+			dt:   Angle,
+			want: float32(math.Sqrt2 / 2),
+		},
+		{
+			name: "return the cosine of cosine distance",
+			dt:   Cosine,
+			want: float32(math.Sqrt2 / 2),
+		},
+		{
+			name: "return the inner product of the negative inner product",
+			dt:   InnerProduct,
+			want: 1,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if got := test.dt.Similarity(test.dt.Distance(x, y)); math.Abs(float64(got-test.want)) > 1e-6 {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	if _, err := Parse("hamming"); err == nil {
+		t.Error("want the error of the unsupported distance type")
+	}
+}
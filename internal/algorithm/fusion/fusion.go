@@ -0,0 +1,216 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fusion implements the fusion of the dense and sparse search results of the hybrid search.
+// Both the agent, which fuses the results of its own dense and sparse indexes, and the LB gateway,
+// which fuses the results aggregated from the agents, delegate here so that the results are ranked
+// in the same way wherever they are fused.
+package fusion
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+)
+
+const (
+	// DefaultRankConstant is the rank constant of the reciprocal rank fusion used when it is not set.
+	DefaultRankConstant = 60
+
+	defaultWeight = 1
+)
+
+// Enabled reports whether the fusion is enabled by the configuration.
+func Enabled(cfg *payload.Search_Fusion) bool {
+	switch cfg.GetAlgorithm() {
+	case payload.Search_Fusion_RRF, payload.Search_Fusion_Weighted:
+		return true
+	}
+	return false
+}
+
+type candidate struct {
+	id     string
+	dist   float32
+	score  float32
+	drank  uint32
+	srank  uint32
+	dense  bool
+	sparse bool
+	fused  float64
+}
+
+// Fuse ranks the results by the fusion algorithm of cfg and returns the top size results.
+//
+// The result which has the hybrid score is regarded as found by the dense search when its dense
+// rank is not 0 and by the sparse search when its sparse rank is not 0, and the result without
+// the hybrid score is regarded as the dense search result of its distance. The results are
+// deduplicated by ID and the dense and sparse ranks are re-calculated over the given results, so
+// that the results of the multiple agents are fused as if they were returned by a single index.
+//
+// The fused score is
+//
+//	RRF:      dense_weight / (rank_constant + dense_rank) + sparse_weight / (rank_constant + sparse_rank)
+//	Weighted: sparse_weight * sparse_score - dense_weight * dense_distance
+//
+// where the term of the missing rank is omitted for RRF, and for Weighted the missing sparse
+// score is 0 and the missing dense distance is the largest dense distance of the results.
+// The distance of each fused result is the negated fused score, so that the smaller is the better
+// as well as the dense search results. When the fusion is disabled, results are returned as they are.
+func Fuse(cfg *payload.Search_Fusion, results []*payload.Object_Distance, size int) []*payload.Object_Distance {
+	if !Enabled(cfg) {
+		if size > 0 && len(results) > size {
+			return results[:size]
+		}
+		return results
+	}
+	cands := merge(results)
+	if len(cands) == 0 {
+		return nil
+	}
+	worst := rank(cands)
+
+	dw, sw := weights(cfg)
+	k := float64(cfg.GetRankConstant())
+	if k == 0 {
+		k = DefaultRankConstant
+	}
+	for _, c := range cands {
+		switch cfg.GetAlgorithm() {
+		case payload.Search_Fusion_RRF:
+			if c.dense {
+				c.fused += dw / (k + float64(c.drank))
+			}
+			if c.sparse {
+				c.fused += sw / (k + float64(c.srank))
+			}
+		case payload.Search_Fusion_Weighted:
+			dist := worst
+			if c.dense {
+				dist = c.dist
+			}
+			c.fused = sw*float64(c.score) - dw*float64(dist)
+		}
+	}
+	slices.SortStableFunc(cands, func(a, b *candidate) int {
+		if c := cmp.Compare(b.fused, a.fused); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.id, b.id)
+	})
+	if size > 0 && len(cands) > size {
+		cands = cands[:size]
+	}
+	res := make([]*payload.Object_Distance, 0, len(cands))
+	for _, c := range cands {
+		res = append(res, &payload.Object_Distance{
+			Id:       c.id,
+			Distance: float32(-c.fused),
+			Hybrid: &payload.Object_HybridScore{
+				DenseRank:     c.drank,
+				DenseDistance: c.dist,
+				SparseRank:    c.srank,
+				SparseScore:   c.score,
+			},
+		})
+	}
+	return res
+}
+
+// merge deduplicates the results by ID and keeps the best dense distance and sparse score of each ID.
+func merge(results []*payload.Object_Distance) []*candidate {
+	cands := make([]*candidate, 0, len(results))
+	idx := make(map[string]int, len(results))
+	for _, r := range results {
+		if r == nil || r.GetId() == "" {
+			continue
+		}
+		c := &candidate{id: r.GetId()}
+		if h := r.GetHybrid(); h == nil {
+			c.dense, c.dist = true, r.GetDistance()
+		} else {
+			c.dense, c.dist = h.GetDenseRank() != 0, h.GetDenseDistance()
+			c.sparse, c.score = h.GetSparseRank() != 0, h.GetSparseScore()
+		}
+		i, ok := idx[c.id]
+		if !ok {
+			idx[c.id] = len(cands)
+			cands = append(cands, c)
+			continue
+		}
+		e := cands[i]
+		if c.dense && (!e.dense || c.dist < e.dist) {
+			e.dense, e.dist = true, c.dist
+		}
+		if c.sparse && (!e.sparse || c.score > e.score) {
+			e.sparse, e.score = true, c.score
+		}
+	}
+	return cands
+}
+
+// rank sets the dense and sparse ranks of the candidates and returns the largest dense distance.
+func rank(cands []*candidate) (worst float32) {
+	dense := make([]*candidate, 0, len(cands))
+	sparse := make([]*candidate, 0, len(cands))
+	for _, c := range cands {
+		c.drank, c.srank = 0, 0
+		if c.dense {
+			dense = append(dense, c)
+		} else {
+			c.dist = 0
+		}
+		if c.sparse {
+			sparse = append(sparse, c)
+		} else {
+			c.score = 0
+		}
+	}
+	slices.SortStableFunc(dense, func(a, b *candidate) int {
+		if c := cmp.Compare(a.dist, b.dist); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.id, b.id)
+	})
+	slices.SortStableFunc(sparse, func(a, b *candidate) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.id, b.id)
+	})
+	for i, c := range dense {
+		c.drank = uint32(i + 1)
+	}
+	for i, c := range sparse {
+		c.srank = uint32(i + 1)
+	}
+	if len(dense) != 0 {
+		worst = dense[len(dense)-1].dist
+	}
+	return worst
+}
+
+// weights returns the weights of the dense and the sparse search results.
+// The default weight is used only when the weight is not set, so that the explicit 0 weight ignores the search result.
+func weights(cfg *payload.Search_Fusion) (dw, sw float64) {
+	dw, sw = defaultWeight, defaultWeight
+	if cfg.DenseWeight != nil {
+		dw = float64(cfg.GetDenseWeight())
+	}
+	if cfg.SparseWeight != nil {
+		sw = float64(cfg.GetSparseWeight())
+	}
+	return dw, sw
+}
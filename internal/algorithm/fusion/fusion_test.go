@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fusion
+
+import (
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func dense(id string, rank uint32, dist float32) *payload.Object_Distance {
+	return &payload.Object_Distance{
+		Id:       id,
+		Distance: dist,
+		Hybrid: &payload.Object_HybridScore{
+			DenseRank:     rank,
+			DenseDistance: dist,
+		},
+	}
+}
+
+func sparse(id string, rank uint32, score float32) *payload.Object_Distance {
+	return &payload.Object_Distance{
+		Id: id,
+		Hybrid: &payload.Object_HybridScore{
+			SparseRank:  rank,
+			SparseScore: score,
+		},
+	}
+}
+
+func weight(w float32) *float32 {
+	return &w
+}
+
+func ids(res []*payload.Object_Distance) []string {
+	out := make([]string, 0, len(res))
+	for _, r := range res {
+		out = append(out, r.GetId())
+	}
+	return out
+}
+
+func TestFuse(t *testing.T) {
+	type test struct {
+		name    string
+		cfg     *payload.Search_Fusion
+		results []*payload.Object_Distance
+		size    int
+		want    []string
+	}
+
+	tests := []test{
+		{
+			name: "results are returned as they are when the fusion is disabled",
+			results: []*payload.Object_Distance{
+				{Id: "b", Distance: 2},
+				{Id: "a", Distance: 1},
+				{Id: "c", Distance: 3},
+			},
+			size: 2,
+			want: []string{"b", "a"},
+		},
+		{
+			name: "rrf ranks the result found by both searches first",
+			cfg:  &payload.Search_Fusion{Algorithm: payload.Search_Fusion_RRF},
+			results: []*payload.Object_Distance{
+				dense("a", 1, 0.1),
+				dense("b", 2, 0.2),
+				sparse("c", 1, 5),
+				sparse("b", 2, 3),
+			},
+			size: 3,
+			want: []string{"b", "a", "c"},
+		},
+		{
+			name: "rrf re-calculates the ranks over the results of the multiple agents",
+			cfg:  &payload.Search_Fusion{Algorithm: payload.Search_Fusion_RRF, SparseWeight: weight(2)},
+			results: []*payload.Object_Distance{
+				// agent 1
+				dense("a", 1, 0.5),
+				sparse("a", 1, 1),
+				// agent 2
+				dense("b", 1, 0.1),
+				sparse("c", 1, 9),
+			},
+			size: 3,
+			want: []string{"a", "c", "b"},
+		},
+		{
+			name: "weighted score combines the sparse score and the dense distance",
+			cfg:  &payload.Search_Fusion{Algorithm: payload.Search_Fusion_Weighted, DenseWeight: weight(2)},
+			results: []*payload.Object_Distance{
+				dense("a", 1, 0.1),
+				dense("b", 2, 0.3),
+				sparse("b", 1, 1.5),
+				sparse("c", 2, 1),
+			},
+			size: 2,
+			want: []string{"b", "c"},
+		},
+		{
+			name: "weighted score ignores the dense distance of the zero weight",
+			cfg:  &payload.Search_Fusion{Algorithm: payload.Search_Fusion_Weighted, DenseWeight: weight(0)},
+			results: []*payload.Object_Distance{
+				dense("a", 1, 0.1),
+				dense("c", 2, 0.3),
+				sparse("c", 1, 1.5),
+				sparse("b", 2, 1),
+			},
+			size: 2,
+			want: []string{"c", "b"},
+		},
+		{
+			name: "duplicated results keep the best scores",
+			cfg:  &payload.Search_Fusion{Algorithm: payload.Search_Fusion_Weighted},
+			results: []*payload.Object_Distance{
+				dense("a", 1, 0.9),
+				dense("a", 1, 0.1),
+				{Id: "b", Distance: 0.5},
+			},
+			size: 10,
+			want: []string{"a", "b"},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			got := Fuse(test.cfg, test.results, test.size)
+			if g := ids(got); len(g) != len(test.want) {
+				tt.Fatalf("got ids %v, want %v", g, test.want)
+			}
+			for i, id := range ids(got) {
+				if id != test.want[i] {
+					tt.Errorf("got ids %v, want %v", ids(got), test.want)
+					break
+				}
+			}
+			if !Enabled(test.cfg) {
+				return
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i-1].GetDistance() > got[i].GetDistance() {
+					tt.Errorf("distances are not sorted: %v", got)
+				}
+			}
+		})
+	}
+}
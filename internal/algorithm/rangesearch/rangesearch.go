@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rangesearch implements the ordering, the cursor and the pagination of the range search results.
+// Both the agent, which pages its own results, and the LB gateway, which merges the pages of the agents,
+// order the results by the distance and the ID, so the cursor of the last result of a page points to the
+// same position of the results wherever it is decoded.
+package rangesearch
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+	"slices"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+)
+
+// DefaultPageSize is the number of results in a page used when the page size is not set.
+const DefaultPageSize = 100
+
+// Cursor represents the position of the last result of a page.
+type Cursor struct {
+	ID       string
+	Distance float32
+}
+
+// Source returns the next page of the results sorted by Compare. It returns io.EOF after the last page.
+type Source func() ([]*payload.Object_Distance, error)
+
+// Compare compares the results by the distance and then by the ID.
+func Compare(a, b *payload.Object_Distance) int {
+	if c := cmp.Compare(a.GetDistance(), b.GetDistance()); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.GetId(), b.GetId())
+}
+
+// PageSize returns the page size, or DefaultPageSize when it is 0.
+func PageSize(size uint32) int {
+	if size == 0 {
+		return DefaultPageSize
+	}
+	return int(size)
+}
+
+// Encode returns the cursor pointing to the position right after d.
+func Encode(d *payload.Object_Distance) string {
+	buf := make([]byte, 4, 4+len(d.GetId()))
+	binary.BigEndian.PutUint32(buf, math.Float32bits(d.GetDistance()))
+	return base64.RawURLEncoding.EncodeToString(append(buf, d.GetId()...))
+}
+
+// Decode decodes the cursor. It returns nil when the cursor is empty.
+func Decode(cursor string) (*Cursor, error) {
+	if len(cursor) == 0 {
+		return nil, nil
+	}
+	buf, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(buf) <= 4 {
+		return nil, errors.ErrInvalidRangeSearchCursor(cursor)
+	}
+	return &Cursor{
+		ID:       string(buf[4:]),
+		Distance: math.Float32frombits(binary.BigEndian.Uint32(buf[:4])),
+	}, nil
+}
+
+// After reports whether d is located after the cursor. Every result is located after the nil cursor.
+func (c *Cursor) After(d *payload.Object_Distance) bool {
+	if c == nil {
+		return true
+	}
+	return Compare(d, &payload.Object_Distance{
+		Id:       c.ID,
+		Distance: c.Distance,
+	}) > 0
+}
+
+// Sort sorts the results by Compare and removes the results of the IDs which appear earlier.
+// The replicas of a vector are stored in several agents, so only the nearest one is left.
+func Sort(results []*payload.Object_Distance) []*payload.Object_Distance {
+	slices.SortFunc(results, Compare)
+	seen := make(map[string]struct{}, len(results))
+	return slices.DeleteFunc(results, func(d *payload.Object_Distance) bool {
+		if _, ok := seen[d.GetId()]; ok {
+			return true
+		}
+		seen[d.GetId()] = struct{}{}
+		return false
+	})
+}
+
+// Page returns at most size results located after the cursor from the results sorted by Sort,
+// and the cursor of the next page, which is empty when there is no more result.
+func Page(
+	results []*payload.Object_Distance, cursor string, size uint32,
+) (page []*payload.Object_Distance, next string, err error) {
+	c, err := Decode(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	start, _ := slices.BinarySearchFunc(results, c, func(d *payload.Object_Distance, c *Cursor) int {
+		if c.After(d) {
+			return 1
+		}
+		return -1
+	})
+	results = results[start:]
+	if n := PageSize(size); len(results) > n {
+		results = results[:n]
+		next = Encode(results[n-1])
+	}
+	return results, next, nil
+}
+
+// Merge merges the pages of the sources into the pages of size results sorted by Compare, and calls f with
+// each page and the cursor of the next page, which is empty for the last page.
+// Only the current page of each source is held, so the results are not materialized at once, though the IDs of
+// the merged results are kept to drop the replicas of a vector returned by several sources.
+func Merge(srcs []Source, size uint32, f func(page []*payload.Object_Distance, next string) error) error {
+	type source struct {
+		next Source
+		page []*payload.Object_Distance
+		done bool
+	}
+	ss := make([]*source, 0, len(srcs))
+	fill := func(s *source) error {
+		for len(s.page) == 0 && !s.done {
+			page, err := s.next()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					s.done = true
+					return nil
+				}
+				return err
+			}
+			s.page = page
+		}
+		return nil
+	}
+	for _, src := range srcs {
+		s := &source{next: src}
+		if err := fill(s); err != nil {
+			return err
+		}
+		ss = append(ss, s)
+	}
+	n := PageSize(size)
+	seen := make(map[string]struct{})
+	page := make([]*payload.Object_Distance, 0, n)
+	for {
+		var head *source
+		for _, s := range ss {
+			if len(s.page) != 0 && (head == nil || Compare(s.page[0], head.page[0]) < 0) {
+				head = s
+			}
+		}
+		if head == nil {
+			break
+		}
+		d := head.page[0]
+		head.page = head.page[1:]
+		if err := fill(head); err != nil {
+			return err
+		}
+		if _, ok := seen[d.GetId()]; ok {
+			continue
+		}
+		seen[d.GetId()] = struct{}{}
+		if len(page) == n {
+			if err := f(page, Encode(page[n-1])); err != nil {
+				return err
+			}
+			page = make([]*payload.Object_Distance, 0, n)
+		}
+		page = append(page, d)
+	}
+	if len(page) == 0 {
+		return nil
+	}
+	return f(page, "")
+}
@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rangesearch
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func dist(id string, d float32) *payload.Object_Distance {
+	return &payload.Object_Distance{
+		Id:       id,
+		Distance: d,
+	}
+}
+
+func ids(res []*payload.Object_Distance) []string {
+	out := make([]string, 0, len(res))
+	for _, r := range res {
+		out = append(out, r.GetId())
+	}
+	return out
+}
+
+func source(pages ...[]*payload.Object_Distance) Source {
+	return func() ([]*payload.Object_Distance, error) {
+		if len(pages) == 0 {
+			return nil, io.EOF
+		}
+		page := pages[0]
+		pages = pages[1:]
+		return page, nil
+	}
+}
+
+func TestCursor(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	d := dist("vector-1", 0.25)
+	c, err := Decode(Encode(d))
+	if err != nil {
+		t.Fatalf("failed to decode the cursor: %v", err)
+	}
+	if c.ID != d.GetId() || c.Distance != d.GetDistance() {
+		t.Errorf("got cursor %+v, want %v", c, d)
+	}
+	if c.After(d) {
+		t.Error("the result of the cursor must not be located after the cursor")
+	}
+	if !c.After(dist("vector-2", 0.25)) || !c.After(dist("vector-0", 0.5)) {
+		t.Error("the results ordered after the cursor must be located after the cursor")
+	}
+	if c, err := Decode(""); c != nil || err != nil {
+		t.Errorf("got cursor %v and error %v for the empty cursor", c, err)
+	}
+	if _, err := Decode("not a cursor"); err == nil {
+		t.Error("decoding the invalid cursor must fail")
+	}
+}
+
+func TestPage(t *testing.T) {
+	type test struct {
+		name    string
+		results []*payload.Object_Distance
+		size    uint32
+		want    [][]string
+	}
+
+	tests := []test{
+		{
+			name: "results are paged in order of the distance and the id",
+			results: []*payload.Object_Distance{
+				dist("c", 0.2),
+				dist("a", 0.1),
+				dist("e", 0.3),
+				dist("b", 0.2),
+				dist("d", 0.3),
+			},
+			size: 2,
+			want: [][]string{{"a", "b"}, {"c", "d"}, {"e"}},
+		},
+		{
+			name: "the last page has no next cursor when it is full",
+			results: []*payload.Object_Distance{
+				dist("a", 0.1),
+				dist("b", 0.2),
+			},
+			size: 2,
+			want: [][]string{{"a", "b"}},
+		},
+		{
+			name: "duplicated ids keep the nearest result",
+			results: []*payload.Object_Distance{
+				dist("a", 0.3),
+				dist("b", 0.2),
+				dist("a", 0.1),
+			},
+			size: 10,
+			want: [][]string{{"a", "b"}},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			results := Sort(test.results)
+			var (
+				got    [][]string
+				cursor string
+			)
+			for {
+				page, next, err := Page(results, cursor, test.size)
+				if err != nil {
+					tt.Fatalf("failed to page the results: %v", err)
+				}
+				got = append(got, ids(page))
+				if next == "" {
+					break
+				}
+				cursor = next
+			}
+			if !slices.EqualFunc(got, test.want, slices.Equal) {
+				tt.Errorf("got pages %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	srcs := []Source{
+		source(
+			[]*payload.Object_Distance{dist("a", 0.1), dist("c", 0.3)},
+			[]*payload.Object_Distance{dist("e", 0.5)},
+		),
+		source(
+			[]*payload.Object_Distance{dist("b", 0.2), dist("c", 0.3)},
+			[]*payload.Object_Distance{dist("d", 0.4), dist("f", 0.6)},
+		),
+		source(),
+	}
+	var (
+		got   [][]string
+		nexts []string
+	)
+	err := Merge(srcs, 2, func(page []*payload.Object_Distance, next string) error {
+		got = append(got, ids(page))
+		nexts = append(nexts, next)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to merge the sources: %v", err)
+	}
+	want := [][]string{{"a", "b"}, {"c", "d"}, {"e", "f"}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got pages %v, want %v", got, want)
+	}
+	if nexts[0] != Encode(dist("b", 0.2)) || nexts[1] != Encode(dist("d", 0.4)) || nexts[2] != "" {
+		t.Errorf("got next cursors %v", nexts)
+	}
+}
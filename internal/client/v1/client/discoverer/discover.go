@@ -25,6 +25,7 @@ import (
 
 	"github.com/vdaas/vald/apis/grpc/v1/discoverer"
 	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/log"
 	"github.com/vdaas/vald/internal/net"
@@ -46,6 +47,10 @@ type Client interface {
 	// Internally, this API round robin between c.client and c.readClient with the ratio of
 	// agent replicas and read replica agent replicas.
 	GetReadClient() grpc.Client
+
+	// IsBusy returns true when the agent at addr was indexing, saving, flushing or loading its index at the last discovery.
+	// It always returns false unless the index state check is enabled.
+	IsBusy(addr string) bool
 }
 
 type client struct {
@@ -67,6 +72,9 @@ type client struct {
 	readReplicaReplicas uint64
 	roundRobin          atomic.Uint64
 	autoconn            bool
+	// checkIndexState enables fetching the index state of the agents on each discovery to deprioritize the busy agents.
+	checkIndexState bool
+	busy            atomic.Pointer[map[string]bool]
 }
 
 func New(opts ...Option) (d Client, err error) {
@@ -200,7 +208,38 @@ func (c *client) GetAddrs(ctx context.Context) (addrs []string) {
 	} else {
 		addrs = *a
 	}
-	return addrs
+	return c.deprioritizeBusy(addrs)
+}
+
+// deprioritizeBusy returns addrs with the busy agents moved to the end, keeping the order of the others.
+func (c *client) deprioritizeBusy(addrs []string) []string {
+	if !c.checkIndexState {
+		return addrs
+	}
+	busy := c.busy.Load()
+	if busy == nil || len(*busy) == 0 {
+		return addrs
+	}
+	ordered := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		if !(*busy)[addr] {
+			ordered = append(ordered, addr)
+		}
+	}
+	for _, addr := range addrs {
+		if (*busy)[addr] {
+			ordered = append(ordered, addr)
+		}
+	}
+	return ordered
+}
+
+func (c *client) IsBusy(addr string) bool {
+	if !c.checkIndexState {
+		return false
+	}
+	busy := c.busy.Load()
+	return busy != nil && (*busy)[addr]
 }
 
 func (c *client) GetClient() grpc.Client {
@@ -305,9 +344,55 @@ func (c *client) discover(ctx context.Context) (err error) {
 
 	oldAddrs := c.GetAddrs(ctx)
 	c.addrs.Store(&connected)
+	if c.checkIndexState {
+		c.updateIndexStates(ctx, connected)
+	}
 	return c.disconnectOldAddrs(ctx, oldAddrs, connected)
 }
 
+// updateIndexStates fetches the index state of the agents and stores the busy ones.
+// The agent which does not answer within the discovery duration is regarded as busy, and the agent which fails to
+// answer is not, since it may not support the index information API.
+func (c *client) updateIndexStates(ctx context.Context, addrs []string) {
+	var mu sync.Mutex
+	busy := make(map[string]bool, len(addrs))
+	err := c.client.OrderedRangeConcurrent(ctx, addrs, -1, func(ctx context.Context,
+		addr string, conn *grpc.ClientConn, copts ...grpc.CallOption,
+	) error {
+		ictx, cancel := context.WithTimeout(ctx, c.dscDur)
+		defer cancel()
+		cnt, err := vald.NewIndexClient(conn).IndexInfo(ictx, new(payload.Empty), copts...)
+		if err != nil {
+			if !errors.Is(ictx.Err(), context.DeadlineExceeded) {
+				return err
+			}
+		} else if !isBusy(cnt) {
+			return nil
+		}
+		mu.Lock()
+		busy[addr] = true
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		log.Debugf("failed to fetch the index state of the agents, error: %v", err)
+	}
+	c.busy.Store(&busy)
+}
+
+// isBusy returns true when the index of the agent or any of its collections is locked by an index operation.
+func isBusy(cnt *payload.Info_Index_Count) bool {
+	if cnt.GetIndexing() || cnt.GetSaving() || cnt.GetFlushing() || cnt.GetLoading() {
+		return true
+	}
+	for _, c := range cnt.GetCollections() {
+		if isBusy(c) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *client) updateDiscoveryInfo(ctx context.Context) (connected []string, err error) {
 	nodes, err := c.discoverNodes(ctx)
 	if err != nil {
@@ -16,7 +16,9 @@
 package discoverer
 
 import (
+	"context"
 	"reflect"
+	"slices"
 	"sync/atomic"
 	"testing"
 
@@ -2094,3 +2096,54 @@ func Test_client_GetReadClient_concurrent(t *testing.T) {
 // 		})
 // 	}
 // }
+
+func Test_client_GetAddrs_busy(t *testing.T) {
+	addrs := []string{"10.0.0.1:8081", "10.0.0.2:8081", "10.0.0.3:8081", "10.0.0.4:8081"}
+	tests := []struct {
+		name            string
+		checkIndexState bool
+		busy            map[string]bool
+		want            []string
+		wantBusy        string
+	}{
+		{
+			name:            "return the addrs as they are when the index state check is disabled",
+			checkIndexState: false,
+			busy:            map[string]bool{addrs[0]: true},
+			want:            addrs,
+		},
+		{
+			name:            "return the addrs as they are when no agent is busy",
+			checkIndexState: true,
+			busy:            map[string]bool{},
+			want:            addrs,
+		},
+		{
+			name:            "return the busy agents last keeping the order of the others",
+			checkIndexState: true,
+			busy:            map[string]bool{addrs[0]: true, addrs[2]: true},
+			want:            []string{addrs[1], addrs[3], addrs[0], addrs[2]},
+			wantBusy:        addrs[2],
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			c := &client{
+				checkIndexState: test.checkIndexState,
+			}
+			c.addrs.Store(&addrs)
+			c.busy.Store(&test.busy)
+			if got := c.GetAddrs(context.Background()); !slices.Equal(got, test.want) {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+			if test.wantBusy != "" && !c.IsBusy(test.wantBusy) {
+				tt.Errorf("IsBusy(%s) = false, want true", test.wantBusy)
+			}
+			if c.IsBusy(addrs[1]) {
+				tt.Errorf("IsBusy(%s) = true, want false", addrs[1])
+			}
+		})
+	}
+}
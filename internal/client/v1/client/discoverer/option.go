@@ -154,3 +154,12 @@ func WithReadReplicaReplicas(num uint64) Option {
 		return nil
 	}
 }
+
+// WithIndexStateCheck returns the option to fetch the index state of the agents on each discovery, so that GetAddrs
+// returns the agents indexing, saving, flushing or loading their index last.
+func WithIndexStateCheck(enabled bool) Option {
+	return func(c *client) error {
+		c.checkIndexState = enabled
+		return nil
+	}
+}
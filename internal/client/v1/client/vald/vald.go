@@ -351,6 +351,69 @@ func (c *client) MultiLinearSearchByID(
 	return res, nil
 }
 
+func (c *client) RangeSearch(
+	ctx context.Context, in *payload.Search_RangeRequest, opts ...grpc.CallOption,
+) (res *payload.Search_RangeResponse, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "internal/client/"+vald.RangeSearchRPCName), apiName+"/"+vald.RangeSearchRPCName)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	res, err = grpc.RoundRobin(ctx, c.c, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption,
+	) (*payload.Search_RangeResponse, error) {
+		return vald.NewValdClient(conn).RangeSearch(ctx, in, append(copts, opts...)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *client) StreamRangeSearch(
+	ctx context.Context, in *payload.Search_RangeRequest, opts ...grpc.CallOption,
+) (res vald.Search_StreamRangeSearchClient, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "internal/client/"+vald.StreamRangeSearchRPCName), apiName+"/"+vald.StreamRangeSearchRPCName)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	res, err = grpc.RoundRobin(ctx, c.c, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption,
+	) (vald.Search_StreamRangeSearchClient, error) {
+		return vald.NewValdClient(conn).StreamRangeSearch(ctx, in, append(copts, opts...)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *client) SearchNext(
+	ctx context.Context, in *payload.Search_NextRequest, opts ...grpc.CallOption,
+) (res *payload.Search_Response, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "internal/client/"+vald.SearchNextRPCName), apiName+"/"+vald.SearchNextRPCName)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	res, err = grpc.RoundRobin(ctx, c.c, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption,
+	) (*payload.Search_Response, error) {
+		return vald.NewValdClient(conn).SearchNext(ctx, in, append(copts, opts...)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (c *client) Insert(
 	ctx context.Context, in *payload.Insert_Request, opts ...grpc.CallOption,
 ) (res *payload.Object_Location, err error) {
@@ -645,6 +708,90 @@ func (c *client) Flush(
 	return res, nil
 }
 
+func (c *client) CreateCollection(
+	ctx context.Context, in *payload.Collection_CreateRequest, opts ...grpc.CallOption,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "internal/client/"+vald.CreateCollectionRPCName), apiName+"/"+vald.CreateCollectionRPCName)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	res, err = grpc.RoundRobin(ctx, c.c, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption,
+	) (*payload.Empty, error) {
+		return vald.NewValdClient(conn).CreateCollection(ctx, in, append(copts, opts...)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *client) DropCollection(
+	ctx context.Context, in *payload.Collection_DropRequest, opts ...grpc.CallOption,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "internal/client/"+vald.DropCollectionRPCName), apiName+"/"+vald.DropCollectionRPCName)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	res, err = grpc.RoundRobin(ctx, c.c, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption,
+	) (*payload.Empty, error) {
+		return vald.NewValdClient(conn).DropCollection(ctx, in, append(copts, opts...)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *client) ListCollections(
+	ctx context.Context, in *payload.Empty, opts ...grpc.CallOption,
+) (res *payload.Collection_List, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "internal/client/"+vald.ListCollectionsRPCName), apiName+"/"+vald.ListCollectionsRPCName)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	res, err = grpc.RoundRobin(ctx, c.c, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption,
+	) (*payload.Collection_List, error) {
+		return vald.NewValdClient(conn).ListCollections(ctx, in, append(copts, opts...)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *client) SwitchCollection(
+	ctx context.Context, in *payload.Collection_Switch, opts ...grpc.CallOption,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "internal/client/"+vald.SwitchCollectionRPCName), apiName+"/"+vald.SwitchCollectionRPCName)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	res, err = grpc.RoundRobin(ctx, c.c, func(ctx context.Context,
+		conn *grpc.ClientConn,
+		copts ...grpc.CallOption,
+	) (*payload.Empty, error) {
+		return vald.NewValdClient(conn).SwitchCollection(ctx, in, append(copts, opts...)...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (c *client) RemoveByTimestamp(
 	ctx context.Context, in *payload.Remove_TimestampRequest, opts ...grpc.CallOption,
 ) (res *payload.Object_Locations, err error) {
@@ -1023,6 +1170,42 @@ func (c *singleClient) MultiLinearSearchByID(
 	return c.vc.MultiLinearSearchByID(ctx, in, opts...)
 }
 
+func (c *singleClient) RangeSearch(
+	ctx context.Context, in *payload.Search_RangeRequest, opts ...grpc.CallOption,
+) (res *payload.Search_RangeResponse, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "internal/singleClient/"+vald.RangeSearchRPCName), apiName+"/"+vald.RangeSearchRPCName)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return c.vc.RangeSearch(ctx, in, opts...)
+}
+
+func (c *singleClient) StreamRangeSearch(
+	ctx context.Context, in *payload.Search_RangeRequest, opts ...grpc.CallOption,
+) (res vald.Search_StreamRangeSearchClient, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "internal/singleClient/"+vald.StreamRangeSearchRPCName), apiName+"/"+vald.StreamRangeSearchRPCName)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return c.vc.StreamRangeSearch(ctx, in, opts...)
+}
+
+func (c *singleClient) SearchNext(
+	ctx context.Context, in *payload.Search_NextRequest, opts ...grpc.CallOption,
+) (res *payload.Search_Response, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "internal/singleClient/"+vald.SearchNextRPCName), apiName+"/"+vald.SearchNextRPCName)
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return c.vc.SearchNext(ctx, in, opts...)
+}
+
 func (c *singleClient) Insert(
 	ctx context.Context, in *payload.Insert_Request, opts ...grpc.CallOption,
 ) (res *payload.Object_Location, err error) {
@@ -1191,6 +1374,54 @@ func (c *singleClient) Flush(
 	return c.vc.Flush(ctx, in, opts...)
 }
 
+func (c *singleClient) CreateCollection(
+	ctx context.Context, in *payload.Collection_CreateRequest, opts ...grpc.CallOption,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/singleClient.CreateCollection")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return c.vc.CreateCollection(ctx, in, opts...)
+}
+
+func (c *singleClient) DropCollection(
+	ctx context.Context, in *payload.Collection_DropRequest, opts ...grpc.CallOption,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/singleClient.DropCollection")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return c.vc.DropCollection(ctx, in, opts...)
+}
+
+func (c *singleClient) ListCollections(
+	ctx context.Context, in *payload.Empty, opts ...grpc.CallOption,
+) (res *payload.Collection_List, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/singleClient.ListCollections")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return c.vc.ListCollections(ctx, in, opts...)
+}
+
+func (c *singleClient) SwitchCollection(
+	ctx context.Context, in *payload.Collection_Switch, opts ...grpc.CallOption,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/singleClient.SwitchCollection")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return c.vc.SwitchCollection(ctx, in, opts...)
+}
+
 func (c *singleClient) RemoveByTimestamp(
 	ctx context.Context, in *payload.Remove_TimestampRequest, opts ...grpc.CallOption,
 ) (res *payload.Object_Locations, err error) {
@@ -23,6 +23,8 @@ const (
 	// S3 represents s3 storage type.
 	S3 BlobStorageType = 1 + iota
 	CloudStorage
+	// File represents the local filesystem storage type.
+	File
 )
 
 // String returns blob storage type.
@@ -32,6 +34,8 @@ func (bst BlobStorageType) String() string {
 		return "s3"
 	case CloudStorage:
 		return "cloud_storage"
+	case File:
+		return "file"
 	}
 	return "unknown"
 }
@@ -43,6 +47,8 @@ func AtoBST(bst string) BlobStorageType {
 		return S3
 	case CloudStorage.String():
 		return CloudStorage
+	case File.String():
+		return File
 	}
 	return 0
 }
@@ -53,6 +59,8 @@ type Blob struct {
 	S3 *S3Config `json:"s3" yaml:"s3"`
 	// CloudStorage represents CloudStorage configuration.
 	CloudStorage *CloudStorageConfig `json:"cloud_storage" yaml:"cloud_storage"`
+	// File represents the local filesystem storage configuration.
+	File *FileConfig `json:"file" yaml:"file"`
 	// StorageType represents the storage type.
 	StorageType string `json:"storage_type" yaml:"storage_type"`
 	// Bucket represents the bucket name.
@@ -149,6 +157,11 @@ func (b *Blob) Bind() *Blob {
 	}
 	b.CloudStorage.Bind()
 
+	if b.File == nil {
+		b.File = new(FileConfig)
+	}
+	b.File.Bind()
+
 	return b
 }
 
@@ -181,3 +194,15 @@ func (c *CloudStorageConfig) Bind() *CloudStorageConfig {
 
 	return c
 }
+
+// FileConfig represents the local filesystem storage configuration.
+type FileConfig struct {
+	// Path represents the root directory of the buckets.
+	Path string `json:"path" yaml:"path"`
+}
+
+// Bind binds the actual data from the FileConfig receiver field.
+func (f *FileConfig) Bind() *FileConfig {
+	f.Path = GetActualValue(f.Path)
+	return f
+}
@@ -55,6 +55,13 @@ func TestBlobStorageType_String(t *testing.T) {
 				want: "cloud_storage",
 			},
 		},
+		{
+			name: "return file when the bst is File",
+			bst:  File,
+			want: want{
+				want: "file",
+			},
+		},
 		{
 			name: "return unknown when the bst is empty",
 			want: want{
@@ -151,6 +158,15 @@ func TestAtoBST(t *testing.T) {
 				want: CloudStorage,
 			},
 		},
+		{
+			name: "return File when the bst is file",
+			args: args{
+				bst: "file",
+			},
+			want: want{
+				want: File,
+			},
+		},
 		{
 			name: "return 0 when the bst is empty",
 			want: want{
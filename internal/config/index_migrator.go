@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package config
+
+// IndexMigrator represents the configurations for index migration.
+type IndexMigrator struct {
+	// Gateway represents the gateway client configuration.
+	Gateway *GRPCClient `json:"gateway" yaml:"gateway"`
+	// Transformer represents the ingress filter client configuration which generates the vectors of the target collection.
+	Transformer *GRPCClient `json:"transformer" yaml:"transformer"`
+	// SourceCollection represents the collection to be migrated. The default collection is migrated when it is empty.
+	SourceCollection string `json:"source_collection" yaml:"source_collection"`
+	// TargetCollection represents the shadow collection which stores the migrated vectors.
+	TargetCollection string `json:"target_collection" yaml:"target_collection"`
+	// TargetDimension represents the dimension of the target collection.
+	TargetDimension int `json:"target_dimension" yaml:"target_dimension"`
+	// TargetDistanceType represents the distance type of the target collection.
+	TargetDistanceType string `json:"target_distance_type" yaml:"target_distance_type"`
+	// TargetObjectType represents the object type of the target collection.
+	TargetObjectType string `json:"target_object_type" yaml:"target_object_type"`
+	// Concurrency represents the concurrency.
+	Concurrency int `json:"concurrency" yaml:"concurrency"`
+	// CatchUpPasses represents the maximum number of the passes after the backfill to migrate the vectors written during the migration.
+	CatchUpPasses int `json:"catch_up_passes" yaml:"catch_up_passes"`
+	// SwitchSearch represents whether to switch the requests of the source collection to the target collection after the migration.
+	SwitchSearch bool `json:"switch_search" yaml:"switch_search"`
+}
+
+func (im *IndexMigrator) Bind() *IndexMigrator {
+	im.SourceCollection = GetActualValue(im.SourceCollection)
+	im.TargetCollection = GetActualValue(im.TargetCollection)
+	im.TargetDistanceType = GetActualValue(im.TargetDistanceType)
+	im.TargetObjectType = GetActualValue(im.TargetObjectType)
+
+	if im.Gateway != nil {
+		im.Gateway = im.Gateway.Bind()
+	}
+	if im.Transformer != nil {
+		im.Transformer = im.Transformer.Bind()
+	}
+	return im
+}
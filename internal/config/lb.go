@@ -36,6 +36,95 @@ type LB struct {
 	ReadReplicaReplicas uint64 `json:"read_replica_replicas" yaml:"read_replica_replicas"`
 	// MultiOperationConcurrency represents the multi operation concurrency.
 	MultiOperationConcurrency int `json:"multi_operation_concurrency" yaml:"multi_operation_concurrency"`
+	// Placement represents the placement strategy of the vectors, memory or rendezvous.
+	Placement string `json:"placement" yaml:"placement"`
+	// RebalanceDuration represents the interval to check the changes of the agents to rebalance the vectors under the rendezvous placement.
+	RebalanceDuration string `json:"rebalance_duration" yaml:"rebalance_duration"`
+	// AvoidBusyAgents enables to deprioritize the agents indexing, saving, flushing or loading their index.
+	AvoidBusyAgents bool `json:"avoid_busy_agents" yaml:"avoid_busy_agents"`
+	// Partition represents the partition routing configuration.
+	Partition *Partition `json:"partition,omitempty" yaml:"partition"`
+	// Straggler represents the configuration to handle the slow agents of the search.
+	Straggler *Straggler `json:"straggler,omitempty" yaml:"straggler"`
+	// ResultCache represents the configuration of the cache of the search responses.
+	ResultCache *ResultCache `json:"result_cache,omitempty" yaml:"result_cache"`
+	// Rerank represents the configuration of the re-ranking of the search results.
+	Rerank *Rerank `json:"rerank,omitempty" yaml:"rerank"`
+}
+
+// Partition represents the configuration of the partition routing, which routes the vectors of the default collection
+// to the agents owning their nearest coarse centroids.
+type Partition struct {
+	// Partitions represents the number of the partitions. The partition routing is disabled when it is 0.
+	Partitions int `json:"partitions,omitempty" yaml:"partitions"`
+	// Nprobe represents the number of the partitions searched when the request does not specify it.
+	Nprobe int `json:"nprobe,omitempty" yaml:"nprobe"`
+	// EnableTraining enables training the partition table and migrating the vectors on the gateway.
+	EnableTraining bool `json:"enable_training,omitempty" yaml:"enable_training"`
+	// TrainSampleSize represents the number of the vectors sampled to train the partition table.
+	TrainSampleSize int `json:"train_sample_size,omitempty" yaml:"train_sample_size"`
+	// ImbalanceRatio represents the ratio of the vectors of the largest agent to the average to retrain the partition table.
+	ImbalanceRatio float64 `json:"imbalance_ratio,omitempty" yaml:"imbalance_ratio"`
+	// CheckDuration represents the interval to reload the partition table and check the partitions.
+	CheckDuration string `json:"check_duration,omitempty" yaml:"check_duration"`
+}
+
+// Bind binds the actual data from the Partition receiver fields.
+func (p *Partition) Bind() *Partition {
+	p.CheckDuration = GetActualValue(p.CheckDuration)
+	return p
+}
+
+// Straggler represents the configuration to handle the slow agents of the search, such as the agents creating the
+// index, by skipping them or hedging the requests to the other agents holding the same vectors.
+type Straggler struct {
+	// Enabled enables to stop waiting for the slow agents once the other agents cover the searched vectors and min_num.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled"`
+	// Hedge enables to call the agents holding the same vectors one by one after the hedge delay.
+	Hedge bool `json:"hedge,omitempty" yaml:"hedge"`
+	// Percentile represents the percentile of the latencies of the agent used as the hedge delay.
+	Percentile float64 `json:"percentile,omitempty" yaml:"percentile"`
+	// MinDelay represents the minimum hedge delay.
+	MinDelay string `json:"min_delay,omitempty" yaml:"min_delay"`
+}
+
+// Bind binds the actual data from the Straggler receiver fields.
+func (s *Straggler) Bind() *Straggler {
+	s.MinDelay = GetActualValue(s.MinDelay)
+	return s
+}
+
+// ResultCache represents the configuration of the cache of the search responses, which is invalidated when the gateway
+// handles the requests changing the vectors.
+type ResultCache struct {
+	// Enabled enables to cache the search responses.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled"`
+	// TTL represents the duration to keep the search responses in the cache.
+	TTL string `json:"ttl,omitempty" yaml:"ttl"`
+	// MaxMemory represents the max memory of the search responses in the cache, which is unlimited when it is 0.
+	MaxMemory string `json:"max_memory,omitempty" yaml:"max_memory"`
+}
+
+// Bind binds the actual data from the ResultCache receiver fields.
+func (c *ResultCache) Bind() *ResultCache {
+	c.TTL = GetActualValue(c.TTL)
+	c.MaxMemory = GetActualValue(c.MaxMemory)
+	return c
+}
+
+// Rerank represents the configuration of the re-ranking of the search results by the exact distances of the vectors
+// stored in the agents, which is requested by the search config.
+type Rerank struct {
+	// DistanceType represents the distance type to re-rank the search results, which is disabled when it is empty.
+	DistanceType string `json:"distance_type,omitempty" yaml:"distance_type"`
+	// Ratio represents the ratio of the number of the candidates to the number of the results when the request does not set it.
+	Ratio float64 `json:"ratio,omitempty" yaml:"ratio"`
+}
+
+// Bind binds the actual data from the Rerank receiver fields.
+func (r *Rerank) Bind() *Rerank {
+	r.DistanceType = GetActualValue(r.DistanceType)
+	return r
 }
 
 // Bind binds the actual data from the LB receiver fields.
@@ -44,10 +133,24 @@ func (g *LB) Bind() *LB {
 	g.AgentNamespace = GetActualValue(g.AgentNamespace)
 	g.AgentDNS = GetActualValue(g.AgentDNS)
 	g.NodeName = GetActualValue(g.NodeName)
+	g.Placement = GetActualValue(g.Placement)
+	g.RebalanceDuration = GetActualValue(g.RebalanceDuration)
 
 	if g.Discoverer != nil {
 		g.Discoverer = g.Discoverer.Bind()
 	}
+	if g.Partition != nil {
+		g.Partition = g.Partition.Bind()
+	}
+	if g.Straggler != nil {
+		g.Straggler = g.Straggler.Bind()
+	}
+	if g.ResultCache != nil {
+		g.ResultCache = g.ResultCache.Bind()
+	}
+	if g.Rerank != nil {
+		g.Rerank = g.Rerank.Bind()
+	}
 	return g
 }
 
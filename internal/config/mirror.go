@@ -45,6 +45,57 @@ type Mirror struct {
 	// Group represents the group name of the Mirror Gateways.
 	// It is used to discover ValdMirrorTarget resources with the same group name.
 	Group string `json:"group" yaml:"group"`
+
+	// Replication represents the configuration of the replication of the write requests to the other Mirror Gateways.
+	Replication *MirrorReplication `json:"replication,omitempty" yaml:"replication"`
+}
+
+// MirrorReplication represents the configuration of the replication of the write requests to the other Mirror Gateways.
+type MirrorReplication struct {
+	// Mode represents the replication mode, sync or async.
+	// sync forwards the write requests to the other Mirror Gateways inline, and async appends them to the outbox shipped to the targets.
+	Mode string `json:"mode,omitempty" yaml:"mode"`
+
+	// OutboxPath represents the directory of the durable outbox and the cursors of the targets in the async mode.
+	OutboxPath string `json:"outbox_path,omitempty" yaml:"outbox_path"`
+
+	// BatchSize represents the max number of the write requests shipped to a target at once.
+	BatchSize int `json:"batch_size,omitempty" yaml:"batch_size"`
+
+	// FlushDuration represents the interval to ship the write requests remaining in the outbox.
+	FlushDuration string `json:"flush_duration,omitempty" yaml:"flush_duration"`
+
+	// MaxOutboxSize represents the max size of the write requests pending in the outbox, e.g. 1GB, which is unlimited when it is 0.
+	MaxOutboxSize string `json:"max_outbox_size,omitempty" yaml:"max_outbox_size"`
+
+	// OverflowPolicy represents the policy applied when the outbox reaches the max size, drop or reject.
+	// drop drops the oldest pending write requests, and reject rejects the new write request with RESOURCE_EXHAUSTED.
+	OverflowPolicy string `json:"overflow_policy,omitempty" yaml:"overflow_policy"`
+
+	// MaxLag represents the replication lag of a target to raise the alarm, which is disabled when it is empty.
+	MaxLag string `json:"max_lag,omitempty" yaml:"max_lag"`
+
+	// RetentionDuration represents the duration to keep the pending write requests of a target after it leaves the mirror set.
+	RetentionDuration string `json:"retention_duration,omitempty" yaml:"retention_duration"`
+
+	// Backoff represents the backoff configuration to retry shipping the write requests.
+	Backoff *Backoff `json:"backoff,omitempty" yaml:"backoff"`
+}
+
+// Bind binds the actual data from the MirrorReplication receiver fields.
+func (r *MirrorReplication) Bind() *MirrorReplication {
+	r.Mode = GetActualValue(r.Mode)
+	r.OutboxPath = GetActualValue(r.OutboxPath)
+	r.FlushDuration = GetActualValue(r.FlushDuration)
+	r.MaxOutboxSize = GetActualValue(r.MaxOutboxSize)
+	r.OverflowPolicy = GetActualValue(r.OverflowPolicy)
+	r.RetentionDuration = GetActualValue(r.RetentionDuration)
+	r.MaxLag = GetActualValue(r.MaxLag)
+	if r.Backoff == nil {
+		r.Backoff = new(Backoff)
+	}
+	r.Backoff.Bind()
+	return r
 }
 
 // Bind binds the actual data from the Mirror receiver fields.
@@ -69,5 +120,9 @@ func (m *Mirror) Bind() *Mirror {
 	} else {
 		m.Client.Bind() // Call Bind on existing client
 	}
+
+	if m.Replication != nil {
+		m.Replication = m.Replication.Bind()
+	}
 	return m
 }
@@ -20,6 +20,8 @@ type NGT struct {
 	KVSDB *KVSDB `json:"kvsdb,omitempty" yaml:"kvsdb"`
 	// VQueue represents the vector queue configuration.
 	VQueue *VQueue `json:"vqueue,omitempty" yaml:"vqueue"`
+	// Quantization represents the quantization configuration of the index.
+	Quantization *Quantization `json:"quantization,omitempty" yaml:"quantization"`
 	// ObjectType represents the object type.
 	ObjectType string `json:"object_type,omitempty" yaml:"object_type" info:"object_type"`
 	// ExportIndexInfoDuration represents the export index info duration.
@@ -72,6 +74,14 @@ type NGT struct {
 	EnableInMemoryMode bool `json:"enable_in_memory_mode,omitempty" yaml:"enable_in_memory_mode"`
 	// EnableCopyOnWrite enables copy on write.
 	EnableCopyOnWrite bool `json:"enable_copy_on_write,omitempty" yaml:"enable_copy_on_write"`
+	// EnableDeltaSave enables saving only the objects changed since the last base snapshot instead of the whole index.
+	EnableDeltaSave bool `json:"enable_delta_save,omitempty" yaml:"enable_delta_save"`
+	// MaxDeltaSnapshots represents the number of delta snapshots after which the index is compacted into a new base snapshot.
+	MaxDeltaSnapshots int `json:"max_delta_snapshots,omitempty" yaml:"max_delta_snapshots"`
+	// MaxFilteredSearchCandidates represents the max number of candidates fetched by the search with the attribute predicate.
+	MaxFilteredSearchCandidates int `json:"max_filtered_search_candidates,omitempty" yaml:"max_filtered_search_candidates"`
+	// MaxRangeSearchResults represents the max number of results of the range search.
+	MaxRangeSearchResults int `json:"max_range_search_results,omitempty" yaml:"max_range_search_results"`
 	// IsReadReplica enables read replica.
 	IsReadReplica bool `json:"is_readreplica" yaml:"is_readreplica"`
 	// EnableExportIndexInfoToK8s enables exporting index info to k8s.
@@ -101,14 +111,48 @@ type VQueue struct {
 
 	// DeleteBufferPoolSize represents delete time ordered slice buffer size
 	DeleteBufferPoolSize int `json:"delete_buffer_pool_size,omitempty" yaml:"delete_buffer_pool_size"`
+
+	// EnableWAL enables the write-ahead log of the vector queue under the index path
+	EnableWAL bool `json:"enable_wal,omitempty" yaml:"enable_wal"`
+
+	// WALSyncPolicy represents the fsync policy of the write-ahead log, always, interval or none
+	WALSyncPolicy string `json:"wal_sync_policy,omitempty" yaml:"wal_sync_policy"`
+
+	// WALSyncInterval represents the fsync interval of the write-ahead log when the sync policy is interval
+	WALSyncInterval string `json:"wal_sync_interval,omitempty" yaml:"wal_sync_interval"`
 }
 
 // Bind binds the actual data from the VQueue receiver fields.
 func (vq *VQueue) Bind() *VQueue {
-	// No string fields or nested structs to bind in VQueue itself
+	vq.WALSyncPolicy = GetActualValue(vq.WALSyncPolicy)
+	vq.WALSyncInterval = GetActualValue(vq.WALSyncInterval)
 	return vq
 }
 
+// Quantization represent the ngt index quantization configuration.
+type Quantization struct {
+	// Type represents the quantization type of the index, none or scalar
+	Type string `json:"type,omitempty" yaml:"type"`
+
+	// MinValue represents the lower bound of the vector element values for the scalar quantization
+	MinValue float32 `json:"min_value,omitempty" yaml:"min_value"`
+
+	// MaxValue represents the upper bound of the vector element values for the scalar quantization
+	MaxValue float32 `json:"max_value,omitempty" yaml:"max_value"`
+
+	// EnableReRank enables re-ranking the candidates of the quantized index with the full-precision vectors
+	EnableReRank bool `json:"enable_rerank,omitempty" yaml:"enable_rerank"`
+
+	// ReRankFactor represents the multiplier of the number of candidates fetched from the quantized index for re-ranking
+	ReRankFactor int `json:"rerank_factor,omitempty" yaml:"rerank_factor"`
+}
+
+// Bind binds the actual data from the Quantization receiver fields.
+func (q *Quantization) Bind() *Quantization {
+	q.Type = GetActualValue(q.Type)
+	return q
+}
+
 // Bind returns NGT object whose some string value is filed value or environment value.
 func (n *NGT) Bind() *NGT {
 	n.PodName = GetActualValue(n.PodName)
@@ -135,5 +179,9 @@ func (n *NGT) Bind() *NGT {
 	}
 	n.KVSDB.Bind()
 
+	if n.Quantization != nil {
+		n.Quantization.Bind()
+	}
+
 	return n
 }
@@ -18,6 +18,8 @@ import (
 	"github.com/vdaas/vald/internal/net"
 	"github.com/vdaas/vald/internal/net/grpc"
 	"github.com/vdaas/vald/internal/net/grpc/admin"
+	"github.com/vdaas/vald/internal/net/grpc/interceptor/server/priority"
+	"github.com/vdaas/vald/internal/net/grpc/interceptor/server/quota"
 	"github.com/vdaas/vald/internal/net/grpc/reflection"
 	"github.com/vdaas/vald/internal/servers/server"
 	"github.com/vdaas/vald/internal/strings"
@@ -159,6 +161,72 @@ type GRPC struct {
 	EnableReflection bool `json:"enable_reflection,omitempty" yaml:"enable_reflection"`
 	// EnableChannelz enables the channelz service.
 	EnableChannelz bool `json:"enable_channelz,omitempty" yaml:"enable_channelz"`
+	// Quota represents the per-tenant quota of the requests.
+	Quota *GRPCQuota `json:"quota,omitempty" yaml:"quota"`
+	// Priority represents the concurrency pools of the priority classes of the requests.
+	Priority *GRPCPriority `json:"priority,omitempty" yaml:"priority"`
+}
+
+// GRPCQuota represents the configuration of the per-tenant quota of the gRPC requests.
+type GRPCQuota struct {
+	// Enabled enables the quota.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled"`
+	// MetadataKey represents the metadata key of the API key identifying the tenant.
+	MetadataKey string `json:"metadata_key,omitempty" yaml:"metadata_key"`
+	// Default represents the limits of the requests not identified as any tenant.
+	Default *GRPCQuotaLimits `json:"default,omitempty" yaml:"default"`
+	// Tenants represents the tenants and their limits.
+	Tenants []*GRPCQuotaTenant `json:"tenants,omitempty" yaml:"tenants"`
+}
+
+// GRPCQuotaTenant represents the configuration of a tenant of the quota.
+type GRPCQuotaTenant struct {
+	// Name represents the name of the tenant.
+	Name string `json:"name,omitempty" yaml:"name"`
+	// APIKeys represents the API keys identifying the tenant.
+	APIKeys []string `json:"api_keys,omitempty" yaml:"api_keys"`
+	// Subjects represents the subject common names of the client certificates identifying the tenant.
+	Subjects []string `json:"subjects,omitempty" yaml:"subjects"`
+	// Read represents the budget of the read requests.
+	Read *GRPCQuotaBudget `json:"read,omitempty" yaml:"read"`
+	// Write represents the budget of the write requests.
+	Write *GRPCQuotaBudget `json:"write,omitempty" yaml:"write"`
+}
+
+// GRPCQuotaLimits represents the budgets of the read and write requests.
+type GRPCQuotaLimits struct {
+	// Read represents the budget of the read requests.
+	Read *GRPCQuotaBudget `json:"read,omitempty" yaml:"read"`
+	// Write represents the budget of the write requests.
+	Write *GRPCQuotaBudget `json:"write,omitempty" yaml:"write"`
+}
+
+// GRPCQuotaBudget represents the limits per second of the requests, which are unlimited when they are 0.
+type GRPCQuotaBudget struct {
+	// QPS represents the limit of the requests per second.
+	QPS float64 `json:"qps,omitempty" yaml:"qps"`
+	// VectorsPerSecond represents the limit of the vectors per second.
+	VectorsPerSecond float64 `json:"vectors_per_second,omitempty" yaml:"vectors_per_second"`
+}
+
+// GRPCPriority represents the configuration of the priority classes of the gRPC requests.
+type GRPCPriority struct {
+	// Enabled enables the priority classes.
+	Enabled bool `json:"enabled,omitempty" yaml:"enabled"`
+	// CPUThreshold represents the CPU utilization over which the low priority requests are throttled.
+	CPUThreshold float64 `json:"cpu_threshold,omitempty" yaml:"cpu_threshold"`
+	// High represents the pool of the high priority requests.
+	High *GRPCPriorityPool `json:"high,omitempty" yaml:"high"`
+	// Low represents the pool of the low priority requests.
+	Low *GRPCPriorityPool `json:"low,omitempty" yaml:"low"`
+}
+
+// GRPCPriorityPool represents the concurrency pool of a priority class.
+type GRPCPriorityPool struct {
+	// Concurrency represents the limit of the requests in flight, which is unlimited when it is 0.
+	Concurrency int `json:"concurrency,omitempty" yaml:"concurrency"`
+	// Wait represents how long the request waits for the pool before it is rejected.
+	Wait string `json:"wait,omitempty" yaml:"wait"`
 }
 
 // GRPCKeepalive represents the configuration for gRPC keep-alive.
@@ -268,9 +336,85 @@ func (g *GRPC) Bind() *GRPC {
 	if g.Keepalive != nil {
 		g.Keepalive.Bind()
 	}
+	if g.Quota != nil {
+		g.Quota.Bind()
+	}
+	if g.Priority != nil {
+		g.Priority.Bind()
+	}
 	return g
 }
 
+// Bind binds the actual value from the GRPCQuota struct field.
+func (q *GRPCQuota) Bind() *GRPCQuota {
+	q.MetadataKey = GetActualValue(q.MetadataKey)
+	for _, t := range q.Tenants {
+		if t != nil {
+			t.Name = GetActualValue(t.Name)
+			t.APIKeys = GetActualValues(t.APIKeys)
+			t.Subjects = GetActualValues(t.Subjects)
+		}
+	}
+	return q
+}
+
+// Opts returns the options of the quota interceptors.
+func (q *GRPCQuota) Opts() []quota.Option {
+	opts := []quota.Option{
+		quota.WithMetadataKey(q.MetadataKey),
+	}
+	if q.Default != nil {
+		opts = append(opts, quota.WithDefaultLimits(quotaLimits(q.Default.Read, q.Default.Write)))
+	}
+	for _, t := range q.Tenants {
+		if t != nil {
+			opts = append(opts, quota.WithTenant(t.Name, quotaLimits(t.Read, t.Write), t.APIKeys, t.Subjects))
+		}
+	}
+	return opts
+}
+
+func quotaLimits(read, write *GRPCQuotaBudget) quota.Limits {
+	var l quota.Limits
+	if read != nil {
+		l.Read = quota.Budget{
+			QPS:              read.QPS,
+			VectorsPerSecond: read.VectorsPerSecond,
+		}
+	}
+	if write != nil {
+		l.Write = quota.Budget{
+			QPS:              write.QPS,
+			VectorsPerSecond: write.VectorsPerSecond,
+		}
+	}
+	return l
+}
+
+// Bind binds the actual value from the GRPCPriority struct field.
+func (p *GRPCPriority) Bind() *GRPCPriority {
+	for _, pl := range []*GRPCPriorityPool{p.High, p.Low} {
+		if pl != nil {
+			pl.Wait = GetActualValue(pl.Wait)
+		}
+	}
+	return p
+}
+
+// Opts returns the options of the priority interceptors.
+func (p *GRPCPriority) Opts() []priority.Option {
+	opts := []priority.Option{
+		priority.WithCPUThreshold(p.CPUThreshold),
+	}
+	if p.High != nil {
+		opts = append(opts, priority.WithPool(grpc.HighPriority, p.High.Concurrency, p.High.Wait))
+	}
+	if p.Low != nil {
+		opts = append(opts, priority.WithPool(grpc.LowPriority, p.Low.Concurrency, p.Low.Wait))
+	}
+	return opts
+}
+
 // Bind binds the actual value from the GRPCKeepalive struct field.
 func (k *GRPCKeepalive) Bind() *GRPCKeepalive {
 	k.MaxConnIdle = GetActualValue(k.MaxConnIdle)
@@ -376,6 +520,12 @@ func (s *Server) Opts() []server.Option {
 				server.WithGRPCWaitForHandlers(s.GRPC.WaitForHandlers),
 				server.WithGRPCWriteBufferSize(s.GRPC.WriteBufferSize),
 			)
+			if s.GRPC.Quota != nil && s.GRPC.Quota.Enabled {
+				opts = append(opts, server.WithGRPCQuota(s.GRPC.Quota.Opts()...))
+			}
+			if s.GRPC.Priority != nil && s.GRPC.Priority.Enabled {
+				opts = append(opts, server.WithGRPCPriority(s.GRPC.Priority.Opts()...))
+			}
 
 			if s.GRPC.EnableReflection {
 				opts = append(opts,
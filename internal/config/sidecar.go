@@ -14,6 +14,8 @@
 
 package config
 
+import "github.com/vdaas/vald/internal/strings"
+
 // AgentSidecar represents the configuration for the agent sidecar.
 type AgentSidecar struct {
 	// BlobStorage represents the blob storage configuration.
@@ -24,6 +26,10 @@ type AgentSidecar struct {
 	RestoreBackoff *Backoff `json:"restore_backoff" yaml:"restore_backoff"`
 	// Compress represents the compress configuration.
 	Compress *CompressCore `json:"compress" yaml:"compress"`
+	// Versioning represents the backup generation configuration.
+	Versioning *BackupVersioning `json:"versioning" yaml:"versioning"`
+	// Encryption represents the client-side encryption configuration of the backups.
+	Encryption *BackupEncryption `json:"encryption" yaml:"encryption"`
 	// Filename represents the filename.
 	Filename string `json:"filename" yaml:"filename"`
 	// PostStopTimeout represents the post stop timeout duration.
@@ -38,6 +44,8 @@ type AgentSidecar struct {
 	WatchDir string `json:"watch_dir" yaml:"watch_dir"`
 	// AutoBackupEnabled enables auto backup.
 	AutoBackupEnabled bool `json:"auto_backup_enabled" yaml:"auto_backup_enabled"`
+	// DeltaBackupEnabled enables uploading and restoring the delta snapshots of the index.
+	DeltaBackupEnabled bool `json:"delta_backup_enabled" yaml:"delta_backup_enabled"`
 	// RestoreBackoffEnabled enables restore backoff.
 	RestoreBackoffEnabled bool `json:"restore_backoff_enabled" yaml:"restore_backoff_enabled"`
 	// WatchEnabled enables watch.
@@ -77,5 +85,93 @@ func (s *AgentSidecar) Bind() *AgentSidecar {
 		s.Client = new(Client)
 	}
 
+	if s.Versioning != nil {
+		s.Versioning = s.Versioning.Bind()
+	} else {
+		s.Versioning = new(BackupVersioning)
+	}
+
+	if s.Encryption != nil {
+		s.Encryption = s.Encryption.Bind()
+	} else {
+		s.Encryption = new(BackupEncryption)
+	}
+
 	return s
 }
+
+// BackupVersioning represents the configuration for the immutable backup generations.
+type BackupVersioning struct {
+	// MaxAge represents the age of the generations to be deleted. Empty keeps all generations.
+	MaxAge string `json:"max_age" yaml:"max_age"`
+	// RestoreGeneration represents the generation id to restore. Empty or "latest" restores the newest valid generation.
+	RestoreGeneration string `json:"restore_generation" yaml:"restore_generation"`
+	// MaxGenerations represents the number of the newest generations to keep. 0 keeps all generations.
+	MaxGenerations int `json:"max_generations" yaml:"max_generations"`
+	// Enabled enables uploading every backup as a new generation.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+}
+
+// Bind binds the actual data from the BackupVersioning receiver fields.
+func (v *BackupVersioning) Bind() *BackupVersioning {
+	v.MaxAge = GetActualValue(v.MaxAge)
+	v.RestoreGeneration = GetActualValue(v.RestoreGeneration)
+	return v
+}
+
+// EncryptionKeyProvider is an enum for the provider of the backup encryption keys.
+type EncryptionKeyProvider uint8
+
+const (
+	// KeyfileProvider represents the provider which reads the keys from the mounted file.
+	KeyfileProvider EncryptionKeyProvider = 1 + iota
+	// PluginProvider represents the provider which delegates the key operations to the external command.
+	PluginProvider
+)
+
+// String returns the name of the encryption key provider.
+func (p EncryptionKeyProvider) String() string {
+	switch p {
+	case KeyfileProvider:
+		return "keyfile"
+	case PluginProvider:
+		return "plugin"
+	}
+	return "unknown"
+}
+
+// AToEncryptionKeyProvider returns EncryptionKeyProvider converted from string.
+func AToEncryptionKeyProvider(p string) EncryptionKeyProvider {
+	switch strings.ToLower(p) {
+	case "keyfile":
+		return KeyfileProvider
+	case "plugin":
+		return PluginProvider
+	}
+	return 0
+}
+
+// BackupEncryption represents the configuration for the client-side encryption of the backups.
+type BackupEncryption struct {
+	// Provider represents the provider of the key encryption keys, keyfile or plugin.
+	Provider string `json:"provider" yaml:"provider"`
+	// Keyfile represents the path of the key file for the keyfile provider.
+	Keyfile string `json:"keyfile" yaml:"keyfile"`
+	// KeyID represents the id of the key to encrypt the new backups. Empty uses the last key in the key file.
+	KeyID string `json:"key_id" yaml:"key_id"`
+	// PluginCommand represents the command and its arguments for the plugin provider.
+	PluginCommand []string `json:"plugin_command" yaml:"plugin_command"`
+	// Enabled enables encrypting the backups before they are uploaded.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// AllowUnencryptedRestore allows restoring the backups uploaded before the encryption is enabled.
+	AllowUnencryptedRestore bool `json:"allow_unencrypted_restore" yaml:"allow_unencrypted_restore"`
+}
+
+// Bind binds the actual data from the BackupEncryption receiver fields.
+func (e *BackupEncryption) Bind() *BackupEncryption {
+	e.Provider = GetActualValue(e.Provider)
+	e.Keyfile = GetActualValue(e.Keyfile)
+	e.KeyID = GetActualValue(e.KeyID)
+	e.PluginCommand = GetActualValues(e.PluginCommand)
+	return e
+}
@@ -140,6 +140,8 @@ func TestAgentSidecar_Bind(t *testing.T) {
 						Compress:           new(CompressCore),
 						RestoreBackoff:     new(Backoff),
 						Client:             new(Client),
+						Versioning:         new(BackupVersioning),
+						Encryption:         new(BackupEncryption),
 					},
 				},
 			}
@@ -189,6 +191,8 @@ func TestAgentSidecar_Bind(t *testing.T) {
 						Compress:           new(CompressCore),
 						RestoreBackoff:     new(Backoff),
 						Client:             new(Client),
+						Versioning:         new(BackupVersioning),
+						Encryption:         new(BackupEncryption),
 					},
 				},
 			}
@@ -203,6 +207,8 @@ func TestAgentSidecar_Bind(t *testing.T) {
 						Compress:       new(CompressCore),
 						RestoreBackoff: new(Backoff),
 						Client:         new(Client),
+						Versioning:     new(BackupVersioning),
+						Encryption:     new(BackupEncryption),
 					},
 				},
 			}
@@ -84,3 +84,36 @@ func (c *client) Writer(ctx context.Context, key string) (io.WriteCloser, error)
 	}
 	return c.bucket.NewWriter(ctx, key, c.writerOpts)
 }
+
+func (c *client) Delete(ctx context.Context, key string) error {
+	if c.bucket == nil {
+		return errors.ErrBucketNotOpened
+	}
+	err := c.bucket.Delete(ctx, key)
+	if err != nil && gcerrors.Code(err) == gcerrors.NotFound {
+		return nil
+	}
+	return err
+}
+
+// List returns the keys of the objects which start with prefix.
+func (c *client) List(ctx context.Context, prefix string) (keys []string, err error) {
+	if c.bucket == nil {
+		return nil, errors.ErrBucketNotOpened
+	}
+	it := c.bucket.List(&blob.ListOptions{
+		Prefix: prefix,
+	})
+	for {
+		obj, err := it.Next(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return keys, nil
+			}
+			return nil, err
+		}
+		if !obj.IsDir {
+			keys = append(keys, obj.Key)
+		}
+	}
+}
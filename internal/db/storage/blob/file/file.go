@@ -0,0 +1,214 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"reflect"
+
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/os"
+	"github.com/vdaas/vald/internal/strings"
+)
+
+type client struct {
+	dir  string
+	perm fs.FileMode
+}
+
+// New returns blob.Bucket implementation which stores the objects as the files under the directory.
+func New(opts ...Option) (blob.Bucket, error) {
+	c := new(client)
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(c); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+
+	if c.dir == "" {
+		return nil, errors.NewErrInvalidOption("dir", c.dir)
+	}
+
+	return c, nil
+}
+
+// Open creates the directory of the bucket if it does not exist.
+func (c *client) Open(context.Context) error {
+	return file.MkdirAll(c.dir, c.perm)
+}
+
+// Close does nothing. Always returns nil.
+func (*client) Close() error {
+	return nil
+}
+
+// Reader returns the reader of the object.
+// It returns an empty reader when the object does not exist, like the other implementations.
+func (c *client) Reader(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := c.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return io.NopCloser(io.NewEOFReader()), nil
+		}
+		return nil, err
+	}
+	return io.NewReadCloserWithContext(ctx, f)
+}
+
+// Writer returns the writer of the object.
+// The data is written to a temporary file next to the object, which is renamed to the object on Close,
+// so the readers never observe a partially written object.
+func (c *client) Writer(ctx context.Context, key string) (io.WriteCloser, error) {
+	path, err := c.path(key)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(path)
+	err = file.MkdirAll(dir, c.perm)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	return &writer{
+		ctx:  ctx,
+		f:    f,
+		path: path,
+	}, nil
+}
+
+// Delete deletes the object. Deleting the object which does not exist is not an error.
+func (c *client) Delete(_ context.Context, key string) error {
+	path, err := c.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// List returns the keys of the objects which start with prefix.
+// The temporary files of the writers which are not closed yet are not listed.
+func (c *client) List(ctx context.Context, prefix string) (keys []string, err error) {
+	dir := filepath.Clean(c.dir)
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") && strings.HasSuffix(d.Name(), ".tmp") {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// path returns the path of the object, which must stay within the directory of the bucket.
+func (c *client) path(key string) (string, error) {
+	dir := filepath.Clean(c.dir)
+	path := filepath.Join(dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, dir+string(os.PathSeparator)) {
+		return "", errors.ErrInvalidBlobKey(key)
+	}
+	return path, nil
+}
+
+type writer struct {
+	ctx  context.Context
+	f    *os.File
+	path string
+	// err is the first error of Write, which fails the later writes and Close, so the object is never committed
+	// with the data lost by the failed write.
+	err    error
+	closed bool
+}
+
+func (w *writer) Write(p []byte) (n int, err error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	select {
+	case <-w.ctx.Done():
+		w.err = w.ctx.Err()
+		return 0, w.err
+	default:
+	}
+	n, err = w.f.Write(p)
+	if err != nil {
+		w.err = err
+	}
+	return n, err
+}
+
+// Close commits the object by renaming the temporary file.
+// The temporary file is removed instead when a write failed, the context is canceled or the file cannot be synced.
+func (w *writer) Close() (err error) {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	err = w.err
+	if err == nil {
+		err = w.f.Sync()
+	}
+	if cerr := w.f.Close(); cerr != nil && w.err == nil {
+		err = errors.Join(err, cerr)
+	}
+	if err == nil {
+		err = w.ctx.Err()
+	}
+	if err != nil {
+		return errors.Join(err, os.Remove(w.f.Name()))
+	}
+	err = os.Rename(w.f.Name(), w.path)
+	if err != nil {
+		return errors.Join(err, os.Remove(w.f.Name()))
+	}
+	return nil
+}
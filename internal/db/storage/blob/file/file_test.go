@@ -0,0 +1,196 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/os"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New()
+	require.EqualError(t, err, errors.NewErrInvalidOption("dir", "").Error())
+
+	b, err := New(WithDir(t.TempDir()))
+	require.NoError(t, err)
+	require.NotNil(t, b)
+}
+
+func TestClient_ReadWrite(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name string
+		// run writes and reads the objects with the bucket of dir.
+		run func(t *testing.T, ctx context.Context, c *client)
+	}
+
+	read := func(t *testing.T, ctx context.Context, c *client, key string) string {
+		t.Helper()
+		r, err := c.Reader(ctx, key)
+		require.NoError(t, err)
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		return string(data)
+	}
+	write := func(t *testing.T, ctx context.Context, c *client, key, data string) io.WriteCloser {
+		t.Helper()
+		w, err := c.Writer(ctx, key)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(data))
+		require.NoError(t, err)
+		return w
+	}
+
+	tests := []test{
+		{
+			name: "written object is read after the writer is closed",
+			run: func(t *testing.T, ctx context.Context, c *client) {
+				w := write(t, ctx, c, "dir/obj.tar.gz", "data")
+				require.Empty(t, read(t, ctx, c, "dir/obj.tar.gz"))
+				require.NoError(t, w.Close())
+				require.Equal(t, "data", read(t, ctx, c, "dir/obj.tar.gz"))
+
+				files, err := os.ReadDir(filepath.Join(c.dir, "dir"))
+				require.NoError(t, err)
+				require.Len(t, files, 1)
+			},
+		},
+		{
+			name: "overwritten object is replaced on close",
+			run: func(t *testing.T, ctx context.Context, c *client) {
+				require.NoError(t, write(t, ctx, c, "obj", "old").Close())
+				w := write(t, ctx, c, "obj", "new")
+				require.Equal(t, "old", read(t, ctx, c, "obj"))
+				require.NoError(t, w.Close())
+				require.Equal(t, "new", read(t, ctx, c, "obj"))
+			},
+		},
+		{
+			name: "object of the canceled writer is not committed",
+			run: func(t *testing.T, ctx context.Context, c *client) {
+				wctx, cancel := context.WithCancel(ctx)
+				w := write(t, wctx, c, "obj", "data")
+				cancel()
+				require.ErrorIs(t, w.Close(), context.Canceled)
+				require.Empty(t, read(t, ctx, c, "obj"))
+
+				files, err := os.ReadDir(c.dir)
+				require.NoError(t, err)
+				require.Empty(t, files)
+			},
+		},
+		{
+			name: "object of the failed write is not committed and the first error is returned",
+			run: func(t *testing.T, ctx context.Context, c *client) {
+				require.NoError(t, write(t, ctx, c, "obj", "old").Close())
+				w := write(t, ctx, c, "obj", "data")
+				// the write fails as if the disk were full.
+				require.NoError(t, w.(*writer).f.Close())
+				_, err := w.Write([]byte("lost"))
+				require.ErrorIs(t, err, os.ErrClosed)
+				_, err = w.Write([]byte("more"))
+				require.ErrorIs(t, err, os.ErrClosed)
+				require.ErrorIs(t, w.Close(), os.ErrClosed)
+				require.Equal(t, "old", read(t, ctx, c, "obj"))
+
+				files, err := os.ReadDir(c.dir)
+				require.NoError(t, err)
+				require.Len(t, files, 1)
+			},
+		},
+		{
+			name: "object written after the context is canceled is not committed",
+			run: func(t *testing.T, ctx context.Context, c *client) {
+				wctx, cancel := context.WithCancel(ctx)
+				w := write(t, wctx, c, "obj", "data")
+				cancel()
+				_, err := w.Write([]byte("lost"))
+				require.ErrorIs(t, err, context.Canceled)
+				require.ErrorIs(t, w.Close(), context.Canceled)
+				require.Empty(t, read(t, ctx, c, "obj"))
+			},
+		},
+		{
+			name: "missing object is read as empty",
+			run: func(t *testing.T, ctx context.Context, c *client) {
+				require.Empty(t, read(t, ctx, c, "missing"))
+			},
+		},
+		{
+			name: "deleted object is read as empty and deleting it again succeeds",
+			run: func(t *testing.T, ctx context.Context, c *client) {
+				require.NoError(t, write(t, ctx, c, "obj", "data").Close())
+				require.NoError(t, c.Delete(ctx, "obj"))
+				require.Empty(t, read(t, ctx, c, "obj"))
+				require.NoError(t, c.Delete(ctx, "obj"))
+			},
+		},
+		{
+			name: "listed keys start with the prefix and exclude the objects being written",
+			run: func(t *testing.T, ctx context.Context, c *client) {
+				require.NoError(t, write(t, ctx, c, "a/obj", "data").Close())
+				require.NoError(t, write(t, ctx, c, "a/obj.json", "data").Close())
+				require.NoError(t, write(t, ctx, c, "b/obj", "data").Close())
+				w := write(t, ctx, c, "a/pending", "data")
+				defer w.Close()
+
+				keys, err := c.List(ctx, "a/")
+				require.NoError(t, err)
+				require.Equal(t, []string{"a/obj", "a/obj.json"}, keys)
+				keys, err = c.List(ctx, "")
+				require.NoError(t, err)
+				require.Len(t, keys, 3)
+			},
+		},
+		{
+			name: "key outside of the bucket is rejected",
+			run: func(t *testing.T, ctx context.Context, c *client) {
+				for _, key := range []string{"../obj", "dir/../../obj", ""} {
+					_, err := c.Writer(ctx, key)
+					require.EqualError(t, err, errors.ErrInvalidBlobKey(key).Error())
+					_, err = c.Reader(ctx, key)
+					require.EqualError(t, err, errors.ErrInvalidBlobKey(key).Error())
+					require.EqualError(t, c.Delete(ctx, key), errors.ErrInvalidBlobKey(key).Error())
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			b, err := New(WithDir(filepath.Join(tt.TempDir(), "bucket")))
+			require.NoError(tt, err)
+			require.NoError(tt, b.Open(ctx))
+			defer b.Close()
+
+			test.run(tt, ctx, b.(*client))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package file
+
+import "io/fs"
+
+// Option configures the client of the filesystem blob storage.
+type Option func(*client) error
+
+var defaultOptions = []Option{
+	WithPerm(fs.ModePerm),
+}
+
+// WithDir returns Option that sets the directory of the bucket.
+func WithDir(dir string) Option {
+	return func(c *client) error {
+		if dir != "" {
+			c.dir = dir
+		}
+		return nil
+	}
+}
+
+// WithPerm returns Option that sets the permission of the directories created for the objects.
+func WithPerm(perm fs.FileMode) Option {
+	return func(c *client) error {
+		if perm != 0 {
+			c.perm = perm
+		}
+		return nil
+	}
+}
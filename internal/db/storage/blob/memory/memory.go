@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"github.com/vdaas/vald/internal/db/storage/blob"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/internal/sync"
+)
+
+// Bucket is the blob.Bucket implementation which keeps the objects in memory.
+// An object becomes visible when its writer is closed, like the file implementation.
+type Bucket struct {
+	objects map[string][]byte
+	mu      sync.RWMutex
+	opened  bool
+}
+
+var _ blob.Bucket = (*Bucket)(nil)
+
+// New returns an empty in-memory bucket.
+func New() *Bucket {
+	return &Bucket{
+		objects: make(map[string][]byte),
+	}
+}
+
+// Open opens the bucket.
+func (b *Bucket) Open(context.Context) error {
+	b.mu.Lock()
+	b.opened = true
+	b.mu.Unlock()
+	return nil
+}
+
+// Close closes the bucket. The objects are kept, so the bucket can be opened again.
+func (b *Bucket) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.opened {
+		return errors.ErrBucketNotOpened
+	}
+	b.opened = false
+	return nil
+}
+
+// Reader returns the reader of the object.
+// It returns an empty reader when the object does not exist.
+func (b *Bucket) Reader(ctx context.Context, key string) (io.ReadCloser, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.opened {
+		return nil, errors.ErrBucketNotOpened
+	}
+	data, ok := b.objects[key]
+	if !ok {
+		return io.NopCloser(io.NewEOFReader()), nil
+	}
+	return io.NewReadCloserWithContext(ctx, io.NopCloser(bytes.NewReader(data)))
+}
+
+// Writer returns the writer of the object.
+func (b *Bucket) Writer(ctx context.Context, key string) (io.WriteCloser, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if !b.opened {
+		return nil, errors.ErrBucketNotOpened
+	}
+	return &writer{
+		ctx:    ctx,
+		bucket: b,
+		key:    key,
+	}, nil
+}
+
+// Delete deletes the object.
+func (b *Bucket) Delete(_ context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.opened {
+		return errors.ErrBucketNotOpened
+	}
+	delete(b.objects, key)
+	return nil
+}
+
+// List returns the sorted keys of the objects which start with prefix.
+func (b *Bucket) List(_ context.Context, prefix string) ([]string, error) {
+	b.mu.RLock()
+	opened := b.opened
+	b.mu.RUnlock()
+	if !opened {
+		return nil, errors.ErrBucketNotOpened
+	}
+	keys := b.Keys()
+	return slices.DeleteFunc(keys, func(key string) bool {
+		return !strings.HasPrefix(key, prefix)
+	}), nil
+}
+
+// Keys returns the sorted keys of the objects in the bucket.
+func (b *Bucket) Keys() []string {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	keys := make([]string, 0, len(b.objects))
+	for key := range b.objects {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+type writer struct {
+	ctx    context.Context
+	bucket *Bucket
+	key    string
+	buf    bytes.Buffer
+	closed bool
+}
+
+func (w *writer) Write(p []byte) (n int, err error) {
+	if w.closed {
+		return 0, io.ErrClosedPipe
+	}
+	select {
+	case <-w.ctx.Done():
+		return 0, w.ctx.Err()
+	default:
+	}
+	return w.buf.Write(p)
+}
+
+// Close stores the written data as the object unless the context is canceled.
+func (w *writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if err := w.ctx.Err(); err != nil {
+		return err
+	}
+	w.bucket.mu.Lock()
+	w.bucket.objects[w.key] = w.buf.Bytes()
+	w.bucket.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestBucket(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name string
+		run  func(t *testing.T, ctx context.Context, b *Bucket)
+	}
+
+	read := func(t *testing.T, ctx context.Context, b *Bucket, key string) string {
+		t.Helper()
+		r, err := b.Reader(ctx, key)
+		require.NoError(t, err)
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		return string(data)
+	}
+	write := func(t *testing.T, ctx context.Context, b *Bucket, key, data string) io.WriteCloser {
+		t.Helper()
+		w, err := b.Writer(ctx, key)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(data))
+		require.NoError(t, err)
+		return w
+	}
+
+	tests := []test{
+		{
+			name: "written object is visible after the writer is closed",
+			run: func(t *testing.T, ctx context.Context, b *Bucket) {
+				w := write(t, ctx, b, "b", "data")
+				require.Empty(t, read(t, ctx, b, "b"))
+				require.NoError(t, w.Close())
+				require.NoError(t, write(t, ctx, b, "a", "other").Close())
+				require.Equal(t, "data", read(t, ctx, b, "b"))
+				require.Equal(t, []string{"a", "b"}, b.Keys())
+			},
+		},
+		{
+			name: "object of the canceled writer is not stored",
+			run: func(t *testing.T, ctx context.Context, b *Bucket) {
+				wctx, cancel := context.WithCancel(ctx)
+				w := write(t, wctx, b, "obj", "data")
+				cancel()
+				require.ErrorIs(t, w.Close(), context.Canceled)
+				require.Empty(t, b.Keys())
+			},
+		},
+		{
+			name: "deleted object is read as empty",
+			run: func(t *testing.T, ctx context.Context, b *Bucket) {
+				require.NoError(t, write(t, ctx, b, "obj", "data").Close())
+				require.NoError(t, b.Delete(ctx, "obj"))
+				require.Empty(t, read(t, ctx, b, "obj"))
+				require.Empty(t, b.Keys())
+			},
+		},
+		{
+			name: "listed keys start with the prefix",
+			run: func(t *testing.T, ctx context.Context, b *Bucket) {
+				for _, key := range []string{"b/obj", "a/obj", "a/obj.json"} {
+					require.NoError(t, write(t, ctx, b, key, "data").Close())
+				}
+				keys, err := b.List(ctx, "a/")
+				require.NoError(t, err)
+				require.Equal(t, []string{"a/obj", "a/obj.json"}, keys)
+			},
+		},
+		{
+			name: "closed bucket keeps the objects and rejects the operations",
+			run: func(t *testing.T, ctx context.Context, b *Bucket) {
+				require.NoError(t, write(t, ctx, b, "obj", "data").Close())
+				require.NoError(t, b.Close())
+				_, err := b.Reader(ctx, "obj")
+				require.ErrorIs(t, err, errors.ErrBucketNotOpened)
+				_, err = b.Writer(ctx, "obj")
+				require.ErrorIs(t, err, errors.ErrBucketNotOpened)
+				require.ErrorIs(t, b.Delete(ctx, "obj"), errors.ErrBucketNotOpened)
+				_, err = b.List(ctx, "")
+				require.ErrorIs(t, err, errors.ErrBucketNotOpened)
+				require.ErrorIs(t, b.Close(), errors.ErrBucketNotOpened)
+
+				require.NoError(t, b.Open(ctx))
+				require.Equal(t, "data", read(t, ctx, b, "obj"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			b := New()
+			require.NoError(tt, b.Open(ctx))
+			test.run(tt, ctx, b)
+		})
+	}
+}
@@ -112,3 +112,32 @@ func (c *client) Writer(ctx context.Context, key string) (wc io.WriteCloser, err
 	}
 	return c.writer, nil
 }
+
+// Delete deletes the object of the key.
+// Deleting the object which does not exist is not an error.
+func (c *client) Delete(ctx context.Context, key string) error {
+	_, err := c.service.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: new(c.bucket),
+		Key:    new(key),
+	})
+	return err
+}
+
+// List returns the keys of the objects which start with prefix.
+func (c *client) List(ctx context.Context, prefix string) (keys []string, err error) {
+	err = c.service.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: new(c.bucket),
+		Prefix: new(prefix),
+	}, func(out *s3.ListObjectsV2Output, _ bool) bool {
+		for _, obj := range out.Contents {
+			if obj.Key != nil {
+				keys = append(keys, *obj.Key)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
@@ -0,0 +1,358 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encrypt
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"math"
+	"reflect"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+)
+
+// Encryptor encrypts and decrypts the streams with the envelope encryption.
+// Every stream is encrypted with its own data key, which is wrapped by the KeyProvider
+// and stored in the header of the stream together with the id of the wrapping key.
+type Encryptor interface {
+	// KeyID returns the id of the key which wraps the data keys of the new streams.
+	KeyID() string
+	Reader(ctx context.Context, src io.ReadCloser) (io.ReadCloser, error)
+	Writer(ctx context.Context, dst io.WriteCloser) (io.WriteCloser, error)
+}
+
+// KeyProvider wraps and unwraps the data keys.
+type KeyProvider interface {
+	// KeyID returns the id of the key which is used by Wrap.
+	KeyID() string
+	Wrap(ctx context.Context, dek []byte) (wrapped []byte, err error)
+	Unwrap(ctx context.Context, keyID string, wrapped []byte) (dek []byte, err error)
+}
+
+const (
+	magic = "VALDENC\x01"
+
+	dataKeySize = 32
+
+	// lastChunk is set to the length of the final chunk, which is also authenticated by its nonce,
+	// so a stream cut at a chunk boundary is detected.
+	lastChunk = uint32(1) << 31
+)
+
+type encryptor struct {
+	kp               KeyProvider
+	chunkSize        int
+	allowUnencrypted bool
+}
+
+// New returns the Encryptor implementation of AES-GCM.
+func New(opts ...Option) (Encryptor, error) {
+	e := new(encryptor)
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(e); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	if e.kp == nil {
+		return nil, errors.NewErrInvalidOption("keyProvider", e.kp)
+	}
+	return e, nil
+}
+
+func (e *encryptor) KeyID() string {
+	return e.kp.KeyID()
+}
+
+// Writer returns the writer which encrypts the data written to dst.
+// The final chunk is written on Close, so the stream is invalid unless the writer is closed.
+func (e *encryptor) Writer(ctx context.Context, dst io.WriteCloser) (io.WriteCloser, error) {
+	dek := make([]byte, dataKeySize)
+	_, err := rand.Read(dek)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := e.kp.Wrap(ctx, dek)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+	header, err := encodeHeader(e.kp.KeyID(), wrapped, e.chunkSize)
+	if err != nil {
+		return nil, err
+	}
+	_, err = dst.Write(header)
+	if err != nil {
+		return nil, err
+	}
+	return &writer{
+		dst:    dst,
+		aead:   aead,
+		header: header,
+		buf:    make([]byte, 0, e.chunkSize),
+	}, nil
+}
+
+// Reader returns the reader which decrypts the data read from src.
+// It returns io.EOF when src is empty, so a missing object is handled like the unencrypted one.
+// The stream without the magic, which was written before the encryption is enabled, is rejected unless the unencrypted
+// streams are allowed by WithAllowUnencrypted. The allowed one is read as it is, so the unencrypted backups are restored
+// and encrypted by the next backup.
+func (e *encryptor) Reader(ctx context.Context, src io.ReadCloser) (io.ReadCloser, error) {
+	prefix := make([]byte, len(magic))
+	n, err := io.ReadFull(src, prefix)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		if n == 0 && errors.Is(err, io.EOF) {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	if string(prefix[:n]) != magic {
+		if !e.allowUnencrypted {
+			return nil, errors.ErrUnencryptedStream
+		}
+		log.Warn("reading the unencrypted stream, which is encrypted when it is written next time")
+		return &plainReader{
+			Reader: io.MultiReader(bytes.NewReader(prefix[:n]), src),
+			src:    src,
+		}, nil
+	}
+	header, keyID, wrapped, chunkSize, err := decodeHeader(prefix, src)
+	if err != nil {
+		return nil, err
+	}
+	dek, err := e.kp.Unwrap(ctx, keyID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{
+		src:       src,
+		aead:      aead,
+		header:    header,
+		chunkSize: chunkSize,
+	}, nil
+}
+
+// plainReader reads the unencrypted stream and closes its source.
+type plainReader struct {
+	io.Reader
+	src io.Closer
+}
+
+func (r *plainReader) Close() error {
+	return r.src.Close()
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encodeHeader returns the header of the stream:
+// magic | chunk size (uint32) | key id length (uint16) | key id | wrapped key length (uint16) | wrapped key.
+func encodeHeader(keyID string, wrapped []byte, chunkSize int) ([]byte, error) {
+	if len(keyID) > math.MaxUint16 || len(wrapped) > math.MaxUint16 {
+		return nil, errors.ErrInvalidEncryptedStream
+	}
+	header := make([]byte, 0, len(magic)+4+2+len(keyID)+2+len(wrapped))
+	header = append(header, magic...)
+	header = binary.BigEndian.AppendUint32(header, uint32(chunkSize))
+	header = binary.BigEndian.AppendUint16(header, uint16(len(keyID)))
+	header = append(header, keyID...)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(wrapped)))
+	header = append(header, wrapped...)
+	return header, nil
+}
+
+// decodeHeader decodes the header of the stream whose magic is already read from r as prefix.
+func decodeHeader(prefix []byte, r io.Reader) (header []byte, keyID string, wrapped []byte, chunkSize int, err error) {
+	header = append(prefix, make([]byte, 4+2)...)
+	_, err = io.ReadFull(r, header[len(prefix):])
+	if err != nil {
+		return nil, "", nil, 0, errors.ErrInvalidEncryptedStream
+	}
+	chunkSize = int(binary.BigEndian.Uint32(header[len(magic):]))
+	if chunkSize <= 0 || uint32(chunkSize) >= lastChunk {
+		return nil, "", nil, 0, errors.ErrInvalidEncryptedStream
+	}
+
+	readField := func(size int) ([]byte, error) {
+		buf := make([]byte, size)
+		_, err := io.ReadFull(r, buf)
+		if err != nil {
+			return nil, errors.ErrInvalidEncryptedStream
+		}
+		header = append(header, buf...)
+		return buf, nil
+	}
+	id, err := readField(int(binary.BigEndian.Uint16(header[len(magic)+4:])))
+	if err != nil {
+		return nil, "", nil, 0, err
+	}
+	size, err := readField(2)
+	if err != nil {
+		return nil, "", nil, 0, err
+	}
+	wrapped, err = readField(int(binary.BigEndian.Uint16(size)))
+	if err != nil {
+		return nil, "", nil, 0, err
+	}
+	return header, string(id), wrapped, chunkSize, nil
+}
+
+// nonce returns the nonce of the chunk. The data key is used only for a single stream,
+// so the counter of the chunk never repeats with the same key.
+func nonce(size int, counter uint64, last bool) []byte {
+	n := make([]byte, size)
+	binary.BigEndian.PutUint64(n, counter)
+	if last {
+		n[size-1] = 1
+	}
+	return n
+}
+
+type writer struct {
+	dst     io.WriteCloser
+	aead    cipher.AEAD
+	header  []byte
+	buf     []byte
+	counter uint64
+	closed  bool
+}
+
+func (w *writer) Write(p []byte) (n int, err error) {
+	if w.closed {
+		return 0, io.ErrClosedPipe
+	}
+	for len(p) > 0 {
+		// the full buffer is sealed only when more data comes, because the final chunk must be sealed on Close.
+		if len(w.buf) == cap(w.buf) {
+			err = w.seal(false)
+			if err != nil {
+				return n, err
+			}
+		}
+		c := min(cap(w.buf)-len(w.buf), len(p))
+		w.buf = append(w.buf, p[:c]...)
+		p = p[c:]
+		n += c
+	}
+	return n, nil
+}
+
+// Close seals the final chunk and closes dst.
+func (w *writer) Close() (err error) {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	err = w.seal(true)
+	return errors.Join(err, w.dst.Close())
+}
+
+func (w *writer) seal(last bool) (err error) {
+	ct := w.aead.Seal(nil, nonce(w.aead.NonceSize(), w.counter, last), w.buf, w.header)
+	w.counter++
+	w.buf = w.buf[:0]
+
+	length := uint32(len(ct))
+	if last {
+		length |= lastChunk
+	}
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], length)
+	_, err = w.dst.Write(size[:])
+	if err != nil {
+		return err
+	}
+	_, err = w.dst.Write(ct)
+	return err
+}
+
+type reader struct {
+	src       io.ReadCloser
+	aead      cipher.AEAD
+	header    []byte
+	plain     []byte
+	chunkSize int
+	counter   uint64
+	done      bool
+}
+
+func (r *reader) Read(p []byte) (n int, err error) {
+	for len(r.plain) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		err = r.open()
+		if err != nil {
+			return 0, err
+		}
+	}
+	n = copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+func (r *reader) open() error {
+	var size [4]byte
+	_, err := io.ReadFull(r.src, size[:])
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return errors.ErrEncryptedStreamTruncated
+		}
+		return err
+	}
+	length := binary.BigEndian.Uint32(size[:])
+	last := length&lastChunk != 0
+	length &^= lastChunk
+	if int(length) > r.chunkSize+r.aead.Overhead() {
+		return errors.ErrInvalidEncryptedStream
+	}
+	ct := make([]byte, length)
+	_, err = io.ReadFull(r.src, ct)
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return errors.ErrEncryptedStreamTruncated
+		}
+		return err
+	}
+	r.plain, err = r.aead.Open(ct[:0], nonce(r.aead.NonceSize(), r.counter, last), ct, r.header)
+	if err != nil {
+		return errors.ErrInvalidEncryptedStream
+	}
+	r.counter++
+	r.done = last
+	return nil
+}
+
+func (r *reader) Close() error {
+	return r.src.Close()
+}
@@ -0,0 +1,231 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encrypt
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+type buffer struct {
+	bytes.Buffer
+}
+
+func (*buffer) Close() error {
+	return nil
+}
+
+func TestEncryptor(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name string
+		run  func(t *testing.T, ctx context.Context)
+	}
+
+	newKey := func(t *testing.T, id string) Key {
+		t.Helper()
+		key := make([]byte, 32)
+		_, err := rand.Read(key)
+		require.NoError(t, err)
+		return Key{ID: id, Key: key}
+	}
+	newEncryptor := func(t *testing.T, id string, keys ...Key) Encryptor {
+		t.Helper()
+		kp, err := newKeyfileProvider(Keyfile{Keys: keys}, id)
+		require.NoError(t, err)
+		e, err := New(WithKeyProvider(kp), WithChunkSize(16))
+		require.NoError(t, err)
+		return e
+	}
+	encrypt := func(t *testing.T, ctx context.Context, e Encryptor, data []byte) []byte {
+		t.Helper()
+		buf := new(buffer)
+		w, err := e.Writer(ctx, buf)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		return buf.Bytes()
+	}
+	decrypt := func(ctx context.Context, e Encryptor, data []byte) ([]byte, error) {
+		r, err := e.Reader(ctx, io.NopCloser(bytes.NewReader(data)))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	}
+
+	tests := []test{
+		{
+			name: "encrypted stream is decrypted to the original data",
+			run: func(t *testing.T, ctx context.Context) {
+				e := newEncryptor(t, "", newKey(t, "k1"))
+				for _, size := range []int{0, 1, 15, 16, 17, 64, 1000} {
+					data := bytes.Repeat([]byte{'a'}, size)
+					enc := encrypt(t, ctx, e, data)
+					if size >= 8 {
+						require.False(t, bytes.Contains(enc, data[:8]), size)
+					}
+					dec, err := decrypt(ctx, e, enc)
+					require.NoError(t, err, size)
+					require.Equal(t, data, append([]byte{}, dec...), size)
+				}
+			},
+		},
+		{
+			name: "empty source is read as io.EOF",
+			run: func(t *testing.T, ctx context.Context) {
+				e := newEncryptor(t, "", newKey(t, "k1"))
+				_, err := decrypt(ctx, e, nil)
+				require.ErrorIs(t, err, io.EOF)
+			},
+		},
+		{
+			name: "truncated stream is rejected",
+			run: func(t *testing.T, ctx context.Context) {
+				e := newEncryptor(t, "", newKey(t, "k1"))
+				enc := encrypt(t, ctx, e, bytes.Repeat([]byte{'a'}, 100))
+				// the final chunk of 100 % 16 = 4 bytes is 4 + 4 + 16 bytes long.
+				_, err := decrypt(ctx, e, enc[:len(enc)-24])
+				require.ErrorIs(t, err, errors.ErrEncryptedStreamTruncated)
+				_, err = decrypt(ctx, e, enc[:len(enc)-1])
+				require.ErrorIs(t, err, errors.ErrEncryptedStreamTruncated)
+			},
+		},
+		{
+			name: "tampered stream is rejected",
+			run: func(t *testing.T, ctx context.Context) {
+				e := newEncryptor(t, "", newKey(t, "k1"))
+				enc := encrypt(t, ctx, e, bytes.Repeat([]byte{'a'}, 100))
+				enc[len(enc)-1] ^= 1
+				_, err := decrypt(ctx, e, enc)
+				require.ErrorIs(t, err, errors.ErrInvalidEncryptedStream)
+				_, err = decrypt(ctx, e, enc[:len(magic)+3])
+				require.ErrorIs(t, err, errors.ErrInvalidEncryptedStream)
+			},
+		},
+		{
+			name: "unencrypted stream is rejected by default",
+			run: func(t *testing.T, ctx context.Context) {
+				e := newEncryptor(t, "", newKey(t, "k1"))
+				for _, data := range []string{"plain text is not encrypted", "VALD", "V"} {
+					_, err := decrypt(ctx, e, []byte(data))
+					require.ErrorIs(t, err, errors.ErrUnencryptedStream, data)
+				}
+			},
+		},
+		{
+			name: "unencrypted stream is read as it is when it is allowed",
+			run: func(t *testing.T, ctx context.Context) {
+				kp, err := newKeyfileProvider(Keyfile{Keys: []Key{newKey(t, "k1")}}, "")
+				require.NoError(t, err)
+				e, err := New(WithKeyProvider(kp), WithChunkSize(16), WithAllowUnencrypted(true))
+				require.NoError(t, err)
+				for _, data := range []string{"plain text is not encrypted", "VALD", "V"} {
+					dec, err := decrypt(ctx, e, []byte(data))
+					require.NoError(t, err, data)
+					require.Equal(t, data, string(dec))
+				}
+				enc := encrypt(t, ctx, e, []byte("data"))
+				dec, err := decrypt(ctx, e, enc)
+				require.NoError(t, err)
+				require.Equal(t, "data", string(dec))
+			},
+		},
+		{
+			name: "stream encrypted by the old key is decrypted after the rotation",
+			run: func(t *testing.T, ctx context.Context) {
+				k1, k2 := newKey(t, "k1"), newKey(t, "k2")
+				old := newEncryptor(t, "", k1)
+				enc := encrypt(t, ctx, old, []byte("data"))
+
+				rotated := newEncryptor(t, "", k1, k2)
+				require.Equal(t, "k2", rotated.KeyID())
+				dec, err := decrypt(ctx, rotated, enc)
+				require.NoError(t, err)
+				require.Equal(t, "data", string(dec))
+
+				_, err = decrypt(ctx, newEncryptor(t, "", k2), enc)
+				require.EqualError(t, err, errors.ErrEncryptionKeyNotFound("k1").Error())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			test.run(tt, ctx)
+		})
+	}
+}
+
+func TestNewKeyfileProvider(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		kf   Keyfile
+		id   string
+		want string
+		err  error
+	}{
+		{
+			name: "last key is used when the id is empty",
+			kf:   Keyfile{Keys: []Key{{ID: "k1", Key: make([]byte, 16)}, {ID: "k2", Key: make([]byte, 32)}}},
+			want: "k2",
+		},
+		{
+			name: "key of the id is used",
+			kf:   Keyfile{Keys: []Key{{ID: "k1", Key: make([]byte, 16)}, {ID: "k2", Key: make([]byte, 32)}}},
+			id:   "k1",
+			want: "k1",
+		},
+		{
+			name: "missing key is rejected",
+			kf:   Keyfile{Keys: []Key{{ID: "k1", Key: make([]byte, 16)}}},
+			id:   "k2",
+			err:  errors.ErrEncryptionKeyNotFound("k2"),
+		},
+		{
+			name: "key of the invalid size is rejected",
+			kf:   Keyfile{Keys: []Key{{ID: "k1", Key: make([]byte, 10)}}},
+			err:  errors.ErrInvalidEncryptionKey("k1", 10),
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			kp, err := newKeyfileProvider(test.kf, test.id)
+			if test.err != nil {
+				require.EqualError(tt, err, test.err.Error())
+				return
+			}
+			require.NoError(tt, err)
+			require.Equal(tt, test.want, kp.KeyID())
+		})
+	}
+}
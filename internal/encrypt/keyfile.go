@@ -0,0 +1,119 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encrypt
+
+import (
+	"context"
+	"crypto/rand"
+
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+)
+
+// Keyfile is the content of the key file:
+//
+//	{"keys": [{"id": "2024-01", "key": "<base64 encoded 16, 24 or 32 bytes>"}]}
+//
+// The old keys should be kept in the file after the rotation to decrypt the old backups.
+type Keyfile struct {
+	Keys []Key `json:"keys"`
+}
+
+// Key is the key encryption key.
+type Key struct {
+	ID  string `json:"id"`
+	Key []byte `json:"key"`
+}
+
+type keyfile struct {
+	id   string
+	keys map[string][]byte
+}
+
+// NewKeyfileProvider returns the KeyProvider which wraps the data keys with AES-GCM by the keys read from path.
+// The key of id wraps the new data keys, and the last key in the file is used when id is empty.
+func NewKeyfileProvider(path, id string) (KeyProvider, error) {
+	b, err := file.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var kf Keyfile
+	err = json.Unmarshal(b, &kf)
+	if err != nil {
+		return nil, err
+	}
+	return newKeyfileProvider(kf, id)
+}
+
+func newKeyfileProvider(kf Keyfile, id string) (KeyProvider, error) {
+	k := &keyfile{
+		id:   id,
+		keys: make(map[string][]byte, len(kf.Keys)),
+	}
+	for _, key := range kf.Keys {
+		switch len(key.Key) {
+		case 16, 24, 32:
+		default:
+			return nil, errors.ErrInvalidEncryptionKey(key.ID, len(key.Key))
+		}
+		k.keys[key.ID] = key.Key
+		if id == "" {
+			k.id = key.ID
+		}
+	}
+	if _, ok := k.keys[k.id]; !ok {
+		return nil, errors.ErrEncryptionKeyNotFound(k.id)
+	}
+	return k, nil
+}
+
+func (k *keyfile) KeyID() string {
+	return k.id
+}
+
+// Wrap returns the nonce followed by the sealed data key. The key id is authenticated
+// so the wrapped key cannot be unwrapped as the one of the other key.
+func (k *keyfile) Wrap(_ context.Context, dek []byte) ([]byte, error) {
+	aead, err := newAEAD(k.keys[k.id])
+	if err != nil {
+		return nil, err
+	}
+	n := make([]byte, aead.NonceSize())
+	_, err = rand.Read(n)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(n, n, dek, []byte(k.id)), nil
+}
+
+func (k *keyfile) Unwrap(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, errors.ErrEncryptionKeyNotFound(keyID)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.ErrInvalidEncryptedStream
+	}
+	dek, err := aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, errors.ErrInvalidEncryptedStream
+	}
+	return dek, nil
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encrypt
+
+// Option configures the encryptor.
+type Option func(*encryptor) error
+
+var defaultOptions = []Option{
+	WithChunkSize(64 << 10),
+}
+
+// WithKeyProvider returns Option that sets the provider of the keys which wrap the data keys.
+func WithKeyProvider(kp KeyProvider) Option {
+	return func(e *encryptor) error {
+		if kp != nil {
+			e.kp = kp
+		}
+		return nil
+	}
+}
+
+// WithChunkSize returns Option that sets the size of the plaintext sealed at once.
+func WithChunkSize(size int) Option {
+	return func(e *encryptor) error {
+		if size > 0 && uint32(size) < lastChunk {
+			e.chunkSize = size
+		}
+		return nil
+	}
+}
+
+// WithAllowUnencrypted returns Option that sets whether the stream without the header of the encryption is read as it is.
+// It must be enabled only to restore the data written before the encryption is enabled, because the unencrypted stream
+// is not authenticated and can be replaced by anyone who can write to the storage.
+func WithAllowUnencrypted(allow bool) Option {
+	return func(e *encryptor) error {
+		e.allowUnencrypted = allow
+		return nil
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encrypt
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+// PluginRequest is written to the stdin of the plugin command.
+// The command is run with "wrap" or "unwrap" as its last argument,
+// and Plaintext is set for wrap and Ciphertext is set for unwrap.
+type PluginRequest struct {
+	KeyID      string `json:"key_id"`
+	Plaintext  []byte `json:"plaintext,omitempty"`
+	Ciphertext []byte `json:"ciphertext,omitempty"`
+}
+
+// PluginResponse is read from the stdout of the plugin command.
+type PluginResponse struct {
+	Plaintext  []byte `json:"plaintext,omitempty"`
+	Ciphertext []byte `json:"ciphertext,omitempty"`
+}
+
+const (
+	pluginWrap   = "wrap"
+	pluginUnwrap = "unwrap"
+)
+
+type plugin struct {
+	id      string
+	command []string
+}
+
+// NewPluginProvider returns the KeyProvider which delegates wrapping the data keys to the external command,
+// e.g. the client of the KMS. The key material never leaves the command.
+func NewPluginProvider(id string, command ...string) (KeyProvider, error) {
+	if len(command) == 0 {
+		return nil, errors.NewErrInvalidOption("command", command)
+	}
+	if id == "" {
+		return nil, errors.NewErrInvalidOption("keyID", id)
+	}
+	return &plugin{
+		id:      id,
+		command: command,
+	}, nil
+}
+
+func (p *plugin) KeyID() string {
+	return p.id
+}
+
+func (p *plugin) Wrap(ctx context.Context, dek []byte) ([]byte, error) {
+	res, err := p.run(ctx, pluginWrap, &PluginRequest{
+		KeyID:     p.id,
+		Plaintext: dek,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Ciphertext, nil
+}
+
+func (p *plugin) Unwrap(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	res, err := p.run(ctx, pluginUnwrap, &PluginRequest{
+		KeyID:      keyID,
+		Ciphertext: wrapped,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Plaintext) != dataKeySize {
+		return nil, errors.ErrInvalidEncryptionKey(keyID, len(res.Plaintext))
+	}
+	return res.Plaintext, nil
+}
+
+func (p *plugin) run(ctx context.Context, op string, req *PluginRequest) (*PluginResponse, error) {
+	in, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.command[0], append(p.command[1:], op)...)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err = cmd.Run()
+	if err != nil {
+		return nil, errors.ErrEncryptionKeyPluginFailed(op, err, strings.TrimSpace(stderr.String()))
+	}
+	res := new(PluginResponse)
+	err = json.Unmarshal(stdout.Bytes(), res)
+	if err != nil {
+		return nil, errors.ErrEncryptionKeyPluginFailed(op, err, strings.TrimSpace(stderr.String()))
+	}
+	return res, nil
+}
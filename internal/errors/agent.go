@@ -163,4 +163,92 @@ var (
 	ErrNothingToBeDoneForUpdate = func(uuid string) error {
 		return Errorf("nothing to be done for update uuid %s's object", uuid)
 	}
+
+	// ErrInvalidAttributePredicate represents a function to generate an error that the attribute predicate expression is invalid.
+	ErrInvalidAttributePredicate = func(expr string, pos int, err error) error {
+		return Wrapf(err, "invalid attribute predicate \"%s\" at position %d", expr, pos)
+	}
+
+	// ErrAttributeNotFound represents a function to generate an error that the attributes of the uuid are not found.
+	ErrAttributeNotFound = func(uuid string) error {
+		return Errorf("uuid %s's attributes not found", uuid)
+	}
+
+	// ErrDeltaSnapshotIsNil represents an error that the delta snapshot to be stored is nil.
+	ErrDeltaSnapshotIsNil = New("delta snapshot is nil")
+
+	// ErrDeltaSnapshotBaseMismatch represents a function to generate an error that the delta snapshot does not belong to the base snapshot.
+	ErrDeltaSnapshotBaseMismatch = func(path string, baseID, want int64) error {
+		return Errorf("delta snapshot %s belongs to base snapshot %d, but the current base snapshot is %d", path, baseID, want)
+	}
+
+	// ErrUnsupportedQuantizationType represents a function to generate an error that the quantization type is not supported.
+	ErrUnsupportedQuantizationType = func(t string) error {
+		return Errorf("quantization type %s is not supported", t)
+	}
+
+	// ErrUnsupportedQuantizationDistanceType represents a function to generate an error that the distance type is not supported by the quantized index.
+	ErrUnsupportedQuantizationDistanceType = func(dt string) error {
+		return Errorf("distance type %s is not supported by the quantized index", dt)
+	}
+
+	// ErrInvalidQuantizationRange represents a function to generate an error that the range of the scalar quantization is invalid.
+	ErrInvalidQuantizationRange = func(minValue, maxValue float32) error {
+		return Errorf("invalid scalar quantization range [%f, %f]", minValue, maxValue)
+	}
+
+	// ErrReadYourWritesNotSupported represents a function to generate an error that the search does not support the read-your-writes consistency.
+	ErrReadYourWritesNotSupported = func(search string) error {
+		return Errorf("read-your-writes consistency is not supported by the %s", search)
+	}
+
+	// ErrQuantizationRangeRequired represents a function to generate an error that the range of the scalar quantization is required for the distance type.
+	ErrQuantizationRangeRequired = func(dt string) error {
+		return Errorf("min_value and max_value of the scalar quantization are required for distance type %s", dt)
+	}
+
+	// ErrSparseVectorLengthMismatch represents a function to generate an error that the numbers of the indices and values of the sparse vector are different.
+	ErrSparseVectorLengthMismatch = func(indices, values int) error {
+		return Errorf("sparse vector has %d indices but %d values", indices, values)
+	}
+
+	// ErrSparseVectorDuplicateIndex represents a function to generate an error that the sparse vector has the duplicate index.
+	ErrSparseVectorDuplicateIndex = func(idx uint32) error {
+		return Errorf("sparse vector has duplicate index %d", idx)
+	}
+
+	// ErrInvalidMultiVectorID represents a function to generate an error that the uuid contains the separator of the sub-vector ids of the multi-vector document.
+	ErrInvalidMultiVectorID = func(uuid string) error {
+		return Errorf("uuid %q contains the reserved separator of the multi-vector document", uuid)
+	}
+
+	// ErrCollectionNotFound represents a function to generate an error that the named collection is not found.
+	ErrCollectionNotFound = func(name string) error {
+		return Errorf("collection %s not found", name)
+	}
+
+	// ErrCollectionAlreadyExists represents a function to generate an error that the named collection already exists.
+	ErrCollectionAlreadyExists = func(name string) error {
+		return Errorf("collection %s already exists", name)
+	}
+
+	// ErrInvalidCollectionName represents a function to generate an error that the collection name is invalid.
+	ErrInvalidCollectionName = func(name string) error {
+		return Errorf("invalid collection name %q, the name must consist of 1 to 63 alphanumeric characters, '-' or '_'", name)
+	}
+
+	// ErrInvalidCollectionSwitch represents a function to generate an error that the collection cannot be switched to the target.
+	ErrInvalidCollectionSwitch = func(name, target string) error {
+		return Errorf("invalid collection switch from %q to %q", name, target)
+	}
+
+	// ErrCollectionSwitched represents a function to generate an error that the named collection serves the requests of another collection.
+	ErrCollectionSwitched = func(name string) error {
+		return Errorf("collection %s serves the requests of another collection", name)
+	}
+
+	// ErrCollectionFenced represents a function to generate an error that the write requests to the named collection are fenced.
+	ErrCollectionFenced = func(name string) error {
+		return Errorf("collection %q is fenced, the write requests are rejected", name)
+	}
 )
@@ -14,4 +14,43 @@
 
 package errors
 
-var ErrInvalidBackupConfig = New("invalid backup config")
+import "github.com/vdaas/vald/internal/strings"
+
+var (
+	ErrInvalidBackupConfig = New("invalid backup config")
+
+	// ErrBackupGenerationNotFound represents a function to generate an error that the backup generation is not listed in the manifest.
+	ErrBackupGenerationNotFound = func(id string) error {
+		return Errorf("backup generation %s not found", id)
+	}
+
+	// ErrInvalidBackupGeneration represents a function to generate an error that the metadata of the backup generation is missing or invalid.
+	ErrInvalidBackupGeneration = func(id string) error {
+		return Errorf("backup generation %s has no valid metadata", id)
+	}
+
+	// ErrBackupFileMissing represents a function to generate an error that the file recorded in the integrity manifest is not in the backup.
+	ErrBackupFileMissing = func(name string) error {
+		return Errorf("backup file %s is missing", name)
+	}
+
+	// ErrUnexpectedBackupFile represents a function to generate an error that the file in the backup is not recorded in the integrity manifest.
+	ErrUnexpectedBackupFile = func(name string) error {
+		return Errorf("backup file %s is not recorded in the integrity manifest", name)
+	}
+
+	// ErrBackupFileCorrupted represents a function to generate an error that the size or the digest of the file differs from the integrity manifest.
+	ErrBackupFileCorrupted = func(name string, wantSize, gotSize int64, wantDigest, gotDigest string) error {
+		return Errorf("backup file %s is corrupted: expected %d bytes with sha256 %s, but got %d bytes with sha256 %s", name, wantSize, wantDigest, gotSize, gotDigest)
+	}
+
+	// ErrBackupIntegrityMismatch represents a function to generate an error that the backup does not match its integrity manifest.
+	ErrBackupIntegrityMismatch = func(key string, err error) error {
+		return Wrapf(err, "backup %s does not match its integrity manifest", key)
+	}
+
+	// ErrCorruptedBackups represents a function to generate an error that the verification found the corrupted backups.
+	ErrCorruptedBackups = func(keys ...string) error {
+		return Errorf("%d corrupted backups found: %s", len(keys), strings.Join(keys, ", "))
+	}
+)
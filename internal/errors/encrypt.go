@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+var (
+	// ErrInvalidEncryptedStream represents an error that the stream is not encrypted by the encryptor or is corrupted.
+	ErrInvalidEncryptedStream = New("invalid encrypted stream")
+
+	// ErrUnencryptedStream represents an error that the stream has no header of the encryption while the unencrypted
+	// streams are not allowed.
+	ErrUnencryptedStream = New("stream is not encrypted and the unencrypted restore is not allowed")
+
+	// ErrEncryptedStreamTruncated represents an error that the encrypted stream ends before its final chunk.
+	ErrEncryptedStreamTruncated = New("encrypted stream is truncated")
+
+	// ErrEncryptionKeyNotFound represents a function to generate an error that the key of the id is not found.
+	ErrEncryptionKeyNotFound = func(id string) error {
+		return Errorf("encryption key %s not found", id)
+	}
+
+	// ErrInvalidEncryptionKey represents a function to generate an error that the key of the id is not a valid AES key.
+	ErrInvalidEncryptionKey = func(id string, size int) error {
+		return Errorf("encryption key %s must be 16, 24 or 32 bytes, but it is %d bytes", id, size)
+	}
+
+	// ErrEncryptionKeyProviderNotFound represents a function to generate an error that the key provider is not supported.
+	ErrEncryptionKeyProviderNotFound = func(name string) error {
+		return Errorf("encryption key provider %s not found", name)
+	}
+
+	// ErrEncryptionKeyPluginFailed represents a function to generate an error that the key plugin command failed.
+	ErrEncryptionKeyPluginFailed = func(op string, err error, stderr string) error {
+		return Wrapf(err, "encryption key plugin failed to %s: %s", op, stderr)
+	}
+)
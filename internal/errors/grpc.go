@@ -85,4 +85,19 @@ var (
 	ErrServerStreamServerSend = func(err error) error {
 		return Wrap(err, "gRPC server failed to send to stream")
 	}
+
+	// ErrQuotaExceeded represents a function to generate an error that the tenant exceeded the quota of the budget.
+	ErrQuotaExceeded = func(tenant, budget string) error {
+		return Errorf("tenant %s exceeded the %s quota", tenant, budget)
+	}
+
+	// ErrQuotaBurstExceeded represents a function to generate an error that the request of n vectors exceeded the burst of the budget.
+	ErrQuotaBurstExceeded = func(tenant, budget string, n, burst int) error {
+		return Errorf("the request of %d vectors exceeded the %s quota burst %d of tenant %s", n, budget, burst, tenant)
+	}
+
+	// ErrRequestShed represents a function to generate an error that the request of the priority class was shed by the load.
+	ErrRequestShed = func(priority string) error {
+		return Errorf("%s priority request was shed by the server load", priority)
+	}
 )
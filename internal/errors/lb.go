@@ -27,4 +27,52 @@ var (
 	ErrInvalidSearchConfig = func(msg string) error {
 		return Errorf("Invalid Search_Config: %s", msg)
 	}
+
+	// ErrInvalidRangeSearchCursor represents a function to generate an error that the cursor of the range search is invalid.
+	ErrInvalidRangeSearchCursor = func(cursor string) error {
+		return Errorf("invalid range search cursor %q", cursor)
+	}
+
+	// ErrInvalidSearchCursor represents an error that the cursor of the search results is invalid.
+	ErrInvalidSearchCursor = New("invalid search cursor")
+
+	// ErrSearchCursorWithFusion represents an error that the cursor is requested for the fused search results,
+	// which are not ordered by the distance.
+	ErrSearchCursorWithFusion = New("search cursor is not supported for the fused search results")
+
+	// ErrRerankNotSupported represents a function to generate an error that the re-ranking is requested for the search
+	// whose results cannot be re-ranked by the distances of the dense vectors.
+	ErrRerankNotSupported = func(target string) error {
+		return Errorf("re-ranking is not supported for %s", target)
+	}
+
+	// ErrInvalidMMRLambda represents a function to generate an error that the lambda of the maximal marginal relevance is
+	// out of [0, 1].
+	ErrInvalidMMRLambda = func(lambda float32) error {
+		return Errorf("mmr lambda %v is out of [0, 1]", lambda)
+	}
+
+	// ErrPartitionWithRendezvousPlacement represents an error that the partition routing is enabled with the rendezvous
+	// placement, which places the vectors by their IDs instead of their partitions.
+	ErrPartitionWithRendezvousPlacement = New("partition routing cannot be enabled with the rendezvous placement")
+
+	// ErrStalePartitionTable represents an error that the partition tables have not been reloaded recently, so the
+	// vector may be routed by the outdated table.
+	ErrStalePartitionTable = New("partition tables are not reloaded recently")
+
+	// ErrPartitionHeadOverflow represents a function to generate an error that the version and the number of the
+	// centroids of the partition table cannot be held by the head vector of the dimension.
+	ErrPartitionHeadOverflow = func(version uint64, n, dim int) error {
+		return Errorf("partition table version %d with %d centroids cannot be held by the head of dimension %d", version, n, dim)
+	}
+
+	// ErrInvalidPartitionHead represents a function to generate an error that the head vector of the partition table is invalid.
+	ErrInvalidPartitionHead = func(head []float32) error {
+		return Errorf("invalid partition table head %v", head)
+	}
+
+	// ErrReservedCollection represents a function to generate an error that the collection is reserved for the LB gateways.
+	ErrReservedCollection = func(name string) error {
+		return Errorf("collection %s is reserved for the LB gateways", name)
+	}
 )
@@ -0,0 +1,29 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package errors
+
+// ErrSameMigrationCollection represents a function to generate an error that the source and the target collections of the migration are the same.
+var ErrSameMigrationCollection = func(name string) error {
+	return Errorf("source and target collections of the migration are the same collection %q", name)
+}
+
+// ErrMigratedVectorDimensionMismatch represents a function to generate an error that the dimension of the transformed vector does not match the target collection.
+var ErrMigratedVectorDimensionMismatch = func(id string, got, want int) error {
+	return Errorf("transformed vector %s has dimension %d, but the target collection requires dimension %d", id, got, want)
+}
+
+// ErrMigrationNotConverged represents a function to generate an error that the catch-up passes of the migration do not converge.
+var ErrMigrationNotConverged = func(source, target string, passes int) error {
+	return Errorf("migration from collection %q to %s did not converge after %d catch-up passes", source, target, passes)
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+var (
+	// ErrInvalidReplicationMode represents a function to generate an error that the replication mode of the Mirror Gateway is invalid.
+	ErrInvalidReplicationMode = func(mode string) error {
+		return Errorf("invalid mirror replication mode: %s", mode)
+	}
+
+	// ErrReplicatorNotStarted represents an error that the write request is replicated before the replicator is started.
+	ErrReplicatorNotStarted = New("mirror replicator is not started")
+
+	// ErrOutboxClosed represents an error that the outbox is already closed.
+	ErrOutboxClosed = New("mirror replication outbox is closed")
+
+	// ErrOutboxFull represents an error that the write request is rejected because the outbox reaches the max size.
+	ErrOutboxFull = New("mirror replication outbox is full")
+
+	// ErrOutboxRecordCorrupted represents a function to generate an error that the outbox record is corrupted.
+	ErrOutboxRecordCorrupted = func(path string, offset int64) error {
+		return Errorf("mirror replication outbox record corrupted at offset %d in %s", offset, path)
+	}
+
+	// ErrOutboxCursorCorrupted represents a function to generate an error that the cursor file of the outbox is corrupted.
+	ErrOutboxCursorCorrupted = func(path string) error {
+		return Errorf("mirror replication outbox cursor corrupted in %s", path)
+	}
+)
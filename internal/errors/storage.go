@@ -21,4 +21,9 @@ var (
 	ErrStorageWriterNotOpened = New("writer not opened")
 
 	ErrBucketNotOpened = New("bucket not opened")
+
+	// ErrInvalidBlobKey represents a function to generate an error that the object key resolves to a path outside of the bucket.
+	ErrInvalidBlobKey = func(key string) error {
+		return Errorf("invalid blob key %s: the key resolves outside of the bucket", key)
+	}
 )
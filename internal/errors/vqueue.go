@@ -14,4 +14,16 @@
 
 package errors
 
-var ErrVQueueFinalizing = New("error vector queue is now finalizing...")
+var (
+	ErrVQueueFinalizing = New("error vector queue is now finalizing...")
+
+	// ErrInvalidWALSyncPolicy represents a function to generate an error that the sync policy of the write-ahead log is invalid.
+	ErrInvalidWALSyncPolicy = func(policy string) error {
+		return Errorf("invalid vqueue wal sync policy: %s", policy)
+	}
+
+	// ErrWALRecordCorrupted represents a function to generate an error that the write-ahead log record is corrupted.
+	ErrWALRecordCorrupted = func(path string, offset int64) error {
+		return Errorf("vqueue wal record corrupted at offset %d in %s", offset, path)
+	}
+)
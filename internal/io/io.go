@@ -33,10 +33,15 @@ type (
 	WriteCloser = io.WriteCloser
 )
 
+const SeekStart = io.SeekStart
+
 var (
 	Pipe             = io.Pipe
 	EOF              = io.EOF
 	NopCloser        = io.NopCloser
+	MultiReader      = io.MultiReader
+	LimitReader      = io.LimitReader
+	ReadFull         = io.ReadFull
 	Discard          = io.Discard
 	ErrUnexpectedEOF = io.ErrUnexpectedEOF
 	ErrClosedPipe    = io.ErrClosedPipe
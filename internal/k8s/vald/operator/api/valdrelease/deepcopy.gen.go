@@ -228,11 +228,14 @@ func (in *AgentSidecarConfig) DeepCopyInto(out *AgentSidecarConfig) {
 	out.BlobStorage = resource.CopyPtrInto(in.BlobStorage)
 	out.Client = resource.CopyPtrInto(in.Client)
 	out.Compress = resource.CopyPtrInto(in.Compress)
+	out.DeltaBackupEnabled = resource.CopyPtr(in.DeltaBackupEnabled)
+	out.Encryption = resource.CopyPtrInto(in.Encryption)
 	out.Filename = resource.CopyPtr(in.Filename)
 	out.FilenameSuffix = resource.CopyPtr(in.FilenameSuffix)
 	out.PostStopTimeout = resource.CopyPtr(in.PostStopTimeout)
 	out.RestoreBackoff = resource.CopyPtr(in.RestoreBackoff)
 	out.RestoreBackoffEnabled = resource.CopyPtr(in.RestoreBackoffEnabled)
+	out.Versioning = resource.CopyPtrInto(in.Versioning)
 	out.WatchEnabled = resource.CopyPtr(in.WatchEnabled)
 }
 
@@ -240,6 +243,7 @@ func (in *AgentSidecarConfigBlobStorage) DeepCopyInto(out *AgentSidecarConfigBlo
 	*out = *in
 	out.Bucket = resource.CopyPtr(in.Bucket)
 	out.CloudStorage = resource.CopyPtrInto(in.CloudStorage)
+	out.File = resource.CopyPtrInto(in.File)
 	out.S3 = resource.CopyPtrInto(in.S3)
 	out.StorageType = resource.CopyPtr(in.StorageType)
 }
@@ -266,6 +270,11 @@ func (in *AgentSidecarConfigBlobStorageCloudStorageClient) DeepCopyInto(
 	out.CredentialsJson = resource.CopyPtr(in.CredentialsJson)
 }
 
+func (in *AgentSidecarConfigBlobStorageFile) DeepCopyInto(out *AgentSidecarConfigBlobStorageFile) {
+	*out = *in
+	out.Path = resource.CopyPtr(in.Path)
+}
+
 func (in *AgentSidecarConfigBlobStorageS3) DeepCopyInto(out *AgentSidecarConfigBlobStorageS3) {
 	*out = *in
 	out.AccessKey = resource.CopyPtr(in.AccessKey)
@@ -323,6 +332,23 @@ func (in *AgentSidecarConfigCompress) DeepCopyInto(out *AgentSidecarConfigCompre
 	out.CompressionLevel = resource.CopyPtr(in.CompressionLevel)
 }
 
+func (in *AgentSidecarConfigEncryption) DeepCopyInto(out *AgentSidecarConfigEncryption) {
+	*out = *in
+	out.Enabled = resource.CopyPtr(in.Enabled)
+	out.KeyId = resource.CopyPtr(in.KeyId)
+	out.Keyfile = resource.CopyPtr(in.Keyfile)
+	out.PluginCommand = resource.CopyPtr(in.PluginCommand)
+	out.Provider = resource.CopyPtr(in.Provider)
+}
+
+func (in *AgentSidecarConfigVersioning) DeepCopyInto(out *AgentSidecarConfigVersioning) {
+	*out = *in
+	out.Enabled = resource.CopyPtr(in.Enabled)
+	out.MaxAge = resource.CopyPtr(in.MaxAge)
+	out.MaxGenerations = resource.CopyPtr(in.MaxGenerations)
+	out.RestoreGeneration = resource.CopyPtr(in.RestoreGeneration)
+}
+
 func (in *AgentSidecarService) DeepCopyInto(out *AgentSidecarService) {
 	*out = *in
 	out.Annotations = resource.CopyPtr(in.Annotations) // TODO(free-form map): deep copy
@@ -872,10 +898,17 @@ func (in *GatewayLb) DeepCopyInto(out *GatewayLb) {
 func (in *GatewayLbGatewayConfig) DeepCopyInto(out *GatewayLbGatewayConfig) {
 	*out = *in
 	out.AgentNamespace = resource.CopyPtr(in.AgentNamespace)
+	out.AvoidBusyAgents = resource.CopyPtr(in.AvoidBusyAgents)
 	out.Discoverer = resource.CopyPtrInto(in.Discoverer)
 	out.IndexReplica = resource.CopyPtr(in.IndexReplica)
 	out.MultiOperationConcurrency = resource.CopyPtr(in.MultiOperationConcurrency)
 	out.NodeName = resource.CopyPtr(in.NodeName)
+	out.Partition = resource.CopyPtrInto(in.Partition)
+	out.Placement = resource.CopyPtr(in.Placement)
+	out.RebalanceDuration = resource.CopyPtr(in.RebalanceDuration)
+	out.Rerank = resource.CopyPtrInto(in.Rerank)
+	out.ResultCache = resource.CopyPtrInto(in.ResultCache)
+	out.Straggler = resource.CopyPtrInto(in.Straggler)
 }
 
 func (in *GatewayLbGatewayConfigDiscoverer) DeepCopyInto(out *GatewayLbGatewayConfigDiscoverer) {
@@ -886,6 +919,37 @@ func (in *GatewayLbGatewayConfigDiscoverer) DeepCopyInto(out *GatewayLbGatewayCo
 	out.ReadClient = resource.CopyPtrInto(in.ReadClient)
 }
 
+func (in *GatewayLbGatewayConfigPartition) DeepCopyInto(out *GatewayLbGatewayConfigPartition) {
+	*out = *in
+	out.CheckDuration = resource.CopyPtr(in.CheckDuration)
+	out.EnableTraining = resource.CopyPtr(in.EnableTraining)
+	out.ImbalanceRatio = resource.CopyPtr(in.ImbalanceRatio)
+	out.Nprobe = resource.CopyPtr(in.Nprobe)
+	out.Partitions = resource.CopyPtr(in.Partitions)
+	out.TrainSampleSize = resource.CopyPtr(in.TrainSampleSize)
+}
+
+func (in *GatewayLbGatewayConfigRerank) DeepCopyInto(out *GatewayLbGatewayConfigRerank) {
+	*out = *in
+	out.DistanceType = resource.CopyPtr(in.DistanceType)
+	out.Ratio = resource.CopyPtr(in.Ratio)
+}
+
+func (in *GatewayLbGatewayConfigResultCache) DeepCopyInto(out *GatewayLbGatewayConfigResultCache) {
+	*out = *in
+	out.Enabled = resource.CopyPtr(in.Enabled)
+	out.MaxMemory = resource.CopyPtr(in.MaxMemory)
+	out.Ttl = resource.CopyPtr(in.Ttl)
+}
+
+func (in *GatewayLbGatewayConfigStraggler) DeepCopyInto(out *GatewayLbGatewayConfigStraggler) {
+	*out = *in
+	out.Enabled = resource.CopyPtr(in.Enabled)
+	out.Hedge = resource.CopyPtr(in.Hedge)
+	out.MinDelay = resource.CopyPtr(in.MinDelay)
+	out.Percentile = resource.CopyPtr(in.Percentile)
+}
+
 func (in *GatewayLbIngress) DeepCopyInto(out *GatewayLbIngress) {
 	*out = *in
 	out.Annotations = resource.CopyPtr(in.Annotations) // TODO(free-form map): deep copy
@@ -996,9 +1060,23 @@ func (in *GatewayMirrorGatewayConfig) DeepCopyInto(out *GatewayMirrorGatewayConf
 	out.Net = resource.CopyPtr(in.Net)
 	out.PodName = resource.CopyPtr(in.PodName)
 	out.RegisterDuration = resource.CopyPtr(in.RegisterDuration)
+	out.Replication = resource.CopyPtrInto(in.Replication)
 	out.SelfMirrorAddr = resource.CopyPtr(in.SelfMirrorAddr)
 }
 
+func (in *GatewayMirrorGatewayConfigReplication) DeepCopyInto(out *GatewayMirrorGatewayConfigReplication) {
+	*out = *in
+	out.Backoff = resource.CopyPtr(in.Backoff)
+	out.BatchSize = resource.CopyPtr(in.BatchSize)
+	out.FlushDuration = resource.CopyPtr(in.FlushDuration)
+	out.MaxLag = resource.CopyPtr(in.MaxLag)
+	out.MaxOutboxSize = resource.CopyPtr(in.MaxOutboxSize)
+	out.Mode = resource.CopyPtr(in.Mode)
+	out.OutboxPath = resource.CopyPtr(in.OutboxPath)
+	out.OverflowPolicy = resource.CopyPtr(in.OverflowPolicy)
+	out.RetentionDuration = resource.CopyPtr(in.RetentionDuration)
+}
+
 func (in *GatewayMirrorIngress) DeepCopyInto(out *GatewayMirrorIngress) {
 	*out = *in
 	out.Annotations = resource.CopyPtr(in.Annotations) // TODO(free-form map): deep copy
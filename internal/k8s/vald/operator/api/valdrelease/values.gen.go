@@ -102,6 +102,7 @@ const (
 // Defines values for AgentSidecarConfigBlobStorageStorageType.
 const (
 	CloudStorage AgentSidecarConfigBlobStorageStorageType = "cloud_storage"
+	File         AgentSidecarConfigBlobStorageStorageType = "file"
 	S3           AgentSidecarConfigBlobStorageStorageType = "s3"
 )
 
@@ -113,6 +114,12 @@ const (
 	Zstd AgentSidecarConfigCompressCompressAlgorithm = "zstd"
 )
 
+// Defines values for AgentSidecarConfigEncryptionProvider.
+const (
+	Keyfile AgentSidecarConfigEncryptionProvider = "keyfile"
+	Plugin  AgentSidecarConfigEncryptionProvider = "plugin"
+)
+
 // Defines values for AgentSidecarServiceType.
 const (
 	AgentSidecarServiceTypeClusterIP    AgentSidecarServiceType = "ClusterIP"
@@ -178,6 +185,12 @@ const (
 	GatewayFilterUnhealthyPodEvictionPolicyIfHealthyBudget GatewayFilterUnhealthyPodEvictionPolicy = "IfHealthyBudget"
 )
 
+// Defines values for GatewayLbGatewayConfigPlacement.
+const (
+	Memory     GatewayLbGatewayConfigPlacement = "memory"
+	Rendezvous GatewayLbGatewayConfigPlacement = "rendezvous"
+)
+
 // Defines values for GatewayLbKind.
 const (
 	GatewayLbKindDaemonSet  GatewayLbKind = "DaemonSet"
@@ -197,6 +210,18 @@ const (
 	GatewayLbUnhealthyPodEvictionPolicyIfHealthyBudget GatewayLbUnhealthyPodEvictionPolicy = "IfHealthyBudget"
 )
 
+// Defines values for GatewayMirrorGatewayConfigReplicationMode.
+const (
+	Async GatewayMirrorGatewayConfigReplicationMode = "async"
+	Sync  GatewayMirrorGatewayConfigReplicationMode = "sync"
+)
+
+// Defines values for GatewayMirrorGatewayConfigReplicationOverflowPolicy.
+const (
+	Drop   GatewayMirrorGatewayConfigReplicationOverflowPolicy = "drop"
+	Reject GatewayMirrorGatewayConfigReplicationOverflowPolicy = "reject"
+)
+
 // Defines values for GatewayMirrorKind.
 const (
 	GatewayMirrorKindDaemonSet  GatewayMirrorKind = "DaemonSet"
@@ -534,6 +559,9 @@ type AgentNgt struct {
 	// EnableCopyOnWrite enable copy on write saving for more stable backup
 	EnableCopyOnWrite *bool `json:"enable_copy_on_write,omitempty"`
 
+	// EnableDeltaSave enable delta snapshot saving which persists only the objects changed since the last base snapshot
+	EnableDeltaSave *bool `json:"enable_delta_save,omitempty"`
+
 	// EnableExportIndexInfoToK8s enable export index info to k8s
 	EnableExportIndexInfoToK8s *bool `json:"enable_export_index_info_to_k8s,omitempty"`
 
@@ -565,9 +593,18 @@ type AgentNgt struct {
 	// LoadIndexTimeoutFactor a factor of load index timeout. timeout duration will be calculated by (index count to be loaded) * (factor).
 	LoadIndexTimeoutFactor *string `json:"load_index_timeout_factor,omitempty"`
 
+	// MaxDeltaSnapshots maximum number of delta snapshots before compacting them into a new base snapshot
+	MaxDeltaSnapshots *int `json:"max_delta_snapshots,omitempty"`
+
+	// MaxFilteredSearchCandidates maximum number of candidates fetched by the search with the attribute predicate. the results found so far are returned as partial when it is reached
+	MaxFilteredSearchCandidates *int `json:"max_filtered_search_candidates,omitempty"`
+
 	// MaxLoadIndexTimeout maximum duration of load index timeout
 	MaxLoadIndexTimeout *string `json:"max_load_index_timeout,omitempty"`
 
+	// MaxRangeSearchResults maximum number of results of the range search. the results found so far are returned as partial when it is reached
+	MaxRangeSearchResults *int `json:"max_range_search_results,omitempty"`
+
 	// MinLoadIndexTimeout minimum duration of load index timeout
 	MinLoadIndexTimeout *string `json:"min_load_index_timeout,omitempty"`
 
@@ -578,7 +615,8 @@ type AgentNgt struct {
 	ObjectType *AgentNgtObjectType `json:"object_type,omitempty"`
 
 	// PodName pod name of myself
-	PodName *string `json:"pod_name,omitempty"`
+	PodName      *string               `json:"pod_name,omitempty"`
+	Quantization *AgentNgtQuantization `json:"quantization,omitempty"`
 
 	// SearchEdgeSize search edge size
 	SearchEdgeSize *int            `json:"search_edge_size,omitempty"`
@@ -601,13 +639,40 @@ type AgentNgtKvsdb struct {
 	Concurrency *int `json:"concurrency,omitempty"`
 }
 
+// AgentNgtQuantization defines model for agent_ngt_quantization.
+type AgentNgtQuantization struct {
+	// EnableRerank enables re-ranking of the search results by the full-precision vectors stored next to the index
+	EnableRerank *bool `json:"enable_rerank,omitempty"`
+
+	// MaxValue maximum value of the vector elements for scalar quantization
+	MaxValue *float32 `json:"max_value,omitempty"`
+
+	// MinValue minimum value of the vector elements for scalar quantization. required for l1 and l2. the normalized range [-1, 1] is used for angle and cosine when both min_value and max_value are 0
+	MinValue *float32 `json:"min_value,omitempty"`
+
+	// RerankFactor multiplier of the number of candidates fetched from the quantized index for re-ranking
+	RerankFactor *int `json:"rerank_factor,omitempty"`
+
+	// Type quantization type of the index. scalar stores each element of the vectors as 8bit unsigned integer to reduce the memory footprint
+	Type *string `json:"type,omitempty"`
+}
+
 // AgentNgtVqueue defines model for agent_ngt_vqueue.
 type AgentNgtVqueue struct {
 	// DeleteBufferPoolSize delete slice pool buffer size
 	DeleteBufferPoolSize *int `json:"delete_buffer_pool_size,omitempty"`
 
+	// EnableWal enables the write-ahead log of the vector queue so that un-indexed inserts and deletes survive a crash. the log is written under the index path
+	EnableWal *bool `json:"enable_wal,omitempty"`
+
 	// InsertBufferPoolSize insert slice pool buffer size
 	InsertBufferPoolSize *int `json:"insert_buffer_pool_size,omitempty"`
+
+	// WalSyncInterval fsync interval of the write-ahead log when wal_sync_policy is interval
+	WalSyncInterval *string `json:"wal_sync_interval,omitempty"`
+
+	// WalSyncPolicy fsync policy of the write-ahead log. always: fsync on every write, interval: fsync every wal_sync_interval, none: leave it to the OS
+	WalSyncPolicy *string `json:"wal_sync_policy,omitempty"`
 }
 
 // AgentPersistentVolume defines model for agent_persistentVolume.
@@ -719,6 +784,10 @@ type AgentSidecarConfig struct {
 	Client            *AgentSidecarConfigClient      `json:"client,omitempty"`
 	Compress          *AgentSidecarConfigCompress    `json:"compress,omitempty"`
 
+	// DeltaBackupEnabled upload and restore the delta snapshots of the index instead of the full backup while the base snapshot is unchanged
+	DeltaBackupEnabled *bool                         `json:"delta_backup_enabled,omitempty"`
+	Encryption         *AgentSidecarConfigEncryption `json:"encryption,omitempty"`
+
 	// Filename backup filename
 	Filename *string `json:"filename,omitempty"`
 
@@ -730,7 +799,8 @@ type AgentSidecarConfig struct {
 	RestoreBackoff  *Backoff `json:"restore_backoff,omitempty"`
 
 	// RestoreBackoffEnabled restore backoff enabled
-	RestoreBackoffEnabled *bool `json:"restore_backoff_enabled,omitempty"`
+	RestoreBackoffEnabled *bool                         `json:"restore_backoff_enabled,omitempty"`
+	Versioning            *AgentSidecarConfigVersioning `json:"versioning,omitempty"`
 
 	// WatchEnabled auto backup triggered by file changes is enabled
 	WatchEnabled *bool `json:"watch_enabled,omitempty"`
@@ -741,6 +811,7 @@ type AgentSidecarConfigBlobStorage struct {
 	// Bucket bucket name
 	Bucket       *string                                    `json:"bucket,omitempty"`
 	CloudStorage *AgentSidecarConfigBlobStorageCloudStorage `json:"cloud_storage,omitempty"`
+	File         *AgentSidecarConfigBlobStorageFile         `json:"file,omitempty"`
 	S3           *AgentSidecarConfigBlobStorageS3           `json:"s3,omitempty"`
 
 	// StorageType storage type
@@ -785,6 +856,12 @@ type AgentSidecarConfigBlobStorageCloudStorageClient struct {
 	CredentialsJson *string `json:"credentials_json,omitempty"`
 }
 
+// AgentSidecarConfigBlobStorageFile defines model for agent_sidecar_config_blob_storage_file.
+type AgentSidecarConfigBlobStorageFile struct {
+	// Path root directory of the buckets for the file storage type. the objects are stored under `<path>/<bucket>`
+	Path *string `json:"path,omitempty"`
+}
+
 // AgentSidecarConfigBlobStorageS3 defines model for agent_sidecar_config_blob_storage_s3.
 type AgentSidecarConfigBlobStorageS3 struct {
 	// AccessKey s3 access key
@@ -902,6 +979,45 @@ type AgentSidecarConfigCompress struct {
 // AgentSidecarConfigCompressCompressAlgorithm compression algorithm. must be `gob`, `gzip`, `lz4` or `zstd`
 type AgentSidecarConfigCompressCompressAlgorithm string
 
+// AgentSidecarConfigEncryption defines model for agent_sidecar_config_encryption.
+type AgentSidecarConfigEncryption struct {
+	// AllowUnencryptedRestore restore the backups uploaded before the encryption is enabled as they are. the unencrypted backups fail to restore when it is false
+	AllowUnencryptedRestore *bool `json:"allow_unencrypted_restore,omitempty"`
+
+	// Enabled encrypt the backups with AES-GCM envelope encryption before they are uploaded
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// KeyId id of the key to encrypt the new backups. empty uses the last key in the key file. required for the plugin provider
+	KeyId *string `json:"key_id,omitempty"`
+
+	// Keyfile path of the key file for the keyfile provider. keep the rotated keys in the file to restore the old backups
+	Keyfile *string `json:"keyfile,omitempty"`
+
+	// PluginCommand command and arguments of the plugin provider which wraps and unwraps the data keys
+	PluginCommand *[]string `json:"plugin_command,omitempty"`
+
+	// Provider provider of the key encryption keys
+	Provider *AgentSidecarConfigEncryptionProvider `json:"provider,omitempty"`
+}
+
+// AgentSidecarConfigEncryptionProvider provider of the key encryption keys
+type AgentSidecarConfigEncryptionProvider string
+
+// AgentSidecarConfigVersioning defines model for agent_sidecar_config_versioning.
+type AgentSidecarConfigVersioning struct {
+	// Enabled upload every backup as a new immutable generation listed in the manifest instead of overwriting the backup file
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaxAge generations older than this duration are deleted. empty keeps all generations
+	MaxAge *string `json:"max_age,omitempty"`
+
+	// MaxGenerations number of the newest generations to keep. 0 keeps all generations
+	MaxGenerations *int `json:"max_generations,omitempty"`
+
+	// RestoreGeneration generation id restored by the initContainer. `latest` restores the newest generation with valid metadata
+	RestoreGeneration *string `json:"restore_generation,omitempty"`
+}
+
 // AgentSidecarService defines model for agent_sidecar_service.
 type AgentSidecarService struct {
 	// Annotations agent sidecar service annotations
@@ -1916,8 +2032,11 @@ type GatewayLbUnhealthyPodEvictionPolicy string
 // GatewayLbGatewayConfig defines model for gateway_lb_gateway_config.
 type GatewayLbGatewayConfig struct {
 	// AgentNamespace agent namespace
-	AgentNamespace *string                           `json:"agent_namespace,omitempty"`
-	Discoverer     *GatewayLbGatewayConfigDiscoverer `json:"discoverer,omitempty"`
+	AgentNamespace *string `json:"agent_namespace,omitempty"`
+
+	// AvoidBusyAgents deprioritizes the agents indexing, saving, flushing or loading their index. the gateway fetches the index state of the agents on each discovery, inserts the vectors into the other agents first, and skips the busy agents of the search while the other agents cover their vectors
+	AvoidBusyAgents *bool                             `json:"avoid_busy_agents,omitempty"`
+	Discoverer      *GatewayLbGatewayConfigDiscoverer `json:"discoverer,omitempty"`
 
 	// IndexReplica number of index replica
 	IndexReplica *int `json:"index_replica,omitempty"`
@@ -1927,6 +2046,78 @@ type GatewayLbGatewayConfig struct {
 
 	// NodeName node name
 	NodeName *string `json:"node_name,omitempty"`
+
+	Partition *GatewayLbGatewayConfigPartition `json:"partition,omitempty"`
+
+	// Placement placement strategy of the vectors. memory places a vector on the agents of the most free memory, rendezvous places a vector on the agents chosen by the rendezvous hashing of its ID and routes the requests for an ID to its owners only
+	Placement *GatewayLbGatewayConfigPlacement `json:"placement,omitempty"`
+
+	// RebalanceDuration interval to check the changes of the agents to rebalance the vectors under the rendezvous placement. rebalancing is disabled when it is empty
+	RebalanceDuration *string `json:"rebalance_duration,omitempty"`
+
+	Rerank      *GatewayLbGatewayConfigRerank      `json:"rerank,omitempty"`
+	ResultCache *GatewayLbGatewayConfigResultCache `json:"result_cache,omitempty"`
+	Straggler   *GatewayLbGatewayConfigStraggler   `json:"straggler,omitempty"`
+}
+
+// GatewayLbGatewayConfigPartition defines model for gateway_lb_gateway_config_partition.
+type GatewayLbGatewayConfigPartition struct {
+	// CheckDuration interval to reload the partition table and check the partitions
+	CheckDuration *string `json:"check_duration,omitempty"`
+
+	// EnableTraining enables training the partition table and migrating the vectors on the gateway. it should be enabled on a single gateway pod
+	EnableTraining *bool `json:"enable_training,omitempty"`
+
+	// ImbalanceRatio ratio of the vectors of the largest agent to the average to retrain the partition table
+	ImbalanceRatio *float32 `json:"imbalance_ratio,omitempty"`
+
+	// Nprobe number of the partitions searched when the search request does not specify nprobe
+	Nprobe *int `json:"nprobe,omitempty"`
+
+	// Partitions number of the partitions of the partition routing, which routes the vectors of the default collection to the agents owning their nearest coarse centroids. the partition routing is disabled when it is 0
+	Partitions *int `json:"partitions,omitempty"`
+
+	// TrainSampleSize number of the vectors sampled to train the partition table
+	TrainSampleSize *int `json:"train_sample_size,omitempty"`
+}
+
+// GatewayLbGatewayConfigPlacement placement strategy of the vectors. memory places a vector on the agents of the most free memory, rendezvous places a vector on the agents chosen by the rendezvous hashing of its ID and routes the requests for an ID to its owners only
+type GatewayLbGatewayConfigPlacement string
+
+// GatewayLbGatewayConfigRerank defines model for gateway_lb_gateway_config_rerank.
+type GatewayLbGatewayConfigRerank struct {
+	// DistanceType distance type to re-rank the search results requested by the search config. the distance type of the agents is used when it is empty
+	DistanceType *string `json:"distance_type,omitempty"`
+
+	// Ratio ratio of the number of the candidates fetched from the agents to the number of the results, which is used when the request does not set it
+	Ratio *float32 `json:"ratio,omitempty"`
+}
+
+// GatewayLbGatewayConfigResultCache defines model for gateway_lb_gateway_config_result_cache.
+type GatewayLbGatewayConfigResultCache struct {
+	// Enabled enables to cache the responses of Search and SearchByID. the cached responses are invalidated when the gateway handles the requests changing the vectors, but not the ones handled by the other gateways, so they may be stale for up to ttl
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MaxMemory max memory of the search responses in the cache, e.g. 256MB. the new responses are not cached while it is reached. 0 means unlimited
+	MaxMemory *string `json:"max_memory,omitempty"`
+
+	// Ttl duration to keep the search responses in the cache
+	Ttl *string `json:"ttl,omitempty"`
+}
+
+// GatewayLbGatewayConfigStraggler defines model for gateway_lb_gateway_config_straggler.
+type GatewayLbGatewayConfigStraggler struct {
+	// Enabled enables to stop waiting for the slow agents of the search once the other agents cover the searched vectors and min_num. the addresses of the agents not waited for are returned in skipped_agents of the search response
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Hedge enables to call the agents holding the same vectors one by one after the hedge delay instead of calling all of them at once. the slow agents are not waited for when it is enabled
+	Hedge *bool `json:"hedge,omitempty"`
+
+	// MinDelay minimum hedge delay, which is used for the agents whose latencies are not observed yet
+	MinDelay *string `json:"min_delay,omitempty"`
+
+	// Percentile percentile of the latencies of the agent used as the hedge delay
+	Percentile *float32 `json:"percentile,omitempty"`
 }
 
 // GatewayLbGatewayConfigDiscoverer defines model for gateway_lb_gateway_config_discoverer.
@@ -2153,12 +2344,48 @@ type GatewayMirrorGatewayConfig struct {
 	PodName *string `json:"pod_name,omitempty"`
 
 	// RegisterDuration duration to register mirror-gateway.
-	RegisterDuration *string `json:"register_duration,omitempty"`
+	RegisterDuration *string                                `json:"register_duration,omitempty"`
+	Replication      *GatewayMirrorGatewayConfigReplication `json:"replication,omitempty"`
 
 	// SelfMirrorAddr address for self mirror-gateway
 	SelfMirrorAddr *string `json:"self_mirror_addr,omitempty"`
 }
 
+// GatewayMirrorGatewayConfigReplication defines model for gateway_mirror_gateway_config_replication.
+type GatewayMirrorGatewayConfigReplication struct {
+	Backoff *Backoff `json:"backoff,omitempty"`
+
+	// BatchSize max number of the write requests shipped to a target at once
+	BatchSize *int `json:"batch_size,omitempty"`
+
+	// FlushDuration interval to ship the write requests remaining in the outbox
+	FlushDuration *string `json:"flush_duration,omitempty"`
+
+	// MaxLag replication lag of a target to raise the alarm. empty disables the alarm
+	MaxLag *string `json:"max_lag,omitempty"`
+
+	// MaxOutboxSize max size of the write requests pending in the outbox, e.g. 1GB. 0 means unlimited
+	MaxOutboxSize *string `json:"max_outbox_size,omitempty"`
+
+	// Mode replication mode of the write requests to the other mirror gateways. sync forwards them inline, and async appends them to the durable outbox and ships them to each target in the background
+	Mode *GatewayMirrorGatewayConfigReplicationMode `json:"mode,omitempty"`
+
+	// OutboxPath directory of the outbox and the cursors of the targets in the async mode. mount a persistent volume by volumes and volumeMounts to keep the pending write requests across restarts
+	OutboxPath *string `json:"outbox_path,omitempty"`
+
+	// OverflowPolicy policy applied when the outbox reaches max_outbox_size. drop drops the oldest pending write requests, and reject rejects the new write request with RESOURCE_EXHAUSTED
+	OverflowPolicy *GatewayMirrorGatewayConfigReplicationOverflowPolicy `json:"overflow_policy,omitempty"`
+
+	// RetentionDuration duration to keep the pending write requests of a target after it leaves the mirror set. its cursor is removed after the duration
+	RetentionDuration *string `json:"retention_duration,omitempty"`
+}
+
+// GatewayMirrorGatewayConfigReplicationMode replication mode of the write requests to the other mirror gateways. sync forwards them inline, and async appends them to the durable outbox and ships them to each target in the background
+type GatewayMirrorGatewayConfigReplicationMode string
+
+// GatewayMirrorGatewayConfigReplicationOverflowPolicy policy applied when the outbox reaches max_outbox_size. drop drops the oldest pending write requests, and reject rejects the new write request with RESOURCE_EXHAUSTED
+type GatewayMirrorGatewayConfigReplicationOverflowPolicy string
+
 // GatewayMirrorIngress defines model for gateway_mirror_ingress.
 type GatewayMirrorIngress struct {
 	// Annotations annotations for ingress
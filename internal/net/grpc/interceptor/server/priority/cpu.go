@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priority
+
+import (
+	"runtime"
+	"syscall"
+	"time"
+
+	"github.com/vdaas/vald/internal/sync"
+)
+
+// cpuSampleInterval is the minimum interval of sampling the CPU time of the process.
+const cpuSampleInterval = 250 * time.Millisecond
+
+// cpuMeter measures the CPU utilization of the process, which is the CPU time used in the last sampling interval
+// divided by the time the GOMAXPROCS processors can use in the interval.
+type cpuMeter struct {
+	mu          sync.Mutex
+	last        time.Time
+	lastCPU     time.Duration
+	utilization float64
+}
+
+// Utilization returns the latest CPU utilization, sampling the CPU time again when the last sample is older than
+// cpuSampleInterval.
+func (m *cpuMeter) Utilization() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	if !m.last.IsZero() && now.Sub(m.last) < cpuSampleInterval {
+		return m.utilization
+	}
+	cpu, err := cpuTime()
+	if err != nil {
+		return m.utilization
+	}
+	if !m.last.IsZero() {
+		m.utilization = float64(cpu-m.lastCPU) / float64(now.Sub(m.last)) / float64(runtime.GOMAXPROCS(0))
+	}
+	m.last, m.lastCPU = now, cpu
+	return m.utilization
+}
+
+// cpuTime returns the user and system CPU time used by the process.
+func cpuTime() (time.Duration, error) {
+	var ru syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &ru); err != nil {
+		return 0, err
+	}
+	return time.Duration(ru.Utime.Nano() + ru.Stime.Nano()), nil
+}
@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priority
+
+import (
+	"time"
+
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
+// Option represents the functional option for the priority interceptors.
+type Option func(*shedder)
+
+var defaultOptions = []Option{
+	WithPool(grpc.HighPriority, 0, "0s"),
+	WithPool(grpc.LowPriority, 0, "1s"),
+	WithCPUThreshold(0.9),
+}
+
+// WithPool returns the option to set the concurrency of the pool of the priority class, and how long the request
+// waits for the pool before it is rejected. The concurrency is unlimited when it is 0, and the request is rejected
+// without waiting when the wait is 0.
+func WithPool(p grpc.Priority, concurrency int, wait string) Option {
+	return func(s *shedder) {
+		var d time.Duration
+		if len(wait) != 0 {
+			d, _ = timeutil.Parse(wait)
+		}
+		s.pools[p] = newPool(concurrency, d)
+	}
+}
+
+// WithCPUThreshold returns the option to set the CPU utilization in (0, 1] over which the low priority requests are
+// throttled. The CPU utilization is not checked when it is 0.
+func WithCPUThreshold(th float64) Option {
+	return func(s *shedder) {
+		if th >= 0 && th <= 1 {
+			s.cpuThreshold = th
+		}
+	}
+}
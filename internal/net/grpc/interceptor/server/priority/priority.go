@@ -0,0 +1,242 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priority
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/errdetails"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/attribute"
+	"github.com/vdaas/vald/internal/observability/metrics"
+	"github.com/vdaas/vald/internal/strings"
+	api "go.opentelemetry.io/otel/metric"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	requestsMetricsName = "server_priority_requests"
+
+	PriorityKeyName = "priority"
+	ResultKeyName   = "result"
+
+	resultAccepted  = "accepted"
+	resultThrottled = "throttled"
+	resultRejected  = "rejected"
+
+	// pollInterval is the interval of checking the load while the low priority request is throttled.
+	pollInterval = 10 * time.Millisecond
+)
+
+// pool limits the requests of a priority class in flight, which is unlimited when sem is nil.
+type pool struct {
+	sem  chan struct{}
+	wait time.Duration
+}
+
+func newPool(concurrency int, wait time.Duration) *pool {
+	p := &pool{
+		wait: wait,
+	}
+	if concurrency > 0 {
+		p.sem = make(chan struct{}, concurrency)
+	}
+	return p
+}
+
+// full reports whether the requests in flight reach the concurrency of the pool.
+func (p *pool) full() bool {
+	return p.sem != nil && len(p.sem) == cap(p.sem)
+}
+
+type shedder struct {
+	pools        map[grpc.Priority]*pool
+	cpuThreshold float64
+	cpu          *cpuMeter
+	requests     api.Int64Counter
+}
+
+// PriorityInterceptors returns the gRPC server interceptors which serve the requests of each priority class in its own
+// pool of the concurrency. The low priority requests are throttled while the CPU utilization is over the threshold or
+// the high priority pool is full, and are rejected with RESOURCE_EXHAUSTED when they wait longer than the wait of the
+// pool. A stream holds a slot of the pool until it ends, and each message of the low priority stream is throttled too.
+// The priority class of the request is forwarded to the requests which the handler sends with its context.
+func PriorityInterceptors(opts ...Option) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor, error) {
+	s := &shedder{
+		pools: make(map[grpc.Priority]*pool, 2),
+		cpu:   new(cpuMeter),
+	}
+	for _, opt := range append(defaultOptions, opts...) {
+		opt(s)
+	}
+
+	var err error
+	s.requests, err = metrics.GetMeter().Int64Counter(
+		requestsMetricsName,
+		metrics.WithDescription("Count of requests by priority class and shedding result"),
+		metrics.WithUnit(metrics.Dimensionless),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create priority requests metric")
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+			if exempt(info.FullMethod) {
+				return handler(ctx, req)
+			}
+			p := grpc.PriorityFromIncomingContext(ctx)
+			release, err := s.acquire(ctx, p, info.FullMethod, true)
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+			return handler(grpc.WithPriority(ctx, p), req)
+		}, func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if exempt(info.FullMethod) {
+				return handler(srv, ss)
+			}
+			ctx := ss.Context()
+			p := grpc.PriorityFromIncomingContext(ctx)
+			release, err := s.acquire(ctx, p, info.FullMethod, true)
+			if err != nil {
+				return err
+			}
+			defer release()
+			return handler(srv, &stream{
+				ServerStream: ss,
+				ctx:          grpc.WithPriority(ctx, p),
+				s:            s,
+				p:            p,
+				method:       info.FullMethod,
+			})
+		}, nil
+}
+
+// stream throttles each received message of the low priority stream while the server is overloaded.
+type stream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	s      *shedder
+	p      grpc.Priority
+	method string
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.p != grpc.LowPriority {
+		return nil
+	}
+	_, err := s.s.acquire(s.ctx, s.p, s.method, false)
+	return err
+}
+
+// exempt reports whether the method is out of the priority pools, such as the health check and the reflection.
+func exempt(method string) bool {
+	return strings.HasPrefix(method, "/grpc.")
+}
+
+// overloaded reports whether the low priority requests should be throttled.
+func (s *shedder) overloaded(p grpc.Priority) bool {
+	if p != grpc.LowPriority {
+		return false
+	}
+	return s.pools[grpc.HighPriority].full() ||
+		(s.cpuThreshold > 0 && s.cpu.Utilization() >= s.cpuThreshold)
+}
+
+// acquire waits until the request of the priority class p can be served, taking a slot of its pool when slot is true,
+// and returns the function releasing the slot. It returns the RESOURCE_EXHAUSTED error when the request waits longer
+// than the wait of the pool.
+func (s *shedder) acquire(ctx context.Context, p grpc.Priority, method string, slot bool) (release func(), err error) {
+	pl := s.pools[p]
+	var (
+		deadline <-chan time.Time
+		ticker   *time.Ticker
+		waited   bool
+	)
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+	for {
+		if !s.overloaded(p) {
+			if !slot || pl.sem == nil {
+				// the message of the stream is recorded only when it is throttled, since the stream is recorded.
+				if slot || waited {
+					s.record(ctx, p, waited, nil)
+				}
+				return func() {}, nil
+			}
+			select {
+			case pl.sem <- struct{}{}:
+				s.record(ctx, p, waited, nil)
+				return func() { <-pl.sem }, nil
+			default:
+			}
+		}
+		if pl.wait <= 0 {
+			return nil, s.reject(ctx, p, method)
+		}
+		if ticker == nil {
+			timer := time.NewTimer(pl.wait)
+			defer timer.Stop()
+			deadline = timer.C
+			ticker = time.NewTicker(pollInterval)
+		}
+		waited = true
+		select {
+		case <-ctx.Done():
+			s.record(ctx, p, waited, ctx.Err())
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, s.reject(ctx, p, method)
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *shedder) reject(ctx context.Context, p grpc.Priority, method string) error {
+	err := errors.ErrRequestShed(string(p))
+	s.record(ctx, p, true, err)
+	return status.WrapWithResourceExhausted(path.Base(method)+" API request shed", err,
+		&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(max(s.pools[p].wait, time.Second)),
+		})
+}
+
+func (s *shedder) record(ctx context.Context, p grpc.Priority, waited bool, err error) {
+	result := resultAccepted
+	switch {
+	case err != nil:
+		result = resultRejected
+	case waited:
+		result = resultThrottled
+	}
+	s.requests.Add(ctx, 1, metrics.WithAttributes(
+		attribute.String(PriorityKeyName, string(p)),
+		attribute.String(ResultKeyName, result),
+	))
+}
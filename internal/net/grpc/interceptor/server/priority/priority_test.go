@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priority
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/internal/test/goleak"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestPriorityInterceptors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		// held is the number of the high priority requests in flight, which are released after release.
+		held     int
+		release  time.Duration
+		priority grpc.Priority
+		want     codes.Code
+	}{
+		{
+			name: "return OK when the pool of the high priority request is not full",
+			opts: []Option{
+				WithPool(grpc.HighPriority, 2, "0s"),
+			},
+			held:     1,
+			priority: grpc.HighPriority,
+			want:     codes.OK,
+		},
+		{
+			name: "return ResourceExhausted when the pool of the high priority request is full",
+			opts: []Option{
+				WithPool(grpc.HighPriority, 1, "0s"),
+			},
+			held:     1,
+			priority: grpc.HighPriority,
+			want:     codes.ResourceExhausted,
+		},
+		{
+			name: "return ResourceExhausted when the low priority request waits for the full high priority pool too long",
+			opts: []Option{
+				WithPool(grpc.HighPriority, 1, "0s"),
+				WithPool(grpc.LowPriority, 0, "20ms"),
+			},
+			held:     1,
+			priority: grpc.LowPriority,
+			want:     codes.ResourceExhausted,
+		},
+		{
+			name: "return OK when the low priority request is throttled until the high priority pool has room",
+			opts: []Option{
+				WithPool(grpc.HighPriority, 1, "0s"),
+				WithPool(grpc.LowPriority, 0, "1s"),
+			},
+			held:     1,
+			release:  20 * time.Millisecond,
+			priority: grpc.LowPriority,
+			want:     codes.OK,
+		},
+		{
+			name: "return ResourceExhausted when the low priority request is over the CPU threshold",
+			opts: []Option{
+				WithPool(grpc.LowPriority, 0, "0s"),
+				WithCPUThreshold(1e-9),
+			},
+			priority: grpc.LowPriority,
+			want:     codes.ResourceExhausted,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			unary, _, err := PriorityInterceptors(append([]Option{WithCPUThreshold(0)}, test.opts...)...)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			info := &grpc.UnaryServerInfo{
+				FullMethod: "/vald.v1.Search/Search",
+			}
+			var (
+				wg      sync.WaitGroup
+				started sync.WaitGroup
+				done    = make(chan struct{})
+			)
+			for range test.held {
+				wg.Add(1)
+				started.Add(1)
+				go func() {
+					defer wg.Done()
+					_, _ = unary(context.Background(), nil, info, func(context.Context, any) (any, error) {
+						started.Done()
+						<-done
+						return nil, nil
+					})
+				}()
+			}
+			started.Wait()
+			defer wg.Wait()
+			if test.release > 0 {
+				time.AfterFunc(test.release, func() {
+					close(done)
+				})
+			} else {
+				defer close(done)
+			}
+
+			ctx := metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs(grpc.PriorityMetadataKey, string(test.priority)))
+			// the CPU utilization is measured from the first sample taken by the low priority request.
+			if test.priority == grpc.LowPriority && test.held == 0 {
+				_, _ = unary(ctx, nil, info, func(context.Context, any) (any, error) {
+					return nil, nil
+				})
+				time.Sleep(cpuSampleInterval)
+				for start := time.Now(); time.Since(start) < 10*time.Millisecond; {
+				}
+			}
+			_, err = unary(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+				md, _ := metadata.FromOutgoingContext(ctx)
+				if got := md.Get(grpc.PriorityMetadataKey); len(got) != 1 || got[0] != string(test.priority) {
+					tt.Errorf("forwarded priority = %v, want %v", got, test.priority)
+				}
+				return nil, nil
+			})
+			st, _ := status.FromError(err)
+			code := codes.OK
+			if err != nil && st != nil {
+				code = st.Code()
+			}
+			if code != test.want {
+				tt.Errorf("code = %v, want %v, err = %v", code, test.want, err)
+			}
+		})
+	}
+}
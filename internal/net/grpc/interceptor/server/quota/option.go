@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import "github.com/vdaas/vald/internal/strings"
+
+// Option represents the functional option for the quota interceptors.
+type Option func(*quota)
+
+var defaultOptions = []Option{
+	WithMetadataKey("x-api-key"),
+}
+
+// WithMetadataKey returns the option to set the metadata key of the API key identifying the tenant.
+func WithMetadataKey(key string) Option {
+	return func(q *quota) {
+		if len(key) != 0 {
+			q.metadataKey = strings.ToLower(key)
+		}
+	}
+}
+
+// WithDefaultLimits returns the option to set the limits of the requests not identified as any tenant.
+func WithDefaultLimits(l Limits) Option {
+	return func(q *quota) {
+		q.defaultLimits = l
+	}
+}
+
+// WithTenant returns the option to set the limits of the tenant identified by the API keys or the subject common names
+// of the client certificates.
+func WithTenant(name string, l Limits, apiKeys, subjects []string) Option {
+	return func(q *quota) {
+		if len(name) == 0 || name == DefaultTenant {
+			return
+		}
+		q.tenants[name] = &tenantConfig{
+			limits:   l,
+			apiKeys:  apiKeys,
+			subjects: subjects,
+		}
+	}
+}
@@ -0,0 +1,384 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/errdetails"
+	"github.com/vdaas/vald/internal/net/grpc/proto"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/attribute"
+	"github.com/vdaas/vald/internal/observability/metrics"
+	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/internal/sync"
+	api "go.opentelemetry.io/otel/metric"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	requestsMetricsName = "server_quota_requests"
+	vectorsMetricsName  = "server_quota_vectors"
+
+	TenantKeyName = "tenant"
+	BudgetKeyName = "budget"
+	ResultKeyName = "result"
+
+	// DefaultTenant is the name of the tenant of the requests not identified as any tenant.
+	DefaultTenant = "default"
+
+	readBudget  = "read"
+	writeBudget = "write"
+
+	resultAccepted = "accepted"
+	resultRejected = "rejected"
+
+	// statusFieldName is the name of the status field of the stream responses, such as Object_StreamLocation.
+	statusFieldName = "status"
+)
+
+// writePrefixes is the prefixes of the names of the methods changing the vectors or the index, which use the write budget.
+var writePrefixes = []string{
+	"Insert",
+	"Update",
+	"Upsert",
+	"Remove",
+	"Flush",
+	"Create",
+	"Save",
+	"Drop",
+	"Switch",
+}
+
+// Budget represents the limits per second of the read or write requests of a tenant. Each limit is unlimited when it is 0.
+type Budget struct {
+	QPS              float64
+	VectorsPerSecond float64
+}
+
+// Limits represents the budgets of the read and write requests of a tenant.
+type Limits struct {
+	Read  Budget
+	Write Budget
+}
+
+// bucket is the token buckets of a budget, which are nil when the limits are unlimited.
+type bucket struct {
+	requests *rate.Limiter
+	vectors  *rate.Limiter
+}
+
+type tenant struct {
+	name  string
+	read  bucket
+	write bucket
+}
+
+type quota struct {
+	metadataKey   string
+	defaultLimits Limits
+	tenants       map[string]*tenantConfig
+	def           *tenant
+	// keys and subjects are the tenants by the API keys and the subject common names of the client certificates.
+	keys     map[string]*tenant
+	subjects map[string]*tenant
+	requests api.Int64Counter
+	vectors  api.Int64Counter
+}
+
+type tenantConfig struct {
+	limits   Limits
+	apiKeys  []string
+	subjects []string
+}
+
+// QuotaInterceptors returns the gRPC server interceptors which identify the tenant of the request by the API key in
+// the metadata or the subject common name of the verified client certificate, and reject the request with
+// RESOURCE_EXHAUSTED when the tenant exceeds its budget. Each message of the stream is limited as a request, and the
+// rejected message is answered with the status response, such as Object_StreamLocation, without closing the stream.
+// The request of more vectors than the burst of the budget is rejected with INVALID_ARGUMENT since it never succeeds.
+func QuotaInterceptors(opts ...Option) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor, error) {
+	q := &quota{
+		tenants:  make(map[string]*tenantConfig),
+		keys:     make(map[string]*tenant),
+		subjects: make(map[string]*tenant),
+	}
+	for _, opt := range append(defaultOptions, opts...) {
+		opt(q)
+	}
+	q.def = newTenant(DefaultTenant, q.defaultLimits)
+	for name, tc := range q.tenants {
+		t := newTenant(name, tc.limits)
+		for _, key := range tc.apiKeys {
+			q.keys[key] = t
+		}
+		for _, sub := range tc.subjects {
+			q.subjects[sub] = t
+		}
+	}
+
+	meter := metrics.GetMeter()
+	var err error
+	q.requests, err = meter.Int64Counter(
+		requestsMetricsName,
+		metrics.WithDescription("Count of requests by tenant, budget and quota result"),
+		metrics.WithUnit(metrics.Dimensionless),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create quota requests metric")
+	}
+	q.vectors, err = meter.Int64Counter(
+		vectorsMetricsName,
+		metrics.WithDescription("Count of vectors by tenant, budget and quota result"),
+		metrics.WithUnit(metrics.Dimensionless),
+	)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to create quota vectors metric")
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+			if exempt(info.FullMethod) {
+				return handler(ctx, req)
+			}
+			if err = q.take(ctx, q.tenant(ctx), isWrite(info.FullMethod), info.FullMethod, count(req)); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}, func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if exempt(info.FullMethod) {
+				return handler(srv, ss)
+			}
+			s := &stream{
+				ServerStream: ss,
+				q:            q,
+				t:            q.tenant(ss.Context()),
+				write:        isWrite(info.FullMethod),
+				method:       info.FullMethod,
+			}
+			if info.IsClientStream && info.IsServerStream {
+				s.res, s.status = statusResponse(info.FullMethod)
+			}
+			return handler(srv, s)
+		}, nil
+}
+
+// stream limits each received message as a request.
+type stream struct {
+	grpc.ServerStream
+	q      *quota
+	t      *tenant
+	write  bool
+	method string
+	// res and status are the type and the status field of the response, which are nil when the response has no status.
+	res    proto.MessageType
+	status proto.FieldDescriptor
+	// mu serializes SendMsg of the handler and the status responses of the rejected messages.
+	mu sync.Mutex
+}
+
+// RecvMsg receives the next message within the budget. The rejected message is answered with the status response and
+// skipped when the response has the status, otherwise the error closes the stream.
+func (s *stream) RecvMsg(m any) error {
+	for {
+		if err := s.ServerStream.RecvMsg(m); err != nil {
+			return err
+		}
+		err := s.q.take(s.Context(), s.t, s.write, s.method, count(m))
+		if err == nil || s.res == nil {
+			return err
+		}
+		st, _ := status.FromError(err)
+		res := s.res.New()
+		res.Set(s.status, proto.ValueOfMessage(st.Proto()))
+		if err = s.SendMsg(res.Interface()); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *stream) SendMsg(m any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ServerStream.SendMsg(m)
+}
+
+// statusResponse returns the type and the status field of the response of the method, or nil when the response has no
+// google.rpc.Status field named status.
+func statusResponse(method string) (proto.MessageType, proto.FieldDescriptor) {
+	mt, err := proto.OutputType(method)
+	if err != nil {
+		return nil, nil
+	}
+	fd := mt.Descriptor().Fields().ByName(statusFieldName)
+	if fd == nil || fd.Message() == nil || fd.Message().FullName() != "google.rpc.Status" {
+		return nil, nil
+	}
+	return mt, fd
+}
+
+func newTenant(name string, l Limits) *tenant {
+	return &tenant{
+		name:  name,
+		read:  newBucket(l.Read),
+		write: newBucket(l.Write),
+	}
+}
+
+func newBucket(b Budget) bucket {
+	return bucket{
+		requests: newLimiter(b.QPS),
+		vectors:  newLimiter(b.VectorsPerSecond),
+	}
+}
+
+// newLimiter returns the token bucket filled at r per second, which holds the tokens of a second.
+func newLimiter(r float64) *rate.Limiter {
+	if r <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(r), max(int(math.Ceil(r)), 1))
+}
+
+// exempt reports whether the method is out of the quota, such as the health check and the reflection.
+func exempt(method string) bool {
+	return strings.HasPrefix(method, "/grpc.")
+}
+
+func isWrite(method string) bool {
+	name := strings.TrimPrefix(strings.TrimPrefix(path.Base(method), "Stream"), "Multi")
+	for _, p := range writePrefixes {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// count returns the number of the vectors of the request, which is the number of the requests of the multi request.
+func count(req any) int {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return 1
+	}
+	pm := m.ProtoReflect()
+	fd := pm.Descriptor().Fields().ByName("requests")
+	if fd == nil || !fd.IsList() {
+		return 1
+	}
+	return max(pm.Get(fd).List().Len(), 1)
+}
+
+func (q *quota) tenant(ctx context.Context) *tenant {
+	if md, ok := grpc.FromIncomingContext(ctx); ok {
+		for _, key := range md.Get(q.metadataKey) {
+			if t, ok := q.keys[key]; ok {
+				return t
+			}
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p != nil {
+		// only the verified client certificate identifies the tenant, since the others may be forged.
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok &&
+			len(info.State.VerifiedChains) != 0 && len(info.State.VerifiedChains[0]) != 0 {
+			if t, ok := q.subjects[info.State.VerifiedChains[0][0].Subject.CommonName]; ok {
+				return t
+			}
+		}
+	}
+	return q.def
+}
+
+// take takes the tokens of a request and its n vectors from the budget of t, or returns the RESOURCE_EXHAUSTED error
+// without taking any token when they are not available now.
+func (q *quota) take(ctx context.Context, t *tenant, write bool, method string, n int) error {
+	b, budget := &t.read, readBudget
+	if write {
+		b, budget = &t.write, writeBudget
+	}
+	if b.vectors != nil && n > b.vectors.Burst() {
+		q.record(ctx, t, budget, resultRejected, n)
+		return status.WrapWithInvalidArgument(path.Base(method)+" API request exceeds the quota burst",
+			errors.ErrQuotaBurstExceeded(t.name, budget, n, b.vectors.Burst()),
+			&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequestFieldViolation{
+					{
+						Field:       "requests",
+						Description: fmt.Sprintf("the request of %d vectors exceeds the %s burst of %d", n, budget, b.vectors.Burst()),
+					},
+				},
+			})
+	}
+	now := time.Now()
+	var (
+		violations []*errdetails.QuotaFailureViolation
+		taken      []*rate.Reservation
+	)
+	for _, l := range []struct {
+		limiter *rate.Limiter
+		n       int
+		unit    string
+	}{
+		{b.requests, 1, "requests"},
+		{b.vectors, n, "vectors"},
+	} {
+		if l.limiter == nil {
+			continue
+		}
+		r := l.limiter.ReserveN(now, l.n)
+		if r.OK() && r.DelayFrom(now) == 0 {
+			taken = append(taken, r)
+			continue
+		}
+		r.CancelAt(now)
+		violations = append(violations, &errdetails.QuotaFailureViolation{
+			Subject:     TenantKeyName + ":" + t.name,
+			Description: fmt.Sprintf("the %s budget of %v %s per second is exhausted", budget, float64(l.limiter.Limit()), l.unit),
+		})
+	}
+	result := resultAccepted
+	if len(violations) != 0 {
+		for _, r := range taken {
+			r.CancelAt(now)
+		}
+		result = resultRejected
+	}
+	q.record(ctx, t, budget, result, n)
+	if len(violations) == 0 {
+		return nil
+	}
+	return status.WrapWithResourceExhausted(path.Base(method)+" API quota exceeded", errors.ErrQuotaExceeded(t.name, budget),
+		&errdetails.QuotaFailure{
+			Violations: violations,
+		})
+}
+
+// record counts a request and its n vectors of the budget of t by the quota result.
+func (q *quota) record(ctx context.Context, t *tenant, budget, result string, n int) {
+	attrs := metrics.WithAttributes(
+		attribute.String(TenantKeyName, t.name),
+		attribute.String(BudgetKeyName, budget),
+		attribute.String(ResultKeyName, result),
+	)
+	q.requests.Add(ctx, 1, attrs)
+	q.vectors.Add(ctx, int64(n), attrs)
+}
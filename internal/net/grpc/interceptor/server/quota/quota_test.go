@@ -0,0 +1,247 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	_ "github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/errdetails"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/test/goleak"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestQuotaInterceptors(t *testing.T) {
+	type call struct {
+		key    string
+		method string
+		req    any
+	}
+	limits := Limits{
+		Read: Budget{
+			QPS: 2,
+		},
+		Write: Budget{
+			VectorsPerSecond: 3,
+		},
+	}
+	insert := &payload.Insert_Request{}
+	multiInsert := &payload.Insert_MultiRequest{
+		Requests: []*payload.Insert_Request{{}, {}},
+	}
+	tests := []struct {
+		name  string
+		calls []call
+		// want is the codes of the calls.
+		want []codes.Code
+	}{
+		{
+			name: "return ResourceExhausted when the tenant exceeds the read qps",
+			calls: []call{
+				{key: "a", method: "/vald.v1.Search/Search"},
+				{key: "a", method: "/vald.v1.Search/SearchByID"},
+				{key: "a", method: "/vald.v1.Search/Search"},
+			},
+			want: []codes.Code{codes.OK, codes.OK, codes.ResourceExhausted},
+		},
+		{
+			name: "return OK when the other tenant exceeds the read qps",
+			calls: []call{
+				{key: "a", method: "/vald.v1.Search/Search"},
+				{key: "a", method: "/vald.v1.Search/Search"},
+				{key: "b", method: "/vald.v1.Search/Search"},
+			},
+			want: []codes.Code{codes.OK, codes.OK, codes.OK},
+		},
+		{
+			name: "return OK for the write requests when the tenant exceeds the read qps",
+			calls: []call{
+				{key: "a", method: "/vald.v1.Search/Search"},
+				{key: "a", method: "/vald.v1.Search/Search"},
+				{key: "a", method: "/vald.v1.Insert/Insert", req: insert},
+			},
+			want: []codes.Code{codes.OK, codes.OK, codes.OK},
+		},
+		{
+			name: "return ResourceExhausted when the vectors of the multi request exceed the write budget",
+			calls: []call{
+				{key: "a", method: "/vald.v1.Insert/MultiInsert", req: multiInsert},
+				{key: "a", method: "/vald.v1.Upsert/MultiUpsert", req: multiInsert},
+				{key: "a", method: "/vald.v1.Remove/Remove", req: insert},
+			},
+			want: []codes.Code{codes.OK, codes.ResourceExhausted, codes.OK},
+		},
+		{
+			name: "return InvalidArgument when the vectors of the multi request exceed the write burst",
+			calls: []call{
+				{key: "a", method: "/vald.v1.Insert/MultiInsert", req: &payload.Insert_MultiRequest{
+					Requests: []*payload.Insert_Request{{}, {}, {}, {}},
+				}},
+				{key: "a", method: "/vald.v1.Insert/MultiInsert", req: multiInsert},
+			},
+			want: []codes.Code{codes.InvalidArgument, codes.OK},
+		},
+		{
+			name: "return ResourceExhausted when the request without the API key exceeds the default budget",
+			calls: []call{
+				{method: "/vald.v1.Search/Search"},
+				{key: "unknown", method: "/vald.v1.Search/Search"},
+			},
+			want: []codes.Code{codes.OK, codes.ResourceExhausted},
+		},
+		{
+			name: "return OK for the health check regardless of the budget",
+			calls: []call{
+				{method: "/vald.v1.Search/Search"},
+				{method: "/grpc.health.v1.Health/Check"},
+			},
+			want: []codes.Code{codes.OK, codes.OK},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			ui, _, err := QuotaInterceptors(
+				WithDefaultLimits(Limits{
+					Read: Budget{
+						QPS: 1,
+					},
+				}),
+				WithTenant("tenant-a", limits, []string{"a"}, nil),
+				WithTenant("tenant-b", limits, []string{"b"}, nil),
+			)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			for i, c := range test.calls {
+				ctx := context.Background()
+				if len(c.key) != 0 {
+					ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", c.key))
+				}
+				_, err := ui(ctx, c.req, &grpc.UnaryServerInfo{
+					FullMethod: c.method,
+				}, func(context.Context, any) (any, error) {
+					return nil, nil
+				})
+				st, _ := status.FromError(err)
+				code := codes.OK
+				if err != nil && st != nil {
+					code = st.Code()
+				}
+				if code != test.want[i] {
+					tt.Errorf("call %d: code = %v, want %v, err = %v", i, code, test.want[i], err)
+				}
+				if code != codes.ResourceExhausted {
+					continue
+				}
+				var found bool
+				for _, d := range st.Details() {
+					if _, ok := d.(*errdetails.QuotaFailure); ok {
+						found = true
+					}
+				}
+				if !found {
+					tt.Errorf("call %d: details = %v, want QuotaFailure", i, st.Details())
+				}
+			}
+		})
+	}
+}
+
+// fakeStream receives the requests and records the sent responses.
+type fakeStream struct {
+	grpc.ServerStream
+	reqs []*payload.Insert_Request
+	sent []any
+}
+
+func (s *fakeStream) Context() context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-api-key", "a"))
+}
+
+func (s *fakeStream) RecvMsg(m any) error {
+	if len(s.reqs) == 0 {
+		return io.EOF
+	}
+	m.(*payload.Insert_Request).Vector = s.reqs[0].GetVector()
+	s.reqs = s.reqs[1:]
+	return nil
+}
+
+func (s *fakeStream) SendMsg(m any) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func TestQuotaInterceptors_stream(t *testing.T) {
+	_, si, err := QuotaInterceptors(
+		WithTenant("tenant-a", Limits{
+			Write: Budget{
+				QPS: 1,
+			},
+		}, []string{"a"}, nil),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ss := &fakeStream{
+		reqs: []*payload.Insert_Request{
+			{Vector: &payload.Object_Vector{Id: "1"}},
+			{Vector: &payload.Object_Vector{Id: "2"}},
+		},
+	}
+	var ids []string
+	err = si(nil, ss, &grpc.StreamServerInfo{
+		FullMethod:     "/vald.v1.Insert/StreamInsert",
+		IsClientStream: true,
+		IsServerStream: true,
+	}, func(_ any, stream grpc.ServerStream) error {
+		for {
+			req := new(payload.Insert_Request)
+			if err := stream.RecvMsg(req); err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+			ids = append(ids, req.GetVector().GetId())
+		}
+	})
+	if err != nil {
+		t.Fatalf("stream error = %v, want nil", err)
+	}
+	if len(ids) != 1 || ids[0] != "1" {
+		t.Errorf("received ids = %v, want [1]", ids)
+	}
+	if len(ss.sent) != 1 {
+		t.Fatalf("sent = %v, want a status response", ss.sent)
+	}
+	res, ok := ss.sent[0].(*payload.Object_StreamLocation)
+	if !ok {
+		t.Fatalf("sent = %T, want *payload.Object_StreamLocation", ss.sent[0])
+	}
+	if got := codes.Code(res.GetStatus().GetCode()); got != codes.ResourceExhausted {
+		t.Errorf("status code = %v, want %v", got, codes.ResourceExhausted)
+	}
+}
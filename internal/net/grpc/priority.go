@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/internal/strings"
+	"google.golang.org/grpc/metadata"
+)
+
+// Priority represents the priority class of the request, which is sent to the servers as the PriorityMetadataKey
+// metadata. The servers serve the low priority requests with the capacity left by the high priority requests.
+type Priority string
+
+const (
+	// PriorityMetadataKey is the metadata key of the priority class of the request.
+	PriorityMetadataKey = "vald-priority"
+
+	// HighPriority is the priority class of the user-facing requests, which is the default.
+	HighPriority Priority = "high"
+	// LowPriority is the priority class of the background requests, such as the index jobs.
+	LowPriority Priority = "low"
+)
+
+// ParsePriority returns the priority class of s, which is HighPriority unless s is LowPriority.
+func ParsePriority(s string) Priority {
+	if strings.EqualFold(strings.TrimSpace(s), string(LowPriority)) {
+		return LowPriority
+	}
+	return HighPriority
+}
+
+// WithPriority returns a copy of ctx whose outgoing requests are sent as the priority class p.
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	md.Set(PriorityMetadataKey, string(p))
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// PriorityFromIncomingContext returns the priority class of the incoming request, which is HighPriority when the
+// request does not have it.
+func PriorityFromIncomingContext(ctx context.Context) Priority {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(PriorityMetadataKey); len(vals) != 0 {
+			return ParsePriority(vals[0])
+		}
+	}
+	return HighPriority
+}
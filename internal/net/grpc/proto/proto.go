@@ -15,8 +15,11 @@
 package proto
 
 import (
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/strings"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/runtime/protoiface"
 	"google.golang.org/protobuf/runtime/protoimpl"
 )
@@ -30,6 +33,15 @@ type (
 
 	// Name is a type alias of protoreflect.Name.
 	Name = protoreflect.Name
+
+	// MessageType is a type alias of protoreflect.MessageType.
+	MessageType = protoreflect.MessageType
+
+	// FieldDescriptor is a type alias of protoreflect.FieldDescriptor.
+	FieldDescriptor = protoreflect.FieldDescriptor
+
+	// Value is a type alias of protoreflect.Value.
+	Value = protoreflect.Value
 )
 
 // Marshal returns the wire-format encoding of m.
@@ -56,3 +68,22 @@ func ToMessageV1(m Message) MessageV1 {
 func ToMessageV2(m MessageV1) Message {
 	return protoimpl.X.ProtoMessageV2Of(m)
 }
+
+// ValueOfMessage returns a Value initialized with the message m.
+func ValueOfMessage(m Message) Value {
+	return protoreflect.ValueOfMessage(m.ProtoReflect())
+}
+
+// OutputType returns the type of the response message of the registered gRPC method, such as "/vald.v1.Insert/Insert".
+func OutputType(method string) (MessageType, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(method, "/"), "/", "."))
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+	md, ok := d.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, errors.Errorf("%s is not a method", name)
+	}
+	return protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+}
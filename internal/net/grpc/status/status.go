@@ -467,7 +467,7 @@ func withDetails(st *Status, err error, details ...any) *Status {
 	if st != nil {
 		if len(st.Details()) == 0 {
 			ds := make([]proto.MessageV1, 0, len(details))
-			for _, msgs := range toProtoMessage(err, details) {
+			for _, msgs := range toProtoMessage(err, details...) {
 				for _, msg := range msgs {
 					ds = append(ds, proto.ToMessageV1(msg))
 				}
@@ -482,7 +482,7 @@ func withDetails(st *Status, err error, details ...any) *Status {
 		details = append(st.Details(), details...)
 	}
 
-	dmap := toProtoMessage(err, details)
+	dmap := toProtoMessage(err, details...)
 
 	msgs := make([]proto.MessageV1, 0, len(dmap))
 	visited := make(map[string]bool, len(dmap))
@@ -150,6 +150,31 @@ func TestParseError(t *testing.T) {
 	}
 }
 
+func TestWrapWithResourceExhausted(t *testing.T) {
+	err := WrapWithResourceExhausted("quota exceeded", errors.New("exceeded"),
+		&errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailureViolation{
+				{
+					Subject:     "tenant",
+					Description: "exceeded",
+				},
+			},
+		})
+	st, ok := FromError(err)
+	if !ok || st == nil {
+		t.Fatalf("got no status from %v", err)
+	}
+	var found bool
+	for _, d := range st.Details() {
+		if _, ok := d.(*errdetails.QuotaFailure); ok {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("details = %v, want QuotaFailure", st.Details())
+	}
+}
+
 func TestToCode(t *testing.T) {
 	type args struct {
 		err  error
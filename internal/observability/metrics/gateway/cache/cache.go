@@ -0,0 +1,140 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/internal/observability/metrics"
+	"github.com/vdaas/vald/pkg/gateway/lb/service"
+	api "go.opentelemetry.io/otel/metric"
+	view "go.opentelemetry.io/otel/sdk/metric"
+)
+
+const (
+	HitMetricsName        = "gateway_lb_result_cache_hit_total"
+	HitMetricsDescription = "Cumulative count of the search requests served from the result cache"
+
+	MissMetricsName        = "gateway_lb_result_cache_miss_total"
+	MissMetricsDescription = "Cumulative count of the search requests not found in the result cache"
+
+	EntriesMetricsName        = "gateway_lb_result_cache_entries"
+	EntriesMetricsDescription = "Number of the search responses in the result cache"
+
+	BytesMetricsName        = "gateway_lb_result_cache_bytes"
+	BytesMetricsDescription = "Approximate memory used by the search responses in the result cache"
+)
+
+type cacheMetrics struct {
+	c service.ResultCache
+}
+
+func New(c service.ResultCache) metrics.Metric {
+	return &cacheMetrics{
+		c: c,
+	}
+}
+
+func (*cacheMetrics) View() ([]metrics.View, error) {
+	return []metrics.View{
+		view.NewView(
+			view.Instrument{
+				Name:        HitMetricsName,
+				Description: HitMetricsDescription,
+			},
+			view.Stream{
+				Aggregation: view.AggregationSum{},
+			},
+		),
+		view.NewView(
+			view.Instrument{
+				Name:        MissMetricsName,
+				Description: MissMetricsDescription,
+			},
+			view.Stream{
+				Aggregation: view.AggregationSum{},
+			},
+		),
+		view.NewView(
+			view.Instrument{
+				Name:        EntriesMetricsName,
+				Description: EntriesMetricsDescription,
+			},
+			view.Stream{
+				Aggregation: view.AggregationLastValue{},
+			},
+		),
+		view.NewView(
+			view.Instrument{
+				Name:        BytesMetricsName,
+				Description: BytesMetricsDescription,
+			},
+			view.Stream{
+				Aggregation: view.AggregationLastValue{},
+			},
+		),
+	}, nil
+}
+
+func (cm *cacheMetrics) Register(m metrics.Meter) error {
+	hits, err := m.Int64ObservableCounter(
+		HitMetricsName,
+		metrics.WithDescription(HitMetricsDescription),
+		metrics.WithUnit(metrics.Dimensionless),
+	)
+	if err != nil {
+		return err
+	}
+	misses, err := m.Int64ObservableCounter(
+		MissMetricsName,
+		metrics.WithDescription(MissMetricsDescription),
+		metrics.WithUnit(metrics.Dimensionless),
+	)
+	if err != nil {
+		return err
+	}
+	entries, err := m.Int64ObservableGauge(
+		EntriesMetricsName,
+		metrics.WithDescription(EntriesMetricsDescription),
+		metrics.WithUnit(metrics.Dimensionless),
+	)
+	if err != nil {
+		return err
+	}
+	bytes, err := m.Int64ObservableGauge(
+		BytesMetricsName,
+		metrics.WithDescription(BytesMetricsDescription),
+		metrics.WithUnit(metrics.Bytes),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.RegisterCallback(
+		func(_ context.Context, o api.Observer) error {
+			st := cm.c.Stats()
+			o.ObserveInt64(hits, int64(st.Hits))
+			o.ObserveInt64(misses, int64(st.Misses))
+			o.ObserveInt64(entries, st.Entries)
+			o.ObserveInt64(bytes, st.Bytes)
+			return nil
+		},
+		hits,
+		misses,
+		entries,
+		bytes,
+	)
+	return err
+}
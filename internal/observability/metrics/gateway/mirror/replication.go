@@ -0,0 +1,166 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package mirror
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/internal/observability/attribute"
+	"github.com/vdaas/vald/internal/observability/metrics"
+	"github.com/vdaas/vald/pkg/gateway/mirror/service"
+	api "go.opentelemetry.io/otel/metric"
+	view "go.opentelemetry.io/otel/sdk/metric"
+)
+
+const (
+	ReplicationLagMetricsName        = "gateway_mirror_replication_lag_seconds"
+	ReplicationLagMetricsDescription = "Elapsed time since the oldest write request not shipped to the target was appended to the outbox"
+
+	ReplicationPendingMetricsName        = "gateway_mirror_replication_pending"
+	ReplicationPendingMetricsDescription = "Number of the write requests not shipped to the target yet"
+
+	ReplicationShippedMetricsName        = "gateway_mirror_replication_shipped_total"
+	ReplicationShippedMetricsDescription = "Cumulative count of the write requests shipped to the target"
+
+	ReplicationDroppedMetricsName        = "gateway_mirror_replication_dropped_total"
+	ReplicationDroppedMetricsDescription = "Cumulative count of the write requests dropped because the target rejected them as invalid or the outbox overflowed"
+
+	ReplicationLagAlarmMetricsName        = "gateway_mirror_replication_lag_alarm"
+	ReplicationLagAlarmMetricsDescription = "Whether the replication lag of the target exceeds the max lag (1) or not (0)"
+)
+
+type replicationMetrics struct {
+	r service.Replicator
+}
+
+// NewReplication returns the metrics of the asynchronous replication to each target.
+func NewReplication(r service.Replicator) metrics.Metric {
+	return &replicationMetrics{
+		r: r,
+	}
+}
+
+func (*replicationMetrics) View() ([]metrics.View, error) {
+	return []metrics.View{
+		view.NewView(
+			view.Instrument{
+				Name:        ReplicationLagMetricsName,
+				Description: ReplicationLagMetricsDescription,
+			},
+			view.Stream{
+				Aggregation: view.AggregationLastValue{},
+			},
+		),
+		view.NewView(
+			view.Instrument{
+				Name:        ReplicationPendingMetricsName,
+				Description: ReplicationPendingMetricsDescription,
+			},
+			view.Stream{
+				Aggregation: view.AggregationLastValue{},
+			},
+		),
+		view.NewView(
+			view.Instrument{
+				Name:        ReplicationShippedMetricsName,
+				Description: ReplicationShippedMetricsDescription,
+			},
+			view.Stream{
+				Aggregation: view.AggregationSum{},
+			},
+		),
+		view.NewView(
+			view.Instrument{
+				Name:        ReplicationDroppedMetricsName,
+				Description: ReplicationDroppedMetricsDescription,
+			},
+			view.Stream{
+				Aggregation: view.AggregationSum{},
+			},
+		),
+		view.NewView(
+			view.Instrument{
+				Name:        ReplicationLagAlarmMetricsName,
+				Description: ReplicationLagAlarmMetricsDescription,
+			},
+			view.Stream{
+				Aggregation: view.AggregationLastValue{},
+			},
+		),
+	}, nil
+}
+
+func (rm *replicationMetrics) Register(m metrics.Meter) error {
+	lag, err := m.Float64ObservableGauge(
+		ReplicationLagMetricsName,
+		metrics.WithDescription(ReplicationLagMetricsDescription),
+		metrics.WithUnit("s"),
+	)
+	if err != nil {
+		return err
+	}
+	pending, err := m.Int64ObservableGauge(
+		ReplicationPendingMetricsName,
+		metrics.WithDescription(ReplicationPendingMetricsDescription),
+		metrics.WithUnit(metrics.Dimensionless),
+	)
+	if err != nil {
+		return err
+	}
+	shipped, err := m.Int64ObservableCounter(
+		ReplicationShippedMetricsName,
+		metrics.WithDescription(ReplicationShippedMetricsDescription),
+		metrics.WithUnit(metrics.Dimensionless),
+	)
+	if err != nil {
+		return err
+	}
+	dropped, err := m.Int64ObservableCounter(
+		ReplicationDroppedMetricsName,
+		metrics.WithDescription(ReplicationDroppedMetricsDescription),
+		metrics.WithUnit(metrics.Dimensionless),
+	)
+	if err != nil {
+		return err
+	}
+	alarm, err := m.Int64ObservableGauge(
+		ReplicationLagAlarmMetricsName,
+		metrics.WithDescription(ReplicationLagAlarmMetricsDescription),
+		metrics.WithUnit(metrics.Dimensionless),
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.RegisterCallback(
+		func(_ context.Context, o api.Observer) error {
+			rm.r.RangeStats(func(target string, st *service.ReplicationStats) bool {
+				attrs := api.WithAttributes(attribute.String(targetAddrKey, target))
+				o.ObserveFloat64(lag, st.Lag.Seconds(), attrs)
+				o.ObserveInt64(pending, int64(st.Pending), attrs)
+				o.ObserveInt64(shipped, int64(st.Shipped), attrs)
+				o.ObserveInt64(dropped, int64(st.Dropped), attrs)
+				var a int64
+				if st.Alarm {
+					a = 1
+				}
+				o.ObserveInt64(alarm, a, attrs)
+				return true
+			})
+			return nil
+		},
+		lag, pending, shipped, dropped, alarm,
+	)
+	return err
+}
@@ -27,6 +27,8 @@ import (
 	"github.com/vdaas/vald/internal/net/grpc"
 	"github.com/vdaas/vald/internal/net/grpc/interceptor/server/logging"
 	"github.com/vdaas/vald/internal/net/grpc/interceptor/server/metric"
+	"github.com/vdaas/vald/internal/net/grpc/interceptor/server/priority"
+	"github.com/vdaas/vald/internal/net/grpc/interceptor/server/quota"
 	"github.com/vdaas/vald/internal/net/grpc/interceptor/server/recover"
 	"github.com/vdaas/vald/internal/net/grpc/interceptor/server/trace"
 	"github.com/vdaas/vald/internal/net/http/rest"
@@ -709,3 +711,35 @@ func WithGRPCInterceptors(names ...string) Option {
 		return nil
 	}
 }
+
+// WithGRPCQuota returns the option to limit the requests and the vectors of each tenant by the quota interceptors.
+func WithGRPCQuota(opts ...quota.Option) Option {
+	return func(s *server) error {
+		qi, qsi, err := quota.QuotaInterceptors(opts...)
+		if err != nil {
+			return errors.NewErrCriticalOption("gRPCQuota", opts, errors.Wrap(err, "failed to create Interceptor"))
+		}
+		s.grpc.opts = append(
+			s.grpc.opts,
+			grpc.ChainUnaryInterceptor(qi),
+			grpc.ChainStreamInterceptor(qsi),
+		)
+		return nil
+	}
+}
+
+// WithGRPCPriority returns the option to serve the requests by their priority classes by the priority interceptors.
+func WithGRPCPriority(opts ...priority.Option) Option {
+	return func(s *server) error {
+		pi, psi, err := priority.PriorityInterceptors(opts...)
+		if err != nil {
+			return errors.NewErrCriticalOption("gRPCPriority", opts, errors.Wrap(err, "failed to create Interceptor"))
+		}
+		s.grpc.opts = append(
+			s.grpc.opts,
+			grpc.ChainUnaryInterceptor(pi),
+			grpc.ChainStreamInterceptor(psi),
+		)
+		return nil
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/vdaas/vald/internal/net/grpc/status"
 	"github.com/vdaas/vald/internal/observability/attribute"
 	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/pkg/agent/internal/pagination"
 )
 
 func (s *server) Search(
@@ -62,11 +63,18 @@ func (s *server) Search(
 		errhandler.RecordSpanAttrs(span, trace.StatusCodeInvalidArgument(err.Error()), err)
 		return nil, err
 	}
-	res, err = s.faiss.Search(
-		req.GetConfig().GetNum(),
-		req.GetConfig().GetNprobe(),
-		1,
-		req.GetVector())
+	search := func(num uint32) (*payload.Search_Response, error) {
+		return s.faiss.Search(
+			num,
+			req.GetConfig().GetNprobe(),
+			1,
+			req.GetVector())
+	}
+	if req.GetConfig().AfterDistance != nil {
+		res, err = pagination.SearchAfter(req.GetConfig(), s.faiss.Len(), search)
+	} else {
+		res, err = search(req.GetConfig().GetNum())
+	}
 	if err == nil && res == nil {
 		return nil, nil
 	}
@@ -0,0 +1,265 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/errdetails"
+	"github.com/vdaas/vald/internal/net/grpc/errhandler"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/pkg/agent/core/ngt/service"
+)
+
+// CreateCollection creates the named collection which has its own index in the `vald-agent`.
+func (s *server) CreateCollection(
+	ctx context.Context, req *payload.Collection_CreateRequest,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/"+vald.CreateCollectionRPCName)
+	defer trace.End(span)
+	cfg := req.GetConfig()
+	err = s.ngt.CreateCollection(ctx, service.CollectionConfig{
+		Name:         cfg.GetName(),
+		Dimension:    int(cfg.GetDimension()),
+		DistanceType: cfg.GetDistanceType(),
+		ObjectType:   cfg.GetObjectType(),
+	})
+	if err != nil {
+		var (
+			code    codes.Code
+			details = []any{
+				&errdetails.RequestInfo{
+					RequestId:   cfg.GetName(),
+					ServingData: errdetails.Serialize(req),
+				},
+				s.resourceInfo(ngtResourceType + "/ngt.CreateCollection"),
+			}
+		)
+		switch {
+		case errors.Is(err, errors.ErrCollectionAlreadyExists(cfg.GetName())):
+			err = status.WrapWithAlreadyExists(fmt.Sprintf("CreateCollection API collection %s already exists", cfg.GetName()), err, details...)
+			code = codes.AlreadyExists
+			log.Warn(err)
+		case errors.Is(err, errors.ErrInvalidCollectionName(cfg.GetName())):
+			err = status.WrapWithInvalidArgument(fmt.Sprintf("CreateCollection API invalid collection name %q detected", cfg.GetName()), err,
+				append(details, &errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequestFieldViolation{
+						{
+							Field:       "name",
+							Description: err.Error(),
+						},
+					},
+				})...)
+			code = codes.InvalidArgument
+			log.Warn(err)
+		case errors.Is(err, errors.ErrWriteOperationToReadReplica):
+			err = status.WrapWithAborted("CreateCollection API aborted due to agent is read only", err, details...)
+			code = codes.Aborted
+			log.Debug(err)
+		default:
+			err = status.WrapWithInternal(fmt.Sprintf("CreateCollection API failed to create collection %s", cfg.GetName()), err, append(details, info.Get())...)
+			code = codes.Internal
+			log.Error(err)
+		}
+		return errhandler.HandleError[payload.Empty](span, code, err)
+	}
+	return new(payload.Empty), nil
+}
+
+// DropCollection drops the named collection and removes all vectors in it.
+func (s *server) DropCollection(
+	ctx context.Context, req *payload.Collection_DropRequest,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/"+vald.DropCollectionRPCName)
+	defer trace.End(span)
+	err = s.ngt.DropCollection(ctx, req.GetName())
+	if err != nil {
+		var (
+			code    codes.Code
+			details = []any{
+				&errdetails.RequestInfo{
+					RequestId:   req.GetName(),
+					ServingData: errdetails.Serialize(req),
+				},
+				s.resourceInfo(ngtResourceType + "/ngt.DropCollection"),
+			}
+		)
+		switch {
+		case errors.Is(err, errors.ErrCollectionNotFound(req.GetName())):
+			err = status.WrapWithNotFound(fmt.Sprintf("DropCollection API collection %s not found", req.GetName()), err, details...)
+			code = codes.NotFound
+			log.Warn(err)
+		case errors.Is(err, errors.ErrCollectionSwitched(req.GetName())):
+			err = status.WrapWithFailedPrecondition(fmt.Sprintf("DropCollection API collection %s serves the requests of another collection", req.GetName()), err, details...)
+			code = codes.FailedPrecondition
+			log.Warn(err)
+		case errors.Is(err, errors.ErrWriteOperationToReadReplica):
+			err = status.WrapWithAborted("DropCollection API aborted due to agent is read only", err, details...)
+			code = codes.Aborted
+			log.Debug(err)
+		case errors.Is(err, context.Canceled):
+			err = status.WrapWithCanceled(fmt.Sprintf("DropCollection API canceled to drop collection %s", req.GetName()), err, details...)
+			code = codes.Canceled
+		case errors.Is(err, context.DeadlineExceeded):
+			err = status.WrapWithDeadlineExceeded(fmt.Sprintf("DropCollection API deadline exceeded to drop collection %s", req.GetName()), err, details...)
+			code = codes.DeadlineExceeded
+		default:
+			err = status.WrapWithInternal(fmt.Sprintf("DropCollection API failed to drop collection %s", req.GetName()), err, append(details, info.Get())...)
+			code = codes.Internal
+			log.Error(err)
+		}
+		return errhandler.HandleError[payload.Empty](span, code, err)
+	}
+	return new(payload.Empty), nil
+}
+
+// ListCollections returns the configurations of the named collections.
+func (s *server) ListCollections(
+	ctx context.Context, _ *payload.Empty,
+) (res *payload.Collection_List, err error) {
+	_, span := trace.StartSpan(ctx, apiName+"/"+vald.ListCollectionsRPCName)
+	defer trace.End(span)
+	cfgs := s.ngt.ListCollections()
+	sws := s.ngt.ListCollectionSwitches()
+	res = &payload.Collection_List{
+		Collections: make([]*payload.Collection_Config, 0, len(cfgs)),
+		Switches:    make([]*payload.Collection_Switch, 0, len(sws)),
+	}
+	for _, cfg := range cfgs {
+		res.Collections = append(res.GetCollections(), &payload.Collection_Config{
+			Name:         cfg.Name,
+			Dimension:    int32(cfg.Dimension),
+			DistanceType: cfg.DistanceType,
+			ObjectType:   cfg.ObjectType,
+		})
+	}
+	for _, sw := range sws {
+		res.Switches = append(res.GetSwitches(), &payload.Collection_Switch{
+			Name:   sw.Name,
+			Target: sw.Target,
+			Fence:  sw.Fence,
+		})
+	}
+	return res, nil
+}
+
+// SwitchCollection switches the requests of the named collection to the target collection, or fences the write requests to the named collection.
+func (s *server) SwitchCollection(
+	ctx context.Context, req *payload.Collection_Switch,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/"+vald.SwitchCollectionRPCName)
+	defer trace.End(span)
+	err = s.ngt.SwitchCollection(ctx, req.GetName(), req.GetTarget(), req.GetFence())
+	if err != nil {
+		var (
+			code    codes.Code
+			details = []any{
+				&errdetails.RequestInfo{
+					RequestId:   req.GetName(),
+					ServingData: errdetails.Serialize(req),
+				},
+				s.resourceInfo(ngtResourceType + "/ngt.SwitchCollection"),
+			}
+		)
+		switch {
+		case errors.Is(err, errors.ErrCollectionNotFound(req.GetTarget())):
+			err = status.WrapWithNotFound(fmt.Sprintf("SwitchCollection API collection %s not found", req.GetTarget()), err, details...)
+			code = codes.NotFound
+			log.Warn(err)
+		case errors.Is(err, errors.ErrInvalidCollectionSwitch(req.GetName(), req.GetTarget())):
+			err = status.WrapWithInvalidArgument(fmt.Sprintf("SwitchCollection API invalid switch from %q to %q detected", req.GetName(), req.GetTarget()), err,
+				append(details, &errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequestFieldViolation{
+						{
+							Field:       "target",
+							Description: err.Error(),
+						},
+					},
+				})...)
+			code = codes.InvalidArgument
+			log.Warn(err)
+		case errors.Is(err, errors.ErrWriteOperationToReadReplica):
+			err = status.WrapWithAborted("SwitchCollection API aborted due to agent is read only", err, details...)
+			code = codes.Aborted
+			log.Debug(err)
+		default:
+			err = status.WrapWithInternal(fmt.Sprintf("SwitchCollection API failed to switch collection %q to %q", req.GetName(), req.GetTarget()), err, append(details, info.Get())...)
+			code = codes.Internal
+			log.Error(err)
+		}
+		return errhandler.HandleError[payload.Empty](span, code, err)
+	}
+	return new(payload.Empty), nil
+}
+
+// redirected reports whether the requests of the named collection are switched
+// to another collection or fenced. It is false when the server already serves a
+// named collection.
+func (s *server) redirected(name string) bool {
+	if len(s.collection) != 0 {
+		return false
+	}
+	target, fenced := s.ngt.ResolveCollection(name)
+	return target != name || fenced
+}
+
+// splitByCollection groups the requests of the multi request by the collection
+// name. It returns nil when all requests are for the default collection which is
+// neither switched nor fenced so that the multi request is processed as it is.
+func splitByCollection[R any](reqs []R, redirected func(name string) bool, collection func(R) string) (groups map[string][]R) {
+	for _, req := range reqs {
+		if name := collection(req); len(name) != 0 || redirected(name) {
+			groups = make(map[string][]R)
+			break
+		}
+	}
+	if groups == nil {
+		return nil
+	}
+	for _, req := range reqs {
+		name := collection(req)
+		groups[name] = append(groups[name], req)
+	}
+	return groups
+}
+
+// multiByCollection processes the requests of each collection with the server
+// of the collection and merges the locations of the results.
+func multiByCollection[R any](
+	span trace.Span, s *server, rpcName, resourceType string, groups map[string][]R, servingData any,
+	f func(s *server, reqs []R) (*payload.Object_Locations, error),
+) (res *payload.Object_Locations, err error) {
+	res = new(payload.Object_Locations)
+	for name, reqs := range groups {
+		cs, err := s.withWriteCollection(span, rpcName, resourceType, name, name, servingData)
+		if err != nil {
+			return nil, err
+		}
+		locs, err := f(cs, reqs)
+		if err != nil {
+			return nil, err
+		}
+		res.Locations = append(res.GetLocations(), locs.GetLocations()...)
+	}
+	return res, nil
+}
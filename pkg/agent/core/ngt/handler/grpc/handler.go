@@ -15,6 +15,7 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
@@ -26,6 +27,8 @@ import (
 	"github.com/vdaas/vald/internal/net/grpc/errdetails"
 	"github.com/vdaas/vald/internal/sync/errgroup"
 	"github.com/vdaas/vald/pkg/agent/core/ngt/service"
+	"github.com/vdaas/vald/pkg/agent/internal/attribute"
+	"github.com/vdaas/vald/pkg/agent/internal/sparse"
 )
 
 type Server interface {
@@ -40,6 +43,7 @@ type server struct {
 	eg                errgroup.Group
 	name              string
 	ip                string
+	collection        string
 	streamConcurrency int
 }
 
@@ -102,3 +106,40 @@ func (s *server) newLocation(uuid string) *payload.Object_Location {
 	}
 	return nil
 }
+
+// setAttributes replaces the attributes of the uuid's vector after the vector is
+// accepted by the NGT service. The failure is only logged because the vector
+// itself has been already queued.
+func (s *server) setAttributes(uuid string, attrs map[string]*payload.Attribute_Value) {
+	if err := s.ngt.SetAttributes(uuid, attribute.FromPayload(attrs)); err != nil {
+		log.Warnf("failed to set attributes of uuid %s: %v", uuid, err)
+	}
+}
+
+// setSparseVector replaces the sparse vector of the uuid's vector after the
+// vector is accepted by the NGT service. The failure is only logged because the
+// vector itself has been already queued.
+func (s *server) setSparseVector(uuid string, vec sparse.Vector) {
+	if err := s.ngt.SetSparseVector(uuid, vec); err != nil {
+		log.Warnf("failed to set sparse vector of uuid %s: %v", uuid, err)
+	}
+}
+
+// searchQueued returns the num search results merged with the vectors written but not committed to the index yet when
+// the read-your-writes consistency is requested by cfg, otherwise it returns res and err as they are.
+// num is the number of the results searched from the index, which is enlarged from cfg by the pagination.
+func (s *server) searchQueued(
+	ctx context.Context,
+	cfg *payload.Search_Config,
+	vec []float32,
+	num uint32,
+	pred attribute.Predicate,
+	res *payload.Search_Response,
+	err error,
+) (*payload.Search_Response, error) {
+	if cfg.GetConsistency() != payload.Search_ReadYourWrites ||
+		(err != nil && !errors.Is(err, errors.ErrEmptySearchResult)) {
+		return res, err
+	}
+	return s.ngt.SearchQueued(ctx, vec, num, cfg.GetRadius(), pred, res)
+}
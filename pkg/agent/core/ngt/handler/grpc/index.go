@@ -26,6 +26,7 @@ import (
 	"github.com/vdaas/vald/internal/net/grpc/errhandler"
 	"github.com/vdaas/vald/internal/net/grpc/status"
 	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/pkg/agent/core/ngt/service"
 )
 
 func (s *server) CreateIndex(
@@ -34,7 +35,9 @@ func (s *server) CreateIndex(
 	ctx, span := trace.StartSpan(ctx, apiName+".CreateIndex")
 	defer trace.End(span)
 	res = new(payload.Empty)
-	err = s.ngt.CreateIndex(ctx, c.GetPoolSize())
+	err = s.applyCollections(s.ngt.CreateIndex(ctx, c.GetPoolSize()), func(n service.NGT) error {
+		return n.CreateIndex(ctx, c.GetPoolSize())
+	})
 	if err != nil {
 		var (
 			code    codes.Code
@@ -81,7 +84,9 @@ func (s *server) SaveIndex(ctx context.Context, _ *payload.Empty) (res *payload.
 	ctx, span := trace.StartSpan(ctx, apiName+".SaveIndex")
 	defer trace.End(span)
 	res = new(payload.Empty)
-	err = s.ngt.SaveIndex(ctx)
+	err = s.applyCollections(s.ngt.SaveIndex(ctx), func(n service.NGT) error {
+		return n.SaveIndex(ctx)
+	})
 	if err != nil {
 		log.Error(err)
 		err = status.WrapWithInternal("SaveIndex API failed to save indices", err,
@@ -98,7 +103,9 @@ func (s *server) CreateAndSaveIndex(
 	ctx, span := trace.StartSpan(ctx, apiName+".CreateAndSaveIndex")
 	defer trace.End(span)
 	res = new(payload.Empty)
-	err = s.ngt.CreateAndSaveIndex(ctx, c.GetPoolSize())
+	err = s.applyCollections(s.ngt.CreateAndSaveIndex(ctx, c.GetPoolSize()), func(n service.NGT) error {
+		return n.CreateAndSaveIndex(ctx, c.GetPoolSize())
+	})
 	if err != nil {
 		var (
 			code    codes.Code
@@ -146,12 +153,7 @@ func (s *server) IndexInfo(
 ) (res *payload.Info_Index_Count, err error) {
 	_, span := trace.StartSpan(ctx, apiName+".IndexInfo")
 	defer trace.End(span)
-	return &payload.Info_Index_Count{
-		Stored:      uint32(s.ngt.Len()),
-		Uncommitted: uint32(s.ngt.InsertVQueueBufferLen() + s.ngt.DeleteVQueueBufferLen()),
-		Indexing:    s.ngt.IsIndexing(),
-		Saving:      s.ngt.IsSaving(),
-	}, nil
+	return s.indexCount(), nil
 }
 
 func (s *server) IndexDetail(
@@ -164,12 +166,7 @@ func (s *server) IndexDetail(
 		Replica:    1,
 		LiveAgents: 1,
 	}
-	res.Counts[s.name] = &payload.Info_Index_Count{
-		Stored:      uint32(s.ngt.Len()),
-		Uncommitted: uint32(s.ngt.InsertVQueueBufferLen() + s.ngt.DeleteVQueueBufferLen()),
-		Indexing:    s.ngt.IsIndexing(),
-		Saving:      s.ngt.IsSaving(),
-	}
+	res.Counts[s.name] = s.indexCount()
 	return res, nil
 }
 
@@ -212,3 +209,56 @@ func (s *server) IndexProperty(
 		},
 	}, nil
 }
+
+// indexCount returns the index count of the default collection together with the index count of each named collection.
+func (s *server) indexCount() *payload.Info_Index_Count {
+	cnt := countOf(s.ngt)
+	for _, cfg := range s.ngt.ListCollections() {
+		n, err := s.ngt.Collection(cfg.Name)
+		if err != nil {
+			// the collection has been dropped after listing.
+			continue
+		}
+		if cnt.Collections == nil {
+			cnt.Collections = make(map[string]*payload.Info_Index_Count)
+		}
+		cnt.Collections[cfg.Name] = countOf(n)
+	}
+	return cnt
+}
+
+func countOf(n service.NGT) *payload.Info_Index_Count {
+	return &payload.Info_Index_Count{
+		Stored:      uint32(n.Len()),
+		Uncommitted: uint32(n.InsertVQueueBufferLen() + n.DeleteVQueueBufferLen()),
+		Indexing:    n.IsIndexing(),
+		Saving:      n.IsSaving(),
+		Flushing:    n.IsFlushing(),
+		Loading:     n.IsLoading(),
+		BrokenIndex: n.BrokenIndexCount(),
+	}
+}
+
+// applyCollections applies f to each named collection after the same operation
+// of the default collection which results in err. The collections which have no
+// uncommitted index are ignored, and the errors of the other collections take
+// precedence over the precondition failure of the default collection.
+func (s *server) applyCollections(err error, f func(n service.NGT) error) error {
+	for _, cfg := range s.ngt.ListCollections() {
+		n, cerr := s.ngt.Collection(cfg.Name)
+		if cerr != nil {
+			continue
+		}
+		cerr = f(n)
+		if cerr == nil || errors.Is(cerr, errors.ErrUncommittedIndexNotFound) {
+			continue
+		}
+		cerr = errors.Wrapf(cerr, "collection %s", cfg.Name)
+		if err == nil || errors.Is(err, errors.ErrUncommittedIndexNotFound) {
+			err = cerr
+		} else {
+			err = errors.Join(err, cerr)
+		}
+	}
+	return err
+}
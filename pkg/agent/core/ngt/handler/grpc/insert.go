@@ -30,6 +30,7 @@ import (
 	"github.com/vdaas/vald/internal/observability/attribute"
 	"github.com/vdaas/vald/internal/observability/trace"
 	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/pkg/agent/internal/sparse"
 )
 
 // Insert inserts a vector to the NGT.
@@ -38,9 +39,25 @@ func (s *server) Insert(
 ) (res *payload.Object_Location, err error) {
 	_, span := trace.StartSpan(ctx, apiName+"/"+vald.InsertRPCName)
 	defer trace.End(span)
+	if s, err = s.withWriteCollection(span, vald.InsertRPCName, ngtResourceType+"/ngt.Insert",
+		req.GetVector().GetId(), req.GetConfig().GetCollection(), req); err != nil {
+		return nil, err
+	}
 	vec := req.GetVector()
-	if err = s.validateVectorDimension(span, vald.InsertRPCName, ngtResourceType+"/ngt.Insert",
-		vec.GetId(), req, len(vec.GetVector()), s.ngt.GetDimensionSize()); err != nil {
+	subs, err := s.parseSubVectors(span, vald.InsertRPCName, ngtResourceType+"/ngt.Insert",
+		vec.GetId(), vec.GetSubVectors(), req)
+	if err != nil {
+		return nil, err
+	}
+	if len(subs) == 0 {
+		if err = s.validateVectorDimension(span, vald.InsertRPCName, ngtResourceType+"/ngt.Insert",
+			vec.GetId(), req, len(vec.GetVector()), s.ngt.GetDimensionSize()); err != nil {
+			return nil, err
+		}
+	}
+	svec, err := s.parseSparseVector(span, vald.InsertRPCName, ngtResourceType+"/ngt.Insert",
+		vec.GetId(), vec.GetSparse(), req)
+	if err != nil {
 		return nil, err
 	}
 
@@ -48,7 +65,11 @@ func (s *server) Insert(
 	if ts == 0 {
 		ts = req.GetConfig().GetTimestamp()
 	}
-	err = s.ngt.InsertWithTime(vec.GetId(), vec.GetVector(), ts)
+	if len(subs) != 0 {
+		err = s.ngt.InsertMultiVectorWithTime(vec.GetId(), subs, ts)
+	} else {
+		err = s.ngt.InsertWithTime(vec.GetId(), vec.GetVector(), ts)
+	}
 	if err != nil {
 		var attrs []attribute.KeyValue
 		if errors.Is(err, errors.ErrFlushingIsInProgress) {
@@ -69,7 +90,7 @@ func (s *server) Insert(
 				s.resourceInfo(ngtResourceType+"/ngt.Insert"))
 			log.Warn(err)
 			attrs = trace.StatusCodeAlreadyExists(err.Error())
-		} else if errors.Is(err, errors.ErrUUIDNotFound(0)) {
+		} else if errors.Is(err, errors.ErrUUIDNotFound(0)) || errors.Is(err, errors.ErrInvalidMultiVectorID(vec.GetId())) {
 			err = status.WrapWithInvalidArgument(fmt.Sprintf("Insert API invalid id: \"%s\" or vector: %v was given", vec.GetId(), vec.GetVector()), err,
 				&errdetails.RequestInfo{
 					RequestId:   req.GetVector().GetId(),
@@ -103,6 +124,8 @@ func (s *server) Insert(
 		errhandler.RecordSpanAttrs(span, attrs, err)
 		return nil, err
 	}
+	s.setAttributes(vec.GetId(), req.GetConfig().GetAttributes())
+	s.setSparseVector(vec.GetId(), svec)
 	return s.newLocation(vec.GetId()), nil
 }
 
@@ -142,8 +165,18 @@ func (s *server) MultiInsert(
 ) (res *payload.Object_Locations, err error) {
 	_, span := trace.StartSpan(ctx, apiName+"/"+vald.MultiInsertRPCName)
 	defer trace.End(span)
+	if groups := splitByCollection(reqs.GetRequests(), s.redirected, func(req *payload.Insert_Request) string {
+		return req.GetConfig().GetCollection()
+	}); groups != nil && len(s.collection) == 0 {
+		return multiByCollection(span, s, vald.MultiInsertRPCName, ngtResourceType+"/ngt.MultiInsert", groups, reqs,
+			func(s *server, reqs []*payload.Insert_Request) (*payload.Object_Locations, error) {
+				return s.MultiInsert(ctx, &payload.Insert_MultiRequest{Requests: reqs})
+			})
+	}
 	uuids := make([]string, 0, len(reqs.GetRequests()))
 	vmap := make(map[string][]float32, len(reqs.GetRequests()))
+	smap := make(map[string]sparse.Vector, len(reqs.GetRequests()))
+	var docs []*payload.Insert_Request
 	var ts int64
 	for i, req := range reqs.GetRequests() {
 		vec := req.GetVector()
@@ -153,6 +186,11 @@ func (s *server) MultiInsert(
 				ts = req.GetConfig().GetTimestamp()
 			}
 		}
+		if len(vec.GetSubVectors()) != 0 {
+			// the sub-vectors of the multi-vector document are insertd together after the other vectors.
+			docs = append(docs, req)
+			continue
+		}
 		if len(vec.GetVector()) != s.ngt.GetDimensionSize() {
 			err = errors.ErrIncompatibleDimensionSize(len(vec.GetVector()), int(s.ngt.GetDimensionSize()))
 			err = status.WrapWithInvalidArgument("MultiInsert API Incompatible Dimension Size detected",
@@ -173,6 +211,12 @@ func (s *server) MultiInsert(
 			log.Warn(err)
 			return errhandler.HandleError[payload.Object_Locations](span, codes.InvalidArgument, err)
 		}
+		svec, err := s.parseSparseVector(span, vald.MultiInsertRPCName, ngtResourceType+"/ngt.MultiInsert",
+			vec.GetId(), vec.GetSparse(), req)
+		if err != nil {
+			return nil, err
+		}
+		smap[vec.GetId()] = svec
 		vmap[vec.GetId()] = vec.GetVector()
 		uuids = append(uuids, vec.GetId())
 	}
@@ -244,5 +288,19 @@ func (s *server) MultiInsert(
 		}
 		return nil, err
 	}
+	for _, req := range reqs.GetRequests() {
+		if len(req.GetVector().GetSubVectors()) != 0 {
+			continue
+		}
+		s.setAttributes(req.GetVector().GetId(), req.GetConfig().GetAttributes())
+		s.setSparseVector(req.GetVector().GetId(), smap[req.GetVector().GetId()])
+	}
+	for _, req := range docs {
+		_, err = s.Insert(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		uuids = append(uuids, req.GetVector().GetId())
+	}
 	return s.newLocations(uuids...), nil
 }
@@ -39,6 +39,10 @@ func (s *server) LinearSearch(
 ) (res *payload.Search_Response, err error) {
 	ctx, span := trace.StartSpan(ctx, apiName+"/"+vald.LinearSearchRPCName)
 	defer trace.End(span)
+	if s, err = s.withCollection(span, vald.LinearSearchRPCName, ngtResourceType+"/ngt.LinearSearch",
+		req.GetConfig().GetRequestId(), req.GetConfig().GetCollection(), req); err != nil {
+		return nil, err
+	}
 	if len(req.GetVector()) != s.ngt.GetDimensionSize() {
 		err = errors.ErrIncompatibleDimensionSize(len(req.GetVector()), int(s.ngt.GetDimensionSize()))
 		err = status.WrapWithInvalidArgument("LinearSearch API Incompatible Dimension Size detected",
@@ -61,6 +65,10 @@ func (s *server) LinearSearch(
 		log.Warn(err)
 		return errhandler.HandleError[payload.Search_Response](span, codes.InvalidArgument, err)
 	}
+	if err = s.validateConsistency(span, vald.LinearSearchRPCName, ngtResourceType+"/ngt.LinearSearch",
+		req.GetConfig().GetRequestId(), "linear search", req.GetConfig(), req); err != nil {
+		return nil, err
+	}
 	res, err = s.ngt.LinearSearch(ctx,
 		req.GetVector(),
 		req.GetConfig().GetNum())
@@ -151,11 +159,19 @@ func (s *server) LinearSearchByID(
 ) (res *payload.Search_Response, err error) {
 	ctx, span := trace.StartSpan(ctx, apiName+"/"+vald.LinearSearchByIDRPCName)
 	defer trace.End(span)
+	if s, err = s.withCollection(span, vald.LinearSearchByIDRPCName, ngtResourceType+"/ngt.LinearSearchByID",
+		req.GetId(), req.GetConfig().GetCollection(), req); err != nil {
+		return nil, err
+	}
 	uuid := req.GetId()
 	if err = s.validateUUID(span, vald.LinearSearchByIDRPCName, ngtResourceType+"/ngt.LinearSearchByID",
 		uuid, req); err != nil {
 		return nil, err
 	}
+	if err = s.validateConsistency(span, vald.LinearSearchByIDRPCName, ngtResourceType+"/ngt.LinearSearchByID",
+		uuid, "linear search", req.GetConfig(), req); err != nil {
+		return nil, err
+	}
 	vec, res, err := s.ngt.LinearSearchByID(ctx,
 		uuid,
 		req.GetConfig().GetNum())
@@ -27,6 +27,7 @@ import (
 	"github.com/vdaas/vald/internal/net/grpc/status"
 	"github.com/vdaas/vald/internal/observability/trace"
 	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/pkg/agent/internal/multivector"
 )
 
 func (s *server) Exists(
@@ -51,21 +52,50 @@ func (s *server) GetObject(
 ) (res *payload.Object_Vector, err error) {
 	_, span := trace.StartSpan(ctx, apiName+"/"+vald.GetObjectRPCName)
 	defer trace.End(span)
+	if s, err = s.withCollection(span, vald.GetObjectRPCName, ngtResourceType+"/ngt.GetObject",
+		id.GetId().GetId(), id.GetCollection(), id); err != nil {
+		return nil, err
+	}
 	uuid := id.GetId().GetId()
 	if err = s.validateUUID(span, vald.GetObjectRPCName, ngtResourceType+"/ngt.GetObject",
 		uuid, id); err != nil {
 		return nil, err
 	}
-	vec, ts, err := s.ngt.GetObject(uuid)
-	if err != nil || vec == nil {
+	res, err = s.getObject(uuid)
+	if err != nil || res == nil {
 		err = status.New(codes.NotFound, errors.ErrObjectNotFound(err, uuid).Error()).Err()
 		return errhandler.HandleError[payload.Object_Vector](span, codes.NotFound, err)
 	}
+	return res, nil
+}
 
+// getObject returns the vector or the multi-vector document of the uuid together with its sparse vector and attributes.
+// It returns nil when neither of them is found.
+func (s *server) getObject(uuid string) (*payload.Object_Vector, error) {
+	var subs []*payload.Object_SubVector
+	vec, ts, err := s.ngt.GetObject(uuid)
+	if err != nil || vec == nil {
+		vecs, mts, merr := s.ngt.GetMultiVector(uuid)
+		if merr != nil {
+			return nil, err
+		}
+		subs = make([]*payload.Object_SubVector, 0, len(vecs))
+		for _, v := range vecs {
+			subs = append(subs, &payload.Object_SubVector{
+				Vector: v,
+			})
+		}
+		vec, ts = nil, mts
+	}
+	svec, _ := s.ngt.GetSparseVector(uuid)
+	attrs, _ := s.ngt.GetAttributes(uuid)
 	return &payload.Object_Vector{
-		Id:        uuid,
-		Vector:    vec,
-		Timestamp: ts,
+		Id:         uuid,
+		Vector:     vec,
+		Timestamp:  ts,
+		Sparse:     svec.ToPayload(),
+		SubVectors: subs,
+		Attributes: attrs.ToPayload(),
 	}, nil
 }
 
@@ -103,10 +133,14 @@ func (s *server) StreamGetObject(stream vald.Object_StreamGetObjectServer) (err
 }
 
 func (s *server) StreamListObject(
-	_ *payload.Object_List_Request, stream vald.Object_StreamListObjectServer,
+	req *payload.Object_List_Request, stream vald.Object_StreamListObjectServer,
 ) (err error) {
 	ctx, span := trace.StartSpan(stream.Context(), apiName+"/"+vald.StreamListObjectRPCName)
 	defer trace.End(span)
+	if s, err = s.withCollection(span, vald.StreamListObjectRPCName, ngtResourceType+"/ngt.StreamListObject",
+		req.GetCollection(), req.GetCollection(), req); err != nil {
+		return err
+	}
 
 	var (
 		mu   sync.Mutex
@@ -115,10 +149,17 @@ func (s *server) StreamListObject(
 		emap = make(map[string]struct{})
 	)
 	s.ngt.ListObjectFunc(ctx, func(uuid string, _ uint32, _ int64) bool {
-		vec, ts, err := s.ngt.GetObject(uuid)
+		if doc, i, ok := multivector.Parse(uuid); ok {
+			// the multi-vector document is listed once at its first sub-vector.
+			if i != 0 {
+				return true
+			}
+			uuid = doc
+		}
+		vec, err := s.getObject(uuid)
 		var res *payload.Object_List_Response
-		if err != nil {
-			st := status.CreateWithNotFound(fmt.Sprintf("failed to get object with uuid: %s", uuid), err)
+		if err != nil || vec == nil {
+			st := status.CreateWithNotFound(fmt.Sprintf("failed to get object with uuid: %s", uuid), errors.ErrObjectNotFound(err, uuid))
 			res = &payload.Object_List_Response{
 				Payload: &payload.Object_List_Response_Status{
 					Status: st.Proto(),
@@ -127,11 +168,7 @@ func (s *server) StreamListObject(
 		} else {
 			res = &payload.Object_List_Response{
 				Payload: &payload.Object_List_Response_Vector{
-					Vector: &payload.Object_Vector{
-						Id:        uuid,
-						Vector:    vec,
-						Timestamp: ts,
-					},
+					Vector: vec,
 				},
 			}
 		}
@@ -181,12 +218,19 @@ func (s *server) GetTimestamp(
 ) (res *payload.Object_Timestamp, err error) {
 	_, span := trace.StartSpan(ctx, apiName+"/"+vald.GetTimestampRPCName)
 	defer trace.End(span)
+	if s, err = s.withCollection(span, vald.GetTimestampRPCName, ngtResourceType+"/ngt.GetTimestamp",
+		id.GetId().GetId(), id.GetCollection(), id); err != nil {
+		return nil, err
+	}
 	uuid := id.GetId().GetId()
 	if err = s.validateUUID(span, vald.GetTimestampRPCName, ngtResourceType+"/ngt.GetTimestamp",
 		uuid, id); err != nil {
 		return nil, err
 	}
 	_, ts, err := s.ngt.GetObject(uuid)
+	if err != nil {
+		_, ts, err = s.ngt.GetMultiVector(uuid)
+	}
 	if err != nil {
 		err = status.New(codes.NotFound, errors.ErrObjectNotFound(err, uuid).Error()).Err()
 		return errhandler.HandleError[payload.Object_Timestamp](span, codes.NotFound, err)
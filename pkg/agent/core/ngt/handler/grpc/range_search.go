@@ -0,0 +1,222 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grpc
+
+import (
+	"context"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/algorithm/rangesearch"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/info"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/errdetails"
+	"github.com/vdaas/vald/internal/net/grpc/errhandler"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/attribute"
+	"github.com/vdaas/vald/internal/observability/trace"
+)
+
+// RangeSearch returns a page of the vectors within the radius following the cursor.
+// The search stops as soon as the page is filled, so the vectors after the page are not searched.
+func (s *server) RangeSearch(
+	ctx context.Context, req *payload.Search_RangeRequest,
+) (res *payload.Search_RangeResponse, err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/"+vald.RangeSearchRPCName)
+	defer trace.End(span)
+	size := rangesearch.PageSize(req.GetConfig().GetPageSize())
+	page := make([]*payload.Object_Distance, 0, size+1)
+	partial, err := s.rangeSearch(ctx, span, vald.RangeSearchRPCName, req, func(results []*payload.Object_Distance) bool {
+		// one more result than the page size is collected to know whether the next page exists.
+		page = append(page, results[:min(len(results), size+1-len(page))]...)
+		return len(page) <= size
+	})
+	if err != nil {
+		return nil, err
+	}
+	res = &payload.Search_RangeResponse{
+		RequestId: req.GetConfig().GetRequestId(),
+		Results:   page,
+		Partial:   partial,
+	}
+	if len(page) > size {
+		res.Results = page[:size]
+		res.NextCursor = rangesearch.Encode(page[size-1])
+	}
+	return res, nil
+}
+
+// StreamRangeSearch streams the pages of the vectors within the radius following the cursor.
+// The pages are sent as the results are found, so only the current page is held.
+func (s *server) StreamRangeSearch(
+	req *payload.Search_RangeRequest, stream vald.Search_StreamRangeSearchServer,
+) (err error) {
+	ctx, span := trace.StartSpan(stream.Context(), apiName+"/"+vald.StreamRangeSearchRPCName)
+	defer trace.End(span)
+	size := rangesearch.PageSize(req.GetConfig().GetPageSize())
+	page := make([]*payload.Object_Distance, 0, size)
+	send := func(page []*payload.Object_Distance, next string, partial bool) error {
+		err := stream.Send(&payload.Search_RangeResponse{
+			RequestId:  req.GetConfig().GetRequestId(),
+			Results:    page,
+			NextCursor: next,
+			Partial:    partial,
+		})
+		if err != nil {
+			if span != nil {
+				st, msg, err := status.ParseError(err, codes.Internal, "failed to parse StreamRangeSearch send gRPC error response")
+				span.RecordError(err)
+				span.SetAttributes(trace.FromGRPCStatus(st.Code(), msg)...)
+				span.SetStatus(trace.StatusError, err.Error())
+			}
+			return errors.ErrServerStreamServerSend(err)
+		}
+		return nil
+	}
+	var serr error
+	partial, err := s.rangeSearch(ctx, span, vald.StreamRangeSearchRPCName, req, func(results []*payload.Object_Distance) bool {
+		for _, r := range results {
+			// the full page is held until the next result is found, so that the last page has no next cursor.
+			if len(page) == size {
+				if serr = send(page, rangesearch.Encode(page[size-1]), false); serr != nil {
+					return false
+				}
+				page = make([]*payload.Object_Distance, 0, size)
+			}
+			page = append(page, r)
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if serr != nil {
+		return serr
+	}
+	return send(page, "", partial)
+}
+
+// rangeSearch validates the range search request and calls f with the vectors within the radius following the cursor
+// sorted by the distance and the ID, until f returns false.
+func (s *server) rangeSearch(
+	ctx context.Context, span trace.Span, rpcName string, req *payload.Search_RangeRequest,
+	f func([]*payload.Object_Distance) bool,
+) (partial bool, err error) {
+	resourceType := ngtResourceType + "/ngt." + rpcName
+	reqID := req.GetConfig().GetRequestId()
+	if s, err = s.withCollection(span, rpcName, resourceType,
+		reqID, req.GetConfig().GetCollection(), req); err != nil {
+		return false, err
+	}
+	if err = s.validateVectorDimension(span, rpcName, resourceType, reqID, req,
+		len(req.GetVector()), s.ngt.GetDimensionSize()); err != nil {
+		return false, err
+	}
+	after, err := rangesearch.Decode(req.GetConfig().GetCursor())
+	if err != nil {
+		err = status.WrapWithInvalidArgument(rpcName+" API invalid cursor detected", err,
+			&errdetails.RequestInfo{
+				RequestId:   reqID,
+				ServingData: errdetails.Serialize(req),
+			},
+			&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequestFieldViolation{
+					{
+						Field:       "cursor",
+						Description: err.Error(),
+					},
+				},
+			},
+			s.resourceInfo(resourceType))
+		log.Warn(err)
+		errhandler.RecordSpanError(span, codes.InvalidArgument, err)
+		return false, err
+	}
+	pred, err := s.parsePredicate(span, rpcName, resourceType, reqID, req.GetConfig().GetPredicate(), req)
+	if err != nil {
+		return false, err
+	}
+	partial, err = s.ngt.RangeSearch(ctx,
+		req.GetVector(),
+		req.GetConfig().GetRadius(),
+		req.GetConfig().GetEpsilon(),
+		pred,
+		after,
+		f)
+	if err != nil {
+		var attrs []attribute.KeyValue
+		switch {
+		case errors.Is(err, errors.ErrCreateIndexingIsInProgress):
+			err = status.WrapWithAborted(rpcName+" API aborted to process range search request due to creating indices is in progress", err,
+				&errdetails.RequestInfo{
+					RequestId:   reqID,
+					ServingData: errdetails.Serialize(req),
+				},
+				s.resourceInfo(resourceType))
+			log.Debug(err)
+			attrs = trace.StatusCodeAborted(err.Error())
+		case errors.Is(err, errors.ErrFlushingIsInProgress):
+			err = status.WrapWithAborted(rpcName+" API aborted to process range search request due to flushing indices is in progress", err,
+				&errdetails.RequestInfo{
+					RequestId:   reqID,
+					ServingData: errdetails.Serialize(req),
+				},
+				s.resourceInfo(resourceType))
+			log.Debug(err)
+			attrs = trace.StatusCodeAborted(err.Error())
+		case errors.Is(err, context.Canceled):
+			err = status.WrapWithCanceled(rpcName+" API canceled", err,
+				&errdetails.RequestInfo{
+					RequestId:   reqID,
+					ServingData: errdetails.Serialize(req),
+				},
+				s.resourceInfo(resourceType))
+			log.Debug(err)
+			attrs = trace.StatusCodeCancelled(err.Error())
+		case errors.Is(err, context.DeadlineExceeded):
+			err = status.WrapWithDeadlineExceeded(rpcName+" API deadline exceeded", err,
+				&errdetails.RequestInfo{
+					RequestId:   reqID,
+					ServingData: errdetails.Serialize(req),
+				},
+				s.resourceInfo(resourceType))
+			log.Debug(err)
+			attrs = trace.StatusCodeDeadlineExceeded(err.Error())
+		case errors.As(err, &errNGT):
+			log.Errorf("ngt core process returned error: %v", err)
+			err = status.WrapWithInternal(rpcName+" API failed to process range search request due to ngt core process returned error", err,
+				&errdetails.RequestInfo{
+					RequestId:   reqID,
+					ServingData: errdetails.Serialize(req),
+				},
+				s.resourceInfo(resourceType+"/core.ngt"), info.Get())
+			log.Error(err)
+			attrs = trace.StatusCodeInternal(err.Error())
+		default:
+			err = status.WrapWithInternal(rpcName+" API failed to process range search request", err,
+				&errdetails.RequestInfo{
+					RequestId:   reqID,
+					ServingData: errdetails.Serialize(req),
+				},
+				s.resourceInfo(resourceType), info.Get())
+			log.Error(err)
+			attrs = trace.StatusCodeInternal(err.Error())
+		}
+		errhandler.RecordSpanAttrs(span, attrs, err)
+		return false, err
+	}
+	return partial, nil
+}
@@ -38,6 +38,10 @@ func (s *server) Remove(
 ) (res *payload.Object_Location, err error) {
 	_, span := trace.StartSpan(ctx, apiName+"/"+vald.RemoveRPCName)
 	defer trace.End(span)
+	if s, err = s.withWriteCollection(span, vald.RemoveRPCName, ngtResourceType+"/ngt.Remove",
+		req.GetId().GetId(), req.GetConfig().GetCollection(), req); err != nil {
+		return nil, err
+	}
 	id := req.GetId()
 	uuid := id.GetId()
 	if err = s.validateUUID(span, vald.RemoveRPCName, ngtResourceType+"/ngt.Remove",
@@ -134,6 +138,14 @@ func (s *server) MultiRemove(
 ) (res *payload.Object_Locations, err error) {
 	_, span := trace.StartSpan(ctx, apiName+"/"+vald.MultiRemoveRPCName)
 	defer trace.End(span)
+	if groups := splitByCollection(reqs.GetRequests(), s.redirected, func(req *payload.Remove_Request) string {
+		return req.GetConfig().GetCollection()
+	}); groups != nil && len(s.collection) == 0 {
+		return multiByCollection(span, s, vald.MultiRemoveRPCName, ngtResourceType+"/ngt.MultiRemove", groups, reqs,
+			func(s *server, reqs []*payload.Remove_Request) (*payload.Object_Locations, error) {
+				return s.MultiRemove(ctx, &payload.Remove_MultiRequest{Requests: reqs})
+			})
+	}
 	uuids := make([]string, 0, len(reqs.GetRequests()))
 	for _, req := range reqs.GetRequests() {
 		uuids = append(uuids, req.GetId().GetId())
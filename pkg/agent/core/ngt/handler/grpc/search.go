@@ -32,6 +32,8 @@ import (
 	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/internal/strings"
 	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/pkg/agent/internal/multivector"
+	"github.com/vdaas/vald/pkg/agent/internal/pagination"
 )
 
 func (s *server) Search(
@@ -39,7 +41,16 @@ func (s *server) Search(
 ) (res *payload.Search_Response, err error) {
 	_, span := trace.StartSpan(ctx, apiName+"/"+vald.SearchRPCName)
 	defer trace.End(span)
-	if len(req.GetVector()) != s.ngt.GetDimensionSize() {
+	if s, err = s.withCollection(span, vald.SearchRPCName, ngtResourceType+"/ngt.Search",
+		req.GetConfig().GetRequestId(), req.GetConfig().GetCollection(), req); err != nil {
+		return nil, err
+	}
+	subs, err := s.parseSubVectors(span, vald.SearchRPCName, ngtResourceType+"/ngt.Search",
+		req.GetConfig().GetRequestId(), req.GetSubVectors(), req)
+	if err != nil {
+		return nil, err
+	}
+	if len(subs) == 0 && len(req.GetVector()) != s.ngt.GetDimensionSize() {
 		err = errors.ErrIncompatibleDimensionSize(len(req.GetVector()), int(s.ngt.GetDimensionSize()))
 		err = status.WrapWithInvalidArgument("Search API Incompatible Dimension Size detected",
 			err,
@@ -61,12 +72,64 @@ func (s *server) Search(
 		log.Warn(err)
 		return errhandler.HandleError[payload.Search_Response](span, codes.InvalidArgument, err)
 	}
-	res, err = s.ngt.Search(ctx,
-		req.GetVector(),
-		req.GetConfig().GetNum(),
-		req.GetConfig().GetEpsilon(),
-		req.GetConfig().GetRadius(),
-		req.GetConfig().GetEdgeSize())
+	pred, err := s.parsePredicate(span, vald.SearchRPCName, ngtResourceType+"/ngt.Search",
+		req.GetConfig().GetRequestId(), req.GetConfig().GetPredicate(), req)
+	if err != nil {
+		return nil, err
+	}
+	svec, err := s.parseSparseVector(span, vald.SearchRPCName, ngtResourceType+"/ngt.Search",
+		req.GetConfig().GetRequestId(), req.GetSparse(), req)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(subs) != 0:
+		err = s.validateConsistency(span, vald.SearchRPCName, ngtResourceType+"/ngt.SearchMultiVector",
+			req.GetConfig().GetRequestId(), "multi-vector search", req.GetConfig(), req)
+	case svec.Len() != 0:
+		err = s.validateConsistency(span, vald.SearchRPCName, ngtResourceType+"/ngt.SearchHybrid",
+			req.GetConfig().GetRequestId(), "hybrid search", req.GetConfig(), req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	search := func(num uint32) (*payload.Search_Response, error) {
+		switch {
+		case len(subs) != 0:
+			return s.ngt.SearchMultiVector(ctx,
+				subs,
+				num,
+				req.GetConfig().GetEpsilon(),
+				req.GetConfig().GetRadius(),
+				req.GetConfig().GetEdgeSize(),
+				pred,
+				multivector.FromPayload(req.GetConfig().GetMultiVectorAggregation()))
+		case svec.Len() != 0:
+			return s.ngt.SearchHybrid(ctx,
+				req.GetVector(),
+				svec,
+				num,
+				req.GetConfig().GetEpsilon(),
+				req.GetConfig().GetRadius(),
+				req.GetConfig().GetEdgeSize(),
+				pred,
+				req.GetConfig().GetFusion())
+		default:
+			res, err := s.ngt.Search(ctx,
+				req.GetVector(),
+				num,
+				req.GetConfig().GetEpsilon(),
+				req.GetConfig().GetRadius(),
+				req.GetConfig().GetEdgeSize(),
+				pred)
+			return s.searchQueued(ctx, req.GetConfig(), req.GetVector(), num, pred, res, err)
+		}
+	}
+	if req.GetConfig().AfterDistance != nil {
+		res, err = pagination.SearchAfter(req.GetConfig(), s.ngt.Len(), search)
+	} else {
+		res, err = search(req.GetConfig().GetNum())
+	}
 	if err == nil && res == nil {
 		return nil, nil
 	}
@@ -154,17 +217,30 @@ func (s *server) SearchByID(
 ) (res *payload.Search_Response, err error) {
 	_, span := trace.StartSpan(ctx, apiName+"/"+vald.SearchByIDRPCName)
 	defer trace.End(span)
+	if s, err = s.withCollection(span, vald.SearchByIDRPCName, ngtResourceType+"/ngt.SearchByID",
+		req.GetId(), req.GetConfig().GetCollection(), req); err != nil {
+		return nil, err
+	}
 	uuid := req.GetId()
 	if err = s.validateUUID(span, vald.SearchByIDRPCName, ngtResourceType+"/ngt.SearchByID",
 		uuid, req); err != nil {
 		return nil, err
 	}
+	pred, err := s.parsePredicate(span, vald.SearchByIDRPCName, ngtResourceType+"/ngt.SearchByID",
+		req.GetConfig().GetRequestId(), req.GetConfig().GetPredicate(), req)
+	if err != nil {
+		return nil, err
+	}
 	vec, res, err := s.ngt.SearchByID(ctx,
 		uuid,
 		req.GetConfig().GetNum(),
 		req.GetConfig().GetEpsilon(),
 		req.GetConfig().GetRadius(),
-		req.GetConfig().GetEdgeSize())
+		req.GetConfig().GetEdgeSize(),
+		pred)
+	if vec != nil {
+		res, err = s.searchQueued(ctx, req.GetConfig(), vec, req.GetConfig().GetNum(), pred, res, err)
+	}
 	if err == nil && res == nil {
 		return nil, nil
 	}
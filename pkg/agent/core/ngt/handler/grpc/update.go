@@ -30,6 +30,7 @@ import (
 	"github.com/vdaas/vald/internal/observability/attribute"
 	"github.com/vdaas/vald/internal/observability/trace"
 	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/pkg/agent/internal/sparse"
 )
 
 func (s *server) Update(
@@ -37,9 +38,25 @@ func (s *server) Update(
 ) (res *payload.Object_Location, err error) {
 	_, span := trace.StartSpan(ctx, apiName+"/"+vald.UpdateRPCName)
 	defer trace.End(span)
+	if s, err = s.withWriteCollection(span, vald.UpdateRPCName, ngtResourceType+"/ngt.Update",
+		req.GetVector().GetId(), req.GetConfig().GetCollection(), req); err != nil {
+		return nil, err
+	}
 	vec := req.GetVector()
-	if err = s.validateVectorDimension(span, vald.UpdateRPCName, ngtResourceType+"/ngt.Update",
-		vec.GetId(), req, len(vec.GetVector()), s.ngt.GetDimensionSize()); err != nil {
+	subs, err := s.parseSubVectors(span, vald.UpdateRPCName, ngtResourceType+"/ngt.Update",
+		vec.GetId(), vec.GetSubVectors(), req)
+	if err != nil {
+		return nil, err
+	}
+	if len(subs) == 0 {
+		if err = s.validateVectorDimension(span, vald.UpdateRPCName, ngtResourceType+"/ngt.Update",
+			vec.GetId(), req, len(vec.GetVector()), s.ngt.GetDimensionSize()); err != nil {
+			return nil, err
+		}
+	}
+	svec, err := s.parseSparseVector(span, vald.UpdateRPCName, ngtResourceType+"/ngt.Update",
+		vec.GetId(), vec.GetSparse(), req)
+	if err != nil {
 		return nil, err
 	}
 	uuid := vec.GetId()
@@ -49,7 +66,11 @@ func (s *server) Update(
 	if err = s.validateUUID(span, vald.UpdateRPCName, ngtResourceType+"/ngt.Update", uuid, req); err != nil {
 		return nil, err
 	}
-	err = s.ngt.UpdateWithTime(uuid, vec.GetVector(), req.GetConfig().GetTimestamp())
+	if len(subs) != 0 {
+		err = s.ngt.UpdateMultiVectorWithTime(uuid, subs, req.GetConfig().GetTimestamp())
+	} else {
+		err = s.ngt.UpdateWithTime(uuid, vec.GetVector(), req.GetConfig().GetTimestamp())
+	}
 	if err != nil {
 		var attrs []attribute.KeyValue
 		if errors.Is(err, errors.ErrFlushingIsInProgress) {
@@ -109,6 +130,8 @@ func (s *server) Update(
 		errhandler.RecordSpanAttrs(span, attrs, err)
 		return nil, err
 	}
+	s.setAttributes(vec.GetId(), req.GetConfig().GetAttributes())
+	s.setSparseVector(vec.GetId(), svec)
 	return s.newLocation(vec.GetId()), nil
 }
 
@@ -148,11 +171,26 @@ func (s *server) MultiUpdate(
 ) (res *payload.Object_Locations, err error) {
 	_, span := trace.StartSpan(ctx, apiName+"/"+vald.MultiUpdateRPCName)
 	defer trace.End(span)
+	if groups := splitByCollection(reqs.GetRequests(), s.redirected, func(req *payload.Update_Request) string {
+		return req.GetConfig().GetCollection()
+	}); groups != nil && len(s.collection) == 0 {
+		return multiByCollection(span, s, vald.MultiUpdateRPCName, ngtResourceType+"/ngt.MultiUpdate", groups, reqs,
+			func(s *server, reqs []*payload.Update_Request) (*payload.Object_Locations, error) {
+				return s.MultiUpdate(ctx, &payload.Update_MultiRequest{Requests: reqs})
+			})
+	}
 
 	uuids := make([]string, 0, len(reqs.GetRequests()))
 	vmap := make(map[string][]float32, len(reqs.GetRequests()))
+	smap := make(map[string]sparse.Vector, len(reqs.GetRequests()))
+	var docs []*payload.Update_Request
 	for _, req := range reqs.GetRequests() {
 		vec := req.GetVector()
+		if len(vec.GetSubVectors()) != 0 {
+			// the sub-vectors of the multi-vector document are updated together after the other vectors.
+			docs = append(docs, req)
+			continue
+		}
 		if len(vec.GetVector()) != s.ngt.GetDimensionSize() {
 			err = errors.ErrIncompatibleDimensionSize(len(vec.GetVector()), int(s.ngt.GetDimensionSize()))
 			err = status.WrapWithInvalidArgument("MultiUpdate API Incompatible Dimension Size detected",
@@ -173,6 +211,12 @@ func (s *server) MultiUpdate(
 			log.Warn(err)
 			return errhandler.HandleError[payload.Object_Locations](span, codes.InvalidArgument, err)
 		}
+		svec, err := s.parseSparseVector(span, vald.MultiUpdateRPCName, ngtResourceType+"/ngt.MultiUpdate",
+			vec.GetId(), vec.GetSparse(), req)
+		if err != nil {
+			return nil, err
+		}
+		smap[vec.GetId()] = svec
 		vmap[vec.GetId()] = vec.GetVector()
 		uuids = append(uuids, vec.GetId())
 	}
@@ -261,6 +305,20 @@ func (s *server) MultiUpdate(
 		errhandler.RecordSpanAttrs(span, attrs, err)
 		return nil, err
 	}
+	for _, req := range reqs.GetRequests() {
+		if len(req.GetVector().GetSubVectors()) != 0 {
+			continue
+		}
+		s.setAttributes(req.GetVector().GetId(), req.GetConfig().GetAttributes())
+		s.setSparseVector(req.GetVector().GetId(), smap[req.GetVector().GetId()])
+	}
+	for _, req := range docs {
+		_, err = s.Update(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		uuids = append(uuids, req.GetVector().GetId())
+	}
 	return s.newLocations(uuids...), nil
 }
 
@@ -269,6 +327,10 @@ func (s *server) UpdateTimestamp(
 ) (res *payload.Object_Location, err error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.UpdateRPCServiceName+"/"+vald.UpdateTimestampRPCName), apiName+"/"+vald.UpdateTimestampRPCName)
 	defer trace.End(span)
+	if s, err = s.withWriteCollection(span, vald.UpdateTimestampRPCName, ngtResourceType+"/ngt.UpdateTimestamp",
+		req.GetId(), req.GetCollection(), req); err != nil {
+		return nil, err
+	}
 	uuid := req.GetId()
 	reqInfo := &errdetails.RequestInfo{
 		RequestId:   uuid,
@@ -35,11 +35,22 @@ func (s *server) Upsert(
 ) (loc *payload.Object_Location, err error) {
 	ctx, span := trace.StartSpan(ctx, apiName+"/"+vald.UpsertRPCName)
 	defer trace.End(span)
+	if s, err = s.withWriteCollection(span, vald.UpsertRPCName, ngtResourceType+"/ngt.Upsert",
+		req.GetVector().GetId(), req.GetConfig().GetCollection(), req); err != nil {
+		return nil, err
+	}
 	vec := req.GetVector()
-	if err = s.validateVectorDimension(span, vald.UpsertRPCName, ngtResourceType+"/ngt.Upsert",
-		vec.GetId(), req, len(vec.GetVector()), s.ngt.GetDimensionSize()); err != nil {
+	subs, err := s.parseSubVectors(span, vald.UpsertRPCName, ngtResourceType+"/ngt.Upsert",
+		vec.GetId(), vec.GetSubVectors(), req)
+	if err != nil {
 		return nil, err
 	}
+	if len(subs) == 0 {
+		if err = s.validateVectorDimension(span, vald.UpsertRPCName, ngtResourceType+"/ngt.Upsert",
+			vec.GetId(), req, len(vec.GetVector()), s.ngt.GetDimensionSize()); err != nil {
+			return nil, err
+		}
+	}
 	uuid := vec.GetId()
 	// validateUUID builds the exact same InvalidArgument status this block used
 	// to build inline, and additionally records it on the span (the inline
@@ -56,6 +67,7 @@ func (s *server) Upsert(
 			Config: &payload.Update_Config{
 				Timestamp:            req.GetConfig().GetTimestamp(),
 				SkipStrictExistCheck: true,
+				Attributes:           req.GetConfig().GetAttributes(),
 			},
 		})
 		rtName += "/ngt.Update"
@@ -65,6 +77,7 @@ func (s *server) Upsert(
 			Config: &payload.Insert_Config{
 				Timestamp:            req.GetConfig().GetTimestamp(),
 				SkipStrictExistCheck: true,
+				Attributes:           req.GetConfig().GetAttributes(),
 			},
 		})
 		rtName += "/ngt.Insert"
@@ -122,6 +135,14 @@ func (s *server) MultiUpsert(
 ) (res *payload.Object_Locations, err error) {
 	ctx, span := trace.StartSpan(ctx, apiName+"/"+vald.MultiUpsertRPCName)
 	defer trace.End(span)
+	if groups := splitByCollection(reqs.GetRequests(), s.redirected, func(req *payload.Upsert_Request) string {
+		return req.GetConfig().GetCollection()
+	}); groups != nil && len(s.collection) == 0 {
+		return multiByCollection(span, s, vald.MultiUpsertRPCName, ngtResourceType+"/ngt.MultiUpsert", groups, reqs,
+			func(s *server, reqs []*payload.Upsert_Request) (*payload.Object_Locations, error) {
+				return s.MultiUpsert(ctx, &payload.Upsert_MultiRequest{Requests: reqs})
+			})
+	}
 
 	insertReqs := make([]*payload.Insert_Request, 0, len(reqs.GetRequests()))
 	updateReqs := make([]*payload.Update_Request, 0, len(reqs.GetRequests()))
@@ -129,7 +150,7 @@ func (s *server) MultiUpsert(
 	ids := make([]string, 0, len(reqs.GetRequests()))
 	for _, req := range reqs.GetRequests() {
 		vec := req.GetVector()
-		if len(vec.GetVector()) != s.ngt.GetDimensionSize() {
+		if len(vec.GetSubVectors()) == 0 && len(vec.GetVector()) != s.ngt.GetDimensionSize() {
 			err = errors.ErrIncompatibleDimensionSize(len(vec.GetVector()), int(s.ngt.GetDimensionSize()))
 			err = status.WrapWithInvalidArgument("MultiUpsert API Incompatible Dimension Size detected",
 				err,
@@ -157,6 +178,7 @@ func (s *server) MultiUpsert(
 				Config: &payload.Update_Config{
 					Timestamp:            req.GetConfig().GetTimestamp(),
 					SkipStrictExistCheck: true,
+					Attributes:           req.GetConfig().GetAttributes(),
 				},
 			})
 		} else {
@@ -165,6 +187,7 @@ func (s *server) MultiUpsert(
 				Config: &payload.Insert_Config{
 					Timestamp:            req.GetConfig().GetTimestamp(),
 					SkipStrictExistCheck: true,
+					Attributes:           req.GetConfig().GetAttributes(),
 				},
 			})
 		}
@@ -16,6 +16,7 @@ package grpc
 import (
 	"fmt"
 
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/log"
 	"github.com/vdaas/vald/internal/net/grpc/codes"
@@ -23,6 +24,8 @@ import (
 	"github.com/vdaas/vald/internal/net/grpc/errhandler"
 	"github.com/vdaas/vald/internal/net/grpc/status"
 	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/pkg/agent/internal/attribute"
+	"github.com/vdaas/vald/pkg/agent/internal/sparse"
 )
 
 // validateVectorDimension folds the up-front "incompatible dimension size"
@@ -99,3 +102,182 @@ func (s *server) validateUUID(
 	errhandler.RecordSpanError(span, codes.InvalidArgument, err)
 	return err
 }
+
+// validateConsistency rejects the read-your-writes consistency of cfg for the
+// search which does not merge the vectors written but not committed to the
+// index yet. It returns nil for the other consistency levels, otherwise it
+// builds an InvalidArgument status with the "consistency" BadRequest field
+// violation, logs it at Warn, records it on span, and returns it.
+func (s *server) validateConsistency(
+	span trace.Span, rpcName, resourceType, id, search string, cfg *payload.Search_Config, servingData any,
+) error {
+	if cfg.GetConsistency() != payload.Search_ReadYourWrites {
+		return nil
+	}
+	err := errors.ErrReadYourWritesNotSupported(search)
+	err = status.WrapWithInvalidArgument(rpcName+" API unsupported consistency detected", err,
+		&errdetails.RequestInfo{
+			RequestId:   id,
+			ServingData: errdetails.Serialize(servingData),
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequestFieldViolation{
+				{
+					Field:       "consistency",
+					Description: err.Error(),
+				},
+			},
+		},
+		s.resourceInfo(resourceType))
+	log.Warn(err)
+	errhandler.RecordSpanError(span, codes.InvalidArgument, err)
+	return err
+}
+
+// parsePredicate compiles the attribute predicate expression of the search
+// request. It returns nil Predicate when the expression is empty, otherwise
+// when the expression is malformed it builds an InvalidArgument status with
+// the "predicate" BadRequest field violation, logs it at Warn, records it on
+// span, and returns it.
+func (s *server) parsePredicate(
+	span trace.Span, rpcName, resourceType, id, expr string, servingData any,
+) (attribute.Predicate, error) {
+	pred, err := attribute.Parse(expr)
+	if err == nil {
+		return pred, nil
+	}
+	err = status.WrapWithInvalidArgument(rpcName+" API invalid attribute predicate detected", err,
+		&errdetails.RequestInfo{
+			RequestId:   id,
+			ServingData: errdetails.Serialize(servingData),
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequestFieldViolation{
+				{
+					Field:       "predicate",
+					Description: err.Error(),
+				},
+			},
+		},
+		s.resourceInfo(resourceType))
+	log.Warn(err)
+	errhandler.RecordSpanError(span, codes.InvalidArgument, err)
+	return nil, err
+}
+
+// parseSparseVector converts the sparse vector of the request. It returns the
+// empty Vector when the request has no sparse vector, otherwise when the
+// indices and values are inconsistent it builds an InvalidArgument status with
+// the "sparse vector" BadRequest field violation, logs it at Warn, records it
+// on span, and returns it.
+func (s *server) parseSparseVector(
+	span trace.Span, rpcName, resourceType, id string, sv *payload.Object_SparseVector, servingData any,
+) (sparse.Vector, error) {
+	vec, err := sparse.FromPayload(sv)
+	if err == nil {
+		return vec, nil
+	}
+	err = status.WrapWithInvalidArgument(rpcName+" API invalid sparse vector detected", err,
+		&errdetails.RequestInfo{
+			RequestId:   id,
+			ServingData: errdetails.Serialize(servingData),
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequestFieldViolation{
+				{
+					Field:       "sparse vector",
+					Description: err.Error(),
+				},
+			},
+		},
+		s.resourceInfo(resourceType))
+	log.Warn(err)
+	errhandler.RecordSpanError(span, codes.InvalidArgument, err)
+	return sparse.Vector{}, err
+}
+
+// parseSubVectors converts the sub-vectors of the multi-vector document or the
+// query vectors of the multi-vector search. It returns nil when the request has
+// no sub-vectors, otherwise when the dimension of any sub-vector does not match
+// the index it returns the same InvalidArgument status as
+// validateVectorDimension.
+func (s *server) parseSubVectors(
+	span trace.Span, rpcName, resourceType, id string, svs []*payload.Object_SubVector, servingData any,
+) ([][]float32, error) {
+	if len(svs) == 0 {
+		return nil, nil
+	}
+	vecs := make([][]float32, 0, len(svs))
+	for _, sv := range svs {
+		if err := s.validateVectorDimension(span, rpcName, resourceType, id, servingData,
+			len(sv.GetVector()), s.ngt.GetDimensionSize()); err != nil {
+			return nil, err
+		}
+		vecs = append(vecs, sv.GetVector())
+	}
+	return vecs, nil
+}
+
+// withCollection returns the server which serves the named collection of the
+// request, following the switch of the collection. It returns the server itself
+// when the switched name is empty or the server already serves a named
+// collection, otherwise when the collection does not exist it builds a NotFound
+// status with the "collection" ResourceInfo, logs it at Warn, records it on
+// span, and returns it. Callers replace the receiver with the returned server:
+//
+//	if s, err = s.withCollection(span, vald.SearchRPCName,
+//		ngtResourceType+"/ngt.Search", req.GetConfig().GetRequestId(),
+//		req.GetConfig().GetCollection(), req); err != nil {
+//		return nil, err
+//	}
+func (s *server) withCollection(
+	span trace.Span, rpcName, resourceType, id, name string, servingData any,
+) (*server, error) {
+	if len(s.collection) != 0 {
+		return s, nil
+	}
+	name, _ = s.ngt.ResolveCollection(name)
+	if len(name) == 0 {
+		return s, nil
+	}
+	n, err := s.ngt.Collection(name)
+	if err == nil {
+		cs := *s
+		cs.ngt = n
+		cs.collection = name
+		return &cs, nil
+	}
+	err = status.WrapWithNotFound(fmt.Sprintf("%s API collection %s not found", rpcName, name), err,
+		&errdetails.RequestInfo{
+			RequestId:   id,
+			ServingData: errdetails.Serialize(servingData),
+		},
+		s.resourceInfo(resourceType))
+	log.Warn(err)
+	errhandler.RecordSpanError(span, codes.NotFound, err)
+	return nil, err
+}
+
+// withWriteCollection is withCollection for the write requests. When the named
+// collection is fenced it builds a FailedPrecondition status with the
+// "collection" ResourceInfo, logs it at Warn, records it on span, and returns it.
+func (s *server) withWriteCollection(
+	span trace.Span, rpcName, resourceType, id, name string, servingData any,
+) (*server, error) {
+	if len(s.collection) != 0 {
+		return s, nil
+	}
+	if _, fenced := s.ngt.ResolveCollection(name); fenced {
+		err := errors.ErrCollectionFenced(name)
+		err = status.WrapWithFailedPrecondition(fmt.Sprintf("%s API collection %q is fenced", rpcName, name), err,
+			&errdetails.RequestInfo{
+				RequestId:   id,
+				ServingData: errdetails.Serialize(servingData),
+			},
+			s.resourceInfo(resourceType))
+		log.Warn(err)
+		errhandler.RecordSpanError(span, codes.FailedPrecondition, err)
+		return nil, err
+	}
+	return s.withCollection(span, rpcName, resourceType, id, name, servingData)
+}
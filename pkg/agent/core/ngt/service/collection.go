@@ -0,0 +1,490 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"slices"
+	"strings"
+
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/os"
+	"github.com/vdaas/vald/internal/safety"
+)
+
+// CollectionConfig represents the configuration of the named collection which has its own index in the agent.
+type CollectionConfig struct {
+	Name         string `json:"name"          yaml:"name"`
+	Dimension    int    `json:"dimension"     yaml:"dimension"`
+	DistanceType string `json:"distance_type" yaml:"distance_type"`
+	ObjectType   string `json:"object_type"   yaml:"object_type"`
+}
+
+// CollectionSwitch represents the switch of the requests from the named collection to the target collection,
+// or the fence which rejects the write requests to the named collection when the target is empty.
+type CollectionSwitch struct {
+	Name   string `json:"name"            yaml:"name"`
+	Target string `json:"target"          yaml:"target"`
+	Fence  bool   `json:"fence,omitempty" yaml:"fence"`
+}
+
+type collection struct {
+	cfg    CollectionConfig
+	ngt    *ngt
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+const (
+	collectionDirName        = "collections"
+	collectionConfigFileName = "collections.json"
+	collectionSwitchFileName = "switches.json"
+
+	maxCollectionNameLength = 63
+)
+
+// isValidCollectionName reports whether the name can be used as the collection name.
+// The name is used as the directory name of the index, so only the alphanumeric characters, '-' and '_' are allowed.
+func isValidCollectionName(name string) bool {
+	if len(name) == 0 || len(name) > maxCollectionNameLength {
+		return false
+	}
+	return !strings.ContainsFunc(name, func(r rune) bool {
+		return (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' && r != '_'
+	})
+}
+
+func (n *ngt) collectionsPath() string {
+	return file.Join(n.basePath, collectionDirName)
+}
+
+// newCollection creates the NGT service of the named collection.
+// The collection inherits the configuration of the default collection except the dimension and the types, and stores its index under the collections directory.
+func (n *ngt) newCollection(cc CollectionConfig) (*ngt, error) {
+	cfg := *n.cfg
+	cfg.Dimension = cc.Dimension
+	cfg.DistanceType = cc.DistanceType
+	cfg.ObjectType = cc.ObjectType
+	// only the default collection exports the index information to the pod.
+	cfg.EnableExportIndexInfoToK8s = false
+	opts := slices.Clone(n.opts)
+	if !n.inMem {
+		opts = append(opts, WithIndexPath(file.Join(n.collectionsPath(), cc.Name)))
+	}
+	return newNGT(&cfg, opts...)
+}
+
+// loadCollections loads the named collections stored in the index path.
+func (n *ngt) loadCollections() (err error) {
+	n.collections = make(map[string]*collection)
+	if n.inMem {
+		return nil
+	}
+	path := file.Join(n.collectionsPath(), collectionConfigFileName)
+	if !file.Exists(path) {
+		return nil
+	}
+	b, err := file.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var cfgs []CollectionConfig
+	err = json.Unmarshal(b, &cfgs)
+	if err != nil {
+		return err
+	}
+	for _, cc := range cfgs {
+		nn, err := n.newCollection(cc)
+		if err != nil {
+			return errors.Join(err, n.closeCollections(context.Background()))
+		}
+		n.collections[cc.Name] = &collection{
+			cfg: cc,
+			ngt: nn,
+		}
+		log.Infof("collection %s loaded, dimension: %d, distance type: %s, object type: %s", cc.Name, cc.Dimension, cc.DistanceType, cc.ObjectType)
+	}
+	return n.loadSwitches()
+}
+
+// loadSwitches loads the switches and the fences of the collections stored in the index path.
+func (n *ngt) loadSwitches() (err error) {
+	n.switches = make(map[string]string)
+	n.fences = make(map[string]struct{})
+	path := file.Join(n.collectionsPath(), collectionSwitchFileName)
+	if !file.Exists(path) {
+		return nil
+	}
+	b, err := file.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var sws []CollectionSwitch
+	err = json.Unmarshal(b, &sws)
+	if err != nil {
+		return err
+	}
+	for _, sw := range sws {
+		if sw.Fence {
+			n.fences[sw.Name] = struct{}{}
+			log.Infof("collection fence of %q loaded", sw.Name)
+			continue
+		}
+		if _, ok := n.collections[sw.Target]; !ok {
+			log.Warnf("collection switch from %q to %s ignored, the target collection is not found", sw.Name, sw.Target)
+			continue
+		}
+		n.switches[sw.Name] = sw.Target
+		log.Infof("collection switch from %q to %s loaded", sw.Name, sw.Target)
+	}
+	return nil
+}
+
+// storeCollections stores the configurations of the named collections to the index path.
+// The caller must hold the lock of the collections.
+func (n *ngt) storeCollections() (err error) {
+	if n.inMem {
+		return nil
+	}
+	cfgs := make([]CollectionConfig, 0, len(n.collections))
+	for _, c := range n.collections {
+		cfgs = append(cfgs, c.cfg)
+	}
+	slices.SortFunc(cfgs, func(a, b CollectionConfig) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	b, err := json.Marshal(cfgs)
+	if err != nil {
+		return err
+	}
+	err = file.MkdirAll(n.collectionsPath(), fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	_, err = file.OverWriteFile(context.Background(), file.Join(n.collectionsPath(), collectionConfigFileName), bytes.NewReader(b), fs.ModePerm)
+	return err
+}
+
+// storeSwitches stores the switches and the fences of the collections to the index path.
+// The caller must hold the lock of the collections.
+func (n *ngt) storeSwitches() (err error) {
+	if n.inMem {
+		return nil
+	}
+	b, err := json.Marshal(n.listSwitches())
+	if err != nil {
+		return err
+	}
+	err = file.MkdirAll(n.collectionsPath(), fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	_, err = file.OverWriteFile(context.Background(), file.Join(n.collectionsPath(), collectionSwitchFileName), bytes.NewReader(b), fs.ModePerm)
+	return err
+}
+
+// CreateCollection creates the named collection which has its own index.
+// The dimension and the types of the default collection are used when they are not specified.
+func (n *ngt) CreateCollection(ctx context.Context, cc CollectionConfig) (err error) {
+	if n.isReadReplica {
+		return errors.ErrWriteOperationToReadReplica
+	}
+	if !isValidCollectionName(cc.Name) {
+		return errors.ErrInvalidCollectionName(cc.Name)
+	}
+	if cc.Dimension == 0 {
+		cc.Dimension = n.cfg.Dimension
+	}
+	if cc.DistanceType == "" {
+		cc.DistanceType = n.cfg.DistanceType
+	}
+	if cc.ObjectType == "" {
+		cc.ObjectType = n.cfg.ObjectType
+	}
+
+	n.cmu.Lock()
+	defer n.cmu.Unlock()
+	if n.collections == nil {
+		n.collections = make(map[string]*collection)
+	}
+	if _, ok := n.collections[cc.Name]; ok {
+		return errors.ErrCollectionAlreadyExists(cc.Name)
+	}
+	nn, err := n.newCollection(cc)
+	if err != nil {
+		return err
+	}
+	c := &collection{
+		cfg: cc,
+		ngt: nn,
+	}
+	n.collections[cc.Name] = c
+	err = n.storeCollections()
+	if err != nil {
+		delete(n.collections, cc.Name)
+		return errors.Join(err, n.discardCollection(ctx, c))
+	}
+	if n.cctx != nil {
+		n.startCollection(n.cctx, c)
+	}
+	log.Infof("collection %s created, dimension: %d, distance type: %s, object type: %s", cc.Name, cc.Dimension, cc.DistanceType, cc.ObjectType)
+	return nil
+}
+
+// DropCollection drops the named collection and removes its index.
+func (n *ngt) DropCollection(ctx context.Context, name string) (err error) {
+	if n.isReadReplica {
+		return errors.ErrWriteOperationToReadReplica
+	}
+	n.cmu.Lock()
+	c, ok := n.collections[name]
+	if !ok {
+		n.cmu.Unlock()
+		return errors.ErrCollectionNotFound(name)
+	}
+	for _, target := range n.switches {
+		if target == name {
+			n.cmu.Unlock()
+			return errors.ErrCollectionSwitched(name)
+		}
+	}
+	delete(n.collections, name)
+	err = n.storeCollections()
+	if err != nil {
+		n.collections[name] = c
+		n.cmu.Unlock()
+		return err
+	}
+	n.cmu.Unlock()
+
+	c.stop()
+	err = n.discardCollection(ctx, c)
+	if err != nil {
+		return err
+	}
+	log.Infof("collection %s dropped", name)
+	return nil
+}
+
+// discardCollection releases the resources of the collection and removes its index without saving it.
+func (n *ngt) discardCollection(ctx context.Context, c *collection) (err error) {
+	nn := c.ngt
+	// wait for not indexing & not saving
+	if err = nn.waitIdle(ctx); err != nil {
+		return err
+	}
+	nn.cimu.Lock()
+	defer nn.cimu.Unlock()
+	// the dropped collection keeps flushing so that the remaining requests are rejected.
+	nn.flushing.Store(true)
+
+	if nn.refinement != nil {
+		err = errors.Join(err, nn.refinement.Close())
+	}
+	err = errors.Join(err, nn.vq.Close(), nn.kvs.Close(), nn.attrs.Close(), nn.sparse.Close())
+	nn.core.Close()
+	if !nn.inMem {
+		err = errors.Join(err, os.RemoveAll(nn.basePath))
+	}
+	return err
+}
+
+// Collection returns the NGT service of the named collection. The default collection is returned when the name is empty.
+func (n *ngt) Collection(name string) (NGT, error) {
+	if name == "" {
+		return n, nil
+	}
+	n.cmu.RLock()
+	c, ok := n.collections[name]
+	n.cmu.RUnlock()
+	if !ok {
+		return nil, errors.ErrCollectionNotFound(name)
+	}
+	return c.ngt, nil
+}
+
+// SwitchCollection switches the requests of the named collection to the target collection, and removes the switch when the target is empty.
+// The default collection is switched when the name is empty. The switch is not chained, so the target must not be switched and the name must not be the target of another switch.
+// When the fence is true, the target must be empty and the write requests to the named collection are rejected until the fence is removed by another switch.
+func (n *ngt) SwitchCollection(ctx context.Context, name, target string, fence bool) (err error) {
+	if n.isReadReplica {
+		return errors.ErrWriteOperationToReadReplica
+	}
+	if (len(target) != 0 && (name == target || fence)) || (len(name) != 0 && !isValidCollectionName(name)) {
+		return errors.ErrInvalidCollectionSwitch(name, target)
+	}
+
+	n.cmu.Lock()
+	defer n.cmu.Unlock()
+	if n.switches == nil {
+		n.switches = make(map[string]string)
+	}
+	if n.fences == nil {
+		n.fences = make(map[string]struct{})
+	}
+	prev, ok := n.switches[name]
+	_, fenced := n.fences[name]
+	if len(target) == 0 {
+		if !ok && fenced == fence {
+			return nil
+		}
+		delete(n.switches, name)
+		if fence {
+			n.fences[name] = struct{}{}
+		} else {
+			delete(n.fences, name)
+		}
+	} else {
+		if _, ok := n.collections[target]; !ok {
+			return errors.ErrCollectionNotFound(target)
+		}
+		if _, ok := n.switches[target]; ok {
+			return errors.ErrInvalidCollectionSwitch(name, target)
+		}
+		for _, t := range n.switches {
+			if t == name {
+				return errors.ErrInvalidCollectionSwitch(name, target)
+			}
+		}
+		n.switches[name] = target
+		delete(n.fences, name)
+	}
+	err = n.storeSwitches()
+	if err != nil {
+		if ok {
+			n.switches[name] = prev
+		} else {
+			delete(n.switches, name)
+		}
+		if fenced {
+			n.fences[name] = struct{}{}
+		} else {
+			delete(n.fences, name)
+		}
+		return err
+	}
+	log.Infof("collection switch from %q to %q stored, fence: %v", name, target, fence)
+	return nil
+}
+
+// ResolveCollection returns the name of the collection which serves the requests of the named collection,
+// and whether the write requests to the named collection are fenced.
+func (n *ngt) ResolveCollection(name string) (target string, fenced bool) {
+	n.cmu.RLock()
+	defer n.cmu.RUnlock()
+	target, ok := n.switches[name]
+	if !ok {
+		target = name
+	}
+	_, fenced = n.fences[target]
+	return target, fenced
+}
+
+// ListCollectionSwitches returns the switches and the fences of the collections sorted by the name.
+func (n *ngt) ListCollectionSwitches() []CollectionSwitch {
+	n.cmu.RLock()
+	defer n.cmu.RUnlock()
+	return n.listSwitches()
+}
+
+// listSwitches returns the switches and the fences sorted by the name. The caller must hold the lock of the collections.
+func (n *ngt) listSwitches() []CollectionSwitch {
+	sws := make([]CollectionSwitch, 0, len(n.switches)+len(n.fences))
+	for name, target := range n.switches {
+		sws = append(sws, CollectionSwitch{
+			Name:   name,
+			Target: target,
+		})
+	}
+	for name := range n.fences {
+		sws = append(sws, CollectionSwitch{
+			Name:  name,
+			Fence: true,
+		})
+	}
+	slices.SortFunc(sws, func(a, b CollectionSwitch) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return sws
+}
+
+// ListCollections returns the configurations of the named collections sorted by the name.
+func (n *ngt) ListCollections() []CollectionConfig {
+	n.cmu.RLock()
+	cfgs := make([]CollectionConfig, 0, len(n.collections))
+	for _, c := range n.collections {
+		cfgs = append(cfgs, c.cfg)
+	}
+	n.cmu.RUnlock()
+	slices.SortFunc(cfgs, func(a, b CollectionConfig) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return cfgs
+}
+
+// startCollections starts the auto indexing of the named collections, and keeps the context to start the collections created later.
+func (n *ngt) startCollections(ctx context.Context) {
+	n.cmu.Lock()
+	defer n.cmu.Unlock()
+	n.cctx = ctx
+	for _, c := range n.collections {
+		n.startCollection(ctx, c)
+	}
+}
+
+func (n *ngt) startCollection(ctx context.Context, c *collection) {
+	ctx, c.cancel = context.WithCancel(ctx)
+	ech := c.ngt.Start(ctx)
+	if ech == nil {
+		return
+	}
+	c.done = make(chan struct{})
+	n.eg.Go(safety.RecoverFunc(func() error {
+		defer close(c.done)
+		for err := range ech {
+			log.Errorf("failed to process auto indexing of collection %s: %v", c.cfg.Name, err)
+		}
+		return nil
+	}))
+}
+
+// stop stops the auto indexing of the collection and waits for it to finish.
+func (c *collection) stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	if c.done != nil {
+		<-c.done
+	}
+}
+
+// closeCollections closes the named collections with saving their indexes.
+func (n *ngt) closeCollections(ctx context.Context) (err error) {
+	n.cmu.Lock()
+	defer n.cmu.Unlock()
+	for name, c := range n.collections {
+		c.stop()
+		cerr := c.ngt.Close(ctx)
+		if cerr != nil {
+			err = errors.Join(err, errors.Wrapf(cerr, "failed to close collection %s", name))
+		}
+	}
+	return err
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/test/comparator"
+)
+
+func Test_isValidCollectionName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		arg  string
+		want bool
+	}{
+		{
+			name: "return true when the name consists of alphanumerics, hyphens and underscores",
+			arg:  "images-v2_small",
+			want: true,
+		},
+		{
+			name: "return true when the name has the maximum length",
+			arg:  strings.Repeat("a", maxCollectionNameLength),
+			want: true,
+		},
+		{
+			name: "return false when the name is empty",
+			arg:  "",
+			want: false,
+		},
+		{
+			name: "return false when the name is too long",
+			arg:  strings.Repeat("a", maxCollectionNameLength+1),
+			want: false,
+		},
+		{
+			name: "return false when the name contains a path separator",
+			arg:  "../default",
+			want: false,
+		},
+		{
+			name: "return false when the name contains a space",
+			arg:  "my collection",
+			want: false,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			if got := isValidCollectionName(test.arg); got != test.want {
+				tt.Errorf("got: %v, want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_ngt_SwitchCollection(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		name   string
+		target string
+		fence  bool
+	}
+	tests := []struct {
+		name     string
+		switches map[string]string
+		fences   []string
+		args     args
+		want     []CollectionSwitch
+		wantErr  error
+	}{
+		{
+			name: "switch the default collection to the named collection",
+			args: args{
+				target: "images-v2",
+			},
+			want: []CollectionSwitch{
+				{Name: "", Target: "images-v2"},
+			},
+		},
+		{
+			name: "switch the named collection to another named collection",
+			args: args{
+				name:   "images",
+				target: "images-v2",
+			},
+			want: []CollectionSwitch{
+				{Name: "images", Target: "images-v2"},
+			},
+		},
+		{
+			name: "remove the switch when the target is empty",
+			switches: map[string]string{
+				"images": "images-v2",
+			},
+			args: args{
+				name: "images",
+			},
+			want: []CollectionSwitch{},
+		},
+		{
+			name: "return error when the target collection does not exist",
+			args: args{
+				name:   "images",
+				target: "images-v3",
+			},
+			want:    []CollectionSwitch{},
+			wantErr: errors.ErrCollectionNotFound("images-v3"),
+		},
+		{
+			name: "return error when the collection is switched to itself",
+			args: args{
+				name:   "images",
+				target: "images",
+			},
+			want:    []CollectionSwitch{},
+			wantErr: errors.ErrInvalidCollectionSwitch("images", "images"),
+		},
+		{
+			name: "return error when the target collection is switched",
+			switches: map[string]string{
+				"images-v2": "images",
+			},
+			args: args{
+				target: "images-v2",
+			},
+			want: []CollectionSwitch{
+				{Name: "images-v2", Target: "images"},
+			},
+			wantErr: errors.ErrInvalidCollectionSwitch("", "images-v2"),
+		},
+		{
+			name: "return error when the collection is the target of another switch",
+			switches: map[string]string{
+				"": "images",
+			},
+			args: args{
+				name:   "images",
+				target: "images-v2",
+			},
+			want: []CollectionSwitch{
+				{Name: "", Target: "images"},
+			},
+			wantErr: errors.ErrInvalidCollectionSwitch("images", "images-v2"),
+		},
+		{
+			name: "fence the default collection",
+			args: args{
+				fence: true,
+			},
+			want: []CollectionSwitch{
+				{Name: "", Fence: true},
+			},
+		},
+		{
+			name: "remove the fence when the collection is switched",
+			fences: []string{
+				"images",
+			},
+			args: args{
+				name:   "images",
+				target: "images-v2",
+			},
+			want: []CollectionSwitch{
+				{Name: "images", Target: "images-v2"},
+			},
+		},
+		{
+			name: "remove the fence when the target is empty",
+			fences: []string{
+				"images",
+			},
+			args: args{
+				name: "images",
+			},
+			want: []CollectionSwitch{},
+		},
+		{
+			name: "return error when the collection is switched and fenced",
+			args: args{
+				name:   "images",
+				target: "images-v2",
+				fence:  true,
+			},
+			want:    []CollectionSwitch{},
+			wantErr: errors.ErrInvalidCollectionSwitch("images", "images-v2"),
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			n := &ngt{
+				inMem: true,
+				collections: map[string]*collection{
+					"images":    {cfg: CollectionConfig{Name: "images"}},
+					"images-v2": {cfg: CollectionConfig{Name: "images-v2"}},
+				},
+				switches: make(map[string]string),
+				fences:   make(map[string]struct{}),
+			}
+			for name, target := range test.switches {
+				n.switches[name] = target
+			}
+			for _, name := range test.fences {
+				n.fences[name] = struct{}{}
+			}
+			err := n.SwitchCollection(context.Background(), test.args.name, test.args.target, test.args.fence)
+			if !errors.Is(err, test.wantErr) {
+				tt.Errorf("got_error: \"%v\", want: \"%v\"", err, test.wantErr)
+			}
+			if diff := comparator.Diff(n.ListCollectionSwitches(), test.want); diff != "" {
+				tt.Errorf("switches diff (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
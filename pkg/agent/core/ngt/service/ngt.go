@@ -16,6 +16,7 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"encoding/gob"
 	"fmt"
@@ -30,6 +31,9 @@ import (
 	"time"
 
 	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/algorithm/distance"
+	"github.com/vdaas/vald/internal/algorithm/fusion"
+	"github.com/vdaas/vald/internal/algorithm/rangesearch"
 	"github.com/vdaas/vald/internal/config"
 	"github.com/vdaas/vald/internal/conv"
 	"github.com/vdaas/vald/internal/core/algorithm"
@@ -45,19 +49,26 @@ import (
 	"github.com/vdaas/vald/internal/strings"
 	"github.com/vdaas/vald/internal/sync"
 	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/pkg/agent/internal/attribute"
+	"github.com/vdaas/vald/pkg/agent/internal/delta"
 	"github.com/vdaas/vald/pkg/agent/internal/kvs"
 	"github.com/vdaas/vald/pkg/agent/internal/memstore"
 	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+	"github.com/vdaas/vald/pkg/agent/internal/multivector"
+	"github.com/vdaas/vald/pkg/agent/internal/quantization"
+	"github.com/vdaas/vald/pkg/agent/internal/sparse"
 	"github.com/vdaas/vald/pkg/agent/internal/vqueue"
 )
 
 type (
 	NGT interface {
 		Start(ctx context.Context) <-chan error
-		Search(ctx context.Context, vec []float32, size uint32, epsilon, radius float32, edgeSize int32) (*payload.Search_Response, error)
-		SearchByID(ctx context.Context, uuid string, size uint32, epsilon, radius float32, edgeSize int32) ([]float32, *payload.Search_Response, error)
+		Search(ctx context.Context, vec []float32, size uint32, epsilon, radius float32, edgeSize int32, pred attribute.Predicate) (*payload.Search_Response, error)
+		SearchByID(ctx context.Context, uuid string, size uint32, epsilon, radius float32, edgeSize int32, pred attribute.Predicate) ([]float32, *payload.Search_Response, error)
+		SearchQueued(ctx context.Context, vec []float32, size uint32, radius float32, pred attribute.Predicate, res *payload.Search_Response) (*payload.Search_Response, error)
 		LinearSearch(ctx context.Context, vec []float32, size uint32) (*payload.Search_Response, error)
 		LinearSearchByID(ctx context.Context, uuid string, size uint32) ([]float32, *payload.Search_Response, error)
+		RangeSearch(ctx context.Context, vec []float32, radius, epsilon float32, pred attribute.Predicate, after *rangesearch.Cursor, f func([]*payload.Object_Distance) bool) (partial bool, err error)
 		Insert(uuid string, vec []float32) (err error)
 		InsertWithTime(uuid string, vec []float32, t int64) (err error)
 		InsertMultiple(vecs map[string][]float32) (err error)
@@ -71,6 +82,17 @@ type (
 		DeleteWithTime(uuid string, t int64) (err error)
 		DeleteMultiple(uuids ...string) (err error)
 		DeleteMultipleWithTime(uuids []string, t int64) (err error)
+		SetAttributes(uuid string, attrs attribute.Attributes) (err error)
+		GetAttributes(uuid string) (attrs attribute.Attributes, ok bool)
+		SetSparseVector(uuid string, vec sparse.Vector) (err error)
+		GetSparseVector(uuid string) (vec sparse.Vector, ok bool)
+		SearchHybrid(ctx context.Context, vec []float32, svec sparse.Vector, size uint32, epsilon, radius float32, edgeSize int32, pred attribute.Predicate, fcfg *payload.Search_Fusion) (*payload.Search_Response, error)
+		InsertMultiVector(doc string, vecs [][]float32) (err error)
+		InsertMultiVectorWithTime(doc string, vecs [][]float32, t int64) (err error)
+		UpdateMultiVector(doc string, vecs [][]float32) (err error)
+		UpdateMultiVectorWithTime(doc string, vecs [][]float32, t int64) (err error)
+		GetMultiVector(doc string) (vecs [][]float32, timestamp int64, err error)
+		SearchMultiVector(ctx context.Context, vecs [][]float32, size uint32, epsilon, radius float32, edgeSize int32, pred attribute.Predicate, agg multivector.Aggregation) (*payload.Search_Response, error)
 		RegenerateIndexes(ctx context.Context) (err error)
 		GetObject(uuid string) (vec []float32, timestamp int64, err error)
 		ListObjectFunc(ctx context.Context, f func(uuid string, oid uint32, timestamp int64) bool)
@@ -80,6 +102,7 @@ type (
 		CreateAndSaveIndex(ctx context.Context, poolSize uint32) (err error)
 		IsIndexing() bool
 		IsFlushing() bool
+		IsLoading() bool
 		IsSaving() bool
 		Len() uint64
 		NumberOfCreateIndexExecution() uint64
@@ -92,6 +115,13 @@ type (
 		IndexStatistics() (*payload.Info_Index_Statistics, error)
 		IsStatisticsEnabled() bool
 		IndexProperty() (*payload.Info_Index_Property, error)
+		CreateCollection(ctx context.Context, cfg CollectionConfig) (err error)
+		DropCollection(ctx context.Context, name string) (err error)
+		Collection(name string) (NGT, error)
+		ListCollections() []CollectionConfig
+		SwitchCollection(ctx context.Context, name, target string, fence bool) (err error)
+		ResolveCollection(name string) (target string, fenced bool)
+		ListCollectionSwitches() []CollectionSwitch
 		Close(ctx context.Context) error
 	}
 
@@ -99,6 +129,8 @@ type (
 		core                    core.NGT
 		tmpPath                 atomic.Value
 		kvs                     kvs.BidiMap
+		attrs                   attribute.Store
+		sparse                  sparse.Index
 		saving                  atomic.Value
 		eg                      errgroup.Group
 		vq                      vqueue.Queue
@@ -137,6 +169,7 @@ type (
 		cimu                    sync.Mutex
 		fmu                     sync.Mutex
 		flushing                atomic.Bool
+		loading                 atomic.Bool
 		poolSize                uint32
 		radius                  float32
 		epsilon                 float32
@@ -147,6 +180,36 @@ type (
 		enableCopyOnWrite       bool
 		enableStatistics        bool
 		inMem                   bool
+
+		// delta snapshot
+		enableDeltaSave bool
+		maxDeltas       uint64
+		deltaSeq        uint64
+		snapshotID      int64
+		dmu             sync.Mutex
+		dirty           map[string]struct{}
+
+		// filtered search
+		maxFilteredCandidates uint64
+
+		// range search
+		maxRangeResults uint64
+
+		// idle notifies the waiters that the indexing and the saving are finished
+		idlemu sync.Mutex
+		idle   chan struct{}
+
+		// quantization
+		quantizer    *quantization.Quantizer
+		refinement   quantization.Store
+		rerankFactor int
+
+		// named collections
+		cmu         sync.RWMutex
+		collections map[string]*collection
+		switches    map[string]string
+		fences      map[string]struct{}
+		cctx        context.Context
 	}
 
 	contextSaveIndexTimeKey string
@@ -155,12 +218,28 @@ type (
 const (
 	kvsFileName          = "ngt-meta.kvsdb"
 	kvsTimestampFileName = "ngt-timestamp.kvsdb"
+	kvsAttributeFileName = "ngt-attribute.kvsdb"
+	kvsSparseFileName    = "ngt-sparse.kvsdb"
+	vqueueWALFileName    = "vqueue.wal"
+	refinementFileName   = "ngt-refinement.vec"
 	noTimeStampFile      = -1
 
 	oldIndexDirName    = "backup"
 	originIndexDirName = "origin"
 	brokenIndexDirName = "broken"
 
+	// filteredSearchExpansionFactor is the initial multiplier of the number of candidates for the filtered search.
+	filteredSearchExpansionFactor = 4
+
+	// rangeSearchInitialSize is the initial number of candidates for the range search.
+	rangeSearchInitialSize = 128
+
+	// multiVectorExpansionFactor is the initial multiplier of the number of candidate sub-vectors of each query vector for the multi-vector search.
+	multiVectorExpansionFactor = 4
+
+	// defaultReRankFactor is the default multiplier of the number of candidates fetched from the quantized index for re-ranking.
+	defaultReRankFactor = 2
+
 	// use this only for tests. usually just leave the ctx value empty and let time.Now() be used.
 	saveIndexTimeKey contextSaveIndexTimeKey = "saveIndexTimeKey"
 )
@@ -169,7 +248,15 @@ func New(cfg *config.NGT, opts ...Option) (nn NGT, err error) {
 	if cfg.PodName == "" && cfg.EnableExportIndexInfoToK8s {
 		return nil, errors.New("pod_name is empty. this must be set either from environment variable or from config file")
 	}
-	return newNGT(cfg, opts...)
+	n, err := newNGT(cfg, opts...)
+	if err != nil {
+		return nil, err
+	}
+	err = n.loadCollections()
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
 }
 
 func newNGT(cfg *config.NGT, opts ...Option) (n *ngt, err error) {
@@ -206,6 +293,23 @@ func newNGT(cfg *config.NGT, opts ...Option) (n *ngt, err error) {
 		}
 	}
 
+	if n.vq == nil {
+		n.vq, err = vqueue.New(n.vqueueOptions()...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	distanceType, objectType := cfg.DistanceType, cfg.ObjectType
+	err = n.initQuantization()
+	if err != nil {
+		return nil, err
+	}
+	if n.quantizer != nil {
+		// the quantized index stores the codes of the vectors in the 8bit unsigned integer object space.
+		distanceType, objectType = n.quantizer.Metric().IndexDistanceType(), "uint8"
+	}
+
 	err = n.initNGT(
 		core.WithInMemoryMode(n.inMem),
 		core.WithDefaultPoolSize(n.poolSize),
@@ -213,8 +317,8 @@ func newNGT(cfg *config.NGT, opts ...Option) (n *ngt, err error) {
 		core.WithDefaultEpsilon(n.epsilon),
 		core.WithEpsilonForCreation(cfg.EpsilonForCreation),
 		core.WithDimension(cfg.Dimension),
-		core.WithDistanceTypeByString(cfg.DistanceType),
-		core.WithObjectTypeByString(cfg.ObjectType),
+		core.WithDistanceTypeByString(distanceType),
+		core.WithObjectTypeByString(objectType),
 		core.WithBulkInsertChunkSize(cfg.BulkInsertChunkSize),
 		core.WithCreationEdgeSize(cfg.CreationEdgeSize),
 		core.WithSearchEdgeSize(cfg.SearchEdgeSize),
@@ -224,27 +328,104 @@ func newNGT(cfg *config.NGT, opts ...Option) (n *ngt, err error) {
 		return nil, err
 	}
 
+	// replay the uncommitted operations which were not saved to the index before the last shutdown
+	err = n.vq.ReplayWAL(context.TODO(), func(uuid string, attrs attribute.Attributes, vec *sparse.Vector) {
+		if attrs != nil {
+			n.attrs.Set(uuid, attrs)
+		}
+		if vec != nil {
+			n.sparse.Set(uuid, *vec)
+		}
+		n.markDirty(uuid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	if n.dur == 0 || n.alen == 0 {
 		n.dcd = true
 	}
-	if n.vq == nil {
-		n.vq, err = vqueue.New()
-		if err != nil {
-			return nil, err
-		}
-	}
 	n.indexing.Store(false)
 	n.saving.Store(false)
 
 	return n, nil
 }
 
+// vqueueOptions returns the vqueue options to enable the write-ahead log when it is configured.
+func (n *ngt) vqueueOptions() []vqueue.Option {
+	if n.inMem || n.isReadReplica || n.cfg == nil || n.cfg.VQueue == nil || !n.cfg.VQueue.EnableWAL {
+		return nil
+	}
+	return []vqueue.Option{
+		vqueue.WithWALPath(file.Join(n.basePath, vqueueWALFileName)),
+		vqueue.WithWALSyncPolicy(n.cfg.VQueue.WALSyncPolicy),
+		vqueue.WithWALSyncInterval(n.cfg.VQueue.WALSyncInterval),
+	}
+}
+
+// initQuantization initializes the quantizer and the storage of the full-precision vectors for re-ranking.
+// The full-precision vectors are stored in the index directory so that they are saved, backed up and restored together with the index.
+func (n *ngt) initQuantization() (err error) {
+	qcfg := n.cfg.Quantization
+	if qcfg == nil {
+		return nil
+	}
+	typ, err := quantization.ParseType(qcfg.Type)
+	if err != nil {
+		return err
+	}
+	switch typ {
+	case quantization.None:
+		return nil
+	case quantization.Scalar:
+	default:
+		return errors.ErrUnsupportedQuantizationType(qcfg.Type)
+	}
+	metric, err := quantization.ParseMetric(n.cfg.DistanceType)
+	if err != nil {
+		return err
+	}
+	n.quantizer, err = quantization.New(metric, quantization.WithRange(qcfg.MinValue, qcfg.MaxValue))
+	if err != nil {
+		return err
+	}
+	if !qcfg.EnableReRank {
+		return nil
+	}
+	n.rerankFactor = defaultReRankFactor
+	if qcfg.ReRankFactor > 0 {
+		n.rerankFactor = qcfg.ReRankFactor
+	}
+	var path string
+	if !n.inMem {
+		path = file.Join(n.path, refinementFileName)
+		// move the full-precision vectors stored next to the index directories by the former versions.
+		legacy := file.Join(n.basePath, refinementFileName)
+		if !file.Exists(path) && file.Exists(legacy) {
+			if err = os.Rename(legacy, path); err != nil {
+				return err
+			}
+		}
+	}
+	n.refinement, err = quantization.NewStore(path, n.cfg.Dimension)
+	return err
+}
+
 func (n *ngt) copyNGT(src *ngt) {
 	// instances
 	n.core = src.core
 	n.kvs = src.kvs
+	n.attrs = src.attrs
+	n.sparse = src.sparse
 	n.fmap = src.fmap
 	n.vq = src.vq
+	n.quantizer = src.quantizer
+	n.refinement = src.refinement
+	n.rerankFactor = src.rerankFactor
+
+	// delta snapshot
+	n.snapshotID = src.snapshotID
+	n.deltaSeq = src.deltaSeq
 
 	// counters
 	n.wfci = src.wfci
@@ -364,6 +545,8 @@ func (n *ngt) prepareFolders(ctx context.Context) (err error) {
 }
 
 func (n *ngt) load(ctx context.Context, path string, opts ...core.Option) (err error) {
+	n.loading.Store(true)
+	defer n.loading.Store(false)
 	exist, fi, err := file.ExistsWithDetail(path)
 	switch {
 	case !exist, fi == nil, fi != nil && fi.Size() == 0, err != nil && errors.Is(err, fs.ErrNotExist):
@@ -403,6 +586,10 @@ func (n *ngt) load(ctx context.Context, path string, opts ...core.Option) (err e
 
 	log.Debugf("index path: %s and metadata: %s exists, now starting to load metadata", path, metadataPath)
 	agentMetadata, err := metadata.Load(metadataPath)
+	if err == nil && agentMetadata != nil && !agentMetadata.IsInvalid && agentMetadata.NGT != nil && agentMetadata.NGT.IndexCount == 0 && agentMetadata.NGT.SnapshotID != 0 {
+		// the base snapshot is empty, but the delta snapshots saved on it after the save still have to be applied.
+		return n.loadEmptyBase(path, agentMetadata.NGT.SnapshotID, opts...)
+	}
 	if err != nil && errors.Is(err, fs.ErrNotExist) || agentMetadata == nil || agentMetadata.NGT == nil || agentMetadata.NGT.IndexCount == 0 {
 		err = errors.Wrapf(err, "cannot read metadata from path: %s\tmetadata: %v", path, agentMetadata)
 		return err
@@ -504,7 +691,9 @@ func (n *ngt) load(ctx context.Context, path string, opts ...core.Option) (err e
 
 	select {
 	case err := <-ech:
-		return err
+		if err != nil {
+			return err
+		}
 	case <-ctx.Done():
 		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
 			log.Errorf("cannot load index backup data from %s within the timeout %s. the process is going to be killed.", path, timeout)
@@ -523,7 +712,7 @@ func (n *ngt) load(ctx context.Context, path string, opts ...core.Option) (err e
 		}
 	}
 
-	return nil
+	return n.loadDeltas(path, agentMetadata.NGT.SnapshotID)
 }
 
 // backupBroken backup index at originPath into brokenDir.
@@ -579,6 +768,11 @@ func (n *ngt) backupBroken(ctx context.Context) error {
 		return errors.Join(err, errors.ErrIndexDirectoryRecreationFailed)
 	}
 
+	// the full-precision vectors have been moved with the broken index
+	if n.refinement != nil {
+		return n.refinement.Reopen(file.Join(n.path, refinementFileName))
+	}
+
 	return nil
 }
 
@@ -666,6 +860,12 @@ func (n *ngt) initNGT(opts ...core.Option) (err error) {
 	if n.kvs == nil {
 		n.kvs = kvs.New(kvs.WithConcurrency(n.kvsdbConcurrency))
 	}
+	if n.attrs == nil {
+		n.attrs = attribute.New(attribute.WithConcurrency(n.kvsdbConcurrency))
+	}
+	if n.sparse == nil {
+		n.sparse = sparse.New()
+	}
 	if n.inMem {
 		log.Debug("vald agent starts with in-memory mode")
 		n.core, err = core.New(opts...)
@@ -769,6 +969,8 @@ func (n *ngt) loadKVS(ctx context.Context, path string, timeout time.Duration) (
 
 	m := make(map[string]uint32)
 	mt := make(map[string]int64)
+	ma := make(map[string]attribute.Attributes)
+	ms := make(map[string]sparse.Vector)
 
 	eg.Go(safety.RecoverFunc(func() (err error) {
 		gob.Register(map[string]uint32{})
@@ -823,10 +1025,66 @@ func (n *ngt) loadKVS(ctx context.Context, path string, timeout time.Duration) (
 		return nil
 	}))
 
+	eg.Go(safety.RecoverFunc(func() (err error) {
+		gob.Register(map[string]attribute.Attributes{})
+		fa, err := file.Open(
+			file.Join(path, kvsAttributeFileName),
+			os.O_RDONLY|os.O_SYNC,
+			fs.ModePerm,
+		)
+		if err != nil {
+			// attribute kvsdb file does not exist when no vectors have attributes.
+			log.Debugf("error opening attribute kvsdb file,\terr: %v", err)
+			return nil
+		}
+		defer func() {
+			if fa != nil {
+				derr := fa.Close()
+				if derr != nil {
+					err = errors.Join(err, derr)
+				}
+			}
+		}()
+		err = gob.NewDecoder(fa).Decode(&ma)
+		if err != nil {
+			log.Warnf("error decoding attribute kvsdb file,\terr: %v", err)
+		}
+		return nil
+	}))
+
+	eg.Go(safety.RecoverFunc(func() (err error) {
+		gob.Register(map[string]sparse.Vector{})
+		fsv, err := file.Open(
+			file.Join(path, kvsSparseFileName),
+			os.O_RDONLY|os.O_SYNC,
+			fs.ModePerm,
+		)
+		if err != nil {
+			// sparse kvsdb file does not exist when no vectors have sparse vectors.
+			log.Debugf("error opening sparse kvsdb file,\terr: %v", err)
+			return nil
+		}
+		defer func() {
+			if fsv != nil {
+				derr := fsv.Close()
+				if derr != nil {
+					err = errors.Join(err, derr)
+				}
+			}
+		}()
+		err = gob.NewDecoder(fsv).Decode(&ms)
+		if err != nil {
+			log.Warnf("error decoding sparse kvsdb file,\terr: %v", err)
+		}
+		return nil
+	}))
+
 	err = eg.Wait()
 	if err != nil {
 		m = nil
 		mt = nil
+		ma = nil
+		ms = nil
 		return err
 	}
 
@@ -850,13 +1108,34 @@ func (n *ngt) loadKVS(ctx context.Context, path string, timeout time.Duration) (
 			n.fmap[k] = noTimeStampFile
 		}
 	}
+	if n.attrs != nil {
+		n.attrs.Close()
+	}
+	n.attrs = attribute.New(attribute.WithConcurrency(n.kvsdbConcurrency))
+	for k, attrs := range ma {
+		if _, ok := m[k]; ok {
+			n.attrs.Set(k, attrs)
+		}
+	}
+	if n.sparse != nil {
+		n.sparse.Close()
+	}
+	n.sparse = sparse.New()
+	for k, vec := range ms {
+		if _, ok := m[k]; ok {
+			n.sparse.Set(k, vec)
+		}
+	}
 	m = nil
 	mt = nil
+	ma = nil
+	ms = nil
 
 	return nil
 }
 
 func (n *ngt) Start(ctx context.Context) <-chan error {
+	n.startCollections(ctx)
 	if n.dcd {
 		return nil
 	}
@@ -930,8 +1209,15 @@ func (n *ngt) Start(ctx context.Context) <-chan error {
 	return ech
 }
 
+// Search searches the nearest neighbors of the vector.
+// The multi-vector documents are returned once by the distance of their nearest sub-vectors.
 func (n *ngt) Search(
-	ctx context.Context, vec []float32, size uint32, epsilon, radius float32, edgeSize int32,
+	ctx context.Context,
+	vec []float32,
+	size uint32,
+	epsilon, radius float32,
+	edgeSize int32,
+	pred attribute.Predicate,
 ) (res *payload.Search_Response, err error) {
 	if n.IsFlushing() {
 		return nil, errors.ErrFlushingIsInProgress
@@ -939,23 +1225,498 @@ func (n *ngt) Search(
 	if n.IsIndexing() {
 		return nil, errors.ErrCreateIndexingIsInProgress
 	}
-	sr, err := n.core.Search(ctx, vec, int(size), epsilon, radius, edgeSize)
+	if pred != nil {
+		return n.searchWithPredicate(ctx, vec, size, epsilon, radius, edgeSize, pred)
+	}
+	total := n.Len()
+	num := uint64(size)
+	for {
+		sr, reranked, err := n.search(ctx, vec, uint32(num), epsilon, radius, edgeSize)
+		if err != nil {
+			return nil, err
+		}
+		res, err = n.toSearchResponse(sr, reranked)
+		if err != nil {
+			return nil, err
+		}
+		// the sub-vectors of the same multi-vector document are collapsed into the document,
+		// so that the number of candidates is doubled until the requested size of documents is collected.
+		if res == nil || len(res.GetResults()) >= int(size) || uint64(len(sr)) < num || num >= total {
+			break
+		}
+		num = min(num*2, max(total, uint64(size)))
+	}
+	if res != nil && len(res.GetResults()) > int(size) {
+		res.Results = res.GetResults()[:size]
+	}
+	return res, nil
+}
+
+// search searches the nearest neighbors of the vector and refines the results of the quantized index.
+// It reports whether the results are re-ranked by the full-precision vectors.
+func (n *ngt) search(
+	ctx context.Context, vec []float32, size uint32, epsilon, radius float32, edgeSize int32,
+) (sr []algorithm.SearchResult, reranked bool, err error) {
+	num := size
+	if n.quantizer != nil && n.refinement != nil {
+		// over-fetch the candidates from the quantized index to re-rank them with the full-precision vectors.
+		num = size * uint32(n.rerankFactor)
+	}
+	sr, err = n.core.Search(ctx, n.encode(vec), int(num), epsilon, n.indexRadius(radius, len(vec)), edgeSize)
 	if err != nil {
 		if n.IsIndexing() {
-			return nil, errors.ErrCreateIndexingIsInProgress
+			return nil, false, errors.ErrCreateIndexingIsInProgress
 		}
 		if errors.Is(err, errors.ErrSearchResultEmptyButNoDataStored) && n.Len() == 0 {
-			return nil, nil
+			return nil, false, nil
 		}
 		log.Errorf("cgo error detected during search: ngt api returned error %v", err)
+		return nil, false, err
+	}
+	sr, reranked = n.refine(vec, sr, size)
+	return n.inRadius(sr, radius), reranked, nil
+}
+
+// encode returns the quantized code of the vector when the quantization is enabled, otherwise it returns the vector as is.
+func (n *ngt) encode(vec []float32) []float32 {
+	if n.quantizer == nil {
+		return vec
+	}
+	return n.quantizer.Encode(vec)
+}
+
+// indexRadius converts the search radius into the radius between the quantized codes when the quantization is enabled.
+// The default radius is converted as well, since the index would apply it to the codes as it is.
+func (n *ngt) indexRadius(radius float32, dim int) float32 {
+	if n.quantizer == nil {
+		return radius
+	}
+	if radius == 0 {
+		radius = n.radius
+	}
+	return n.quantizer.Radius(radius, dim)
+}
+
+// inRadius drops the results out of the radius, since the quantized index searches by the radius widened by indexRadius.
+func (n *ngt) inRadius(sr []algorithm.SearchResult, radius float32) []algorithm.SearchResult {
+	if n.quantizer == nil {
+		return sr
+	}
+	if radius == 0 {
+		radius = n.radius
+	}
+	if radius <= 0 {
+		return sr
+	}
+	return slices.DeleteFunc(sr, func(d algorithm.SearchResult) bool {
+		return d.Error == nil && d.Distance > radius
+	})
+}
+
+// refine converts the distances of the search results of the quantized index into the distances of the original space.
+// When the full-precision vectors are stored, it re-ranks the candidates by the exact distances and truncates them to size.
+// Otherwise the distances are approximated from the quantized distances.
+// The results are reported as re-ranked only when the exact distances of all the candidates are computed.
+func (n *ngt) refine(
+	query []float32, sr []algorithm.SearchResult, size uint32,
+) (res []algorithm.SearchResult, reranked bool) {
+	if n.quantizer == nil || len(sr) == 0 {
+		return sr, false
+	}
+	if n.refinement == nil {
+		for i := range sr {
+			sr[i].Distance = n.quantizer.Distance(sr[i].Distance)
+		}
+		return sr, false
+	}
+	metric := n.quantizer.Metric()
+	res = make([]algorithm.SearchResult, 0, len(sr))
+	var refined, approximated int
+	for _, d := range sr {
+		if d.ID == 0 && d.Error != nil {
+			res = append(res, d)
+			continue
+		}
+		if vec, ok := n.fullVector(d.ID); ok {
+			d.Distance = metric.Distance(query, vec)
+			refined++
+		} else {
+			// the candidate without the full-precision vector keeps the approximated distance.
+			d.Distance = n.quantizer.Distance(d.Distance)
+			approximated++
+		}
+		res = append(res, d)
+	}
+	slices.SortStableFunc(res, func(a, b algorithm.SearchResult) int {
+		return cmp.Compare(a.Distance, b.Distance)
+	})
+	if len(res) > int(size) {
+		res = res[:size]
+	}
+	return res, refined > 0 && approximated == 0
+}
+
+// setRefinement stores the full-precision vector for re-ranking when the storage is enabled.
+func (n *ngt) setRefinement(oid uint32, uuid string, vec []float32) {
+	if n.refinement == nil {
+		return
+	}
+	if err := n.refinement.Set(oid, uuid, vec); err != nil {
+		log.Warnf("failed to store full-precision vector of uuid: %s oid: %d, error: %v", uuid, oid, err)
+	}
+}
+
+// getVector returns the vector of the oid.
+// When the quantization is enabled, it returns the full-precision vector if stored, otherwise the vector decoded from the quantized code.
+func (n *ngt) getVector(oid uint32) ([]float32, error) {
+	if n.quantizer == nil {
+		return n.core.GetVector(uint(oid))
+	}
+	if vec, ok := n.fullVector(oid); ok {
+		return vec, nil
+	}
+	code, err := n.core.GetVector(uint(oid))
+	if err != nil {
 		return nil, err
 	}
+	return n.quantizer.Decode(code), nil
+}
 
-	return n.toSearchResponse(sr)
+// fullVector returns the full-precision vector of the oid when it is stored for re-ranking.
+func (n *ngt) fullVector(oid uint32) ([]float32, bool) {
+	if n.refinement == nil {
+		return nil, false
+	}
+	uuid, _, ok := n.kvs.GetInverse(oid)
+	if !ok {
+		return nil, false
+	}
+	return n.refinement.Get(oid, uuid)
+}
+
+// searchWithPredicate searches the nearest neighbors which attributes satisfy the predicate.
+// Since the predicate is evaluated after the graph search, it over-fetches the candidates
+// and doubles the number of candidates until the requested size of results is collected,
+// the index is exhausted or the number of candidates reaches the number of indexed vectors.
+// The number of candidates is capped by the max filtered candidates, and when the cap is reached
+// before the requested size of results is collected, the results found so far are returned as partial.
+func (n *ngt) searchWithPredicate(
+	ctx context.Context,
+	vec []float32,
+	size uint32,
+	epsilon, radius float32,
+	edgeSize int32,
+	pred attribute.Predicate,
+) (res *payload.Search_Response, err error) {
+	total := n.Len()
+	limit := max(n.maxFilteredCandidates, uint64(size))
+	num := uint64(size) * filteredSearchExpansionFactor
+	for {
+		if num > total {
+			num = max(total, uint64(size))
+		}
+		if num > limit {
+			num = limit
+		}
+		sr, reranked, err := n.search(ctx, vec, uint32(num), epsilon, radius, edgeSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(sr) == 0 {
+			if n.Len() == 0 {
+				return nil, nil
+			}
+			return nil, errors.ErrEmptySearchResult
+		}
+		res = &payload.Search_Response{
+			Results:  make([]*payload.Object_Distance, 0, size),
+			Reranked: reranked,
+		}
+		visited := make(map[string]struct{}, len(sr))
+		for _, d := range sr {
+			if d.ID == 0 && d.Error != nil {
+				log.Warnf("an error occurred while searching: %v", d.Error)
+				continue
+			}
+			key, _, ok := n.kvs.GetInverse(d.ID)
+			if !ok {
+				log.Warn("not found", d.ID, d.Distance)
+				continue
+			}
+			key, ok = collapse(visited, key)
+			if !ok {
+				continue
+			}
+			attrs, _ := n.attrs.Get(key)
+			if !pred.Match(attrs) {
+				continue
+			}
+			res.Results = append(res.GetResults(), &payload.Object_Distance{
+				Id:       key,
+				Distance: d.Distance,
+			})
+			if len(res.GetResults()) >= int(size) {
+				return res, nil
+			}
+		}
+		if uint64(len(sr)) < num || num >= total {
+			break
+		}
+		if num >= limit {
+			res.Partial = true
+			return res, nil
+		}
+		num *= 2
+	}
+	if len(res.GetResults()) == 0 {
+		return nil, errors.ErrEmptySearchResult
+	}
+	return res, nil
+}
+
+// RangeSearch searches every vector within the radius from vec which attributes satisfy the predicate and which is located after the cursor,
+// and calls f with the results sorted by the distance and the ID as they are found, until f returns false.
+// Since the graph search returns at most the requested number of results, it doubles the number of candidates
+// until the search returns fewer results than the candidates or the number of candidates reaches the number of indexed vectors.
+// Each round calls f only with the results located after the last result passed to f, so the results are never held at once,
+// and the results are reported as partial when the number of candidates reaches the max number of the range search results.
+func (n *ngt) RangeSearch(
+	ctx context.Context,
+	vec []float32,
+	radius, epsilon float32,
+	pred attribute.Predicate,
+	after *rangesearch.Cursor,
+	f func([]*payload.Object_Distance) bool,
+) (partial bool, err error) {
+	if n.IsFlushing() {
+		return false, errors.ErrFlushingIsInProgress
+	}
+	if n.IsIndexing() {
+		return false, errors.ErrCreateIndexingIsInProgress
+	}
+	total := n.Len()
+	if total == 0 {
+		return false, nil
+	}
+	limit := max(n.maxRangeResults, 1)
+	for num := uint64(rangeSearchInitialSize); ; num *= 2 {
+		num = min(num, total, limit)
+		sr, _, err := n.search(ctx, vec, uint32(num), epsilon, radius, 0)
+		if err != nil {
+			if errors.Is(err, errors.ErrEmptySearchResult) {
+				return false, nil
+			}
+			return false, err
+		}
+		if err = ctx.Err(); err != nil {
+			return false, err
+		}
+		res := make([]*payload.Object_Distance, 0, len(sr))
+		visited := make(map[string]struct{}, len(sr))
+		for _, d := range sr {
+			if d.ID == 0 && d.Error != nil {
+				log.Warnf("an error occurred while searching: %v", d.Error)
+				continue
+			}
+			// the distances of the quantized index are refined after the graph search.
+			if d.Distance > radius {
+				continue
+			}
+			key, _, ok := n.kvs.GetInverse(d.ID)
+			if !ok {
+				log.Warn("not found", d.ID, d.Distance)
+				continue
+			}
+			if key, ok = collapse(visited, key); !ok {
+				continue
+			}
+			r := &payload.Object_Distance{
+				Id:       key,
+				Distance: d.Distance,
+			}
+			// the results up to the cursor have been passed to f in the previous rounds or the previous pages.
+			if !after.After(r) {
+				continue
+			}
+			if pred != nil {
+				if attrs, _ := n.attrs.Get(key); !pred.Match(attrs) {
+					continue
+				}
+			}
+			res = append(res, r)
+		}
+		if res = rangesearch.Sort(res); len(res) != 0 {
+			if !f(res) {
+				return false, nil
+			}
+			last := res[len(res)-1]
+			after = &rangesearch.Cursor{
+				ID:       last.GetId(),
+				Distance: last.GetDistance(),
+			}
+		}
+		if uint64(len(sr)) < num || num >= total {
+			return false, nil
+		}
+		if num >= limit {
+			return true, nil
+		}
+	}
+}
+
+// SearchHybrid searches the nearest neighbors of the dense vector and the largest inner products of the sparse vector,
+// and fuses both results by the fusion configuration. Each of the dense and sparse search collects size candidates
+// which attributes satisfy the predicate. The reciprocal rank fusion is used when the fusion is disabled.
+func (n *ngt) SearchHybrid(
+	ctx context.Context,
+	vec []float32,
+	svec sparse.Vector,
+	size uint32,
+	epsilon, radius float32,
+	edgeSize int32,
+	pred attribute.Predicate,
+	fcfg *payload.Search_Fusion,
+) (res *payload.Search_Response, err error) {
+	dres, err := n.Search(ctx, vec, size, epsilon, radius, edgeSize, pred)
+	if err != nil && !errors.Is(err, errors.ErrEmptySearchResult) {
+		return nil, err
+	}
+	var filter func(uuid string) bool
+	if pred != nil {
+		filter = func(uuid string) bool {
+			attrs, _ := n.attrs.Get(uuid)
+			return pred.Match(attrs)
+		}
+	}
+	sres := n.sparse.Search(ctx, svec, int(size), filter)
+
+	results := make([]*payload.Object_Distance, 0, len(dres.GetResults())+len(sres))
+	for i, d := range dres.GetResults() {
+		results = append(results, &payload.Object_Distance{
+			Id:       d.GetId(),
+			Distance: d.GetDistance(),
+			Hybrid: &payload.Object_HybridScore{
+				DenseRank:     uint32(i + 1),
+				DenseDistance: d.GetDistance(),
+			},
+		})
+	}
+	for i, r := range sres {
+		results = append(results, &payload.Object_Distance{
+			Id: r.UUID,
+			Hybrid: &payload.Object_HybridScore{
+				SparseRank:  uint32(i + 1),
+				SparseScore: r.Score,
+			},
+		})
+	}
+	if len(results) == 0 {
+		if n.Len() == 0 {
+			return nil, nil
+		}
+		return nil, errors.ErrEmptySearchResult
+	}
+	if !fusion.Enabled(fcfg) {
+		fcfg = &payload.Search_Fusion{
+			Algorithm: payload.Search_Fusion_RRF,
+		}
+	}
+	return &payload.Search_Response{
+		Results:  fusion.Fuse(fcfg, results, int(size)),
+		Reranked: dres.GetReranked(),
+	}, nil
+}
+
+// SearchMultiVector searches the multi-vector documents nearest to the query vectors.
+// It collects the candidate sub-vectors of each query vector and aggregates their distances into the distances of the documents.
+// The vector which is not a multi-vector document is regarded as a document of a single sub-vector.
+// The number of candidates is doubled until the requested size of documents which attributes satisfy the predicate is collected,
+// the index is exhausted or the number of candidates reaches the number of indexed vectors.
+func (n *ngt) SearchMultiVector(
+	ctx context.Context,
+	vecs [][]float32,
+	size uint32,
+	epsilon, radius float32,
+	edgeSize int32,
+	pred attribute.Predicate,
+	agg multivector.Aggregation,
+) (res *payload.Search_Response, err error) {
+	if n.IsFlushing() {
+		return nil, errors.ErrFlushingIsInProgress
+	}
+	if n.IsIndexing() {
+		return nil, errors.ErrCreateIndexingIsInProgress
+	}
+	total := n.Len()
+	num := uint64(size) * multiVectorExpansionFactor
+	for {
+		if num > total {
+			num = max(total, uint64(size))
+		}
+		exhausted, reranked := true, len(vecs) > 0
+		a := multivector.NewAggregator(agg, len(vecs))
+		for q, vec := range vecs {
+			sr, rr, err := n.search(ctx, vec, uint32(num), epsilon, radius, edgeSize)
+			if err != nil {
+				return nil, err
+			}
+			reranked = reranked && rr
+			if uint64(len(sr)) >= num {
+				exhausted = false
+			}
+			for _, d := range sr {
+				if d.ID == 0 && d.Error != nil {
+					log.Warnf("an error occurred while searching: %v", d.Error)
+					continue
+				}
+				key, _, ok := n.kvs.GetInverse(d.ID)
+				if !ok {
+					log.Warn("not found", d.ID, d.Distance)
+					continue
+				}
+				a.Add(q, multivector.DocID(key), d.Distance)
+			}
+		}
+		res = &payload.Search_Response{
+			Results:  make([]*payload.Object_Distance, 0, size),
+			Reranked: reranked,
+		}
+		for _, r := range a.Results() {
+			if pred != nil {
+				attrs, _ := n.attrs.Get(r.ID)
+				if !pred.Match(attrs) {
+					continue
+				}
+			}
+			res.Results = append(res.GetResults(), &payload.Object_Distance{
+				Id:       r.ID,
+				Distance: r.Distance,
+			})
+			if len(res.GetResults()) >= int(size) {
+				break
+			}
+		}
+		if len(res.GetResults()) >= int(size) || exhausted || num >= total {
+			break
+		}
+		num *= 2
+	}
+	if len(res.GetResults()) == 0 {
+		if n.Len() == 0 {
+			return nil, nil
+		}
+		return nil, errors.ErrEmptySearchResult
+	}
+	return res, nil
 }
 
 func (n *ngt) SearchByID(
-	ctx context.Context, uuid string, size uint32, epsilon, radius float32, edgeSize int32,
+	ctx context.Context,
+	uuid string,
+	size uint32,
+	epsilon, radius float32,
+	edgeSize int32,
+	pred attribute.Predicate,
 ) (vec []float32, dst *payload.Search_Response, err error) {
 	if n.IsFlushing() {
 		return nil, nil, errors.ErrFlushingIsInProgress
@@ -967,7 +1728,7 @@ func (n *ngt) SearchByID(
 	if err != nil {
 		return nil, nil, err
 	}
-	dst, err = n.Search(ctx, vec, size, epsilon, radius, edgeSize)
+	dst, err = n.Search(ctx, vec, size, epsilon, radius, edgeSize, pred)
 	if err != nil {
 		return vec, nil, err
 	}
@@ -983,7 +1744,7 @@ func (n *ngt) LinearSearch(
 	if n.IsIndexing() {
 		return nil, errors.ErrCreateIndexingIsInProgress
 	}
-	sr, err := n.core.LinearSearch(ctx, vec, int(size))
+	sr, err := n.core.LinearSearch(ctx, n.encode(vec), int(size))
 	if err != nil {
 		if n.IsIndexing() {
 			return nil, errors.ErrCreateIndexingIsInProgress
@@ -995,7 +1756,7 @@ func (n *ngt) LinearSearch(
 		return nil, err
 	}
 
-	return n.toSearchResponse(sr)
+	return n.toSearchResponse(n.refine(vec, sr, size))
 }
 
 func (n *ngt) LinearSearchByID(
@@ -1018,6 +1779,86 @@ func (n *ngt) LinearSearchByID(
 	return vec, dst, nil
 }
 
+// SearchQueued returns res merged with the size nearest vectors within radius in the insert queue found by the
+// brute-force scan, and removes the results deleted or updated in the queues, so the vectors written but not committed
+// to the index yet are read. The results of the multi-vector documents are collapsed to their nearest sub-vectors.
+func (n *ngt) SearchQueued(
+	ctx context.Context,
+	vec []float32,
+	size uint32,
+	radius float32,
+	pred attribute.Predicate,
+	res *payload.Search_Response,
+) (*payload.Search_Response, error) {
+	if n.vq.IVQLen() == 0 && n.vq.DVQLen() == 0 {
+		return res, nil
+	}
+	dt, err := distance.Parse(n.cfg.DistanceType)
+	if err != nil {
+		return nil, err
+	}
+	queued := make(map[string]float32, n.vq.IVQLen())
+	n.vq.Range(ctx, func(uuid string, qvec []float32, _ int64) bool {
+		if pred != nil {
+			attrs, _ := n.attrs.Get(uuid)
+			if !pred.Match(attrs) {
+				return true
+			}
+		}
+		d := dt.Distance(vec, qvec)
+		if radius <= 0 || d <= radius {
+			key := uuid
+			if doc, _, ok := multivector.Parse(uuid); ok {
+				key = doc
+			}
+			if qd, ok := queued[key]; !ok || d < qd {
+				queued[key] = d
+			}
+		}
+		return ctx.Err() == nil
+	})
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	results := make([]*payload.Object_Distance, 0, len(res.GetResults())+len(queued))
+	for _, r := range res.GetResults() {
+		if _, ok := queued[r.GetId()]; ok {
+			// the vector is updated in the insert queue.
+			continue
+		}
+		if _, ok := n.vq.DVExists(r.GetId()); ok {
+			continue
+		}
+		if _, ok := n.vq.DVExists(multivector.SubID(r.GetId(), 0)); ok {
+			continue
+		}
+		results = append(results, r)
+	}
+	for uuid, d := range queued {
+		results = append(results, &payload.Object_Distance{
+			Id:       uuid,
+			Distance: d,
+		})
+	}
+	slices.SortFunc(results, func(a, b *payload.Object_Distance) int {
+		return cmp.Or(cmp.Compare(a.GetDistance(), b.GetDistance()), strings.Compare(a.GetId(), b.GetId()))
+	})
+	if len(results) > int(size) {
+		results = results[:size]
+	}
+	if len(results) == 0 {
+		if n.Len() == 0 && n.vq.IVQLen() == 0 {
+			return nil, nil
+		}
+		return nil, errors.ErrEmptySearchResult
+	}
+	if res == nil {
+		res = new(payload.Search_Response)
+	}
+	res.Results = results
+	return res, nil
+}
+
 func (n *ngt) Insert(uuid string, vec []float32) (err error) {
 	if n.IsFlushing() {
 		return errors.ErrFlushingIsInProgress
@@ -1041,6 +1882,9 @@ func (n *ngt) insert(uuid string, vec []float32, t int64, validation bool) (err
 		return err
 	}
 	if validation {
+		if !multivector.IsValidID(uuid) {
+			return errors.ErrInvalidMultiVectorID(uuid)
+		}
 		_, ok := n.Exists(uuid)
 		if ok {
 			return errors.ErrUUIDAlreadyExists(uuid)
@@ -1147,16 +1991,24 @@ func (n *ngt) UpdateTimestamp(uuid string, ts int64, force bool) (err error) {
 	if n.IsFlushing() {
 		return errors.ErrFlushingIsInProgress
 	}
-	return memstore.UpdateTimestamp(n.kvs, n.vq, uuid, ts, force, func(oid uint32) ([]float32, error) {
-		return n.core.GetVector(uint(oid))
-	})
+	err = memstore.UpdateTimestamp(n.kvs, n.vq, uuid, ts, force, n.getVector)
+	if err != nil {
+		return err
+	}
+	n.markDirty(uuid)
+	return nil
 }
 
 func (n *ngt) Delete(uuid string) (err error) {
 	if n.IsFlushing() {
 		return errors.ErrFlushingIsInProgress
 	}
-	return n.delete(uuid, time.Now().UnixNano(), true)
+	err = n.delete(uuid, time.Now().UnixNano(), true)
+	if err != nil {
+		return err
+	}
+	n.deleteAttached(uuid)
+	return nil
 }
 
 func (n *ngt) DeleteWithTime(uuid string, t int64) (err error) {
@@ -1166,7 +2018,12 @@ func (n *ngt) DeleteWithTime(uuid string, t int64) (err error) {
 	if t <= 0 {
 		t = time.Now().UnixNano()
 	}
-	return n.delete(uuid, t, true)
+	err = n.delete(uuid, t, true)
+	if err != nil {
+		return err
+	}
+	n.deleteAttached(uuid)
+	return nil
 }
 
 func (n *ngt) delete(uuid string, t int64, validation bool) (err error) {
@@ -1174,6 +2031,11 @@ func (n *ngt) delete(uuid string, t int64, validation bool) (err error) {
 		err = errors.ErrUUIDNotFound(0)
 		return err
 	}
+	if ids := n.subIDs(uuid); len(ids) != 0 {
+		// the multi-vector document is deleted with all of its sub-vectors.
+		n.markDirty(uuid)
+		return n.deleteMultiple(ids, t, false)
+	}
 	if validation {
 		_, _, ok := n.kvs.Get(uuid)
 		if !ok && func() (ok bool) {
@@ -1190,7 +2052,9 @@ func (n *ngt) DeleteMultiple(uuids ...string) (err error) {
 	if n.IsFlushing() {
 		return errors.ErrFlushingIsInProgress
 	}
-	return n.deleteMultiple(uuids, time.Now().UnixNano(), true)
+	err = n.deleteMultiple(uuids, time.Now().UnixNano(), true)
+	n.deleteAttached(uuids...)
+	return err
 }
 
 func (n *ngt) DeleteMultipleWithTime(uuids []string, t int64) (err error) {
@@ -1200,21 +2064,183 @@ func (n *ngt) DeleteMultipleWithTime(uuids []string, t int64) (err error) {
 	if t <= 0 {
 		t = time.Now().UnixNano()
 	}
-	return n.deleteMultiple(uuids, t, true)
+	err = n.deleteMultiple(uuids, t, true)
+	n.deleteAttached(uuids...)
+	return err
+}
+
+func (n *ngt) deleteMultiple(uuids []string, now int64, validation bool) (err error) {
+	for _, uuid := range uuids {
+		ierr := n.delete(uuid, now, validation)
+		if ierr != nil {
+			if err != nil {
+				err = errors.Join(ierr, err)
+			} else {
+				err = ierr
+			}
+		}
+	}
+	return err
+}
+
+// InsertMultiVector inserts the multi-vector document which sub-vectors are stored as the separate objects of the index.
+func (n *ngt) InsertMultiVector(doc string, vecs [][]float32) (err error) {
+	if n.IsFlushing() {
+		return errors.ErrFlushingIsInProgress
+	}
+	return n.insertMultiVector(doc, vecs, time.Now().UnixNano())
+}
+
+func (n *ngt) InsertMultiVectorWithTime(doc string, vecs [][]float32, t int64) (err error) {
+	if n.IsFlushing() {
+		return errors.ErrFlushingIsInProgress
+	}
+	if t <= 0 {
+		t = time.Now().UnixNano()
+	}
+	return n.insertMultiVector(doc, vecs, t)
+}
+
+func (n *ngt) insertMultiVector(doc string, vecs [][]float32, t int64) (err error) {
+	if len(doc) == 0 {
+		return errors.ErrUUIDNotFound(0)
+	}
+	if !multivector.IsValidID(doc) {
+		return errors.ErrInvalidMultiVectorID(doc)
+	}
+	if len(vecs) == 0 {
+		return errors.ErrInvalidDimensionSize(0, n.GetDimensionSize())
+	}
+	if _, ok := n.Exists(doc); ok {
+		return errors.ErrUUIDAlreadyExists(doc)
+	}
+	for i, vec := range vecs {
+		err = n.insert(multivector.SubID(doc, i), vec, t, false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateMultiVector replaces all of the sub-vectors of the multi-vector document.
+func (n *ngt) UpdateMultiVector(doc string, vecs [][]float32) (err error) {
+	if n.IsFlushing() {
+		return errors.ErrFlushingIsInProgress
+	}
+	return n.updateMultiVector(doc, vecs, time.Now().UnixNano())
+}
+
+func (n *ngt) UpdateMultiVectorWithTime(doc string, vecs [][]float32, t int64) (err error) {
+	if n.IsFlushing() {
+		return errors.ErrFlushingIsInProgress
+	}
+	if t <= 0 {
+		t = time.Now().UnixNano()
+	}
+	return n.updateMultiVector(doc, vecs, t)
+}
+
+func (n *ngt) updateMultiVector(doc string, vecs [][]float32, t int64) (err error) {
+	if len(doc) == 0 {
+		return errors.ErrUUIDNotFound(0)
+	}
+	if len(vecs) == 0 {
+		return errors.ErrInvalidDimensionSize(0, n.GetDimensionSize())
+	}
+	ids := n.subIDs(doc)
+	if len(ids) == 0 {
+		return errors.ErrObjectIDNotFound(doc)
+	}
+	err = n.deleteMultiple(ids, t, false)
+	if err != nil {
+		return err
+	}
+	t++
+	for i, vec := range vecs {
+		err = n.insert(multivector.SubID(doc, i), vec, t, false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetMultiVector returns the sub-vectors of the multi-vector document and the latest timestamp of them.
+func (n *ngt) GetMultiVector(doc string) (vecs [][]float32, timestamp int64, err error) {
+	ids := n.subIDs(doc)
+	if len(ids) == 0 {
+		return nil, 0, errors.ErrObjectIDNotFound(doc)
+	}
+	vecs = make([][]float32, 0, len(ids))
+	for _, id := range ids {
+		vec, ts, err := n.GetObject(id)
+		if err != nil {
+			return nil, 0, err
+		}
+		vecs = append(vecs, vec)
+		timestamp = max(timestamp, ts)
+	}
+	return vecs, timestamp, nil
+}
+
+// subIDs returns the ids of the sub-vectors of the multi-vector document, which are numbered continuously from 0.
+func (n *ngt) subIDs(doc string) (ids []string) {
+	for i := 0; ; i++ {
+		id := multivector.SubID(doc, i)
+		if _, ok := memstore.Exists(n.kvs, n.vq, id); !ok {
+			return ids
+		}
+		ids = append(ids, id)
+	}
+}
+
+// SetAttributes replaces the attributes of the uuid's vector.
+// The attributes are removed when attrs is empty.
+// They are also written to the write-ahead log of the vqueue to survive a crash before the index is saved.
+func (n *ngt) SetAttributes(uuid string, attrs attribute.Attributes) (err error) {
+	if n.IsFlushing() {
+		return errors.ErrFlushingIsInProgress
+	}
+	if len(uuid) == 0 {
+		return errors.ErrUUIDNotFound(0)
+	}
+	n.attrs.Set(uuid, attrs)
+	n.markDirty(uuid)
+	return n.vq.SetAttributes(uuid, attrs)
+}
+
+// GetAttributes returns the attributes of the uuid's vector.
+func (n *ngt) GetAttributes(uuid string) (attrs attribute.Attributes, ok bool) {
+	return n.attrs.Get(uuid)
+}
+
+// SetSparseVector replaces the sparse vector of the uuid's vector.
+// The sparse vector is removed when vec is empty.
+// It is also written to the write-ahead log of the vqueue to survive a crash before the index is saved.
+func (n *ngt) SetSparseVector(uuid string, vec sparse.Vector) (err error) {
+	if n.IsFlushing() {
+		return errors.ErrFlushingIsInProgress
+	}
+	if len(uuid) == 0 {
+		return errors.ErrUUIDNotFound(0)
+	}
+	n.sparse.Set(uuid, vec)
+	n.markDirty(uuid)
+	return n.vq.SetSparseVector(uuid, vec)
+}
+
+// GetSparseVector returns the sparse vector of the uuid's vector.
+func (n *ngt) GetSparseVector(uuid string) (vec sparse.Vector, ok bool) {
+	return n.sparse.Get(uuid)
 }
 
-func (n *ngt) deleteMultiple(uuids []string, now int64, validation bool) (err error) {
+// deleteAttached deletes the attributes and the sparse vectors attached to the uuids' vectors.
+func (n *ngt) deleteAttached(uuids ...string) {
 	for _, uuid := range uuids {
-		ierr := n.delete(uuid, now, validation)
-		if ierr != nil {
-			if err != nil {
-				err = errors.Join(ierr, err)
-			} else {
-				err = ierr
-			}
-		}
+		n.attrs.Delete(uuid)
+		n.sparse.Delete(uuid)
 	}
-	return err
 }
 
 // RegenerateIndexes deletes the KVS and file, and then re-generate the NGT instance.
@@ -1247,13 +2273,21 @@ func (n *ngt) RegenerateIndexes(ctx context.Context) (err error) {
 	n.flushing.Store(true)
 	n.indexing.Store(true)
 	defer n.flushing.Store(false)
-	defer n.indexing.Store(false)
+	defer n.storeState(&n.indexing, false)
 
 	// delete kvs
 	err = n.kvs.Close()
 	if err != nil {
 		log.Errorf("failed to flushing vector to ngt index in delete kvs. error: %v", err)
 	}
+	err = n.attrs.Close()
+	if err != nil {
+		log.Errorf("failed to flushing vector to ngt index in delete attributes. error: %v", err)
+	}
+	err = n.sparse.Close()
+	if err != nil {
+		log.Errorf("failed to flushing vector to ngt index in delete sparse vectors. error: %v", err)
+	}
 
 	// gc
 	runtime.GC()
@@ -1275,6 +2309,31 @@ func (n *ngt) RegenerateIndexes(ctx context.Context) (err error) {
 		}
 	}
 
+	// delete vqueue wal
+	err = n.vq.Close()
+	if err != nil {
+		log.Errorf("failed to flushing vector to ngt index in close vqueue. error: %v", err)
+	}
+	if !n.inMem {
+		path := file.Join(n.basePath, vqueueWALFileName)
+		err = os.Remove(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Errorf("failed to flushing vector to ngt index in delete vqueue wal.\tpath: '%s', error: %v", path, err)
+		}
+	}
+
+	// delete full-precision vectors
+	if n.refinement != nil {
+		err = n.refinement.Reset()
+		if err != nil {
+			log.Errorf("failed to flushing vector to ngt index in reset full-precision vectors. error: %v", err)
+		}
+		err = n.refinement.Close()
+		if err != nil {
+			log.Errorf("failed to flushing vector to ngt index in close full-precision vectors. error: %v", err)
+		}
+	}
+
 	// renew instance
 	nn, err := newNGT(n.cfg, n.opts...)
 	if err != nil {
@@ -1333,7 +2392,7 @@ func (n *ngt) CreateIndex(ctx context.Context, poolSize uint32) (err error) {
 	defer n.cimu.Unlock()
 	n.indexing.Store(true)
 	now := time.Now().UnixNano()
-	defer n.indexing.Store(false)
+	defer n.storeState(&n.indexing, false)
 	defer n.gc()
 	ic = n.vq.IVQLen() + n.vq.DVQLen()
 	if ic == 0 {
@@ -1359,6 +2418,7 @@ func (n *ngt) CreateIndex(ctx context.Context, poolSize uint32) (err error) {
 			n.fmu.Unlock()
 		}
 		log.Debugf("removed from ngt index and kvsdb id: %s, oid: %d", uuid, oid)
+		n.markDirty(uuid)
 
 		vqProcessedCnt++
 		return true
@@ -1369,20 +2429,23 @@ func (n *ngt) CreateIndex(ctx context.Context, poolSize uint32) (err error) {
 	var icnt uint32
 	n.vq.RangePopInsert(ctx, now, func(uuid string, vector []float32, timestamp int64) bool {
 		var oid uint
-		oid, err = n.core.Insert(vector)
+		code := n.encode(vector)
+		oid, err = n.core.Insert(code)
 		if err != nil {
 			log.Warnf("failed to insert vector uuid: %s vec: %v to ngt index. error: %v", uuid, vector, err)
 			if errors.Is(err, errors.ErrIncompatibleDimensionSize(len(vector), n.dim)) {
 				log.Error(err)
 				return true
 			}
-			oid, err = n.core.Insert(vector)
+			oid, err = n.core.Insert(code)
 			if err != nil {
 				log.Errorf("failed to retry insert vector uuid: %s vec: %v to ngt index. error: %v", uuid, vector, err)
 				return true
 			}
 		}
 		n.kvs.Set(uuid, uint32(oid), timestamp)
+		n.setRefinement(uint32(oid), uuid, vector)
+		n.markDirty(uuid)
 		atomic.AddUint32(&icnt, 1)
 
 		n.fmu.Lock()
@@ -1497,6 +2560,7 @@ func (n *ngt) removeInvalidIndex(ctx context.Context) {
 		if err != nil || vec == nil || len(vec) != n.dim {
 			log.Debugf("invalid index detected err: %v\tuuid: %s\toid: %d will remove", err, uuid, oid)
 			n.kvs.Delete(uuid)
+			n.markDirty(uuid)
 			n.fmu.Lock()
 			err = n.core.Remove(uint(oid))
 			n.fmap[uuid] = int64(oid)
@@ -1520,7 +2584,7 @@ func (n *ngt) removeInvalidIndex(ctx context.Context) {
 	n.cimu.Lock()
 	defer n.cimu.Unlock()
 	n.indexing.Store(true)
-	defer n.indexing.Store(false)
+	defer n.storeState(&n.indexing, false)
 	log.Debug("create graph and tree phase for removing invalid index started")
 	err := n.core.CreateIndex(poolSize)
 	if err != nil {
@@ -1583,12 +2647,39 @@ func (n *ngt) saveIndex(ctx context.Context) (err error) {
 
 	defer n.gc()
 	// since deferring here, atomic operations are guaranteed in this scope
-	defer n.saving.Store(false)
+	defer n.storeState(&n.saving, false)
 
 	log.Debug("cleanup invalid index started")
 	n.removeInvalidIndex(ctx)
 	log.Debug("cleanup invalid index finished")
 
+	if n.isDeltaSave() {
+		err = n.saveDelta(ctx, nocie)
+	} else {
+		err = n.saveBase(ctx, nocie)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the committed operations are persisted by the saved index, so drop them from the write-ahead log
+	if err := n.vq.TruncateWAL(); err != nil {
+		log.Warnf("failed to truncate vqueue wal, err: %v", err)
+	}
+	log.Info("save index operation finished")
+
+	// now save operation succeeds, subtract it from n.nopvq
+	n.nopvq.Add(-beforeNopvq)
+	if n.enableExportIndexInfo {
+		if err := n.exportMetricsOnSaveIndex(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveBase saves the whole index, kvsdb and metadata as a new base snapshot.
+func (n *ngt) saveBase(ctx context.Context, nocie uint64) (err error) {
 	eg, ectx := errgroup.New(ctx)
 	// we want to ensure the actual kvs size between kvsdb and metadata,
 	// so we create this counter to count the actual kvs size instead of using kvs.Len()
@@ -1606,6 +2697,16 @@ func (n *ngt) saveIndex(ctx context.Context) (err error) {
 	defer n.smu.Unlock()
 	log.Infof("save index operation started, the number of create index execution = %d", nocie)
 
+	// the base snapshot contains every change, so the changes tracked for the delta snapshot are not needed anymore.
+	// they are restored when the save operation fails.
+	dirty := n.takeDirty()
+	defer func() {
+		if err != nil {
+			n.markDirty(dirty...)
+		}
+	}()
+	snapshotID := time.Now().UnixNano()
+
 	if n.kvs.Len() > 0 && path != "" {
 		eg.Go(safety.RecoverFunc(func() (err error) {
 			log.Debugf("start save operation for kvsdb, the number of kvsdb = %d", n.kvs.Len())
@@ -1695,6 +2796,22 @@ func (n *ngt) saveIndex(ctx context.Context) (err error) {
 		}))
 	}
 
+	if n.refinement != nil {
+		eg.Go(safety.RecoverFunc(func() (err error) {
+			if n.enableCopyOnWrite && path != "" {
+				// the copy on write saves the copy of the full-precision vectors into the temporary index directory,
+				// and the storage is switched to it after the index directory is switched.
+				err = n.refinement.CopyTo(file.Join(path, refinementFileName))
+			} else {
+				err = n.refinement.Sync()
+			}
+			if err != nil {
+				log.Warnf("failed to flush full-precision vectors to storage, err: %v", err)
+			}
+			return err
+		}))
+	}
+
 	eg.Go(safety.RecoverFunc(func() (err error) {
 		n.fmu.Lock()
 		fl := len(n.fmap)
@@ -1737,6 +2854,97 @@ func (n *ngt) saveIndex(ctx context.Context) (err error) {
 		return nil
 	}))
 
+	if path != "" {
+		eg.Go(safety.RecoverFunc(func() (err error) {
+			log.Debugf("start save operation for attribute kvsdb, the number of attribute kvsdb = %d", n.attrs.Len())
+			ma := make(map[string]attribute.Attributes, n.attrs.Len())
+			defer func() {
+				ma = nil
+			}()
+			var mu sync.Mutex
+			n.attrs.Range(ectx, func(uuid string, attrs attribute.Attributes) bool {
+				mu.Lock()
+				ma[uuid] = attrs
+				mu.Unlock()
+				return true
+			})
+			var f *os.File
+			f, err = file.Open(
+				file.Join(path, kvsAttributeFileName),
+				os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
+				fs.ModePerm,
+			)
+			if err != nil {
+				log.Warnf("failed to create or open attribute kvsdb file, err: %v", err)
+				return err
+			}
+			defer func() {
+				if f != nil {
+					derr := f.Close()
+					if derr != nil {
+						err = errors.Join(err, derr)
+					}
+				}
+			}()
+			gob.Register(map[string]attribute.Attributes{})
+			err = gob.NewEncoder(f).Encode(&ma)
+			if err != nil {
+				log.Warnf("failed to encode attribute kvsdb data, err: %v", err)
+				return err
+			}
+			err = f.Sync()
+			if err != nil {
+				log.Warnf("failed to flush all attribute kvsdb data to storage, err: %v", err)
+				return err
+			}
+			log.Debug("save operation for attribute kvsdb finished")
+			return nil
+		}))
+
+		eg.Go(safety.RecoverFunc(func() (err error) {
+			log.Debugf("start save operation for sparse kvsdb, the number of sparse kvsdb = %d", n.sparse.Len())
+			ms := make(map[string]sparse.Vector, n.sparse.Len())
+			defer func() {
+				ms = nil
+			}()
+			n.sparse.Range(ectx, func(uuid string, vec sparse.Vector) bool {
+				ms[uuid] = vec
+				return true
+			})
+			var f *os.File
+			f, err = file.Open(
+				file.Join(path, kvsSparseFileName),
+				os.O_WRONLY|os.O_CREATE|os.O_TRUNC,
+				fs.ModePerm,
+			)
+			if err != nil {
+				log.Warnf("failed to create or open sparse kvsdb file, err: %v", err)
+				return err
+			}
+			defer func() {
+				if f != nil {
+					derr := f.Close()
+					if derr != nil {
+						err = errors.Join(err, derr)
+					}
+				}
+			}()
+			gob.Register(map[string]sparse.Vector{})
+			err = gob.NewEncoder(f).Encode(&ms)
+			if err != nil {
+				log.Warnf("failed to encode sparse kvsdb data, err: %v", err)
+				return err
+			}
+			err = f.Sync()
+			if err != nil {
+				log.Warnf("failed to flush all sparse kvsdb data to storage, err: %v", err)
+				return err
+			}
+			log.Debug("save operation for sparse kvsdb finished")
+			return nil
+		}))
+	}
+
 	eg.Go(safety.RecoverFunc(func() error {
 		log.Debug("start save operation for index")
 		if err := n.core.SaveIndexWithPath(path); err != nil {
@@ -1752,6 +2960,15 @@ func (n *ngt) saveIndex(ctx context.Context) (err error) {
 		return err
 	}
 
+	// the delta snapshots of the previous base snapshot must not be applied to the new one.
+	if path != "" {
+		err = os.RemoveAll(file.Join(path, delta.DirName))
+		if err != nil {
+			log.Warnf("failed to remove delta snapshots, err: %v", err)
+			return err
+		}
+	}
+
 	log.Debug("start save operation for metadata file")
 	err = metadata.Store(
 		file.Join(path, metadata.AgentMetadataFileName),
@@ -1759,6 +2976,7 @@ func (n *ngt) saveIndex(ctx context.Context) (err error) {
 			IsInvalid: false,
 			NGT: &metadata.NGT{
 				IndexCount: kvsLen,
+				SnapshotID: snapshotID,
 			},
 		},
 	)
@@ -1768,19 +2986,20 @@ func (n *ngt) saveIndex(ctx context.Context) (err error) {
 	}
 	log.Debug("save operation for metadata file finished")
 
-	if err := n.moveAndSwitchSavedData(ctx); err != nil {
+	err = n.moveAndSwitchSavedData(ctx)
+	if n.enableCopyOnWrite && n.refinement != nil {
+		// the storage of the full-precision vectors follows the index directory even when the switch is rolled back.
+		if rerr := n.refinement.Reopen(file.Join(n.path, refinementFileName)); rerr != nil {
+			log.Warnf("failed to reopen full-precision vectors storage, err: %v", rerr)
+			err = errors.Join(err, rerr)
+		}
+	}
+	if err != nil {
 		log.Warnf("failed to move and switch saved data for copy on write, err: %v", err)
 		return err
 	}
-	log.Info("save index operation finished")
-
-	// now save operation succeeds, subtract it from n.nopvq
-	n.nopvq.Add(-beforeNopvq)
-	if n.enableExportIndexInfo {
-		if err := n.exportMetricsOnSaveIndex(ctx); err != nil {
-			return err
-		}
-	}
+	n.snapshotID = snapshotID
+	n.deltaSeq = 0
 	return nil
 }
 
@@ -1839,13 +3058,16 @@ func (n *ngt) Exists(uuid string) (oid uint32, ok bool) {
 			uuid, errors.ErrFlushingIsInProgress)
 		return 0, false
 	}
-	return memstore.Exists(n.kvs, n.vq, uuid)
+	oid, ok = memstore.Exists(n.kvs, n.vq, uuid)
+	if !ok {
+		// the multi-vector document exists while its first sub-vector exists.
+		oid, ok = memstore.Exists(n.kvs, n.vq, multivector.SubID(uuid, 0))
+	}
+	return oid, ok
 }
 
 func (n *ngt) GetObject(uuid string) (vec []float32, timestamp int64, err error) {
-	return memstore.GetObject(n.kvs, n.vq, uuid, func(oid uint32) ([]float32, error) {
-		return n.core.GetVector(uint(oid))
-	})
+	return memstore.GetObject(n.kvs, n.vq, uuid, n.getVector)
 }
 
 func (n *ngt) readyForUpdate(uuid string, vec []float32, ts int64) (err error) {
@@ -1876,6 +3098,38 @@ func (n *ngt) readyForUpdate(uuid string, vec []float32, ts int64) (err error) {
 	return errors.ErrUUIDAlreadyExists(uuid)
 }
 
+// storeState stores the state of the indexing or the saving, and wakes up the waiters of the idle state when it is finished.
+func (n *ngt) storeState(state *atomic.Value, v bool) {
+	n.idlemu.Lock()
+	defer n.idlemu.Unlock()
+	state.Store(v)
+	if !v && n.idle != nil {
+		close(n.idle)
+		n.idle = nil
+	}
+}
+
+// waitIdle waits until both the indexing and the saving are finished or the context is canceled.
+func (n *ngt) waitIdle(ctx context.Context) error {
+	for {
+		n.idlemu.Lock()
+		if !n.IsIndexing() && !n.IsSaving() {
+			n.idlemu.Unlock()
+			return nil
+		}
+		if n.idle == nil {
+			n.idle = make(chan struct{})
+		}
+		idle := n.idle
+		n.idlemu.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-idle:
+		}
+	}
+}
+
 func (n *ngt) IsSaving() bool {
 	s, ok := n.saving.Load().(bool)
 	return s && ok
@@ -1890,6 +3144,10 @@ func (n *ngt) IsFlushing() bool {
 	return n.flushing.Load()
 }
 
+func (n *ngt) IsLoading() bool {
+	return n.loading.Load()
+}
+
 func (n *ngt) UUIDs(ctx context.Context) (uuids []string) {
 	return memstore.UUIDs(ctx, n.kvs, n.vq)
 }
@@ -1938,8 +3196,214 @@ func (n *ngt) GetDimensionSize() int {
 	return n.dim
 }
 
+// markDirty records the uuids changed since the last snapshot to be persisted by the next delta snapshot.
+func (n *ngt) markDirty(uuids ...string) {
+	if !n.enableDeltaSave || len(uuids) == 0 {
+		return
+	}
+	n.dmu.Lock()
+	if n.dirty == nil {
+		n.dirty = make(map[string]struct{}, len(uuids))
+	}
+	for _, uuid := range uuids {
+		n.dirty[uuid] = struct{}{}
+	}
+	n.dmu.Unlock()
+}
+
+// takeDirty returns the uuids changed since the last snapshot and resets them.
+func (n *ngt) takeDirty() (uuids []string) {
+	n.dmu.Lock()
+	defer n.dmu.Unlock()
+	if len(n.dirty) == 0 {
+		return nil
+	}
+	uuids = make([]string, 0, len(n.dirty))
+	for uuid := range n.dirty {
+		uuids = append(uuids, uuid)
+	}
+	n.dirty = nil
+	return uuids
+}
+
+// isDeltaSave returns true when the next save operation should write a delta snapshot instead of a new base snapshot.
+// A new base snapshot is written when no base snapshot exists yet or the number of the delta snapshots reaches the limit.
+func (n *ngt) isDeltaSave() bool {
+	return n.enableDeltaSave && n.snapshotID != 0 && n.deltaSeq < n.maxDeltas
+}
+
+// saveDelta saves the objects changed since the last snapshot as a delta snapshot of the current base snapshot.
+func (n *ngt) saveDelta(ctx context.Context, nocie uint64) (err error) {
+	n.smu.Lock()
+	defer n.smu.Unlock()
+	log.Infof("save delta snapshot operation started, the number of create index execution = %d", nocie)
+
+	dirty := n.takeDirty()
+	defer func() {
+		if err != nil {
+			n.markDirty(dirty...)
+		}
+	}()
+	snap := &delta.Snapshot{
+		BaseID:    n.snapshotID,
+		Seq:       n.deltaSeq + 1,
+		Timestamp: time.Now().UnixNano(),
+		Objects:   make([]delta.Object, 0, len(dirty)),
+	}
+	for _, uuid := range dirty {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		oid, ts, ok := n.kvs.Get(uuid)
+		if !ok {
+			if _, ok = memstore.Exists(n.kvs, n.vq, multivector.SubID(uuid, 0)); ok {
+				// the multi-vector document has no vector, its sub-vectors are saved as the separate objects.
+				attrs, _ := n.attrs.Get(uuid)
+				svec, _ := n.sparse.Get(uuid)
+				snap.Objects = append(snap.Objects, delta.Object{
+					UUID:       uuid,
+					Sparse:     svec,
+					Attributes: attrs,
+				})
+				continue
+			}
+			snap.Deleted = append(snap.Deleted, uuid)
+			continue
+		}
+		vec, err := n.getVector(oid)
+		if err != nil {
+			log.Warnf("failed to get vector of uuid: %s oid: %d for delta snapshot, err: %v", uuid, oid, err)
+			return err
+		}
+		attrs, _ := n.attrs.Get(uuid)
+		svec, _ := n.sparse.Get(uuid)
+		snap.Objects = append(snap.Objects, delta.Object{
+			UUID:       uuid,
+			Vector:     vec,
+			Sparse:     svec,
+			Timestamp:  ts,
+			Attributes: attrs,
+		})
+	}
+
+	// the delta snapshot is written into the live index directory, so it must not be moved at the same time in copy on write mode.
+	n.cowmu.Lock()
+	defer n.cowmu.Unlock()
+	path, err := delta.Store(file.Join(n.path, delta.DirName), snap)
+	if err != nil {
+		log.Warnf("failed to save delta snapshot, err: %v", err)
+		return err
+	}
+	n.deltaSeq = snap.Seq
+	log.Infof("delta snapshot %s saved, objects: %d, deleted: %d", path, len(snap.Objects), len(snap.Deleted))
+	return nil
+}
+
+// loadDeltas applies the delta snapshots of the base snapshot loaded from path to the loaded index and kvsdb.
+func (n *ngt) loadDeltas(path string, snapshotID int64) (err error) {
+	n.snapshotID = snapshotID
+	n.deltaSeq = 0
+	paths, err := delta.List(file.Join(path, delta.DirName))
+	if err != nil || len(paths) == 0 {
+		return err
+	}
+	var applied bool
+	for _, p := range paths {
+		snap, err := delta.Load(p)
+		if err != nil {
+			// the delta snapshots are applied in order, so the following ones cannot be applied either.
+			log.Warnf("failed to load delta snapshot %s, the following delta snapshots are ignored, err: %v", p, err)
+			break
+		}
+		if snapshotID == 0 || snap.BaseID != snapshotID {
+			log.Warn(errors.ErrDeltaSnapshotBaseMismatch(p, snap.BaseID, snapshotID))
+			continue
+		}
+		for _, uuid := range snap.Deleted {
+			n.removeLoaded(uuid)
+			n.deleteAttached(uuid)
+		}
+		for _, obj := range snap.Objects {
+			if obj.Vector == nil {
+				n.attrs.Set(obj.UUID, obj.Attributes)
+				n.sparse.Set(obj.UUID, obj.Sparse)
+				continue
+			}
+			n.removeLoaded(obj.UUID)
+			oid, err := n.core.Insert(n.encode(obj.Vector))
+			if err != nil {
+				log.Warnf("failed to insert uuid: %s of delta snapshot %s to ngt index, err: %v", obj.UUID, p, err)
+				continue
+			}
+			n.kvs.Set(obj.UUID, uint32(oid), obj.Timestamp)
+			n.setRefinement(uint32(oid), obj.UUID, obj.Vector)
+			n.attrs.Set(obj.UUID, obj.Attributes)
+			n.sparse.Set(obj.UUID, obj.Sparse)
+		}
+		n.deltaSeq = snap.Seq
+		applied = true
+		log.Infof("delta snapshot %s applied, objects: %d, deleted: %d", p, len(snap.Objects), len(snap.Deleted))
+	}
+	if !applied {
+		return nil
+	}
+	return n.core.CreateIndex(n.poolSize)
+}
+
+// loadEmptyBase creates the new index at path for the empty base snapshot and applies the delta snapshots of it.
+func (n *ngt) loadEmptyBase(path string, snapshotID int64, opts ...core.Option) (err error) {
+	log.Infof("the base snapshot at %s is empty, now starting to apply the delta snapshots to the new index", path)
+	if n.core != nil {
+		n.core.Close()
+		n.core = nil
+	}
+	n.core, err = core.New(append(opts, core.WithIndexPath(path))...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create ngt index for the empty base snapshot at path: %s", path)
+	}
+	if n.kvs.Len() > 0 {
+		n.kvs.Close()
+		n.kvs = kvs.New(kvs.WithConcurrency(n.kvsdbConcurrency))
+	}
+	return n.loadDeltas(path, snapshotID)
+}
+
+// removeLoaded removes the uuid from the loaded index and kvsdb.
+func (n *ngt) removeLoaded(uuid string) {
+	oid, ok := n.kvs.Delete(uuid)
+	if !ok {
+		return
+	}
+	if err := n.core.Remove(uint(oid)); err != nil {
+		log.Warnf("failed to remove uuid: %s oid: %d from ngt index, err: %v", uuid, oid, err)
+	}
+}
+
 func (n *ngt) Close(ctx context.Context) (err error) {
+	defer func() {
+		cerr := n.closeCollections(ctx)
+		if cerr != nil {
+			err = errors.Join(err, cerr)
+		}
+	}()
 	defer n.core.Close()
+	defer func() {
+		if n.refinement == nil {
+			return
+		}
+		rerr := n.refinement.Close()
+		if rerr != nil {
+			err = errors.Join(err, rerr)
+		}
+	}()
+	defer func() {
+		verr := n.vq.Close()
+		if verr != nil {
+			err = errors.Join(err, verr)
+		}
+	}()
 	defer func() {
 		kerr := n.kvs.Close()
 		if errors.IsNot(kerr, context.Canceled, context.DeadlineExceeded) {
@@ -2046,7 +3510,7 @@ func (n *ngt) IndexProperty() (*payload.Info_Index_Property, error) {
 }
 
 func (n *ngt) toSearchResponse(
-	sr []algorithm.SearchResult,
+	sr []algorithm.SearchResult, reranked bool,
 ) (res *payload.Search_Response, err error) {
 	if len(sr) == 0 {
 		if n.Len() == 0 {
@@ -2056,8 +3520,10 @@ func (n *ngt) toSearchResponse(
 	}
 
 	res = &payload.Search_Response{
-		Results: make([]*payload.Object_Distance, 0, len(sr)),
+		Results:  make([]*payload.Object_Distance, 0, len(sr)),
+		Reranked: reranked,
 	}
+	visited := make(map[string]struct{}, len(sr))
 	for _, d := range sr {
 		if err = d.Error; d.ID == 0 && err != nil {
 			log.Warnf("an error occurred while searching: %v", err)
@@ -2065,6 +3531,9 @@ func (n *ngt) toSearchResponse(
 		}
 		key, _, ok := n.kvs.GetInverse(d.ID)
 		if ok {
+			if key, ok = collapse(visited, key); !ok {
+				continue
+			}
 			res.Results = append(res.GetResults(), &payload.Object_Distance{
 				Id:       key,
 				Distance: d.Distance,
@@ -2082,6 +3551,21 @@ func (n *ngt) toSearchResponse(
 	return res, nil
 }
 
+// collapse returns the document id of the sub-vector id of the multi-vector document.
+// Since the search results are sorted by the distance, only the nearest sub-vector of each document is returned,
+// and false is returned for the other sub-vectors of the document already visited.
+func collapse(visited map[string]struct{}, uuid string) (string, bool) {
+	doc, _, ok := multivector.Parse(uuid)
+	if !ok {
+		return uuid, true
+	}
+	if _, ok = visited[doc]; ok {
+		return doc, false
+	}
+	visited[doc] = struct{}{}
+	return doc, true
+}
+
 func (n *ngt) uncommittedEntry() (k, v string) {
 	return vald.UncommittedAnnotationsKey, strconv.FormatUint(n.InsertVQueueBufferLen()+n.DeleteVQueueBufferLen(), 10)
 }
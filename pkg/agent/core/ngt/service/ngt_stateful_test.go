@@ -513,7 +513,7 @@ var (
 			ngtSys := systemUnderTest.(*ngtSystem)
 			ngt := ngtSys.ngt
 
-			res, err := ngt.Search(ngtSys.ctx, []float32{0.1, 0.1, 0.1}, 3, 0.1, -1.0, 0)
+			res, err := ngt.Search(ngtSys.ctx, []float32{0.1, 0.1, 0.1}, 3, 0.1, -1.0, 0, nil)
 			return &resultContainer{
 				err:     err,
 				results: res,
@@ -586,7 +586,7 @@ var (
 			ngtSys := systemUnderTest.(*ngtSystem)
 			ngt := ngtSys.ngt
 
-			_, res, err := ngt.SearchByID(ngtSys.ctx, idA, 3, 0.1, -1.0, 0)
+			_, res, err := ngt.SearchByID(ngtSys.ctx, idA, 3, 0.1, -1.0, 0, nil)
 			return &resultContainer{
 				err:     err,
 				results: res,
@@ -666,7 +666,7 @@ var (
 			ngtSys := systemUnderTest.(*ngtSystem)
 			ngt := ngtSys.ngt
 
-			_, res, err := ngt.SearchByID(ngtSys.ctx, idB, 3, 0.1, -1.0, 0)
+			_, res, err := ngt.SearchByID(ngtSys.ctx, idB, 3, 0.1, -1.0, 0, nil)
 			return &resultContainer{
 				err:     err,
 				results: res,
@@ -746,7 +746,7 @@ var (
 			ngtSys := systemUnderTest.(*ngtSystem)
 			ngt := ngtSys.ngt
 
-			_, res, err := ngt.SearchByID(ngtSys.ctx, idC, 3, 0.1, -1.0, 0)
+			_, res, err := ngt.SearchByID(ngtSys.ctx, idC, 3, 0.1, -1.0, 0, nil)
 			return &resultContainer{
 				err:     err,
 				results: res,
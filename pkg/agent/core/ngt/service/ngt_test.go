@@ -1530,6 +1530,137 @@ func Test_ngt_InsertUpsert(t *testing.T) {
 }
 
 // NOTE: After moving this implementation to the e2e package, remove this test function.
+func Test_ngt_SearchQueued(t *testing.T) {
+	type queued struct {
+		inserts map[string][]float32
+		deletes []string
+	}
+	type want struct {
+		ids       []string
+		distances []float32
+		err       error
+	}
+	tests := []struct {
+		name   string
+		queued queued
+		res    *payload.Search_Response
+		size   uint32
+		want   want
+	}{
+		{
+			name: "return the nearest vectors in the insert queue when the index is empty",
+			queued: queued{
+				inserts: map[string][]float32{
+					"a": {1, 0},
+					"b": {5, 0},
+				},
+			},
+			size: 1,
+			want: want{
+				ids:       []string{"a"},
+				distances: []float32{1},
+			},
+		},
+		{
+			name: "return the results merged with the insert queue without the deleted ones",
+			queued: queued{
+				inserts: map[string][]float32{
+					"a": {2, 0},
+				},
+				deletes: []string{"x"},
+			},
+			res: &payload.Search_Response{
+				Results: []*payload.Object_Distance{
+					{Id: "x", Distance: 0.5},
+					{Id: "y", Distance: 3},
+				},
+			},
+			size: 10,
+			want: want{
+				ids:       []string{"a", "y"},
+				distances: []float32{2, 3},
+			},
+		},
+		{
+			name: "return the distance of the updated vector in the insert queue",
+			queued: queued{
+				inserts: map[string][]float32{
+					"y": {4, 0},
+				},
+			},
+			res: &payload.Search_Response{
+				Results: []*payload.Object_Distance{
+					{Id: "y", Distance: 0.1},
+				},
+			},
+			size: 10,
+			want: want{
+				ids:       []string{"y"},
+				distances: []float32{4},
+			},
+		},
+		{
+			name: "return ErrEmptySearchResult when all results are deleted",
+			queued: queued{
+				deletes: []string{"x"},
+			},
+			res: &payload.Search_Response{
+				Results: []*payload.Object_Distance{
+					{Id: "x", Distance: 0.5},
+				},
+			},
+			size: 10,
+			want: want{
+				err: errors.ErrEmptySearchResult,
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			vq, err := vqueue.New()
+			if err != nil {
+				tt.Fatal(err)
+			}
+			ts := time.Now().UnixNano()
+			for _, uuid := range test.queued.deletes {
+				if err := vq.PushDelete(uuid, ts); err != nil {
+					tt.Fatal(err)
+				}
+			}
+			for uuid, vec := range test.queued.inserts {
+				if err := vq.PushInsert(uuid, vec, ts+1); err != nil {
+					tt.Fatal(err)
+				}
+			}
+			n := &ngt{
+				kvs: kvs.New(),
+				vq:  vq,
+				cfg: &config.NGT{
+					DistanceType: "l2",
+				},
+			}
+			for i, r := range test.res.GetResults() {
+				n.kvs.Set(r.GetId(), uint32(i+1), ts-1)
+			}
+			res, err := n.SearchQueued(context.Background(), []float32{0, 0}, test.size, -1, nil, test.res)
+			if !errors.Is(err, test.want.err) {
+				tt.Fatalf("error = %v, want %v", err, test.want.err)
+			}
+			if len(res.GetResults()) != len(test.want.ids) {
+				tt.Fatalf("results = %v, want ids %v", res.GetResults(), test.want.ids)
+			}
+			for i, r := range res.GetResults() {
+				if r.GetId() != test.want.ids[i] || r.GetDistance() != test.want.distances[i] {
+					tt.Errorf("results[%d] = %v, want id %s and distance %v", i, r, test.want.ids[i], test.want.distances[i])
+				}
+			}
+		})
+	}
+}
+
 func Test_ngt_E2E(t *testing.T) {
 	if testing.Short() {
 		t.Skip("The execution of this test takes a lot of time, so it is not performed during the short test\ttest: Test_ngt_E2E")
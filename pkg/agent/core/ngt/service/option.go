@@ -47,6 +47,9 @@ var defaultOptions = []Option{
 	WithDefaultRadius(core.DefaultRadius),
 	WithDefaultEpsilon(core.DefaultEpsilon),
 	WithProactiveGC(true),
+	WithMaxDeltaSnapshots(10),
+	WithMaxFilteredSearchCandidates(10000),
+	WithMaxRangeSearchResults(10000),
 	WithExportIndexInfoDuration("1m"),
 	WithEnableStatistics(false),
 }
@@ -305,6 +308,47 @@ func WithCopyOnWrite(enabled bool) Option {
 	}
 }
 
+// WithDeltaSave returns the functional option to set the delta save enable flag.
+func WithDeltaSave(enabled bool) Option {
+	return func(n *ngt) error {
+		n.enableDeltaSave = enabled
+		return nil
+	}
+}
+
+// WithMaxDeltaSnapshots returns the functional option to set the number of delta snapshots before compacting them into a new base snapshot.
+func WithMaxDeltaSnapshots(l int) Option {
+	return func(n *ngt) error {
+		if l <= 0 {
+			return nil
+		}
+		n.maxDeltas = uint64(l)
+		return nil
+	}
+}
+
+// WithMaxFilteredSearchCandidates returns the functional option to set the max number of candidates fetched by the filtered search.
+func WithMaxFilteredSearchCandidates(l int) Option {
+	return func(n *ngt) error {
+		if l <= 0 {
+			return nil
+		}
+		n.maxFilteredCandidates = uint64(l)
+		return nil
+	}
+}
+
+// WithMaxRangeSearchResults returns the functional option to set the max number of results of the range search.
+func WithMaxRangeSearchResults(l int) Option {
+	return func(n *ngt) error {
+		if l <= 0 {
+			return nil
+		}
+		n.maxRangeResults = uint64(l)
+		return nil
+	}
+}
+
 // WithIsReadReplica returns the functional option to set the read replica flag.
 func WithIsReadReplica(isReadReplica bool) Option {
 	return func(n *ngt) error {
@@ -69,6 +69,10 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 		service.WithDefaultEpsilon(cfg.NGT.DefaultEpsilon),
 		service.WithProactiveGC(cfg.NGT.EnableProactiveGC),
 		service.WithCopyOnWrite(cfg.NGT.EnableCopyOnWrite),
+		service.WithDeltaSave(cfg.NGT.EnableDeltaSave),
+		service.WithMaxDeltaSnapshots(cfg.NGT.MaxDeltaSnapshots),
+		service.WithMaxFilteredSearchCandidates(cfg.NGT.MaxFilteredSearchCandidates),
+		service.WithMaxRangeSearchResults(cfg.NGT.MaxRangeSearchResults),
 		service.WithIsReadReplica(cfg.NGT.IsReadReplica),
 		service.WithEnableStatistics(cfg.NGT.EnableStatistics),
 	}
@@ -0,0 +1,251 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute
+
+import (
+	"context"
+	"maps"
+	"sync/atomic"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/zeebo/xxh3"
+)
+
+// Kind represents the type of the attribute value.
+type Kind uint8
+
+const (
+	// Unknown represents the attribute value which type is not set.
+	Unknown Kind = iota
+	// String represents the string attribute value.
+	String
+	// Int represents the integer attribute value.
+	Int
+	// Float represents the floating point attribute value.
+	Float
+	// Bool represents the boolean attribute value.
+	Bool
+)
+
+// Value represents a typed attribute value.
+// The fields are exported to be encoded by gob when the agent saves the index.
+type Value struct {
+	S    string
+	I    int64
+	F    float64
+	B    bool
+	Kind Kind
+}
+
+// Attributes represents the attributes of a single vector.
+type Attributes map[string]Value
+
+// Store represents an interface for operating the per-vector attributes.
+type Store interface {
+	Get(uuid string) (Attributes, bool)
+	Set(uuid string, attrs Attributes)
+	Delete(uuid string) bool
+	Range(ctx context.Context, f func(uuid string, attrs Attributes) bool)
+	Len() uint64
+	Close() error
+}
+
+type shard struct {
+	m  map[string]Attributes
+	mu sync.RWMutex
+}
+
+type store struct {
+	shards      [slen]*shard
+	concurrency int
+	l           uint64
+}
+
+const (
+	// slen is shards length.
+	slen = 256
+	// mask is slen-1 Hex value.
+	mask = 0xFF
+
+	maxHashKeyLength = slen / 2
+)
+
+// New returns the store that satisfies the Store interface.
+func New(opts ...Option) Store {
+	s := new(store)
+	for _, opt := range append(defaultOptions, opts...) {
+		opt(s)
+	}
+	for i := range s.shards {
+		s.shards[i] = &shard{
+			m: make(map[string]Attributes),
+		}
+	}
+	return s
+}
+
+// Get returns the attributes of the given uuid.
+// If the attributes do not exist, it returns nil and false.
+func (s *store) Get(uuid string) (Attributes, bool) {
+	sh := s.shards[getShardID(uuid)]
+	sh.mu.RLock()
+	attrs, ok := sh.m[uuid]
+	sh.mu.RUnlock()
+	return attrs, ok
+}
+
+// Set stores the attributes of the given uuid.
+// If the attributes are empty, the stored attributes are deleted.
+func (s *store) Set(uuid string, attrs Attributes) {
+	if len(attrs) == 0 {
+		s.Delete(uuid)
+		return
+	}
+	sh := s.shards[getShardID(uuid)]
+	sh.mu.Lock()
+	_, loaded := sh.m[uuid]
+	sh.m[uuid] = attrs
+	sh.mu.Unlock()
+	if !loaded {
+		atomic.AddUint64(&s.l, 1)
+	}
+}
+
+// Delete deletes the attributes of the given uuid and returns true when they existed.
+func (s *store) Delete(uuid string) bool {
+	sh := s.shards[getShardID(uuid)]
+	sh.mu.Lock()
+	_, ok := sh.m[uuid]
+	if ok {
+		delete(sh.m, uuid)
+	}
+	sh.mu.Unlock()
+	if ok {
+		atomic.AddUint64(&s.l, ^uint64(0))
+	}
+	return ok
+}
+
+// Range retrieves all stored attributes and calls the callback function f.
+func (s *store) Range(ctx context.Context, f func(uuid string, attrs Attributes) bool) {
+	eg, ctx := errgroup.New(ctx)
+	if s.concurrency > 0 {
+		eg.SetLimit(s.concurrency)
+	}
+	for i := range s.shards {
+		idx := i
+		eg.Go(safety.RecoverFunc(func() (err error) {
+			// copy the shard entries so that f can modify the store.
+			sh := s.shards[idx]
+			sh.mu.RLock()
+			entries := make(map[string]Attributes, len(sh.m))
+			maps.Copy(entries, sh.m)
+			sh.mu.RUnlock()
+			for uuid, attrs := range entries {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+					if !f(uuid, attrs) {
+						return nil
+					}
+				}
+			}
+			return nil
+		}))
+	}
+	err := eg.Wait()
+	if err != nil &&
+		!errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+		log.Error(err)
+	}
+}
+
+// Len returns the number of the vectors which have attributes.
+func (s *store) Len() uint64 {
+	if s == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&s.l)
+}
+
+// Close deletes all stored attributes.
+func (s *store) Close() error {
+	for _, sh := range s.shards {
+		sh.mu.Lock()
+		clear(sh.m)
+		sh.mu.Unlock()
+	}
+	atomic.StoreUint64(&s.l, 0)
+	return nil
+}
+
+func getShardID(key string) (id uint64) {
+	if len(key) > maxHashKeyLength {
+		return xxh3.HashString(key[:maxHashKeyLength]) & mask
+	}
+	return xxh3.HashString(key) & mask
+}
+
+// FromPayload converts the attributes of the request payload to Attributes.
+// The values which type is not set are ignored.
+func FromPayload(pattrs map[string]*payload.Attribute_Value) Attributes {
+	if len(pattrs) == 0 {
+		return nil
+	}
+	attrs := make(Attributes, len(pattrs))
+	for k, pv := range pattrs {
+		switch v := pv.GetValue().(type) {
+		case *payload.Attribute_Value_StringValue:
+			attrs[k] = Value{Kind: String, S: v.StringValue}
+		case *payload.Attribute_Value_IntValue:
+			attrs[k] = Value{Kind: Int, I: v.IntValue}
+		case *payload.Attribute_Value_FloatValue:
+			attrs[k] = Value{Kind: Float, F: v.FloatValue}
+		case *payload.Attribute_Value_BoolValue:
+			attrs[k] = Value{Kind: Bool, B: v.BoolValue}
+		}
+	}
+	if len(attrs) == 0 {
+		return nil
+	}
+	return attrs
+}
+
+// ToPayload converts Attributes to the attributes of the payload.
+func (a Attributes) ToPayload() map[string]*payload.Attribute_Value {
+	if len(a) == 0 {
+		return nil
+	}
+	pattrs := make(map[string]*payload.Attribute_Value, len(a))
+	for k, v := range a {
+		switch v.Kind {
+		case String:
+			pattrs[k] = &payload.Attribute_Value{Value: &payload.Attribute_Value_StringValue{StringValue: v.S}}
+		case Int:
+			pattrs[k] = &payload.Attribute_Value{Value: &payload.Attribute_Value_IntValue{IntValue: v.I}}
+		case Float:
+			pattrs[k] = &payload.Attribute_Value{Value: &payload.Attribute_Value_FloatValue{FloatValue: v.F}}
+		case Bool:
+			pattrs[k] = &payload.Attribute_Value{Value: &payload.Attribute_Value_BoolValue{BoolValue: v.B}}
+		}
+	}
+	return pattrs
+}
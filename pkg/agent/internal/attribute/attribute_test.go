@@ -0,0 +1,203 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func Test_store_SetGetDelete(t *testing.T) {
+	t.Parallel()
+	type want struct {
+		attrs Attributes
+		len   uint64
+		ok    bool
+	}
+	type test struct {
+		name       string
+		beforeFunc func(Store)
+		uuid       string
+		want       want
+	}
+	tests := []test{
+		{
+			name: "return the stored attributes",
+			beforeFunc: func(s Store) {
+				s.Set("a", Attributes{"color": {Kind: String, S: "red"}})
+			},
+			uuid: "a",
+			want: want{
+				attrs: Attributes{"color": {Kind: String, S: "red"}},
+				len:   1,
+				ok:    true,
+			},
+		},
+		{
+			name: "return the replaced attributes",
+			beforeFunc: func(s Store) {
+				s.Set("a", Attributes{"color": {Kind: String, S: "red"}})
+				s.Set("a", Attributes{"size": {Kind: Int, I: 10}})
+			},
+			uuid: "a",
+			want: want{
+				attrs: Attributes{"size": {Kind: Int, I: 10}},
+				len:   1,
+				ok:    true,
+			},
+		},
+		{
+			name: "return false when the empty attributes are set",
+			beforeFunc: func(s Store) {
+				s.Set("a", Attributes{"color": {Kind: String, S: "red"}})
+				s.Set("a", nil)
+			},
+			uuid: "a",
+			want: want{},
+		},
+		{
+			name: "return false when the attributes are deleted",
+			beforeFunc: func(s Store) {
+				s.Set("a", Attributes{"color": {Kind: String, S: "red"}})
+				s.Set("b", Attributes{"color": {Kind: String, S: "blue"}})
+				s.Delete("a")
+			},
+			uuid: "a",
+			want: want{
+				len: 1,
+			},
+		},
+		{
+			name: "return false when the store is closed",
+			beforeFunc: func(s Store) {
+				s.Set("a", Attributes{"color": {Kind: String, S: "red"}})
+				s.Close()
+			},
+			uuid: "a",
+			want: want{},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := New()
+			if test.beforeFunc != nil {
+				test.beforeFunc(s)
+			}
+			got, ok := s.Get(test.uuid)
+			if !reflect.DeepEqual(got, test.want.attrs) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want.attrs)
+			}
+			if ok != test.want.ok {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", ok, test.want.ok)
+			}
+			if l := s.Len(); l != test.want.len {
+				tt.Errorf("got len: \"%#v\",\n\t\t\t\twant len: \"%#v\"", l, test.want.len)
+			}
+		})
+	}
+}
+
+func Test_store_Range(t *testing.T) {
+	t.Parallel()
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	s := New(WithConcurrency(4))
+	want := map[string]Attributes{
+		"a": {"color": {Kind: String, S: "red"}},
+		"b": {"size": {Kind: Int, I: 10}},
+		"c": {"score": {Kind: Float, F: 0.5}, "enabled": {Kind: Bool, B: true}},
+	}
+	for uuid, attrs := range want {
+		s.Set(uuid, attrs)
+	}
+	got := make(map[string]Attributes, len(want))
+	var mu sync.Mutex
+	s.Range(context.Background(), func(uuid string, attrs Attributes) bool {
+		mu.Lock()
+		got[uuid] = attrs
+		mu.Unlock()
+		return true
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, want)
+	}
+}
+
+func TestFromPayload(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name   string
+		pattrs map[string]*payload.Attribute_Value
+		want   Attributes
+	}
+	tests := []test{
+		{
+			name: "return nil when the attributes are empty",
+		},
+		{
+			name: "return the converted attributes",
+			pattrs: map[string]*payload.Attribute_Value{
+				"s": {Value: &payload.Attribute_Value_StringValue{StringValue: "red"}},
+				"i": {Value: &payload.Attribute_Value_IntValue{IntValue: -1}},
+				"f": {Value: &payload.Attribute_Value_FloatValue{FloatValue: 1.5}},
+				"b": {Value: &payload.Attribute_Value_BoolValue{BoolValue: true}},
+			},
+			want: Attributes{
+				"s": {Kind: String, S: "red"},
+				"i": {Kind: Int, I: -1},
+				"f": {Kind: Float, F: 1.5},
+				"b": {Kind: Bool, B: true},
+			},
+		},
+		{
+			name: "return nil when all values are not set",
+			pattrs: map[string]*payload.Attribute_Value{
+				"s": {},
+				"n": nil,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			got := FromPayload(test.pattrs)
+			if err := func() error {
+				if !reflect.DeepEqual(got, test.want) {
+					return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want)
+				}
+				return nil
+			}(); err != nil {
+				tt.Error(err)
+			}
+			if len(test.want) == 0 {
+				return
+			}
+			if back := FromPayload(got.ToPayload()); !reflect.DeepEqual(back, test.want) {
+				tt.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", back, test.want)
+			}
+		})
+	}
+}
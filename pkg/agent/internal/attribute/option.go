@@ -0,0 +1,33 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute
+
+import "runtime"
+
+// Option represents the functional option for store.
+type Option func(s *store)
+
+var defaultOptions = []Option{
+	WithConcurrency(runtime.GOMAXPROCS(-1) * 10),
+}
+
+// WithConcurrency returns the option to set the concurrency.
+func WithConcurrency(c int) Option {
+	return func(s *store) {
+		if c > 0 {
+			s.concurrency = c
+		}
+	}
+}
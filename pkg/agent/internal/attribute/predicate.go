@@ -0,0 +1,534 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute
+
+import (
+	"cmp"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/strings"
+)
+
+// Predicate represents a compiled attribute predicate expression.
+//
+// The expression grammar is:
+//
+//	expr       = and { ("||" | "or") and }
+//	and        = not { ("&&" | "and") not }
+//	not        = ("!" | "not") not | primary
+//	primary    = "(" expr ")" | "exists" "(" ident ")" | comparison
+//	comparison = ident ( op literal | [ "not" ] "in" "[" literal { "," literal } "]" )
+//	op         = "==" | "!=" | "<" | "<=" | ">" | ">="
+//	literal    = string | number | "true" | "false"
+//
+// A comparison against a missing attribute or a value of an incomparable type is false.
+type Predicate interface {
+	// Match reports whether the attributes satisfy the predicate.
+	Match(attrs Attributes) bool
+}
+
+// Parse compiles the attribute predicate expression.
+// It returns nil Predicate and nil error when the expression is empty.
+func Parse(expr string) (Predicate, error) {
+	if len(strings.TrimSpace(expr)) == 0 {
+		return nil, nil
+	}
+	p := &parser{
+		lex: lexer{src: expr},
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	pred, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected token %q", p.tok.text)
+	}
+	return pred, nil
+}
+
+type operator uint8
+
+const (
+	opEQ operator = iota
+	opNE
+	opLT
+	opLE
+	opGT
+	opGE
+)
+
+type (
+	orPredicate struct {
+		l, r Predicate
+	}
+	andPredicate struct {
+		l, r Predicate
+	}
+	notPredicate struct {
+		p Predicate
+	}
+	existsPredicate struct {
+		key string
+	}
+	comparePredicate struct {
+		key string
+		val Value
+		op  operator
+	}
+	inPredicate struct {
+		key  string
+		vals []Value
+		not  bool
+	}
+)
+
+func (p *orPredicate) Match(attrs Attributes) bool {
+	return p.l.Match(attrs) || p.r.Match(attrs)
+}
+
+func (p *andPredicate) Match(attrs Attributes) bool {
+	return p.l.Match(attrs) && p.r.Match(attrs)
+}
+
+func (p *notPredicate) Match(attrs Attributes) bool {
+	return !p.p.Match(attrs)
+}
+
+func (p *existsPredicate) Match(attrs Attributes) bool {
+	_, ok := attrs[p.key]
+	return ok
+}
+
+func (p *comparePredicate) Match(attrs Attributes) bool {
+	v, ok := attrs[p.key]
+	if !ok {
+		return false
+	}
+	c, ok := compare(v, p.val)
+	if !ok {
+		return false
+	}
+	switch p.op {
+	case opEQ:
+		return c == 0
+	case opNE:
+		return c != 0
+	case opLT:
+		return c < 0
+	case opLE:
+		return c <= 0
+	case opGT:
+		return c > 0
+	case opGE:
+		return c >= 0
+	}
+	return false
+}
+
+func (p *inPredicate) Match(attrs Attributes) bool {
+	v, ok := attrs[p.key]
+	if !ok {
+		return false
+	}
+	for _, val := range p.vals {
+		if c, ok := compare(v, val); ok && c == 0 {
+			return !p.not
+		}
+	}
+	return p.not
+}
+
+// compare compares two values and reports whether they are comparable.
+// Integer and floating point values are comparable with each other.
+func compare(a, b Value) (int, bool) {
+	switch {
+	case a.Kind == String && b.Kind == String:
+		return strings.Compare(a.S, b.S), true
+	case a.Kind == Int && b.Kind == Int:
+		return cmp.Compare(a.I, b.I), true
+	case a.Kind == Bool && b.Kind == Bool:
+		switch {
+		case a.B == b.B:
+			return 0, true
+		case b.B:
+			return -1, true
+		default:
+			return 1, true
+		}
+	}
+	af, aok := a.float()
+	bf, bok := b.float()
+	if !aok || !bok {
+		return 0, false
+	}
+	return cmp.Compare(af, bf), true
+}
+
+func (v Value) float() (float64, bool) {
+	switch v.Kind {
+	case Int:
+		return float64(v.I), true
+	case Float:
+		return v.F, true
+	}
+	return 0, false
+}
+
+type tokenKind uint8
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokString
+	tokNumber
+	tokLParen
+	tokRParen
+	tokLBracket
+	tokRBracket
+	tokComma
+	tokNot
+	tokAnd
+	tokOr
+	tokOp
+)
+
+type token struct {
+	text string
+	kind tokenKind
+	op   operator
+	pos  int
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+type parser struct {
+	lex lexer
+	tok token
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return errors.ErrInvalidAttributePredicate(p.lex.src, p.tok.pos, errors.Errorf(format, args...))
+}
+
+func (p *parser) next() (err error) {
+	p.tok, err = p.lex.scan()
+	return err
+}
+
+func (p *parser) parseOr() (Predicate, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOr {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = &orPredicate{l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseAnd() (Predicate, error) {
+	l, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokAnd {
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+		r, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l = &andPredicate{l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *parser) parseNot() (Predicate, error) {
+	if p.tok.kind != tokNot {
+		return p.parsePrimary()
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	pred, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	return &notPredicate{p: pred}, nil
+}
+
+func (p *parser) parsePrimary() (Predicate, error) {
+	switch p.tok.kind {
+	case tokLParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		pred, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err = p.expect(tokRParen, ")"); err != nil {
+			return nil, err
+		}
+		return pred, nil
+	case tokIdent:
+	default:
+		return nil, p.errorf("attribute name expected but %q found", p.tok.text)
+	}
+	key := p.tok.text
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	if key == "exists" && p.tok.kind == tokLParen {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokIdent {
+			return nil, p.errorf("attribute name expected but %q found", p.tok.text)
+		}
+		key = p.tok.text
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokRParen, ")"); err != nil {
+			return nil, err
+		}
+		return &existsPredicate{key: key}, nil
+	}
+	switch {
+	case p.tok.kind == tokOp:
+		op := p.tok.op
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		val, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		return &comparePredicate{key: key, op: op, val: val}, nil
+	case p.tok.kind == tokNot, p.tok.kind == tokIdent && p.tok.text == "in":
+		not := p.tok.kind == tokNot
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if not {
+			if p.tok.kind != tokIdent || p.tok.text != "in" {
+				return nil, p.errorf("\"in\" expected but %q found", p.tok.text)
+			}
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		}
+		vals, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return &inPredicate{key: key, vals: vals, not: not}, nil
+	}
+	return nil, p.errorf("operator expected after attribute %q but %q found", key, p.tok.text)
+}
+
+func (p *parser) parseList() (vals []Value, err error) {
+	if err = p.expect(tokLBracket, "["); err != nil {
+		return nil, err
+	}
+	for {
+		val, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		vals = append(vals, val)
+		if p.tok.kind != tokComma {
+			break
+		}
+		if err = p.next(); err != nil {
+			return nil, err
+		}
+	}
+	if err = p.expect(tokRBracket, "]"); err != nil {
+		return nil, err
+	}
+	return vals, nil
+}
+
+func (p *parser) parseLiteral() (val Value, err error) {
+	switch p.tok.kind {
+	case tokString:
+		val = Value{Kind: String, S: p.tok.text}
+	case tokNumber:
+		if i, err := strconv.ParseInt(p.tok.text, 10, 64); err == nil {
+			val = Value{Kind: Int, I: i}
+		} else {
+			f, err := strconv.ParseFloat(p.tok.text, 64)
+			if err != nil {
+				return val, p.errorf("invalid number %q", p.tok.text)
+			}
+			val = Value{Kind: Float, F: f}
+		}
+	case tokIdent:
+		switch p.tok.text {
+		case "true":
+			val = Value{Kind: Bool, B: true}
+		case "false":
+			val = Value{Kind: Bool, B: false}
+		default:
+			return val, p.errorf("literal expected but %q found", p.tok.text)
+		}
+	default:
+		return val, p.errorf("literal expected but %q found", p.tok.text)
+	}
+	return val, p.next()
+}
+
+func (p *parser) expect(kind tokenKind, text string) error {
+	if p.tok.kind != kind {
+		return p.errorf("%q expected but %q found", text, p.tok.text)
+	}
+	return p.next()
+}
+
+func (l *lexer) scan() (tok token, err error) {
+	for l.pos < len(l.src) && isSpace(l.src[l.pos]) {
+		l.pos++
+	}
+	tok.pos = l.pos
+	if l.pos >= len(l.src) {
+		tok.kind = tokEOF
+		return tok, nil
+	}
+	c := l.src[l.pos]
+	two := ""
+	if l.pos+1 < len(l.src) {
+		two = l.src[l.pos : l.pos+2]
+	}
+	switch {
+	case two == "&&":
+		tok.kind, tok.text = tokAnd, two
+	case two == "||":
+		tok.kind, tok.text = tokOr, two
+	case two == "==":
+		tok.kind, tok.text, tok.op = tokOp, two, opEQ
+	case two == "!=":
+		tok.kind, tok.text, tok.op = tokOp, two, opNE
+	case two == "<=":
+		tok.kind, tok.text, tok.op = tokOp, two, opLE
+	case two == ">=":
+		tok.kind, tok.text, tok.op = tokOp, two, opGE
+	case c == '<':
+		tok.kind, tok.text, tok.op = tokOp, "<", opLT
+	case c == '>':
+		tok.kind, tok.text, tok.op = tokOp, ">", opGT
+	case c == '=':
+		// accept a single "=" as an equality operator.
+		tok.kind, tok.text, tok.op = tokOp, "=", opEQ
+	case c == '!':
+		tok.kind, tok.text = tokNot, "!"
+	case c == '(':
+		tok.kind, tok.text = tokLParen, "("
+	case c == ')':
+		tok.kind, tok.text = tokRParen, ")"
+	case c == '[':
+		tok.kind, tok.text = tokLBracket, "["
+	case c == ']':
+		tok.kind, tok.text = tokRBracket, "]"
+	case c == ',':
+		tok.kind, tok.text = tokComma, ","
+	case c == '"' || c == '\'':
+		return l.scanString(c)
+	case c == '-' || c == '+' || c == '.' || isDigit(c):
+		end := l.pos + 1
+		for end < len(l.src) && (isDigit(l.src[end]) || strings.IndexByte(".eE+-", l.src[end]) >= 0) {
+			end++
+		}
+		tok.kind, tok.text = tokNumber, l.src[l.pos:end]
+		l.pos = end
+		return tok, nil
+	default:
+		r, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+		if !isIdentRune(r) {
+			return tok, errors.ErrInvalidAttributePredicate(l.src, l.pos, errors.Errorf("unexpected character %q", r))
+		}
+		end := l.pos
+		for end < len(l.src) {
+			r, size := utf8.DecodeRuneInString(l.src[end:])
+			if !isIdentRune(r) && !unicode.IsDigit(r) && r != '.' && r != '-' {
+				break
+			}
+			end += size
+		}
+		tok.kind, tok.text = tokIdent, l.src[l.pos:end]
+		l.pos = end
+		switch tok.text {
+		case "and":
+			tok.kind = tokAnd
+		case "or":
+			tok.kind = tokOr
+		case "not":
+			tok.kind = tokNot
+		}
+		return tok, nil
+	}
+	l.pos += len(tok.text)
+	return tok, nil
+}
+
+func (l *lexer) scanString(quote byte) (tok token, err error) {
+	tok.pos = l.pos
+	var sb strings.Builder
+	for i := l.pos + 1; i < len(l.src); i++ {
+		c := l.src[i]
+		switch {
+		case c == '\\' && i+1 < len(l.src):
+			i++
+			sb.WriteByte(l.src[i])
+		case c == quote:
+			tok.kind, tok.text = tokString, sb.String()
+			l.pos = i + 1
+			return tok, nil
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return tok, errors.ErrInvalidAttributePredicate(l.src, l.pos, errors.New("unterminated string literal"))
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
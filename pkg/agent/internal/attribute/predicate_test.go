@@ -0,0 +1,96 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attribute
+
+import (
+	"testing"
+
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	attrs := Attributes{
+		"color":   {Kind: String, S: "red"},
+		"size":    {Kind: Int, I: 10},
+		"score":   {Kind: Float, F: 0.75},
+		"enabled": {Kind: Bool, B: true},
+	}
+	type test struct {
+		name    string
+		expr    string
+		want    bool
+		wantErr bool
+		wantNil bool
+	}
+	tests := []test{
+		{name: "return nil predicate when the expression is empty", expr: "  ", wantNil: true},
+		{name: "match string equality", expr: `color == "red"`, want: true},
+		{name: "match single quoted string", expr: `color = 'red'`, want: true},
+		{name: "not match string equality", expr: `color == "blue"`},
+		{name: "match string inequality", expr: `color != "blue"`, want: true},
+		{name: "match integer comparison", expr: "size >= 10 && size < 11", want: true},
+		{name: "match integer and float comparison", expr: "size > 9.5 and score <= 0.75", want: true},
+		{name: "match bool equality", expr: "enabled == true", want: true},
+		{name: "match or", expr: `color == "blue" || size == 10`, want: true},
+		{name: "match not", expr: `!(color == "blue")`, want: true},
+		{name: "match keyword not", expr: `not color == "red"`},
+		{name: "match in", expr: `color in ["blue", "red"]`, want: true},
+		{name: "match not in", expr: `color not in ["blue", "green"]`, want: true},
+		{name: "match exists", expr: "exists(size) && !exists(weight)", want: true},
+		{name: "not match missing attribute", expr: "weight != 1"},
+		{name: "not match incomparable types", expr: `size == "10"`},
+		{name: "respect operator precedence", expr: `color == "blue" && size == 10 || enabled == true`, want: true},
+		{name: "respect parentheses", expr: `color == "blue" && (size == 10 || enabled == true)`},
+		{name: "return error when the operator is missing", expr: "color", wantErr: true},
+		{name: "return error when the literal is missing", expr: "size >", wantErr: true},
+		{name: "return error when the parenthesis is not closed", expr: "(size > 1", wantErr: true},
+		{name: "return error when the string is not terminated", expr: `color == "red`, wantErr: true},
+		{name: "return error when the token is unexpected", expr: "size > 1 size", wantErr: true},
+		{name: "return error when the character is invalid", expr: "size # 1", wantErr: true},
+		{name: "return error when the list is not closed", expr: "size in [1, 2", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			pred, err := Parse(test.expr)
+			if err := func() error {
+				if test.wantErr {
+					if err == nil {
+						return errors.Errorf("want error but got nil for expression %q", test.expr)
+					}
+					return nil
+				}
+				if err != nil {
+					return errors.Errorf("unexpected error: %v", err)
+				}
+				if test.wantNil {
+					if pred != nil {
+						return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: nil", pred)
+					}
+					return nil
+				}
+				if got := pred.Match(attrs); got != test.want {
+					return errors.Errorf("got: \"%#v\",\n\t\t\t\twant: \"%#v\"", got, test.want)
+				}
+				return nil
+			}(); err != nil {
+				tt.Error(err)
+			}
+		})
+	}
+}
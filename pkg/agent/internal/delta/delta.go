@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delta
+
+import (
+	"cmp"
+	"encoding/gob"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/os"
+	"github.com/vdaas/vald/pkg/agent/internal/attribute"
+	"github.com/vdaas/vald/pkg/agent/internal/sparse"
+)
+
+const (
+	// DirName is the name of the directory to store the delta snapshots in the index directory.
+	DirName = "delta"
+
+	fileExt = ".delta"
+)
+
+// Object represents the object which is inserted or updated since the previous snapshot.
+// Vector is nil for the multi-vector document, which sub-vectors are stored as the separate objects.
+type Object struct {
+	Attributes attribute.Attributes
+	UUID       string
+	Vector     []float32
+	Sparse     sparse.Vector
+	Timestamp  int64
+}
+
+// Snapshot represents the changes of the index since the previous snapshot.
+type Snapshot struct {
+	// Objects are the objects inserted or updated since the previous snapshot.
+	Objects []Object
+	// Deleted are the uuids deleted since the previous snapshot.
+	Deleted []string
+	// BaseID is the identifier of the base snapshot which this snapshot is applied to.
+	BaseID int64
+	// Seq is the sequence number of this snapshot in the base snapshot, it starts from 1.
+	Seq uint64
+	// Timestamp is the unix nano time when this snapshot is created.
+	Timestamp int64
+}
+
+// ID returns the identifier of the snapshot, which differs from the snapshot of the same file name of another base.
+func (s *Snapshot) ID() string {
+	return fmt.Sprintf("%d-%d-%d", s.BaseID, s.Seq, s.Timestamp)
+}
+
+// FileName returns the file name of the delta snapshot of the given sequence number.
+func FileName(seq uint64) string {
+	return fmt.Sprintf("%020d%s", seq, fileExt)
+}
+
+// ParseSeq returns the sequence number of the given delta snapshot file name.
+func ParseSeq(name string) (seq uint64, ok bool) {
+	name = filepath.Base(name)
+	if !strings.HasSuffix(name, fileExt) {
+		return 0, false
+	}
+	seq, err := strconv.ParseUint(strings.TrimSuffix(name, fileExt), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return seq, true
+}
+
+// Encode writes the snapshot to w.
+func Encode(w io.Writer, s *Snapshot) error {
+	return gob.NewEncoder(w).Encode(s)
+}
+
+// Decode reads the snapshot from r.
+func Decode(r io.Reader) (s *Snapshot, err error) {
+	s = new(Snapshot)
+	err = gob.NewDecoder(r).Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Store writes the snapshot to the file in dir and returns its path.
+// The file is written to the temporary file first and renamed, so that a crash never leaves a partial snapshot.
+func Store(dir string, s *Snapshot) (path string, err error) {
+	if s == nil {
+		return "", errors.ErrDeltaSnapshotIsNil
+	}
+	path = file.Join(dir, FileName(s.Seq))
+	tmp := path + ".tmp"
+	f, err := file.Open(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fs.ModePerm)
+	if err != nil {
+		return "", err
+	}
+	err = Encode(f, s)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); cerr != nil {
+		err = errors.Join(err, cerr)
+	}
+	if err != nil {
+		return "", errors.Join(err, os.Remove(tmp))
+	}
+	err = os.Rename(tmp, path)
+	if err != nil {
+		return "", errors.Join(err, os.Remove(tmp))
+	}
+	return path, nil
+}
+
+// Load reads the snapshot from the file of path.
+func Load(path string) (s *Snapshot, err error) {
+	f, err := file.Open(path, os.O_RDONLY|os.O_SYNC, fs.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if f != nil {
+			if cerr := f.Close(); cerr != nil {
+				err = errors.Join(err, cerr)
+			}
+		}
+	}()
+	return Decode(f)
+}
+
+// List returns the paths of the delta snapshot files in dir ordered by the sequence number.
+// It returns an empty slice when dir does not exist.
+func List(dir string) (paths []string, err error) {
+	if !file.Exists(dir) {
+		return nil, nil
+	}
+	files, err := file.ListInDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	type entry struct {
+		path string
+		seq  uint64
+	}
+	entries := make([]entry, 0, len(files))
+	for _, f := range files {
+		if seq, ok := ParseSeq(f); ok {
+			entries = append(entries, entry{path: f, seq: seq})
+		}
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return cmp.Compare(a.seq, b.seq)
+	})
+	paths = make([]string, 0, len(entries))
+	for _, e := range entries {
+		paths = append(paths, e.path)
+	}
+	return paths, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delta
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/pkg/agent/internal/attribute"
+)
+
+func TestStoreAndList(t *testing.T) {
+	type test struct {
+		name string
+		// snaps are stored in this order.
+		snaps   []*Snapshot
+		wantErr error
+		// wantSeqs are the sequence numbers returned by List in order.
+		wantSeqs []uint64
+	}
+
+	tests := []test{
+		{
+			name:    "nil snapshot is rejected",
+			snaps:   []*Snapshot{nil},
+			wantErr: errors.ErrDeltaSnapshotIsNil,
+		},
+		{
+			name: "stored snapshot is loaded as it is",
+			snaps: []*Snapshot{
+				{
+					BaseID:    100,
+					Seq:       1,
+					Timestamp: 200,
+					Deleted:   []string{"c"},
+					Objects: []Object{
+						{
+							UUID:      "a",
+							Vector:    []float32{1, 2, 3},
+							Timestamp: 10,
+							Attributes: attribute.Attributes{
+								"color": {Kind: attribute.String, S: "red"},
+							},
+						},
+						{
+							UUID:      "b",
+							Vector:    []float32{4, 5, 6},
+							Timestamp: 20,
+						},
+					},
+				},
+			},
+			wantSeqs: []uint64{1},
+		},
+		{
+			name: "snapshots are listed in the order of the sequence number",
+			snaps: []*Snapshot{
+				{BaseID: 100, Seq: 10},
+				{BaseID: 100, Seq: 2},
+				{BaseID: 100, Seq: 1},
+			},
+			wantSeqs: []uint64{1, 2, 10},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), DirName)
+			stored := make(map[uint64]*Snapshot, len(test.snaps))
+			for _, s := range test.snaps {
+				path, err := Store(dir, s)
+				if test.wantErr != nil {
+					require.ErrorIs(t, err, test.wantErr)
+					continue
+				}
+				require.NoError(t, err)
+				require.Equal(t, filepath.Join(dir, FileName(s.Seq)), path)
+				stored[s.Seq] = s
+			}
+			// the temporary files must not be listed.
+			if len(stored) > 0 {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, FileName(99)+".tmp"), nil, 0o600))
+			}
+
+			paths, err := List(dir)
+			require.NoError(t, err)
+			require.Len(t, paths, len(test.wantSeqs))
+			for i, path := range paths {
+				seq, ok := ParseSeq(path)
+				require.True(t, ok, path)
+				require.Equal(t, test.wantSeqs[i], seq)
+
+				got, err := Load(path)
+				require.NoError(t, err)
+				require.Equal(t, stored[seq], got)
+			}
+		})
+	}
+}
@@ -36,6 +36,8 @@ type Metadata struct {
 
 type NGT struct {
 	IndexCount uint64 `json:"index_count" yaml:"index_count"`
+	// SnapshotID identifies the base snapshot which the delta snapshots are applied to.
+	SnapshotID int64 `json:"snapshot_id,omitempty" yaml:"snapshot_id"`
 }
 
 type Faiss struct {
@@ -0,0 +1,126 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multivector
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+)
+
+// Aggregation represents the aggregation of the sub-vector distances into the distance of the document.
+type Aggregation int
+
+const (
+	// MaxSim is the sum of the minimum distances of each query vector, which is the late interaction score of ColBERT.
+	MaxSim Aggregation = iota
+	// Max is the minimum distance of all query vectors.
+	Max
+	// Mean is the mean of the minimum distances of each query vector.
+	Mean
+)
+
+// FromPayload returns the aggregation of the search configuration. MaxSim is used when it is not set.
+func FromPayload(a payload.Search_MultiVectorAggregation) Aggregation {
+	switch a {
+	case payload.Search_Max:
+		return Max
+	case payload.Search_Mean:
+		return Mean
+	}
+	return MaxSim
+}
+
+// Result represents the aggregated distance of the document.
+type Result struct {
+	ID       string
+	Distance float32
+}
+
+// Aggregator aggregates the distances of the sub-vectors found by each query vector into the distances of the documents.
+// Since the candidates are collected by the approximate search of each query vector, the distance of a query vector
+// to the document which is not found by the query vector is assumed to be the farthest distance found by the query vector.
+type Aggregator struct {
+	agg   Aggregation
+	worst []float32
+	docs  map[string][]float32
+	ids   []string
+}
+
+// NewAggregator returns the aggregator of the number of query vectors.
+func NewAggregator(agg Aggregation, queries int) *Aggregator {
+	return &Aggregator{
+		agg:   agg,
+		worst: make([]float32, queries),
+		docs:  make(map[string][]float32),
+	}
+}
+
+// Add adds the distance between the q-th query vector and the sub-vector of the document.
+func (a *Aggregator) Add(q int, doc string, distance float32) {
+	if q < 0 || q >= len(a.worst) {
+		return
+	}
+	a.worst[q] = max(a.worst[q], distance)
+	dists, ok := a.docs[doc]
+	if !ok {
+		dists = make([]float32, len(a.worst))
+		for i := range dists {
+			dists[i] = math.MaxFloat32
+		}
+		a.docs[doc] = dists
+		a.ids = append(a.ids, doc)
+	}
+	dists[q] = min(dists[q], distance)
+}
+
+// Len returns the number of the documents added.
+func (a *Aggregator) Len() int {
+	return len(a.ids)
+}
+
+// Results returns the documents sorted by the aggregated distance in ascending order.
+func (a *Aggregator) Results() []Result {
+	res := make([]Result, 0, len(a.ids))
+	for _, id := range a.ids {
+		res = append(res, Result{
+			ID:       id,
+			Distance: a.aggregate(a.docs[id]),
+		})
+	}
+	slices.SortStableFunc(res, func(x, y Result) int {
+		return cmp.Compare(x.Distance, y.Distance)
+	})
+	return res
+}
+
+func (a *Aggregator) aggregate(dists []float32) float32 {
+	if a.agg == Max {
+		return slices.Min(dists)
+	}
+	var sum float64
+	for q, d := range dists {
+		if d == math.MaxFloat32 {
+			d = a.worst[q]
+		}
+		sum += float64(d)
+	}
+	if a.agg == Mean {
+		return float32(sum / float64(len(dists)))
+	}
+	return float32(sum)
+}
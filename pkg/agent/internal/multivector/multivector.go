@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multivector
+
+import (
+	"strconv"
+
+	"github.com/vdaas/vald/internal/strings"
+)
+
+// Separator is the separator of the document id and the index of the sub-vector id.
+// The ASCII unit separator is used since it is hardly used in the user-defined ids.
+const Separator = "\x1f"
+
+// SubID returns the id of the i-th sub-vector of the document.
+func SubID(doc string, i int) string {
+	return doc + Separator + strconv.Itoa(i)
+}
+
+// Parse returns the document id and the index of the sub-vector id.
+// It returns false when the uuid is not a sub-vector id.
+func Parse(uuid string) (doc string, i int, ok bool) {
+	idx := strings.LastIndex(uuid, Separator)
+	if idx <= 0 {
+		return "", 0, false
+	}
+	i, err := strconv.Atoi(uuid[idx+len(Separator):])
+	if err != nil || i < 0 {
+		return "", 0, false
+	}
+	return uuid[:idx], i, true
+}
+
+// DocID returns the document id of the sub-vector id, or the uuid itself when it is not a sub-vector id.
+func DocID(uuid string) string {
+	if doc, _, ok := Parse(uuid); ok {
+		return doc
+	}
+	return uuid
+}
+
+// IsValidID returns true when the uuid can be used as the id of the vector or the document.
+func IsValidID(uuid string) bool {
+	return !strings.Contains(uuid, Separator)
+}
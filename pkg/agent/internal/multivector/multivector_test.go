@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multivector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		uuid    string
+		wantDoc string
+		wantIdx int
+		wantOK  bool
+	}{
+		{
+			name:    "sub-vector id is parsed",
+			uuid:    SubID("doc", 12),
+			wantDoc: "doc",
+			wantIdx: 12,
+			wantOK:  true,
+		},
+		{
+			name:    "document id containing digits is parsed",
+			uuid:    SubID("doc-1", 0),
+			wantDoc: "doc-1",
+			wantOK:  true,
+		},
+		{
+			name: "plain uuid is not a sub-vector id",
+			uuid: "doc-1",
+		},
+		{
+			name: "empty document id is not a sub-vector id",
+			uuid: Separator + "1",
+		},
+		{
+			name: "non numeric index is not a sub-vector id",
+			uuid: "doc" + Separator + "x",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, idx, ok := Parse(tc.uuid)
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.wantDoc, doc)
+			require.Equal(t, tc.wantIdx, idx)
+			if ok {
+				require.Equal(t, tc.wantDoc, DocID(tc.uuid))
+			} else {
+				require.Equal(t, tc.uuid, DocID(tc.uuid))
+			}
+		})
+	}
+}
+
+func TestAggregatorResults(t *testing.T) {
+	type hit struct {
+		q    int
+		doc  string
+		dist float32
+	}
+	hits := []hit{
+		{q: 0, doc: "a", dist: 1},
+		{q: 0, doc: "a", dist: 0.5},
+		{q: 0, doc: "b", dist: 2},
+		{q: 1, doc: "b", dist: 1},
+		{q: 1, doc: "c", dist: 3},
+		{q: 1, doc: "a", dist: 4},
+	}
+	tests := []struct {
+		name string
+		agg  Aggregation
+		want []Result
+	}{
+		{
+			name: "max sim sums the minimum distance of each query vector",
+			agg:  MaxSim,
+			want: []Result{{ID: "b", Distance: 3}, {ID: "a", Distance: 4.5}, {ID: "c", Distance: 5}},
+		},
+		{
+			name: "max takes the minimum distance of all query vectors",
+			agg:  Max,
+			want: []Result{{ID: "a", Distance: 0.5}, {ID: "b", Distance: 1}, {ID: "c", Distance: 3}},
+		},
+		{
+			name: "mean averages the minimum distance of each query vector",
+			agg:  Mean,
+			want: []Result{{ID: "b", Distance: 1.5}, {ID: "a", Distance: 2.25}, {ID: "c", Distance: 2.5}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			a := NewAggregator(tc.agg, 2)
+			for _, h := range hits {
+				a.Add(h.q, h.doc, h.dist)
+			}
+			require.Equal(t, 3, a.Len())
+			require.Equal(t, tc.want, a.Results())
+		})
+	}
+}
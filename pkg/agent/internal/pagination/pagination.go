@@ -0,0 +1,56 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagination
+
+import (
+	"math"
+	"slices"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+)
+
+// SearchAfter returns the search results located after the cursor of the paginated search, which are the results
+// farther than the after distance of cfg and the results of the after distance not in the after IDs. The number of
+// the searched results is doubled until the requested number of the results after the cursor is found, or the
+// search is exhausted. total is the number of the indexed vectors, which bounds the number of the searched results.
+func SearchAfter(
+	cfg *payload.Search_Config, total uint64, search func(num uint32) (*payload.Search_Response, error),
+) (res *payload.Search_Response, err error) {
+	size := cfg.GetNum()
+	limit := uint32(min(total, math.MaxUint32))
+	num := size + uint32(len(cfg.GetAfterIds()))
+	for {
+		res, err = search(num)
+		if err != nil || res == nil {
+			return res, err
+		}
+		results := make([]*payload.Object_Distance, 0, size)
+		for _, d := range res.GetResults() {
+			if d.GetDistance() < cfg.GetAfterDistance() ||
+				(d.GetDistance() == cfg.GetAfterDistance() && slices.Contains(cfg.GetAfterIds(), d.GetId())) {
+				continue
+			}
+			results = append(results, d)
+			if len(results) == int(size) {
+				break
+			}
+		}
+		if len(results) == int(size) || len(res.GetResults()) < int(num) || num >= limit {
+			res.Results = results
+			return res, nil
+		}
+		num = uint32(min(uint64(num)*2, uint64(max(limit, size))))
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagination
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestSearchAfter(t *testing.T) {
+	t.Parallel()
+	dist := func(id string, d float32) *payload.Object_Distance {
+		return &payload.Object_Distance{
+			Id:       id,
+			Distance: d,
+		}
+	}
+	index := []*payload.Object_Distance{
+		dist("a", 0.1), dist("b", 0.2), dist("c", 0.2), dist("d", 0.3), dist("e", 0.4), dist("f", 0.5),
+	}
+	distance, last := float32(0.2), float32(0.5)
+	tests := []struct {
+		name     string
+		cfg      *payload.Search_Config
+		want     []*payload.Object_Distance
+		wantNums []uint32
+	}{
+		{
+			name: "return the results after the cursor by doubling the number of the searched results",
+			cfg: &payload.Search_Config{
+				Num:           2,
+				AfterDistance: &distance,
+				AfterIds:      []string{"b"},
+			},
+			want:     []*payload.Object_Distance{dist("c", 0.2), dist("d", 0.3)},
+			wantNums: []uint32{3, 6},
+		},
+		{
+			name: "return the rest of the results when the search is exhausted",
+			cfg: &payload.Search_Config{
+				Num:           5,
+				AfterDistance: &distance,
+				AfterIds:      []string{"b", "c"},
+			},
+			want:     []*payload.Object_Distance{dist("d", 0.3), dist("e", 0.4), dist("f", 0.5)},
+			wantNums: []uint32{7},
+		},
+		{
+			name: "return no result when all results are located before the cursor",
+			cfg: &payload.Search_Config{
+				Num:           2,
+				AfterDistance: &last,
+				AfterIds:      []string{"f"},
+			},
+			want:     []*payload.Object_Distance{},
+			wantNums: []uint32{3, 6},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			var nums []uint32
+			res, err := SearchAfter(test.cfg, uint64(len(index)), func(num uint32) (*payload.Search_Response, error) {
+				nums = append(nums, num)
+				return &payload.Search_Response{
+					Results: index[:min(int(num), len(index))],
+				}, nil
+			})
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if !slices.EqualFunc(res.GetResults(), test.want, func(x, y *payload.Object_Distance) bool {
+				return x.EqualVT(y)
+			}) {
+				tt.Errorf("got = %v, want %v", res.GetResults(), test.want)
+			}
+			if !slices.Equal(nums, test.wantNums) {
+				tt.Errorf("got nums = %v, want %v", nums, test.wantNums)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantization
+
+import (
+	"math"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/strings"
+)
+
+// Metric represents the distance function of the quantized index.
+type Metric int
+
+const (
+	// L1 is l1 norm.
+	L1 Metric = iota
+	// L2 is l2 norm.
+	L2
+	// Angle is angle distance of the normalized vectors.
+	Angle
+	// Cosine is cosine distance of the normalized vectors.
+	Cosine
+)
+
+// ParseMetric returns the metric of the given distance type.
+// The quantized index supports only the distance types which are preserved by the element-wise scaling.
+func ParseMetric(dt string) (Metric, error) {
+	switch strings.TrimForCompare(dt) {
+	case "l1":
+		return L1, nil
+	case "l2":
+		return L2, nil
+	case "angle", "ang", "normalizedangle", "normalizedang", "normang", "nang", "nangle":
+		return Angle, nil
+	case "cosine", "cos", "normalizedcosine", "normalizedcos", "normcos", "ncos", "ncosine":
+		return Cosine, nil
+	}
+	return 0, errors.ErrUnsupportedQuantizationDistanceType(dt)
+}
+
+// IndexDistanceType returns the distance type of the quantized index.
+// Angle and cosine distance of the normalized vectors are monotonic to l2 distance, so the index uses l2 distance for them.
+func (m Metric) IndexDistanceType() string {
+	if m == L1 {
+		return "l1"
+	}
+	return "l2"
+}
+
+// Distance returns the distance between the full-precision vectors.
+func (m Metric) Distance(x, y []float32) float32 {
+	switch m {
+	case L1:
+		var d float64
+		for i := range x {
+			d += math.Abs(float64(x[i] - y[i]))
+		}
+		return float32(d)
+	case L2:
+		var d float64
+		for i := range x {
+			v := float64(x[i] - y[i])
+			d += v * v
+		}
+		return float32(math.Sqrt(d))
+	}
+	var dot, nx, ny float64
+	for i := range x {
+		dot += float64(x[i]) * float64(y[i])
+		nx += float64(x[i]) * float64(x[i])
+		ny += float64(y[i]) * float64(y[i])
+	}
+	cos := 0.0
+	if nx > 0 && ny > 0 {
+		cos = max(-1, min(1, dot/math.Sqrt(nx*ny)))
+	}
+	if m == Angle {
+		return float32(math.Acos(cos))
+	}
+	return float32(1 - cos)
+}
+
+// prepare returns the vector to be quantized.
+func (m Metric) prepare(vec []float32) []float32 {
+	if m == L1 || m == L2 {
+		return vec
+	}
+	var n float64
+	for _, v := range vec {
+		n += float64(v) * float64(v)
+	}
+	if n == 0 {
+		return vec
+	}
+	n = math.Sqrt(n)
+	nvec := make([]float32, len(vec))
+	for i, v := range vec {
+		nvec[i] = float32(float64(v) / n)
+	}
+	return nvec
+}
+
+// fromIndexDistance converts the distance of the index to the distance of the metric.
+func (m Metric) fromIndexDistance(d float32) float32 {
+	switch m {
+	case Angle:
+		// the chord length of the unit vectors is 2 * sin(angle / 2).
+		return float32(2 * math.Asin(min(1, float64(d)/2)))
+	case Cosine:
+		// the squared chord length of the unit vectors is 2 * (1 - cos).
+		return d * d / 2
+	}
+	return d
+}
+
+// toIndexDistance converts the distance of the metric to the distance of the index, which is the inverse of fromIndexDistance.
+func (m Metric) toIndexDistance(d float32) float32 {
+	switch m {
+	case Angle:
+		return float32(2 * math.Sin(min(math.Pi, float64(d))/2))
+	case Cosine:
+		return float32(math.Sqrt(2 * min(2, float64(d))))
+	}
+	return d
+}
@@ -0,0 +1,37 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantization
+
+import "github.com/vdaas/vald/internal/errors"
+
+// Option represents the functional option for the quantizer.
+type Option func(q *Quantizer) error
+
+var defaultOptions []Option
+
+// WithRange returns the option to set the range of the vector elements.
+// The range is left unset when both of them are zero.
+func WithRange(minValue, maxValue float32) Option {
+	return func(q *Quantizer) error {
+		if minValue == 0 && maxValue == 0 {
+			return nil
+		}
+		if !(minValue < maxValue) {
+			return errors.NewErrInvalidOption("range", [2]float32{minValue, maxValue}, errors.ErrInvalidQuantizationRange(minValue, maxValue))
+		}
+		q.min, q.max = minValue, maxValue
+		return nil
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantization
+
+import (
+	"math"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/strings"
+)
+
+// Type represents the quantization type of the index.
+type Type string
+
+const (
+	// None represents the index stores the vectors as they are.
+	None Type = "none"
+	// Scalar represents the index stores each element of the vectors as 8bit unsigned integer.
+	Scalar Type = "scalar"
+)
+
+// levels is the number of the quantization levels of 8bit unsigned integer.
+const levels = math.MaxUint8
+
+// ParseType returns the quantization type of the given string.
+// Only the 8bit scalar quantization is supported, and the product quantization is rejected.
+func ParseType(t string) (Type, error) {
+	switch strings.TrimForCompare(t) {
+	case "", "none", "no":
+		return None, nil
+	case "scalar", "sq", "sq8":
+		return Scalar, nil
+	}
+	return None, errors.ErrUnsupportedQuantizationType(t)
+}
+
+// Quantizer maps each element of the vectors from [min, max] to the 8bit unsigned integer levels.
+// The codes are represented as float32 so that they can be inserted to the Uint8 object space of NGT as they are.
+type Quantizer struct {
+	metric Metric
+	min    float32
+	max    float32
+	step   float32
+}
+
+// New returns the scalar quantizer for the metric.
+// The range of the vector elements is required for L1 and L2, while the normalized range [-1, 1] is used for Angle and Cosine by default.
+func New(metric Metric, opts ...Option) (q *Quantizer, err error) {
+	q = &Quantizer{
+		metric: metric,
+	}
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(q); err != nil {
+			return nil, err
+		}
+	}
+	if q.min == 0 && q.max == 0 {
+		if metric == L1 || metric == L2 {
+			// the range of the vectors is unknown, and the elements out of the range are clamped.
+			return nil, errors.ErrQuantizationRangeRequired(metric.IndexDistanceType())
+		}
+		// the normalized vectors are always in [-1, 1].
+		q.min, q.max = -1, 1
+	}
+	if !(q.min < q.max) {
+		return nil, errors.ErrInvalidQuantizationRange(q.min, q.max)
+	}
+	q.step = (q.max - q.min) / levels
+	return q, nil
+}
+
+// Metric returns the metric of the quantizer.
+func (q *Quantizer) Metric() Metric {
+	return q.metric
+}
+
+// Encode returns the codes of the vector.
+// The vector is normalized before quantization when the metric requires it, and the elements out of range are clamped.
+func (q *Quantizer) Encode(vec []float32) (code []float32) {
+	vec = q.metric.prepare(vec)
+	code = make([]float32, len(vec))
+	for i, v := range vec {
+		c := math.Round(float64((v - q.min) / q.step))
+		code[i] = float32(min(max(c, 0), levels))
+	}
+	return code
+}
+
+// Decode returns the approximate vector of the codes.
+func (q *Quantizer) Decode(code []float32) (vec []float32) {
+	vec = make([]float32, len(code))
+	for i, c := range code {
+		vec[i] = q.min + c*q.step
+	}
+	return vec
+}
+
+// Distance converts the distance between the codes calculated by the index to the distance of the metric.
+func (q *Quantizer) Distance(d float32) float32 {
+	return q.metric.fromIndexDistance(d * q.step)
+}
+
+// Radius converts the search radius of the metric to the radius between the codes of the dim-dimensional vectors.
+// The radius is widened by the rounding error of the codes, which is a half step of each element of both vectors at most,
+// so that the vectors within the radius are not missed by the index. The results should be filtered by the radius
+// after their distances are converted or refined. The radius is returned as it is when it is not positive.
+func (q *Quantizer) Radius(r float32, dim int) float32 {
+	if r <= 0 {
+		return r
+	}
+	slack := float64(dim)
+	if q.metric.IndexDistanceType() == "l2" {
+		slack = math.Sqrt(slack)
+	}
+	return q.metric.toIndexDistance(r)/q.step + float32(slack)
+}
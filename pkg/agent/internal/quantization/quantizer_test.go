@@ -0,0 +1,222 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantization
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func TestQuantizer(t *testing.T) {
+	type test struct {
+		name    string
+		metric  Metric
+		opts    []Option
+		x, y    []float32
+		wantErr error
+	}
+
+	tests := []test{
+		{
+			name:   "l2 distance is approximated within the quantization error",
+			metric: L2,
+			opts:   []Option{WithRange(-1, 1)},
+			x:      []float32{0.1, -0.5, 0.9, 0.3},
+			y:      []float32{-0.2, 0.4, 0.7, -0.8},
+		},
+		{
+			name:   "l1 distance is approximated within the quantization error",
+			metric: L1,
+			opts:   []Option{WithRange(-1, 1)},
+			x:      []float32{0.1, -0.5, 0.9, 0.3},
+			y:      []float32{-0.2, 0.4, 0.7, -0.8},
+		},
+		{
+			name:   "cosine distance is approximated on the normalized vectors",
+			metric: Cosine,
+			x:      []float32{1, 2, 3, 4},
+			y:      []float32{4, -3, 2, 1},
+		},
+		{
+			name:   "angle distance is approximated on the normalized vectors",
+			metric: Angle,
+			x:      []float32{1, 2, 3, 4},
+			y:      []float32{4, -3, 2, 1},
+		},
+		{
+			name:   "custom range is used for the quantization",
+			metric: L2,
+			opts:   []Option{WithRange(0, 100)},
+			x:      []float32{10, 50, 90, 30},
+			y:      []float32{20, 40, 70, 80},
+		},
+		{
+			name:    "range is required for l2 distance",
+			metric:  L2,
+			wantErr: errors.ErrQuantizationRangeRequired("l2"),
+		},
+		{
+			name:    "range is required for l1 distance",
+			metric:  L1,
+			opts:    []Option{WithRange(0, 0)},
+			wantErr: errors.ErrQuantizationRangeRequired("l1"),
+		},
+		{
+			name:    "invalid range is rejected",
+			metric:  L2,
+			opts:    []Option{WithRange(1, -1)},
+			wantErr: errors.ErrInvalidQuantizationRange(1, -1),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := New(tc.metric, tc.opts...)
+			if tc.wantErr != nil {
+				require.ErrorContains(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			cx, cy := q.Encode(tc.x), q.Encode(tc.y)
+			for _, c := range append(cx, cy...) {
+				require.GreaterOrEqual(t, c, float32(0))
+				require.LessOrEqual(t, c, float32(levels))
+				require.Equal(t, float32(math.Round(float64(c))), c)
+			}
+
+			// the decoded vector differs from the prepared vector by half of the step at most.
+			px := tc.metric.prepare(tc.x)
+			for i, v := range q.Decode(cx) {
+				require.InDelta(t, px[i], v, float64(q.step)/2*(1+1e-3))
+			}
+
+			var d float64
+			switch q.Metric().IndexDistanceType() {
+			case "l1":
+				for i := range cx {
+					d += math.Abs(float64(cx[i] - cy[i]))
+				}
+			default:
+				for i := range cx {
+					d += float64((cx[i] - cy[i]) * (cx[i] - cy[i]))
+				}
+				d = math.Sqrt(d)
+			}
+			want := tc.metric.Distance(tc.x, tc.y)
+			require.InDelta(t, want, q.Distance(float32(d)), float64(q.step)*float64(len(tc.x)))
+		})
+	}
+}
+
+func TestQuantizer_Radius(t *testing.T) {
+	vecs := [][]float32{
+		{0.1, -0.5, 0.9, 0.3},
+		{-0.2, 0.4, 0.7, -0.8},
+		{0.12, -0.49, 0.88, 0.31},
+		{0.9, 0.9, -0.9, 0.05},
+		{-0.7, 0.2, 0.1, 0.6},
+	}
+	tests := []struct {
+		name   string
+		metric Metric
+		radius float32
+	}{
+		{
+			name:   "l2 radius covers the codes of the vectors within the radius",
+			metric: L2,
+			radius: 0.5,
+		},
+		{
+			name:   "l1 radius covers the codes of the vectors within the radius",
+			metric: L1,
+			radius: 1,
+		},
+		{
+			name:   "cosine radius covers the codes of the vectors within the radius",
+			metric: Cosine,
+			radius: 0.3,
+		},
+		{
+			name:   "angle radius covers the codes of the vectors within the radius",
+			metric: Angle,
+			radius: 0.8,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := New(tc.metric, WithRange(-1, 1))
+			require.NoError(t, err)
+			require.Equal(t, float32(-1), q.Radius(-1, 4))
+
+			r := q.Radius(tc.radius, 4)
+			// the radius is converted into the code space, which is much larger than the original one.
+			require.Greater(t, r, tc.radius*10)
+			var within int
+			for _, x := range vecs {
+				for _, y := range vecs {
+					if tc.metric.Distance(x, y) > tc.radius {
+						continue
+					}
+					within++
+					cx, cy := q.Encode(x), q.Encode(y)
+					var d float64
+					for i := range cx {
+						if tc.metric.IndexDistanceType() == "l1" {
+							d += math.Abs(float64(cx[i] - cy[i]))
+						} else {
+							d += float64((cx[i] - cy[i]) * (cx[i] - cy[i]))
+						}
+					}
+					if tc.metric.IndexDistanceType() == "l2" {
+						d = math.Sqrt(d)
+					}
+					require.LessOrEqual(t, float32(d), r)
+				}
+			}
+			// the pairs of the same vectors and the close vectors are within the radius.
+			require.Greater(t, within, len(vecs))
+		})
+	}
+}
+
+func TestParseType(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Type
+		wantErr bool
+	}{
+		{in: "", want: None},
+		{in: "none", want: None},
+		{in: "sq8", want: Scalar},
+		{in: "Scalar", want: Scalar},
+		{in: "pq", wantErr: true},
+		{in: "unknown", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := ParseType(tc.in)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
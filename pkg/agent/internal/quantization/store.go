@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantization
+
+import (
+	"encoding/binary"
+	"io/fs"
+	"math"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/os"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/zeebo/xxh3"
+)
+
+// Store represents the storage of the full-precision vectors of the quantized index.
+// The vectors are addressed by the object id of the index and verified by the hash of the uuid,
+// so that the stale vector of the reused object id is never returned.
+type Store interface {
+	Set(oid uint32, uuid string, vec []float32) error
+	Get(oid uint32, uuid string) ([]float32, bool)
+	Reset() error
+	Sync() error
+	// CopyTo writes the synced copy of the stored vectors to the file of path.
+	CopyTo(path string) error
+	// Reopen switches the storage to the file of path, which is the copy written by CopyTo.
+	Reopen(path string) error
+	Close() error
+}
+
+// hashSize is the size of the uuid hash at the head of each record.
+const hashSize = 8
+
+// NewStore returns the store of the dim dimensional vectors.
+// The vectors are stored in the file of path, or in memory when path is empty.
+func NewStore(path string, dim int) (Store, error) {
+	if dim <= 0 {
+		return nil, errors.ErrInvalidDimensionSize(dim, 0)
+	}
+	if path == "" {
+		return &memStore{
+			dim: dim,
+			m:   make(map[uint32]memRecord),
+		}, nil
+	}
+	f, err := file.Open(path, os.O_RDWR|os.O_CREATE, fs.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	return &fileStore{
+		f:    f,
+		dim:  dim,
+		size: int64(hashSize + dim*4),
+	}, nil
+}
+
+type fileStore struct {
+	mu   sync.RWMutex
+	f    *os.File
+	dim  int
+	size int64
+}
+
+// Set writes the record of the vector at the offset of the object id.
+// Each record is encoded as | xxh3 of uuid (uint64) | vector (float32...) |.
+func (s *fileStore) Set(oid uint32, uuid string, vec []float32) (err error) {
+	if len(vec) != s.dim {
+		return errors.ErrIncompatibleDimensionSize(len(vec), s.dim)
+	}
+	buf := make([]byte, s.size)
+	binary.LittleEndian.PutUint64(buf, xxh3.HashString(uuid))
+	for i, v := range vec {
+		binary.LittleEndian.PutUint32(buf[hashSize+i*4:], math.Float32bits(v))
+	}
+	s.mu.Lock()
+	_, err = s.f.WriteAt(buf, int64(oid)*s.size)
+	s.mu.Unlock()
+	return err
+}
+
+func (s *fileStore) Get(oid uint32, uuid string) (vec []float32, ok bool) {
+	buf := make([]byte, s.size)
+	s.mu.RLock()
+	_, err := s.f.ReadAt(buf, int64(oid)*s.size)
+	s.mu.RUnlock()
+	if err != nil || binary.LittleEndian.Uint64(buf) != xxh3.HashString(uuid) {
+		return nil, false
+	}
+	vec = make([]float32, s.dim)
+	for i := range vec {
+		vec[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[hashSize+i*4:]))
+	}
+	return vec, true
+}
+
+func (s *fileStore) Reset() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.f.Truncate(0)
+}
+
+func (s *fileStore) Sync() error {
+	return s.f.Sync()
+}
+
+func (s *fileStore) CopyTo(path string) (err error) {
+	dst, err := file.Open(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, dst.Close())
+	}()
+	// the lock is held during the copy since it moves the offset of the file.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err = s.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err = io.Copy(dst, s.f); err != nil {
+		return err
+	}
+	return dst.Sync()
+}
+
+func (s *fileStore) Reopen(path string) error {
+	f, err := file.Open(path, os.O_RDWR|os.O_CREATE, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	old := s.f
+	s.f = f
+	s.mu.Unlock()
+	return old.Close()
+}
+
+func (s *fileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return errors.Join(s.f.Sync(), s.f.Close())
+}
+
+type memRecord struct {
+	vec  []float32
+	hash uint64
+}
+
+type memStore struct {
+	mu  sync.RWMutex
+	m   map[uint32]memRecord
+	dim int
+}
+
+func (s *memStore) Set(oid uint32, uuid string, vec []float32) error {
+	if len(vec) != s.dim {
+		return errors.ErrIncompatibleDimensionSize(len(vec), s.dim)
+	}
+	s.mu.Lock()
+	s.m[oid] = memRecord{
+		vec:  append(make([]float32, 0, len(vec)), vec...),
+		hash: xxh3.HashString(uuid),
+	}
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *memStore) Get(oid uint32, uuid string) ([]float32, bool) {
+	s.mu.RLock()
+	r, ok := s.m[oid]
+	s.mu.RUnlock()
+	if !ok || r.hash != xxh3.HashString(uuid) {
+		return nil, false
+	}
+	return r.vec, true
+}
+
+func (s *memStore) Reset() error {
+	s.mu.Lock()
+	clear(s.m)
+	s.mu.Unlock()
+	return nil
+}
+
+func (*memStore) Sync() error {
+	return nil
+}
+
+func (*memStore) CopyTo(string) error {
+	return nil
+}
+
+func (*memStore) Reopen(string) error {
+	return nil
+}
+
+func (s *memStore) Close() error {
+	return s.Reset()
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quantization
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore(t *testing.T) {
+	type test struct {
+		name string
+		path func(t *testing.T) string
+	}
+
+	tests := []test{
+		{
+			name: "file store",
+			path: func(t *testing.T) string {
+				t.Helper()
+				return filepath.Join(t.TempDir(), "vec")
+			},
+		},
+		{
+			name: "memory store",
+			path: func(*testing.T) string {
+				return ""
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := tc.path(t)
+			s, err := NewStore(path, 3)
+			require.NoError(t, err)
+
+			require.NoError(t, s.Set(1, "a", []float32{1, 2, 3}))
+			require.NoError(t, s.Set(5, "b", []float32{4, 5, 6}))
+			require.Error(t, s.Set(2, "c", []float32{1, 2}))
+
+			got, ok := s.Get(1, "a")
+			require.True(t, ok)
+			require.Equal(t, []float32{1, 2, 3}, got)
+
+			// the reused object id must not return the vector of the other uuid.
+			_, ok = s.Get(1, "b")
+			require.False(t, ok)
+			_, ok = s.Get(3, "a")
+			require.False(t, ok)
+			_, ok = s.Get(100, "a")
+			require.False(t, ok)
+
+			require.NoError(t, s.Sync())
+			if path != "" {
+				require.NoError(t, s.Close())
+				s, err = NewStore(path, 3)
+				require.NoError(t, err)
+				got, ok = s.Get(5, "b")
+				require.True(t, ok)
+				require.Equal(t, []float32{4, 5, 6}, got)
+			}
+
+			require.NoError(t, s.Reset())
+			_, ok = s.Get(5, "b")
+			require.False(t, ok)
+			require.NoError(t, s.Close())
+		})
+	}
+}
+
+func TestStore_CopyTo(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStore(filepath.Join(dir, "vec"), 2)
+	require.NoError(t, err)
+	require.NoError(t, s.Set(0, "a", []float32{1, 2}))
+	require.NoError(t, s.Set(2, "b", []float32{3, 4}))
+
+	cp := filepath.Join(dir, "copy")
+	require.NoError(t, s.CopyTo(cp))
+	// the record set after the copy is not included in the copy.
+	require.NoError(t, s.Set(3, "c", []float32{5, 6}))
+
+	require.NoError(t, s.Reopen(cp))
+	got, ok := s.Get(2, "b")
+	require.True(t, ok)
+	require.Equal(t, []float32{3, 4}, got)
+	_, ok = s.Get(3, "c")
+	require.False(t, ok)
+
+	require.NoError(t, s.Set(1, "d", []float32{7, 8}))
+	require.NoError(t, s.Close())
+
+	s, err = NewStore(cp, 2)
+	require.NoError(t, err)
+	got, ok = s.Get(1, "d")
+	require.True(t, ok)
+	require.Equal(t, []float32{7, 8}, got)
+	require.NoError(t, s.Close())
+}
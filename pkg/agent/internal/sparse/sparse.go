@@ -0,0 +1,242 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparse
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/sync"
+)
+
+// Vector represents a sparse vector as the pairs of the dimension index and the non-zero value.
+// The fields are exported to be encoded by gob when the agent saves the index.
+type Vector struct {
+	Indices []uint32
+	Values  []float32
+}
+
+// Result represents a search result of the sparse index.
+type Result struct {
+	UUID  string
+	Score float32
+}
+
+// Index represents an interface for operating the inverted index of the sparse vectors.
+type Index interface {
+	Get(uuid string) (Vector, bool)
+	Set(uuid string, vec Vector)
+	Delete(uuid string) bool
+	Search(ctx context.Context, query Vector, size int, filter func(uuid string) bool) []Result
+	Range(ctx context.Context, f func(uuid string, vec Vector) bool)
+	Len() uint64
+	Close() error
+}
+
+type index struct {
+	// vecs holds the sparse vector of each uuid to remove its postings.
+	vecs map[string]Vector
+	// postings holds the values of each dimension index.
+	postings map[uint32]map[string]float32
+	mu       sync.RWMutex
+}
+
+// New returns the inverted index that satisfies the Index interface.
+func New() Index {
+	return &index{
+		vecs:     make(map[string]Vector),
+		postings: make(map[uint32]map[string]float32),
+	}
+}
+
+// FromPayload converts the sparse vector of the request payload to Vector.
+// It returns an error when the numbers of the indices and values are different or the indices are duplicated.
+func FromPayload(sv *payload.Object_SparseVector) (vec Vector, err error) {
+	il, vl := len(sv.GetIndices()), len(sv.GetValues())
+	if il != vl {
+		return vec, errors.ErrSparseVectorLengthMismatch(il, vl)
+	}
+	if il == 0 {
+		return vec, nil
+	}
+	vec = Vector{
+		Indices: make([]uint32, 0, il),
+		Values:  make([]float32, 0, il),
+	}
+	seen := make(map[uint32]struct{}, il)
+	for i, idx := range sv.GetIndices() {
+		if _, ok := seen[idx]; ok {
+			return Vector{}, errors.ErrSparseVectorDuplicateIndex(idx)
+		}
+		seen[idx] = struct{}{}
+		// zero values never contribute to the score.
+		if v := sv.GetValues()[i]; v != 0 {
+			vec.Indices = append(vec.Indices, idx)
+			vec.Values = append(vec.Values, v)
+		}
+	}
+	return vec, nil
+}
+
+// ToPayload converts Vector to the sparse vector of the payload.
+func (v Vector) ToPayload() *payload.Object_SparseVector {
+	if v.Len() == 0 {
+		return nil
+	}
+	return &payload.Object_SparseVector{
+		Indices: v.Indices,
+		Values:  v.Values,
+	}
+}
+
+// Len returns the number of the non-zero values.
+func (v Vector) Len() int {
+	return len(v.Indices)
+}
+
+// Get returns the sparse vector of the given uuid.
+func (i *index) Get(uuid string) (Vector, bool) {
+	i.mu.RLock()
+	vec, ok := i.vecs[uuid]
+	i.mu.RUnlock()
+	return vec, ok
+}
+
+// Set stores the sparse vector of the given uuid and replaces the stored one.
+// If the vector is empty, the stored vector is deleted.
+func (i *index) Set(uuid string, vec Vector) {
+	if vec.Len() == 0 {
+		i.Delete(uuid)
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.delete(uuid)
+	i.vecs[uuid] = vec
+	for j, idx := range vec.Indices {
+		p, ok := i.postings[idx]
+		if !ok {
+			p = make(map[string]float32)
+			i.postings[idx] = p
+		}
+		p[uuid] = vec.Values[j]
+	}
+}
+
+// Delete deletes the sparse vector of the given uuid and returns true when it existed.
+func (i *index) Delete(uuid string) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.delete(uuid)
+}
+
+func (i *index) delete(uuid string) bool {
+	vec, ok := i.vecs[uuid]
+	if !ok {
+		return false
+	}
+	delete(i.vecs, uuid)
+	for _, idx := range vec.Indices {
+		p := i.postings[idx]
+		delete(p, uuid)
+		if len(p) == 0 {
+			delete(i.postings, idx)
+		}
+	}
+	return true
+}
+
+// Search returns the top size vectors of the inner product score with the query in descending order.
+// Only the vectors which share at least one dimension with the query and satisfy the filter are returned.
+func (i *index) Search(ctx context.Context, query Vector, size int, filter func(uuid string) bool) []Result {
+	if query.Len() == 0 || size <= 0 {
+		return nil
+	}
+	scores := make(map[string]float32)
+	i.mu.RLock()
+	for j, idx := range query.Indices {
+		select {
+		case <-ctx.Done():
+			i.mu.RUnlock()
+			return nil
+		default:
+		}
+		qv := query.Values[j]
+		for uuid, v := range i.postings[idx] {
+			scores[uuid] += qv * v
+		}
+	}
+	i.mu.RUnlock()
+
+	res := make([]Result, 0, len(scores))
+	for uuid, score := range scores {
+		if filter != nil && !filter(uuid) {
+			continue
+		}
+		res = append(res, Result{UUID: uuid, Score: score})
+	}
+	slices.SortFunc(res, func(a, b Result) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.UUID, b.UUID)
+	})
+	if len(res) > size {
+		res = res[:size]
+	}
+	return res
+}
+
+// Range retrieves all stored sparse vectors and calls the callback function f.
+func (i *index) Range(ctx context.Context, f func(uuid string, vec Vector) bool) {
+	// copy the entries so that f can modify the index.
+	i.mu.RLock()
+	entries := make(map[string]Vector, len(i.vecs))
+	maps.Copy(entries, i.vecs)
+	i.mu.RUnlock()
+	for uuid, vec := range entries {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			if !f(uuid, vec) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of the stored sparse vectors.
+func (i *index) Len() uint64 {
+	if i == nil {
+		return 0
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return uint64(len(i.vecs))
+}
+
+// Close deletes all stored sparse vectors.
+func (i *index) Close() error {
+	i.mu.Lock()
+	clear(i.vecs)
+	clear(i.postings)
+	i.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparse
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+)
+
+func TestFromPayload(t *testing.T) {
+	tests := []struct {
+		name    string
+		sv      *payload.Object_SparseVector
+		want    Vector
+		wantErr error
+	}{
+		{
+			name: "nil sparse vector is empty",
+		},
+		{
+			name: "zero values are dropped",
+			sv:   &payload.Object_SparseVector{Indices: []uint32{3, 1, 7}, Values: []float32{0.5, 0, 2}},
+			want: Vector{Indices: []uint32{3, 7}, Values: []float32{0.5, 2}},
+		},
+		{
+			name:    "length mismatch is rejected",
+			sv:      &payload.Object_SparseVector{Indices: []uint32{1, 2}, Values: []float32{1}},
+			wantErr: errors.ErrSparseVectorLengthMismatch(2, 1),
+		},
+		{
+			name:    "duplicate index is rejected",
+			sv:      &payload.Object_SparseVector{Indices: []uint32{1, 1}, Values: []float32{1, 2}},
+			wantErr: errors.ErrSparseVectorDuplicateIndex(1),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := FromPayload(tc.sv)
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestIndexSearch(t *testing.T) {
+	type test struct {
+		name   string
+		vecs   map[string]Vector
+		del    []string
+		query  Vector
+		size   int
+		filter func(string) bool
+		want   []Result
+	}
+
+	vecs := map[string]Vector{
+		"a": {Indices: []uint32{1, 2}, Values: []float32{1, 1}},
+		"b": {Indices: []uint32{2, 3}, Values: []float32{2, 1}},
+		"c": {Indices: []uint32{4}, Values: []float32{5}},
+	}
+	tests := []test{
+		{
+			name:  "results are sorted by the inner product score",
+			vecs:  vecs,
+			query: Vector{Indices: []uint32{1, 2}, Values: []float32{1, 1}},
+			size:  10,
+			want:  []Result{{UUID: "a", Score: 2}, {UUID: "b", Score: 2}},
+		},
+		{
+			name:  "results are truncated to size",
+			vecs:  vecs,
+			query: Vector{Indices: []uint32{2, 4}, Values: []float32{1, 1}},
+			size:  2,
+			want:  []Result{{UUID: "c", Score: 5}, {UUID: "b", Score: 2}},
+		},
+		{
+			name:  "deleted vectors are not returned",
+			vecs:  vecs,
+			del:   []string{"b"},
+			query: Vector{Indices: []uint32{2}, Values: []float32{1}},
+			size:  10,
+			want:  []Result{{UUID: "a", Score: 1}},
+		},
+		{
+			name:  "filtered vectors are not returned",
+			vecs:  vecs,
+			query: Vector{Indices: []uint32{2}, Values: []float32{1}},
+			size:  10,
+			filter: func(uuid string) bool {
+				return uuid != "b"
+			},
+			want: []Result{{UUID: "a", Score: 1}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			idx := New()
+			for uuid, vec := range tc.vecs {
+				idx.Set(uuid, vec)
+			}
+			for _, uuid := range tc.del {
+				require.True(t, idx.Delete(uuid))
+			}
+			require.Equal(t, uint64(len(tc.vecs)-len(tc.del)), idx.Len())
+			require.Equal(t, tc.want, idx.Search(context.Background(), tc.query, tc.size, tc.filter))
+		})
+	}
+}
+
+func TestIndexSetReplaces(t *testing.T) {
+	idx := New()
+	idx.Set("a", Vector{Indices: []uint32{1}, Values: []float32{1}})
+	idx.Set("a", Vector{Indices: []uint32{2}, Values: []float32{3}})
+	require.Equal(t, uint64(1), idx.Len())
+	require.Empty(t, idx.Search(context.Background(), Vector{Indices: []uint32{1}, Values: []float32{1}}, 10, nil))
+	require.Equal(t, []Result{{UUID: "a", Score: 3}},
+		idx.Search(context.Background(), Vector{Indices: []uint32{2}, Values: []float32{1}}, 10, nil))
+
+	// the empty vector removes the stored one.
+	idx.Set("a", Vector{})
+	require.Equal(t, uint64(0), idx.Len())
+	_, ok := idx.Get("a")
+	require.False(t, ok)
+}
@@ -14,7 +14,59 @@
 
 package vqueue
 
+import (
+	"strings"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/timeutil"
+)
+
 // Option represents the functional option for vqueue.
 type Option func(n *vqueue) error
 
-var defaultOptions = []Option{}
+var defaultOptions = []Option{
+	WithWALSyncPolicy(string(SyncInterval)),
+	WithWALSyncInterval("1s"),
+}
+
+// WithWALPath returns the option to set the file path of the write-ahead log.
+// The write-ahead log is disabled when the path is empty.
+func WithWALPath(path string) Option {
+	return func(v *vqueue) error {
+		v.walPath = path
+		return nil
+	}
+}
+
+// WithWALSyncPolicy returns the option to set the fsync policy of the write-ahead log.
+// The policy must be one of "always", "interval" or "none".
+func WithWALSyncPolicy(policy string) Option {
+	return func(v *vqueue) error {
+		if policy == "" {
+			return nil
+		}
+		switch p := SyncPolicy(strings.ToLower(policy)); p {
+		case SyncAlways, SyncInterval, SyncNone:
+			v.walPolicy = p
+		default:
+			return errors.NewErrInvalidOption("wal_sync_policy", policy, errors.ErrInvalidWALSyncPolicy(policy))
+		}
+		return nil
+	}
+}
+
+// WithWALSyncInterval returns the option to set the fsync interval of the write-ahead log.
+// It is used only when the sync policy is "interval".
+func WithWALSyncInterval(dur string) Option {
+	return func(v *vqueue) error {
+		if dur == "" {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return errors.NewErrInvalidOption("wal_sync_interval", dur, err)
+		}
+		v.walInterval = d
+		return nil
+	}
+}
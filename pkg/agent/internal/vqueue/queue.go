@@ -25,12 +25,16 @@ import (
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/log"
 	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/pkg/agent/internal/attribute"
+	"github.com/vdaas/vald/pkg/agent/internal/sparse"
 )
 
 // Queue represents vector queue cache interface.
 type Queue interface {
 	PushInsert(uuid string, vector []float32, timestamp int64) error
 	PushDelete(uuid string, timestamp int64) error
+	SetAttributes(uuid string, attrs attribute.Attributes) error
+	SetSparseVector(uuid string, vec sparse.Vector) error
 	PopInsert(uuid string) (vector []float32, timestamp int64, ok bool)
 	PopDelete(uuid string) (timestamp int64, ok bool)
 	GetVector(uuid string) (vec []float32, timestamp int64, exists bool)
@@ -42,16 +46,29 @@ type Queue interface {
 	DVExists(uuid string) (timestamp int64, ok bool)
 	IVQLen() int
 	DVQLen() int
+	ReplayWAL(ctx context.Context, f AttachedFunc) error
+	TruncateWAL() error
+	Close() error
 }
 
+// AttachedFunc is called with the attributes and the sparse vector attached to the uuid's vector which are restored by ReplayWAL.
+// attrs or vec is nil when the record does not change it, and both are empty when they are deleted with the vector.
+type AttachedFunc func(uuid string, attrs attribute.Attributes, vec *sparse.Vector)
+
 type vqueue struct {
-	il, dl sync.Map[string, *index]
-	ic, dc uint64
+	il, dl      sync.Map[string, *index]
+	ic, dc      uint64
+	wal         *wal
+	walPath     string
+	walPolicy   SyncPolicy
+	walInterval time.Duration
 }
 
 type index struct {
 	uuid      string
 	vector    []float32
+	attrs     attribute.Attributes
+	sparse    *sparse.Vector
 	timestamp int64
 }
 
@@ -69,6 +86,13 @@ func New(opts ...Option) (Queue, error) {
 			log.Warn(werr)
 		}
 	}
+	if len(vq.walPath) != 0 {
+		var err error
+		vq.wal, err = openWAL(vq.walPath, vq.walPolicy, vq.walInterval)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return vq, nil
 }
 
@@ -79,9 +103,27 @@ func (v *vqueue) PushInsert(uuid string, vector []float32, timestamp int64) erro
 	if timestamp == 0 {
 		timestamp = time.Now().UnixNano()
 	}
+	if v.wal == nil {
+		_ = v.pushInsert(uuid, vector, timestamp)
+		return nil
+	}
+	return v.wal.apply(func() *walRecord {
+		if !v.pushInsert(uuid, vector, timestamp) {
+			return nil
+		}
+		return &walRecord{
+			op:        walOpInsert,
+			uuid:      uuid,
+			vector:    vector,
+			timestamp: timestamp,
+		}
+	})
+}
+
+func (v *vqueue) pushInsert(uuid string, vector []float32, timestamp int64) (stored bool) {
 	dts, ok := v.loadDVQ(uuid)
 	if ok && newer(dts, timestamp) {
-		return nil
+		return false
 	}
 	idx := index{
 		uuid:      uuid,
@@ -90,13 +132,15 @@ func (v *vqueue) PushInsert(uuid string, vector []float32, timestamp int64) erro
 	}
 	oidx, loaded := v.il.LoadOrStore(uuid, &idx)
 	if loaded {
-		if newer(timestamp, oidx.timestamp) { // if data already exists and existing index is older than new one
-			v.il.Store(uuid, &idx)
+		if !newer(timestamp, oidx.timestamp) {
+			return false
 		}
+		// if data already exists and existing index is older than new one
+		v.il.Store(uuid, &idx)
 	} else {
 		_ = atomic.AddUint64(&v.ic, 1)
 	}
-	return nil
+	return true
 }
 
 func (v *vqueue) PushDelete(uuid string, timestamp int64) error {
@@ -106,22 +150,119 @@ func (v *vqueue) PushDelete(uuid string, timestamp int64) error {
 	if timestamp == 0 {
 		timestamp = time.Now().UnixNano()
 	}
+	if v.wal == nil {
+		_ = v.pushDelete(uuid, timestamp)
+		return nil
+	}
+	return v.wal.apply(func() *walRecord {
+		if !v.pushDelete(uuid, timestamp) {
+			return nil
+		}
+		return &walRecord{
+			op:        walOpDelete,
+			uuid:      uuid,
+			timestamp: timestamp,
+		}
+	})
+}
+
+func (v *vqueue) pushDelete(uuid string, timestamp int64) (stored bool) {
 	idx := index{
 		uuid:      uuid,
 		timestamp: timestamp,
 	}
 	oidx, loaded := v.dl.LoadOrStore(uuid, &idx)
 	if loaded {
-		if newer(timestamp, oidx.timestamp) { // if data already exists and existing index is older than new one
-			v.dl.Store(uuid, &idx)
+		if !newer(timestamp, oidx.timestamp) {
+			return false
 		}
+		// if data already exists and existing index is older than new one
+		v.dl.Store(uuid, &idx)
 	} else {
 		_ = atomic.AddUint64(&v.dc, 1)
 	}
-	return nil
+	return true
+}
+
+// SetAttributes writes the attributes of the uuid's vector to the write-ahead log, so that they are restored by ReplayWAL.
+// The attributes are also kept with the vector in the insert queue to be written again by TruncateWAL.
+// It does nothing when the write-ahead log is disabled.
+func (v *vqueue) SetAttributes(uuid string, attrs attribute.Attributes) error {
+	if v.wal == nil || len(uuid) == 0 {
+		return nil
+	}
+	if attrs == nil {
+		attrs = attribute.Attributes{}
+	}
+	return v.wal.apply(func() *walRecord {
+		v.attach(uuid, attrs, nil)
+		return &walRecord{
+			op:    walOpAttributes,
+			uuid:  uuid,
+			attrs: attrs,
+		}
+	})
+}
+
+// SetSparseVector writes the sparse vector of the uuid's vector to the write-ahead log, so that it is restored by ReplayWAL.
+// The sparse vector is also kept with the vector in the insert queue to be written again by TruncateWAL.
+// It does nothing when the write-ahead log is disabled.
+func (v *vqueue) SetSparseVector(uuid string, vec sparse.Vector) error {
+	if v.wal == nil || len(uuid) == 0 {
+		return nil
+	}
+	return v.wal.apply(func() *walRecord {
+		v.attach(uuid, nil, &vec)
+		return &walRecord{
+			op:     walOpSparse,
+			uuid:   uuid,
+			sparse: &vec,
+		}
+	})
+}
+
+// attach replaces the attributes or the sparse vector kept with the uuid's vector in the insert queue.
+// The entry is replaced by its copy, since the entries are read without the lock of the write-ahead log.
+func (v *vqueue) attach(uuid string, attrs attribute.Attributes, vec *sparse.Vector) {
+	idx, ok := v.il.Load(uuid)
+	if !ok || idx == nil {
+		return
+	}
+	nidx := *idx
+	if attrs != nil {
+		nidx.attrs = attrs
+	}
+	if vec != nil {
+		nidx.sparse = vec
+	}
+	// the entry replaced by the newer insert in the meantime gets its own attributes and sparse vector.
+	_ = v.il.CompareAndSwap(uuid, idx, &nidx)
 }
 
 func (v *vqueue) PopInsert(uuid string) (vector []float32, timestamp int64, ok bool) {
+	if v.wal == nil {
+		return v.popInsert(uuid)
+	}
+	err := v.wal.apply(func() *walRecord {
+		vector, timestamp, ok = v.popInsert(uuid)
+		if !ok {
+			return nil
+		}
+		return &walRecord{
+			op:        walOpPopInsert,
+			uuid:      uuid,
+			timestamp: timestamp,
+		}
+	})
+	if err != nil {
+		log.Warnf("failed to write pop insert operation of %s to vqueue wal, err: %v", uuid, err)
+	}
+	return vector, timestamp, ok
+}
+
+// popInsert pops the vector from the insert queue without writing the operation to the write-ahead log.
+// It is used for committing the queue to the index, the queue state is then persisted by TruncateWAL after the index is saved.
+func (v *vqueue) popInsert(uuid string) (vector []float32, timestamp int64, ok bool) {
 	var idx *index
 	idx, ok = v.il.LoadAndDelete(uuid)
 	if !ok || idx == nil || idx.timestamp == 0 {
@@ -132,6 +273,28 @@ func (v *vqueue) PopInsert(uuid string) (vector []float32, timestamp int64, ok b
 }
 
 func (v *vqueue) PopDelete(uuid string) (timestamp int64, ok bool) {
+	if v.wal == nil {
+		return v.popDelete(uuid)
+	}
+	err := v.wal.apply(func() *walRecord {
+		timestamp, ok = v.popDelete(uuid)
+		if !ok {
+			return nil
+		}
+		return &walRecord{
+			op:        walOpPopDelete,
+			uuid:      uuid,
+			timestamp: timestamp,
+		}
+	})
+	if err != nil {
+		log.Warnf("failed to write pop delete operation of %s to vqueue wal, err: %v", uuid, err)
+	}
+	return timestamp, ok
+}
+
+// popDelete pops the uuid from the delete queue without writing the operation to the write-ahead log.
+func (v *vqueue) popDelete(uuid string) (timestamp int64, ok bool) {
 	var idx *index
 	idx, ok = v.dl.LoadAndDelete(uuid)
 	if !ok || idx == nil || idx.timestamp == 0 {
@@ -217,7 +380,7 @@ func (v *vqueue) RangePopInsert(
 		}
 		dts, ok := v.loadDVQ(uuid)
 		if ok && newer(dts, idx.timestamp) {
-			_, _, _ = v.PopInsert(uuid)
+			_, _, _ = v.popInsert(uuid)
 			return true
 		}
 		uii = append(uii, *idx)
@@ -236,7 +399,7 @@ func (v *vqueue) RangePopInsert(
 			return
 		}
 
-		_, _, _ = v.PopInsert(idx.uuid)
+		_, _, _ = v.popInsert(idx.uuid)
 		select {
 		case <-ctx.Done():
 			return
@@ -269,10 +432,10 @@ func (v *vqueue) RangePopDelete(ctx context.Context, now int64, f func(uuid stri
 		if !f(didx.uuid) {
 			return
 		}
-		_, _ = v.PopDelete(didx.uuid)
+		_, _ = v.popDelete(didx.uuid)
 		_, its, ok := v.loadIVQ(didx.uuid)
 		if ok && newer(didx.timestamp, its) {
-			_, _, _ = v.PopInsert(didx.uuid)
+			_, _, _ = v.popInsert(didx.uuid)
 		}
 		select {
 		case <-ctx.Done():
@@ -307,6 +470,98 @@ func (v *vqueue) DVQLen() (l int) {
 	return int(atomic.LoadUint64(&v.dc))
 }
 
+// ReplayWAL restores the queue from the write-ahead log, and calls f for the attributes and the sparse vectors restored with it.
+// It does nothing when the write-ahead log is disabled.
+func (v *vqueue) ReplayWAL(ctx context.Context, f AttachedFunc) (err error) {
+	if v.wal == nil {
+		return nil
+	}
+	if f == nil {
+		f = func(string, attribute.Attributes, *sparse.Vector) {}
+	}
+	var cnt int
+	err = v.wal.replay(ctx, func(r *walRecord) {
+		cnt++
+		switch r.op {
+		case walOpInsert:
+			if v.pushInsert(r.uuid, r.vector, r.timestamp) && (r.attrs != nil || r.sparse != nil) {
+				v.attach(r.uuid, r.attrs, r.sparse)
+				f(r.uuid, r.attrs, r.sparse)
+			}
+		case walOpDelete:
+			_ = v.pushDelete(r.uuid, r.timestamp)
+			// the attached data is deleted with the vector unless the vector is inserted again by the newer insert.
+			if _, its, ok := v.loadIVQ(r.uuid); !ok || !newer(its, r.timestamp) {
+				f(r.uuid, attribute.Attributes{}, new(sparse.Vector))
+			}
+		case walOpAttributes, walOpSparse:
+			v.attach(r.uuid, r.attrs, r.sparse)
+			f(r.uuid, r.attrs, r.sparse)
+		case walOpPopInsert:
+			if _, its, ok := v.loadIVQ(r.uuid); ok && its == r.timestamp {
+				_, _, _ = v.popInsert(r.uuid)
+			}
+		case walOpPopDelete:
+			if dts, ok := v.loadDVQ(r.uuid); ok && dts == r.timestamp {
+				_, _ = v.popDelete(r.uuid)
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("vqueue wal replay finished, records: %d, uncommitted inserts: %d, uncommitted deletes: %d", cnt, v.IVQLen(), v.DVQLen())
+	return nil
+}
+
+// TruncateWAL truncates the write-ahead log so that it contains only the operations still remaining in the queue.
+// It should be called after the index is saved, since the committed operations are then persisted by the index itself.
+func (v *vqueue) TruncateWAL() error {
+	if v.wal == nil {
+		return nil
+	}
+	return v.wal.rewrite(func(put func(r *walRecord) error) (err error) {
+		// inserts must be written before deletes since the insert is rejected when the newer delete already exists.
+		v.il.Range(func(uuid string, idx *index) bool {
+			if idx == nil {
+				return true
+			}
+			err = put(&walRecord{
+				op:        walOpInsert,
+				uuid:      uuid,
+				vector:    idx.vector,
+				attrs:     idx.attrs,
+				sparse:    idx.sparse,
+				timestamp: idx.timestamp,
+			})
+			return err == nil
+		})
+		if err != nil {
+			return err
+		}
+		v.dl.Range(func(uuid string, idx *index) bool {
+			if idx == nil {
+				return true
+			}
+			err = put(&walRecord{
+				op:        walOpDelete,
+				uuid:      uuid,
+				timestamp: idx.timestamp,
+			})
+			return err == nil
+		})
+		return err
+	})
+}
+
+// Close flushes and closes the write-ahead log.
+func (v *vqueue) Close() error {
+	if v.wal == nil {
+		return nil
+	}
+	return v.wal.close()
+}
+
 func (v *vqueue) loadIVQ(uuid string) (vec []float32, ts int64, ok bool) {
 	var idx *index
 	idx, ok = v.il.Load(uuid)
@@ -0,0 +1,481 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vqueue
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io/fs"
+	"math"
+	"os"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/pkg/agent/internal/attribute"
+	"github.com/vdaas/vald/pkg/agent/internal/sparse"
+)
+
+// SyncPolicy represents the fsync policy of the write-ahead log.
+type SyncPolicy string
+
+const (
+	// SyncAlways flushes the write-ahead log to the storage on every write.
+	SyncAlways SyncPolicy = "always"
+	// SyncInterval flushes the write-ahead log to the storage periodically.
+	SyncInterval SyncPolicy = "interval"
+	// SyncNone never flushes the write-ahead log explicitly and leaves it to the OS.
+	// Records are still written to the file on every operation, so they survive a process crash but not a node crash.
+	SyncNone SyncPolicy = "none"
+)
+
+type walOp uint8
+
+const (
+	walOpInsert walOp = iota + 1
+	walOpDelete
+	walOpPopInsert
+	walOpPopDelete
+	walOpAttributes
+	walOpSparse
+)
+
+const (
+	// walFlagAttributes marks the record which body contains the attributes.
+	walFlagAttributes uint8 = 1 << iota
+	// walFlagSparse marks the record which body contains the sparse vector.
+	walFlagSparse
+)
+
+const (
+	// walHeaderSize is the size of the record header which consists of the body length and the crc32 checksum.
+	walHeaderSize = 8
+	// walMaxRecordSize is the upper limit of the record body size to detect the corrupted header.
+	walMaxRecordSize = 1 << 30
+)
+
+var walCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+type walRecord struct {
+	uuid      string
+	vector    []float32
+	attrs     attribute.Attributes
+	sparse    *sparse.Vector
+	timestamp int64
+	op        walOp
+}
+
+// wal is an append-only, checksummed write-ahead log of the vqueue operations.
+// Each record is framed as | body length (uint32) | crc32c of body (uint32) | body |,
+// and the body is encoded as | op (uint8) | timestamp (int64) | uuid length (uvarint) | uuid | dimension (uvarint) | vector (float32...) |
+// followed by | flags (uint8) | attributes | sparse vector |, where the attributes and the sparse vector are present only when their flags are set.
+// The attributes are encoded as | count (uvarint) | { key length (uvarint) | key | kind (uint8) | value } ... |,
+// and the sparse vector is encoded as | non-zero count (uvarint) | indices (uint32...) | values (float32...) |.
+// The body without the flags written by the older agents is decoded as the record without the attributes and the sparse vector.
+type wal struct {
+	mu       sync.Mutex
+	f        *os.File
+	path     string
+	policy   SyncPolicy
+	interval time.Duration
+	buf      []byte
+	dirty    bool
+	done     chan struct{}
+	wg       sync.WaitGroup
+	once     sync.Once
+}
+
+func openWAL(path string, policy SyncPolicy, interval time.Duration) (w *wal, err error) {
+	w = &wal{
+		path:     path,
+		policy:   policy,
+		interval: interval,
+		done:     make(chan struct{}),
+	}
+	w.f, err = file.Open(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, fs.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	if w.policy == SyncInterval && w.interval > 0 {
+		w.wg.Add(1)
+		go w.syncLoop()
+	}
+	return w, nil
+}
+
+func (w *wal) syncLoop() {
+	defer w.wg.Done()
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.done:
+			return
+		case <-ticker.C:
+			if err := w.sync(); err != nil {
+				log.Warnf("failed to sync vqueue wal %s, err: %v", w.path, err)
+			}
+		}
+	}
+}
+
+// apply calls fn and appends the record returned by fn to the log while holding the lock,
+// so that the operations are logged in the same order as they are applied to the queue.
+// Nothing is written when fn returns nil.
+func (w *wal) apply(fn func() *walRecord) (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	r := fn()
+	if r == nil {
+		return nil
+	}
+	w.buf = appendWALRecord(w.buf[:0], r)
+	_, err = w.f.Write(w.buf)
+	if err != nil {
+		return err
+	}
+	if w.policy == SyncAlways {
+		return w.f.Sync()
+	}
+	w.dirty = true
+	return nil
+}
+
+// replay reads all records from the log and calls fn for each of them.
+// When a torn or corrupted record is found, the log is truncated at the last valid record.
+func (w *wal) replay(ctx context.Context, fn func(r *walRecord)) (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	f, err := file.Open(w.path, os.O_RDONLY, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if f != nil {
+			if cerr := f.Close(); cerr != nil {
+				err = errors.Join(err, cerr)
+			}
+		}
+	}()
+	br := bufio.NewReader(f)
+	var (
+		offset int64
+		header [walHeaderSize]byte
+		body   []byte
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		_, err = io.ReadFull(br, header[:])
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err == nil {
+			size := binary.LittleEndian.Uint32(header[:4])
+			if size > walMaxRecordSize {
+				err = errors.ErrWALRecordCorrupted(w.path, offset)
+			} else {
+				if cap(body) < int(size) {
+					body = make([]byte, size)
+				}
+				body = body[:size]
+				_, err = io.ReadFull(br, body)
+				if err == nil && crc32.Checksum(body, walCRCTable) != binary.LittleEndian.Uint32(header[4:]) {
+					err = errors.ErrWALRecordCorrupted(w.path, offset)
+				}
+			}
+		}
+		var r *walRecord
+		if err == nil {
+			r, err = decodeWALRecord(body)
+			if err != nil {
+				err = errors.Join(errors.ErrWALRecordCorrupted(w.path, offset), err)
+			}
+		}
+		if err != nil {
+			// the tail of the log was not written completely, drop it to keep appending valid records.
+			log.Warnf("vqueue wal %s is truncated at offset %d because of the invalid record, err: %v", w.path, offset, err)
+			return w.f.Truncate(offset)
+		}
+		fn(r)
+		offset += int64(walHeaderSize + len(body))
+	}
+}
+
+// rewrite replaces the log with the records written by fn.
+func (w *wal) rewrite(fn func(put func(r *walRecord) error) error) (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	tmp := w.path + ".tmp"
+	f, err := file.Open(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(f)
+	err = fn(func(r *walRecord) error {
+		w.buf = appendWALRecord(w.buf[:0], r)
+		_, err := bw.Write(w.buf)
+		return err
+	})
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); cerr != nil {
+		err = errors.Join(err, cerr)
+	}
+	if err != nil {
+		return errors.Join(err, os.Remove(tmp))
+	}
+	err = os.Rename(tmp, w.path)
+	if err != nil {
+		return errors.Join(err, os.Remove(tmp))
+	}
+	nf, err := file.Open(w.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	err = w.f.Close()
+	w.f = nf
+	w.dirty = false
+	return err
+}
+
+func (w *wal) sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.dirty {
+		return nil
+	}
+	w.dirty = false
+	return w.f.Sync()
+}
+
+func (w *wal) close() (err error) {
+	w.once.Do(func() {
+		close(w.done)
+		w.wg.Wait()
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		if w.dirty {
+			err = w.f.Sync()
+			w.dirty = false
+		}
+		err = errors.Join(err, w.f.Close())
+	})
+	return err
+}
+
+func appendWALRecord(buf []byte, r *walRecord) []byte {
+	start := len(buf)
+	buf = append(buf, make([]byte, walHeaderSize)...)
+	buf = append(buf, byte(r.op))
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(r.timestamp))
+	buf = binary.AppendUvarint(buf, uint64(len(r.uuid)))
+	buf = append(buf, r.uuid...)
+	buf = binary.AppendUvarint(buf, uint64(len(r.vector)))
+	for _, f := range r.vector {
+		buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(f))
+	}
+	var flags uint8
+	if r.attrs != nil {
+		flags |= walFlagAttributes
+	}
+	if r.sparse != nil {
+		flags |= walFlagSparse
+	}
+	buf = append(buf, flags)
+	if r.attrs != nil {
+		buf = appendWALAttributes(buf, r.attrs)
+	}
+	if r.sparse != nil {
+		buf = appendWALSparse(buf, r.sparse)
+	}
+	body := buf[start+walHeaderSize:]
+	binary.LittleEndian.PutUint32(buf[start:], uint32(len(body)))
+	binary.LittleEndian.PutUint32(buf[start+4:], crc32.Checksum(body, walCRCTable))
+	return buf
+}
+
+func decodeWALRecord(body []byte) (r *walRecord, err error) {
+	if len(body) < 9 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	r = &walRecord{
+		op:        walOp(body[0]),
+		timestamp: int64(binary.LittleEndian.Uint64(body[1:9])),
+	}
+	switch r.op {
+	case walOpInsert, walOpDelete, walOpPopInsert, walOpPopDelete, walOpAttributes, walOpSparse:
+	default:
+		return nil, errors.Errorf("unknown vqueue wal operation: %d", r.op)
+	}
+	body = body[9:]
+	l, n := binary.Uvarint(body)
+	if n <= 0 || uint64(len(body)-n) < l {
+		return nil, io.ErrUnexpectedEOF
+	}
+	r.uuid = string(body[n : n+int(l)])
+	body = body[n+int(l):]
+	l, n = binary.Uvarint(body)
+	if n <= 0 || uint64(len(body)-n) < l*4 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	body = body[n:]
+	if l > 0 {
+		r.vector = make([]float32, l)
+		for i := range r.vector {
+			r.vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(body[i*4:]))
+		}
+	}
+	body = body[l*4:]
+	if len(body) == 0 {
+		return r, nil
+	}
+	flags := body[0]
+	body = body[1:]
+	if flags&walFlagAttributes != 0 {
+		r.attrs, body, err = decodeWALAttributes(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if flags&walFlagSparse != 0 {
+		r.sparse, body, err = decodeWALSparse(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(body) != 0 {
+		return nil, errors.Errorf("vqueue wal record has %d trailing bytes", len(body))
+	}
+	return r, nil
+}
+
+func appendWALAttributes(buf []byte, attrs attribute.Attributes) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(attrs)))
+	for k, v := range attrs {
+		buf = binary.AppendUvarint(buf, uint64(len(k)))
+		buf = append(buf, k...)
+		buf = append(buf, byte(v.Kind))
+		switch v.Kind {
+		case attribute.String:
+			buf = binary.AppendUvarint(buf, uint64(len(v.S)))
+			buf = append(buf, v.S...)
+		case attribute.Int:
+			buf = binary.LittleEndian.AppendUint64(buf, uint64(v.I))
+		case attribute.Float:
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v.F))
+		case attribute.Bool:
+			var b byte
+			if v.B {
+				b = 1
+			}
+			buf = append(buf, b)
+		}
+	}
+	return buf
+}
+
+func decodeWALAttributes(body []byte) (attrs attribute.Attributes, rest []byte, err error) {
+	cnt, n := binary.Uvarint(body)
+	if n <= 0 || cnt > uint64(len(body)) {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	body = body[n:]
+	attrs = make(attribute.Attributes, cnt)
+	for range cnt {
+		l, n := binary.Uvarint(body)
+		if n <= 0 || uint64(len(body)-n) < l+1 {
+			return nil, nil, io.ErrUnexpectedEOF
+		}
+		key := string(body[n : n+int(l)])
+		body = body[n+int(l):]
+		v := attribute.Value{
+			Kind: attribute.Kind(body[0]),
+		}
+		body = body[1:]
+		switch v.Kind {
+		case attribute.Unknown:
+		case attribute.String:
+			l, n = binary.Uvarint(body)
+			if n <= 0 || uint64(len(body)-n) < l {
+				return nil, nil, io.ErrUnexpectedEOF
+			}
+			v.S = string(body[n : n+int(l)])
+			body = body[n+int(l):]
+		case attribute.Int, attribute.Float:
+			if len(body) < 8 {
+				return nil, nil, io.ErrUnexpectedEOF
+			}
+			u := binary.LittleEndian.Uint64(body)
+			if v.Kind == attribute.Int {
+				v.I = int64(u)
+			} else {
+				v.F = math.Float64frombits(u)
+			}
+			body = body[8:]
+		case attribute.Bool:
+			if len(body) < 1 {
+				return nil, nil, io.ErrUnexpectedEOF
+			}
+			v.B = body[0] != 0
+			body = body[1:]
+		default:
+			return nil, nil, errors.Errorf("unknown vqueue wal attribute kind: %d", v.Kind)
+		}
+		attrs[key] = v
+	}
+	return attrs, body, nil
+}
+
+func appendWALSparse(buf []byte, vec *sparse.Vector) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(vec.Indices)))
+	for _, idx := range vec.Indices {
+		buf = binary.LittleEndian.AppendUint32(buf, idx)
+	}
+	for _, f := range vec.Values {
+		buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(f))
+	}
+	return buf
+}
+
+func decodeWALSparse(body []byte) (vec *sparse.Vector, rest []byte, err error) {
+	l, n := binary.Uvarint(body)
+	if n <= 0 || uint64(len(body)-n) < l*8 {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	body = body[n:]
+	vec = new(sparse.Vector)
+	if l > 0 {
+		vec.Indices = make([]uint32, l)
+		vec.Values = make([]float32, l)
+		for i := range vec.Indices {
+			vec.Indices[i] = binary.LittleEndian.Uint32(body[i*4:])
+			vec.Values[i] = math.Float32frombits(binary.LittleEndian.Uint32(body[(int(l)+i)*4:]))
+		}
+	}
+	return vec, body[l*8:], nil
+}
@@ -0,0 +1,275 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vqueue
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/pkg/agent/internal/attribute"
+	"github.com/vdaas/vald/pkg/agent/internal/sparse"
+)
+
+func TestWAL(t *testing.T) {
+	type want struct {
+		inserts map[string]int64
+		deletes map[string]int64
+	}
+	type test struct {
+		name   string
+		policy SyncPolicy
+		// run operates the queue before it is closed and replayed by the new queue.
+		run  func(t *testing.T, q Queue, path string)
+		want want
+	}
+
+	vec := []float32{1, 2, 3}
+
+	tests := []test{
+		{
+			name:   "replay restores pushed inserts and deletes",
+			policy: SyncAlways,
+			run: func(t *testing.T, q Queue, _ string) {
+				t.Helper()
+				require.NoError(t, q.PushInsert("a", vec, 10))
+				require.NoError(t, q.PushInsert("b", vec, 20))
+				require.NoError(t, q.PushDelete("c", 30))
+				require.NoError(t, q.PushDelete("a", 40))
+			},
+			want: want{
+				inserts: map[string]int64{"a": 10, "b": 20},
+				deletes: map[string]int64{"a": 40, "c": 30},
+			},
+		},
+		{
+			name:   "replay restores the popped entries",
+			policy: SyncNone,
+			run: func(t *testing.T, q Queue, _ string) {
+				t.Helper()
+				require.NoError(t, q.PushInsert("a", vec, 10))
+				require.NoError(t, q.PushInsert("b", vec, 20))
+				require.NoError(t, q.PushDelete("b", 30))
+				_, _, ok := q.PopInsert("a")
+				require.True(t, ok)
+				_, ok = q.PopDelete("b")
+				require.True(t, ok)
+			},
+			want: want{
+				inserts: map[string]int64{"b": 20},
+				deletes: map[string]int64{},
+			},
+		},
+		{
+			name:   "truncate keeps only the uncommitted entries",
+			policy: SyncInterval,
+			run: func(t *testing.T, q Queue, path string) {
+				t.Helper()
+				for _, uuid := range []string{"a", "b", "c", "d"} {
+					require.NoError(t, q.PushInsert(uuid, vec, 10))
+				}
+				require.NoError(t, q.PushDelete("a", 20))
+				q.RangePopDelete(context.Background(), 100, func(string) bool { return true })
+				q.RangePopInsert(context.Background(), 100, func(string, []float32, int64) bool { return true })
+				require.NoError(t, q.PushInsert("e", vec, 200))
+				require.NoError(t, q.PushDelete("b", 300))
+				before, err := os.Stat(path)
+				require.NoError(t, err)
+				require.NoError(t, q.TruncateWAL())
+				after, err := os.Stat(path)
+				require.NoError(t, err)
+				require.Less(t, after.Size(), before.Size())
+			},
+			want: want{
+				inserts: map[string]int64{"e": 200},
+				deletes: map[string]int64{"b": 300},
+			},
+		},
+		{
+			name:   "replay drops the torn record at the tail",
+			policy: SyncAlways,
+			run: func(t *testing.T, q Queue, path string) {
+				t.Helper()
+				require.NoError(t, q.PushInsert("a", vec, 10))
+				require.NoError(t, q.PushInsert("b", vec, 20))
+				fi, err := os.Stat(path)
+				require.NoError(t, err)
+				require.NoError(t, os.Truncate(path, fi.Size()-3))
+			},
+			want: want{
+				inserts: map[string]int64{"a": 10},
+				deletes: map[string]int64{},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "vqueue.wal")
+			opts := []Option{
+				WithWALPath(path),
+				WithWALSyncPolicy(string(test.policy)),
+				WithWALSyncInterval("10ms"),
+			}
+			q, err := New(opts...)
+			require.NoError(t, err)
+			test.run(t, q, path)
+			require.NoError(t, q.Close())
+
+			q, err = New(opts...)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, q.Close())
+			}()
+			require.NoError(t, q.ReplayWAL(context.Background(), nil))
+			require.Equal(t, len(test.want.inserts), q.IVQLen())
+			require.Equal(t, len(test.want.deletes), q.DVQLen())
+			for uuid, ts := range test.want.inserts {
+				gotVec, its, ok := q.(*vqueue).loadIVQ(uuid)
+				require.True(t, ok, uuid)
+				require.Equal(t, ts, its, uuid)
+				require.Equal(t, vec, gotVec, uuid)
+			}
+			for uuid, ts := range test.want.deletes {
+				dts, ok := q.(*vqueue).loadDVQ(uuid)
+				require.True(t, ok, uuid)
+				require.Equal(t, ts, dts, uuid)
+			}
+
+			// the replayed log must be appendable after the replay.
+			require.NoError(t, q.PushInsert("z", vec, 1000))
+		})
+	}
+}
+
+func TestWAL_attached(t *testing.T) {
+	type attached struct {
+		attrs  attribute.Attributes
+		sparse *sparse.Vector
+	}
+	vec := []float32{1, 2, 3}
+	attrs := attribute.Attributes{
+		"s": {Kind: attribute.String, S: "book"},
+		"i": {Kind: attribute.Int, I: -42},
+		"f": {Kind: attribute.Float, F: 0.5},
+		"b": {Kind: attribute.Bool, B: true},
+	}
+	svec := sparse.Vector{
+		Indices: []uint32{3, 1024},
+		Values:  []float32{0.25, -1},
+	}
+	empty := attached{
+		attrs:  attribute.Attributes{},
+		sparse: new(sparse.Vector),
+	}
+
+	tests := []struct {
+		name string
+		// truncate truncates the log before the queue is closed and replayed by the new queue.
+		truncate bool
+		want     map[string]attached
+	}{
+		{
+			name: "replay restores the attributes and the sparse vectors",
+			want: map[string]attached{
+				"a": {attrs: attrs, sparse: &svec},
+				"b": {attrs: attrs, sparse: empty.sparse},
+				"c": empty,
+				"d": {attrs: attribute.Attributes{}},
+			},
+		},
+		{
+			name:     "truncate keeps the attributes and the sparse vectors of the uncommitted entries",
+			truncate: true,
+			want: map[string]attached{
+				"a": {attrs: attrs, sparse: &svec},
+				"b": {attrs: attrs},
+				"c": empty,
+				"d": {attrs: attribute.Attributes{}},
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "vqueue.wal")
+			opts := []Option{
+				WithWALPath(path),
+				WithWALSyncPolicy(string(SyncAlways)),
+			}
+			q, err := New(opts...)
+			require.NoError(t, err)
+			require.NoError(t, q.PushInsert("a", vec, 10))
+			require.NoError(t, q.SetAttributes("a", attrs))
+			require.NoError(t, q.SetSparseVector("a", svec))
+			// the update of b keeps the attributes set after the insert, which is newer than the delete.
+			require.NoError(t, q.PushInsert("b", vec, 10))
+			require.NoError(t, q.SetAttributes("b", attrs))
+			require.NoError(t, q.PushDelete("b", 20))
+			require.NoError(t, q.PushInsert("b", vec, 21))
+			require.NoError(t, q.SetAttributes("b", attrs))
+			// the attributes of c are deleted with the vector.
+			require.NoError(t, q.PushInsert("c", vec, 10))
+			require.NoError(t, q.SetAttributes("c", attrs))
+			require.NoError(t, q.PushDelete("c", 20))
+			require.NoError(t, q.PushInsert("d", vec, 10))
+			require.NoError(t, q.SetAttributes("d", nil))
+			if test.truncate {
+				require.NoError(t, q.TruncateWAL())
+			}
+			require.NoError(t, q.Close())
+
+			q, err = New(opts...)
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, q.Close())
+			}()
+			got := make(map[string]attached)
+			require.NoError(t, q.ReplayWAL(context.Background(), func(uuid string, attrs attribute.Attributes, vec *sparse.Vector) {
+				a := got[uuid]
+				if attrs != nil {
+					a.attrs = attrs
+				}
+				if vec != nil {
+					a.sparse = vec
+				}
+				got[uuid] = a
+			}))
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func Test_decodeWALRecord_without_flags(t *testing.T) {
+	// the record written by the older agents ends with the vector.
+	buf := appendWALRecord(nil, &walRecord{
+		op:        walOpInsert,
+		uuid:      "a",
+		vector:    []float32{1, 2},
+		timestamp: 10,
+	})
+	body := buf[walHeaderSize : len(buf)-1]
+	r, err := decodeWALRecord(body)
+	require.NoError(t, err)
+	require.Equal(t, &walRecord{
+		op:        walOpInsert,
+		uuid:      "a",
+		vector:    []float32{1, 2},
+		timestamp: 10,
+	}, r)
+}
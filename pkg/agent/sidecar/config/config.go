@@ -26,6 +26,7 @@ type Mode uint8
 const (
 	SIDECAR Mode = 1 + iota
 	INITCONTAINER
+	VERIFY
 )
 
 func (m Mode) String() string {
@@ -34,6 +35,8 @@ func (m Mode) String() string {
 		return "sidecar"
 	case INITCONTAINER:
 		return "initcontainer"
+	case VERIFY:
+		return "verify"
 	}
 	return "unknown"
 }
@@ -44,6 +47,8 @@ func SidecarMode(m string) Mode {
 		return SIDECAR
 	case "initcontainer":
 		return INITCONTAINER
+	case "verify":
+		return VERIFY
 	}
 	return 0
 }
@@ -20,6 +20,7 @@ import (
 	"io/fs"
 	"path/filepath"
 	"reflect"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/internal/sync"
 	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/pkg/agent/internal/delta"
 	"github.com/vdaas/vald/pkg/agent/internal/metadata"
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
 )
@@ -55,6 +57,16 @@ type observer struct {
 	postStopTimeout time.Duration
 	watchEnabled    bool
 	tickerEnabled   bool
+
+	deltaUploadEnabled bool
+	// snapshotID is the base snapshot id of the last backup.
+	snapshotID atomic.Int64
+	// uploaded maps the names of the uploaded delta snapshots to their base snapshot id.
+	uploaded map[string]int64
+
+	versioningEnabled bool
+	maxGenerations    int
+	maxGenerationAge  time.Duration
 }
 
 func New(opts ...Option) (so StorageObserver, err error) {
@@ -255,6 +267,18 @@ func (o *observer) startTicker(ctx context.Context) (<-chan error, error) {
 					continue
 				}
 
+				if o.deltaUploadEnabled && metadata.NGT != nil && metadata.NGT.SnapshotID != 0 &&
+					metadata.NGT.SnapshotID == o.snapshotID.Load() {
+					// the base snapshot is already backed up, so only the delta snapshots are uploaded.
+					err = o.uploadDeltas(ctx, metadata.NGT.SnapshotID)
+					if err != nil {
+						ech <- err
+						log.Error("failed to upload delta snapshots:", err)
+						err = nil
+					}
+					continue
+				}
+
 				err = o.requestBackup(ctx)
 				if err != nil {
 					ech <- err
@@ -408,6 +432,7 @@ func (o *observer) backup(ctx context.Context) (err error) {
 			attribute.String("storage_type", bi.Type),
 			attribute.String("bucket_name", bi.BucketName),
 			attribute.String("filename", bi.Filename),
+			attribute.String("key_id", bi.KeyID),
 		)
 	}
 	defer func() {
@@ -418,6 +443,12 @@ func (o *observer) backup(ctx context.Context) (err error) {
 
 	log.Infof("started to backup directory %s", o.dir)
 
+	var snapshotID int64
+	md, err := metadata.Load(o.metadataPath)
+	if err == nil && md.NGT != nil {
+		snapshotID = md.NGT.SnapshotID
+	}
+
 	pr, pw := io.Pipe()
 	defer func() {
 		e := pr.Close()
@@ -426,17 +457,38 @@ func (o *observer) backup(ctx context.Context) (err error) {
 		}
 	}()
 
-	sw, err := o.storage.Writer(ctx)
+	var (
+		sw  io.WriteCloser
+		gen *storage.Generation
+	)
+	if o.versioningEnabled {
+		gen = &storage.Generation{
+			ID:        storage.NewGenerationID(bi.StartTime),
+			CreatedAt: bi.StartTime,
+			Metadata:  md,
+			KeyID:     bi.KeyID,
+		}
+		sw, err = o.storage.GenerationWriter(ctx, gen.ID)
+	} else {
+		sw, err = o.storage.Writer(ctx)
+	}
 	if err != nil {
 		return err
 	}
+	closed := false
 	defer func() {
+		if closed {
+			return
+		}
 		e := sw.Close()
 		if e != nil {
 			log.Errorf("error on closing blob-storage writer: %s", e)
 		}
 	}()
 
+	// digests is written by the walker goroutine and read after wg.Wait.
+	var digests []*storage.FileDigest
+	backupID := storage.NewBackupID(bi.StartTime)
 	wg := new(sync.WaitGroup)
 	wg.Add(1)
 
@@ -462,6 +514,12 @@ func (o *observer) backup(ctx context.Context) (err error) {
 			}
 		}()
 
+		// the backup id binds the archive to its integrity manifest, which is uploaded after the archive.
+		err = storage.WriteBackupID(tw, backupID, bi.StartTime)
+		if err != nil {
+			return err
+		}
+
 		return filepath.Walk(o.dir, func(path string, fi os.FileInfo, err error) error {
 			select {
 			case <-ctx.Done():
@@ -512,10 +570,12 @@ func (o *observer) backup(ctx context.Context) (err error) {
 				return err
 			}
 
-			_, err = io.Copy(tw, d)
+			dr := storage.NewDigestReader(header.Name, d)
+			_, err = io.Copy(tw, dr)
 			if err != nil {
 				return err
 			}
+			digests = append(digests, dr.Digest())
 			return nil
 		})
 	}))
@@ -532,6 +592,36 @@ func (o *observer) backup(ctx context.Context) (err error) {
 
 	wg.Wait()
 
+	// The upload completes on Close, so the generation must not be listed in the manifest before it succeeds.
+	closed = true
+	err = sw.Close()
+	if err != nil {
+		return err
+	}
+
+	var genID string
+	if gen != nil {
+		genID = gen.ID
+	}
+	// a crash before the integrity manifest is uploaded leaves the manifest of the previous backup, whose backup id
+	// differs from the one in the archive.
+	err = o.storage.PutIntegrity(ctx, o.storage.ArchiveKey(genID), &storage.Integrity{
+		BackupID:  backupID,
+		CreatedAt: bi.StartTime,
+		Files:     digests,
+	})
+	if err != nil {
+		return err
+	}
+
+	if gen != nil {
+		gen.Bytes = bi.Bytes
+		err = o.commitGeneration(ctx, gen)
+		if err != nil {
+			return err
+		}
+	}
+
 	bi.EndTime = time.Now()
 	for _, hook := range o.hooks {
 		err = hook.AfterProcess(ctx, bi)
@@ -541,6 +631,100 @@ func (o *observer) backup(ctx context.Context) (err error) {
 	}
 
 	log.Infof("finished to backup directory %s", o.dir)
+	o.snapshotID.Store(snapshotID)
+
+	return nil
+}
+
+// commitGeneration lists the uploaded generation in the manifest and deletes the generations which are out of the retention.
+// The pruned generations are unlisted before they are deleted, so the manifest never refers to a deleted object.
+func (o *observer) commitGeneration(ctx context.Context, gen *storage.Generation) error {
+	m, err := o.storage.Manifest(ctx)
+	if err != nil {
+		return err
+	}
+	m.Add(gen)
+	pruned := m.Prune(o.maxGenerations, o.maxGenerationAge, time.Now())
+
+	err = o.storage.PutManifest(ctx, m)
+	if err != nil {
+		return err
+	}
+	log.Infof("backup generation %s uploaded", gen.ID)
+
+	for _, g := range pruned {
+		err = o.storage.DeleteGeneration(ctx, g.ID)
+		if err != nil {
+			log.Warnf("failed to delete backup generation %s: %v", g.ID, err)
+			continue
+		}
+		log.Infof("backup generation %s deleted by the retention policy", g.ID)
+	}
+	return nil
+}
 
+// uploadDeltas uploads the delta snapshots of the base snapshot which are not uploaded yet.
+func (o *observer) uploadDeltas(ctx context.Context, snapshotID int64) (err error) {
+	paths, err := delta.List(file.Join(o.dir, delta.DirName))
+	if err != nil {
+		return err
+	}
+	if o.uploaded == nil {
+		o.uploaded = make(map[string]int64, len(paths))
+	}
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if id, ok := o.uploaded[name]; ok && id == snapshotID {
+			continue
+		}
+		err = o.uploadDelta(ctx, path, name)
+		if err != nil {
+			return err
+		}
+		o.uploaded[name] = snapshotID
+		log.Infof("delta snapshot %s uploaded", path)
+	}
 	return nil
 }
+
+// uploadDelta uploads the delta snapshot and its integrity manifest, which is bound to the snapshot by its id since
+// the snapshot of the same name is overwritten after the base snapshot is changed.
+func (o *observer) uploadDelta(ctx context.Context, path, name string) (err error) {
+	snap, err := delta.Load(path)
+	if err != nil {
+		return err
+	}
+	data, err := file.Open(path, os.O_RDONLY, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		e := data.Close()
+		if e != nil {
+			log.Errorf("failed to close %s: %s", path, e)
+		}
+	}()
+
+	sw, err := o.storage.DeltaWriter(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	d, err := io.NewReaderWithContext(ctx, data)
+	if err != nil {
+		return errors.Join(err, sw.Close())
+	}
+
+	dr := storage.NewDigestReader(name, d)
+	_, err = io.Copy(sw, dr)
+	err = errors.Join(err, sw.Close())
+	if err != nil {
+		return err
+	}
+	return o.storage.PutIntegrity(ctx, o.storage.DeltaKey(name), &storage.Integrity{
+		BackupID:  snap.ID(),
+		CreatedAt: time.Unix(0, snap.Timestamp),
+		Files:     []*storage.FileDigest{dr.Digest()},
+		Delta:     true,
+	})
+}
@@ -15,6 +15,7 @@
 package observer
 
 import (
+	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/file"
 	"github.com/vdaas/vald/internal/sync/errgroup"
 	"github.com/vdaas/vald/internal/timeutil"
@@ -76,6 +77,52 @@ func WithTicker(enabled bool) Option {
 	}
 }
 
+// WithDeltaUpload returns the option to upload only the delta snapshots while the base snapshot is not changed.
+func WithDeltaUpload(enabled bool) Option {
+	return func(o *observer) error {
+		o.deltaUploadEnabled = enabled
+
+		return nil
+	}
+}
+
+// WithVersioning returns the option to upload every backup as a new generation listed in the manifest instead of overwriting the backup file.
+func WithVersioning(enabled bool) Option {
+	return func(o *observer) error {
+		o.versioningEnabled = enabled
+
+		return nil
+	}
+}
+
+// WithMaxGenerations returns the option to keep only the newest n backup generations. 0 keeps all generations.
+func WithMaxGenerations(n int) Option {
+	return func(o *observer) error {
+		if n < 0 {
+			return errors.NewErrInvalidOption("maxGenerations", n)
+		}
+		o.maxGenerations = n
+
+		return nil
+	}
+}
+
+// WithMaxGenerationAge returns the option to delete the backup generations older than dur. Empty keeps all generations.
+func WithMaxGenerationAge(dur string) Option {
+	return func(o *observer) error {
+		if dur == "" {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return errors.NewErrInvalidOption("maxGenerationAge", dur, err)
+		}
+		o.maxGenerationAge = d
+
+		return nil
+	}
+}
+
 func WithErrGroup(eg errgroup.Group) Option {
 	return func(o *observer) error {
 		if eg != nil {
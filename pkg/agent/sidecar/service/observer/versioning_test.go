@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/db/storage/blob/memory"
+	"github.com/vdaas/vald/internal/os"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+func Test_observer_backup(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name           string
+		versioning     bool
+		maxGenerations int
+		backups        int
+		// wantGenerations is the number of the generations listed in the manifest.
+		wantGenerations int
+		// wantKeys is the number of the objects in the bucket, including the integrity manifests of the backups.
+		wantKeys int
+	}
+
+	tests := []test{
+		{
+			name:     "unversioned backup overwrites the backup file",
+			backups:  3,
+			wantKeys: 2,
+		},
+		{
+			name:            "versioned backup uploads the generations and the manifest",
+			versioning:      true,
+			backups:         3,
+			wantGenerations: 3,
+			wantKeys:        7,
+		},
+		{
+			name:            "versioned backup deletes the generations out of the retention",
+			versioning:      true,
+			maxGenerations:  2,
+			backups:         3,
+			wantGenerations: 2,
+			wantKeys:        5,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			eg, ctx := errgroup.New(ctx)
+
+			dir := tt.TempDir()
+			require.NoError(tt, os.WriteFile(filepath.Join(dir, "data"), []byte("data"), 0o600))
+			metadataPath := filepath.Join(dir, metadata.AgentMetadataFileName)
+			require.NoError(tt, metadata.Store(metadataPath, &metadata.Metadata{
+				NGT: &metadata.NGT{IndexCount: 1},
+			}))
+
+			bucket := memory.New()
+			st, err := storage.New(
+				storage.WithErrGroup(eg),
+				storage.WithBucket(bucket),
+				storage.WithFilename("vald-agent"),
+			)
+			require.NoError(tt, err)
+			_, err = st.Start(ctx)
+			require.NoError(tt, err)
+
+			o := &observer{
+				eg:                eg,
+				storage:           st,
+				dir:               dir,
+				metadataPath:      metadataPath,
+				versioningEnabled: test.versioning,
+				maxGenerations:    test.maxGenerations,
+			}
+			for range test.backups {
+				require.NoError(tt, o.backup(ctx))
+			}
+
+			m, err := st.Manifest(ctx)
+			require.NoError(tt, err)
+			require.Len(tt, m.Generations, test.wantGenerations)
+			for _, g := range m.Generations {
+				require.True(tt, g.IsValid())
+				require.Positive(tt, g.Bytes)
+			}
+			require.Len(tt, bucket.Keys(), test.wantKeys)
+
+			require.NoError(tt, st.Stop(ctx))
+			require.NoError(tt, eg.Wait())
+		})
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restorer
+
+import (
+	"archive/tar"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/db/storage/blob/memory"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/os"
+	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/pkg/agent/internal/delta"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+func digest(t *testing.T, name, content string) *storage.FileDigest {
+	t.Helper()
+	dr := storage.NewDigestReader(name, strings.NewReader(content))
+	_, err := io.Copy(io.Discard, dr)
+	require.NoError(t, err)
+	return dr.Digest()
+}
+
+// putArchiveWithID uploads the archive of the data file after the entry of the backup id unless id is empty.
+func putArchiveWithID(t *testing.T, w io.WriteCloser, id, content string) {
+	t.Helper()
+	tw := tar.NewWriter(w)
+	if len(id) != 0 {
+		require.NoError(t, storage.WriteBackupID(tw, id, time.Now()))
+	}
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "data",
+		Mode:     0o600,
+		Size:     int64(len(content)),
+		Typeflag: tar.TypeReg,
+	}))
+	_, err := tw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, w.Close())
+}
+
+func Test_restorer_restore_integrity(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name string
+		// integrity returns the files recorded in the integrity manifest, which is not uploaded when it returns nil.
+		integrity func(t *testing.T) []*storage.FileDigest
+		// archiveID and integrityID are the backup ids of the archive and its integrity manifest.
+		archiveID   string
+		integrityID string
+		wantErr     func(t *testing.T) error
+	}
+
+	tests := []test{
+		{
+			name: "restored files matching the integrity manifest are kept",
+			integrity: func(t *testing.T) []*storage.FileDigest {
+				return []*storage.FileDigest{digest(t, "data", "content")}
+			},
+		},
+		{
+			name: "backup without the integrity manifest is restored without verification",
+		},
+		{
+			name: "corrupted file is detected and the restored files are removed",
+			integrity: func(t *testing.T) []*storage.FileDigest {
+				return []*storage.FileDigest{digest(t, "data", "original content")}
+			},
+			wantErr: func(t *testing.T) error {
+				want, got := digest(t, "data", "original content"), digest(t, "data", "content")
+				return errors.ErrBackupIntegrityMismatch("vald-agent.tar.gz",
+					errors.ErrBackupFileCorrupted("data", want.Size, got.Size, want.SHA256, got.SHA256))
+			},
+		},
+		{
+			name: "corrupted file of the archive of the backup id is detected",
+			integrity: func(t *testing.T) []*storage.FileDigest {
+				return []*storage.FileDigest{digest(t, "data", "original content")}
+			},
+			archiveID:   "2",
+			integrityID: "2",
+			wantErr: func(t *testing.T) error {
+				want, got := digest(t, "data", "original content"), digest(t, "data", "content")
+				return errors.ErrBackupIntegrityMismatch("vald-agent.tar.gz",
+					errors.ErrBackupFileCorrupted("data", want.Size, got.Size, want.SHA256, got.SHA256))
+			},
+		},
+		{
+			name: "integrity manifest of the previous backup is not verified against the overwritten archive",
+			integrity: func(t *testing.T) []*storage.FileDigest {
+				return []*storage.FileDigest{digest(t, "data", "original content")}
+			},
+			archiveID:   "2",
+			integrityID: "1",
+		},
+		{
+			name: "file missing from the truncated archive is detected",
+			integrity: func(t *testing.T) []*storage.FileDigest {
+				return []*storage.FileDigest{digest(t, "data", "content"), digest(t, "kvs", "kvs")}
+			},
+			wantErr: func(*testing.T) error {
+				return errors.ErrBackupIntegrityMismatch("vald-agent.tar.gz", errors.ErrBackupFileMissing("kvs"))
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			eg, ctx := errgroup.New(ctx)
+
+			st, err := storage.New(
+				storage.WithErrGroup(eg),
+				storage.WithBucket(memory.New()),
+				storage.WithFilename("vald-agent"),
+			)
+			require.NoError(tt, err)
+			_, err = st.Start(ctx)
+			require.NoError(tt, err)
+
+			w, err := st.Writer(ctx)
+			require.NoError(tt, err)
+			putArchiveWithID(tt, w, test.archiveID, "content")
+			if test.integrity != nil {
+				require.NoError(tt, st.PutIntegrity(ctx, st.ArchiveKey(""), &storage.Integrity{
+					BackupID: test.integrityID,
+					Files:    test.integrity(tt),
+				}))
+			}
+
+			r := &restorer{
+				eg:      eg,
+				storage: st,
+				dir:     tt.TempDir(),
+			}
+			err = r.restore(ctx)
+			require.NoError(tt, st.Stop(ctx))
+			require.NoError(tt, eg.Wait())
+			if test.wantErr != nil {
+				require.EqualError(tt, err, test.wantErr(tt).Error())
+				_, err = os.Stat(filepath.Join(r.dir, "data"))
+				require.ErrorIs(tt, err, os.ErrNotExist)
+				return
+			}
+			require.NoError(tt, err)
+			got, err := os.ReadFile(filepath.Join(r.dir, "data"))
+			require.NoError(tt, err)
+			require.Equal(tt, "content", string(got))
+		})
+	}
+}
+
+func Test_restorer_readDelta_integrity(t *testing.T) {
+	t.Parallel()
+	snap := &delta.Snapshot{
+		Deleted:   []string{"uuid"},
+		BaseID:    1,
+		Seq:       1,
+		Timestamp: 1,
+	}
+	name := delta.FileName(snap.Seq)
+	type test struct {
+		name string
+		// integrityID is the id of the delta snapshot recorded in the integrity manifest.
+		integrityID string
+		// content is the content of the delta snapshot recorded in the integrity manifest.
+		content string
+		wantErr bool
+	}
+
+	tests := []test{
+		{
+			name:        "delta snapshot of the other id is not verified",
+			integrityID: "0-1-0",
+			content:     "other",
+		},
+		{
+			name:        "corrupted delta snapshot is detected",
+			integrityID: snap.ID(),
+			content:     "other",
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			eg, ctx := errgroup.New(ctx)
+
+			st, err := storage.New(
+				storage.WithErrGroup(eg),
+				storage.WithBucket(memory.New()),
+				storage.WithFilename("vald-agent"),
+			)
+			require.NoError(tt, err)
+			_, err = st.Start(ctx)
+			require.NoError(tt, err)
+
+			w, err := st.DeltaWriter(ctx, name)
+			require.NoError(tt, err)
+			require.NoError(tt, delta.Encode(w, snap))
+			require.NoError(tt, w.Close())
+			require.NoError(tt, st.PutIntegrity(ctx, st.DeltaKey(name), &storage.Integrity{
+				BackupID: test.integrityID,
+				Files:    []*storage.FileDigest{digest(tt, name, test.content)},
+				Delta:    true,
+			}))
+
+			r := &restorer{
+				eg:      eg,
+				storage: st,
+			}
+			got, err := r.readDelta(ctx, name)
+			require.NoError(tt, st.Stop(ctx))
+			require.NoError(tt, eg.Wait())
+			if test.wantErr {
+				require.Error(tt, err)
+				return
+			}
+			require.NoError(tt, err)
+			require.Equal(tt, snap.ID(), got.ID())
+		})
+	}
+}
@@ -64,6 +64,31 @@ func WithBackoff(enabled bool) Option {
 	}
 }
 
+// WithDeltaDownload returns the option to download the delta snapshots of the restored base snapshot.
+func WithDeltaDownload(enabled bool) Option {
+	return func(r *restorer) error {
+		r.deltaDownloadEnabled = enabled
+		return nil
+	}
+}
+
+// WithVersioning returns the option to restore the backup generation listed in the manifest.
+func WithVersioning(enabled bool) Option {
+	return func(r *restorer) error {
+		r.versioningEnabled = enabled
+		return nil
+	}
+}
+
+// WithGeneration returns the option to restore the backup generation of the id.
+// Empty or storage.LatestGeneration restores the newest generation with valid metadata.
+func WithGeneration(id string) Option {
+	return func(r *restorer) error {
+		r.generation = id
+		return nil
+	}
+}
+
 func WithBackoffOpts(opts ...backoff.Option) Option {
 	return func(r *restorer) error {
 		if r.backoffOpts == nil {
@@ -16,6 +16,7 @@ package restorer
 
 import (
 	"archive/tar"
+	"bytes"
 	"context"
 	"io/fs"
 	"reflect"
@@ -31,6 +32,8 @@ import (
 	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/internal/strings"
 	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/pkg/agent/internal/delta"
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
 	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
 )
 
@@ -46,6 +49,11 @@ type restorer struct {
 	dir            string
 	backoffOpts    []backoff.Option
 	backoffEnabled bool
+
+	deltaDownloadEnabled bool
+
+	versioningEnabled bool
+	generation        string
 }
 
 func New(opts ...Option) (Restorer, error) {
@@ -177,6 +185,25 @@ func (r *restorer) restore(ctx context.Context) (err error) {
 
 	log.Infof("started to restore directory %s", r.dir)
 
+	open := r.storage.Reader
+	key := r.storage.ArchiveKey("")
+	if r.versioningEnabled {
+		gen, err := r.findGeneration(ctx)
+		if err != nil {
+			log.Warn(err)
+			return err
+		}
+		if gen != nil {
+			log.Infof("restoring backup generation %s created at %s", gen.ID, gen.CreatedAt)
+			open = func(ctx context.Context) (io.ReadCloser, error) {
+				return r.storage.GenerationReader(ctx, gen.ID)
+			}
+			key = r.storage.ArchiveKey(gen.ID)
+		} else {
+			log.Info("no backup generation is listed in the manifest, restoring the unversioned backup file")
+		}
+	}
+
 	var (
 		pr io.ReadCloser
 		pw io.WriteCloser
@@ -195,7 +222,7 @@ func (r *restorer) restore(ctx context.Context) (err error) {
 	}
 	r.eg.Go(safety.RecoverFunc(func() (err error) {
 		defer pw.Close()
-		sr, err := r.storage.Reader(ctx)
+		sr, err := open(ctx)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				return nil
@@ -228,6 +255,19 @@ func (r *restorer) restore(ctx context.Context) (err error) {
 		return nil
 	}))
 
+	// The restored files are removed when the restore fails, so the agent never loads a partially restored
+	// or corrupted index and the next attempt does not stop at the files which already exist.
+	var (
+		restored []string
+		digests  []*storage.FileDigest
+		backupID string
+	)
+	defer func() {
+		if err != nil {
+			r.removeRestored(restored)
+		}
+	}()
+
 	tr := tar.NewReader(pr)
 
 	for {
@@ -242,11 +282,28 @@ func (r *restorer) restore(ctx context.Context) (err error) {
 			log.Warn(err)
 			if errors.Is(err, io.EOF) {
 				log.Infof("finished to restore directory %s finished by returning io.EOF error: %v", r.dir, err)
+				err = r.verify(ctx, key, backupID, digests)
+				if err != nil {
+					log.Error(err)
+					return err
+				}
+				if r.deltaDownloadEnabled {
+					return r.restoreDeltas(ctx)
+				}
 				return nil
 			}
 			return err
 		}
 
+		if header.Name == storage.BackupIDFileName && header.Typeflag == tar.TypeReg {
+			backupID, err = storage.ReadBackupID(tr)
+			if err != nil {
+				log.Warn(err)
+				return err
+			}
+			continue
+		}
+
 		target := file.Join(r.dir, header.Name)
 		log.Debug("restoring: ", target)
 		// Guard against tar-slip: file.Join already cleans the path, so a ".."
@@ -267,7 +324,8 @@ func (r *restorer) restore(ctx context.Context) (err error) {
 			}
 		case tar.TypeReg:
 			//nolint:gosec // header.Mode is a POSIX file mode within uint32 range; any truncation only affects restored file permissions, not escalation.
-			_, err = file.WriteFile(ctx, target, tr, fs.FileMode(header.Mode))
+			dr := storage.NewDigestReader(header.Name, tr)
+			_, err = file.WriteFile(ctx, target, dr, fs.FileMode(header.Mode))
 			if err != nil {
 				log.Warn(err)
 				if errors.Is(err, errors.ErrFileAlreadyExists(target)) {
@@ -275,6 +333,134 @@ func (r *restorer) restore(ctx context.Context) (err error) {
 				}
 				return err
 			}
+			restored = append(restored, target)
+			digests = append(digests, dr.Digest())
 		}
 	}
 }
+
+// verify compares the restored files with the integrity manifest of the backup archive of key and backupID.
+// The backups uploaded before the integrity manifest was introduced are not verified, and neither is the backup
+// whose integrity manifest was not uploaded by the crash, which leaves the manifest of the previous backup.
+func (r *restorer) verify(ctx context.Context, key, backupID string, digests []*storage.FileDigest) error {
+	i, err := r.storage.Integrity(ctx, key)
+	if err != nil {
+		return err
+	}
+	if i == nil {
+		log.Infof("backup %s has no integrity manifest, skipped verifying the restored files", key)
+		return nil
+	}
+	if !i.Describes(backupID) {
+		log.Warnf("integrity manifest of backup %s describes backup id %s instead of %s, skipped verifying the restored files", key, i.BackupID, backupID)
+		return nil
+	}
+	err = i.Verify(digests)
+	if err != nil {
+		return errors.ErrBackupIntegrityMismatch(key, err)
+	}
+	log.Infof("verified %d restored files of backup %s", len(digests), key)
+	return nil
+}
+
+func (*restorer) removeRestored(paths []string) {
+	for _, path := range paths {
+		err := os.Remove(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Warnf("failed to remove the restored file %s: %v", path, err)
+		}
+	}
+}
+
+// findGeneration returns the backup generation to restore.
+// The metadata recorded in the manifest is validated before the generation is chosen,
+// so a generation backed up from an invalid index is never restored.
+func (r *restorer) findGeneration(ctx context.Context) (*storage.Generation, error) {
+	m, err := r.storage.Manifest(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m.Find(r.generation)
+}
+
+// restoreDeltas downloads the delta snapshots of the restored base snapshot which are newer than the restored ones.
+// It stops at the first delta snapshot which does not exist or belongs to another base snapshot.
+func (r *restorer) restoreDeltas(ctx context.Context) (err error) {
+	md, err := metadata.Load(file.Join(r.dir, metadata.AgentMetadataFileName))
+	if err != nil || md.NGT == nil || md.NGT.SnapshotID == 0 {
+		log.Infof("delta snapshots are not restored because the restored index has no base snapshot id, err: %v", err)
+		return nil
+	}
+	dir := file.Join(r.dir, delta.DirName)
+	paths, err := delta.List(dir)
+	if err != nil {
+		return err
+	}
+	var seq uint64
+	if len(paths) > 0 {
+		seq, _ = delta.ParseSeq(paths[len(paths)-1])
+	}
+	for seq++; ; seq++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		name := delta.FileName(seq)
+		snap, err := r.readDelta(ctx, name)
+		if err != nil {
+			log.Infof("finished to restore delta snapshots at %s, err: %v", name, err)
+			return nil
+		}
+		if snap.BaseID != md.NGT.SnapshotID {
+			log.Infof("finished to restore delta snapshots at %s, err: %v", name, errors.ErrDeltaSnapshotBaseMismatch(name, snap.BaseID, md.NGT.SnapshotID))
+			return nil
+		}
+		path, err := delta.Store(dir, snap)
+		if err != nil {
+			log.Warn(err)
+			return err
+		}
+		log.Debug("restoring: ", path)
+	}
+}
+
+// readDelta reads the delta snapshot of name and verifies it with its integrity manifest.
+func (r *restorer) readDelta(ctx context.Context, name string) (snap *delta.Snapshot, err error) {
+	sr, err := r.storage.DeltaReader(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		e := sr.Close()
+		if e != nil {
+			log.Errorf("error on closing blob-storage reader: %s", e)
+		}
+	}()
+	dr := storage.NewDigestReader(name, sr)
+	data, err := io.ReadAll(dr)
+	if err != nil {
+		return nil, err
+	}
+	snap, err = delta.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	key := r.storage.DeltaKey(name)
+	i, err := r.storage.Integrity(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if i == nil || !i.Describes(snap.ID()) {
+		log.Infof("delta snapshot %s has no integrity manifest, skipped verifying it", key)
+		return snap, nil
+	}
+	err = i.Verify([]*storage.FileDigest{dr.Digest()})
+	if err != nil {
+		err = errors.ErrBackupIntegrityMismatch(key, err)
+		log.Error(err)
+		return nil, err
+	}
+	return snap, nil
+}
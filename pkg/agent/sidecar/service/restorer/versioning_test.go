@@ -0,0 +1,182 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restorer
+
+import (
+	"archive/tar"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/db/storage/blob/memory"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/os"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+// putArchive uploads the tar archive which contains only the file "data" of content with w.
+func putArchive(t *testing.T, w io.WriteCloser, content string) {
+	t.Helper()
+	tw := tar.NewWriter(w)
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "data",
+		Mode:     0o600,
+		Size:     int64(len(content)),
+		Typeflag: tar.TypeReg,
+	}))
+	_, err := tw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, w.Close())
+}
+
+func Test_restorer_restore(t *testing.T) {
+	t.Parallel()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	type generation struct {
+		content string
+		valid   bool
+	}
+	type test struct {
+		name string
+		// unversioned is the content of the unversioned backup file, which is not uploaded when empty.
+		unversioned string
+		// generations are uploaded from the oldest to the newest.
+		generations []generation
+		versioning  bool
+		// generation is the index of generations to restore, or the latest one when it is negative.
+		generation int
+		want       string
+		wantErr    func(ids []string) error
+	}
+
+	tests := []test{
+		{
+			name:        "unversioned backup file is restored when the versioning is disabled",
+			unversioned: "unversioned",
+			generations: []generation{{"gen0", true}},
+			generation:  -1,
+			want:        "unversioned",
+		},
+		{
+			name:        "unversioned backup file is restored when the manifest does not exist",
+			unversioned: "unversioned",
+			versioning:  true,
+			generation:  -1,
+			want:        "unversioned",
+		},
+		{
+			name:        "latest generation is restored",
+			unversioned: "unversioned",
+			generations: []generation{{"gen0", true}, {"gen1", true}},
+			versioning:  true,
+			generation:  -1,
+			want:        "gen1",
+		},
+		{
+			name:        "latest generation skips the generations with invalid metadata",
+			generations: []generation{{"gen0", true}, {"gen1", true}, {"gen2", false}},
+			versioning:  true,
+			generation:  -1,
+			want:        "gen1",
+		},
+		{
+			name:        "specific generation is restored",
+			generations: []generation{{"gen0", true}, {"gen1", true}, {"gen2", true}},
+			versioning:  true,
+			generation:  0,
+			want:        "gen0",
+		},
+		{
+			name:        "specific generation with invalid metadata is not restored",
+			generations: []generation{{"gen0", false}, {"gen1", true}},
+			versioning:  true,
+			generation:  0,
+			wantErr: func(ids []string) error {
+				return errors.ErrInvalidBackupGeneration(ids[0])
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			eg, ctx := errgroup.New(ctx)
+
+			st, err := storage.New(
+				storage.WithErrGroup(eg),
+				storage.WithBucket(memory.New()),
+				storage.WithFilename("vald-agent"),
+			)
+			require.NoError(tt, err)
+			_, err = st.Start(ctx)
+			require.NoError(tt, err)
+
+			if test.unversioned != "" {
+				w, err := st.Writer(ctx)
+				require.NoError(tt, err)
+				putArchive(tt, w, test.unversioned)
+			}
+			ids := make([]string, 0, len(test.generations))
+			if len(test.generations) > 0 {
+				m := new(storage.Manifest)
+				for i, g := range test.generations {
+					createdAt := base.Add(time.Duration(i) * time.Hour)
+					id := storage.NewGenerationID(createdAt)
+					w, err := st.GenerationWriter(ctx, id)
+					require.NoError(tt, err)
+					putArchive(tt, w, g.content)
+					m.Add(&storage.Generation{
+						ID:        id,
+						CreatedAt: createdAt,
+						Metadata:  &metadata.Metadata{IsInvalid: !g.valid},
+					})
+					ids = append(ids, id)
+				}
+				require.NoError(tt, st.PutManifest(ctx, m))
+			}
+
+			r := &restorer{
+				eg:                eg,
+				storage:           st,
+				dir:               tt.TempDir(),
+				versioningEnabled: test.versioning,
+			}
+			if test.generation >= 0 {
+				r.generation = ids[test.generation]
+			}
+
+			err = r.restore(ctx)
+			require.NoError(tt, st.Stop(ctx))
+			if test.wantErr != nil {
+				require.EqualError(tt, err, test.wantErr(ids).Error())
+				return
+			}
+			require.NoError(tt, err)
+			got, err := os.ReadFile(filepath.Join(r.dir, "data"))
+			require.NoError(tt, err)
+			require.Equal(tt, test.want, string(got))
+			require.NoError(tt, eg.Wait())
+		})
+	}
+}
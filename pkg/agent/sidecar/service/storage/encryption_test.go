@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/db/storage/blob/memory"
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/encrypt"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/io"
+)
+
+func Test_bs_encryption(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	writeKeyfile := func(name string, keys ...encrypt.Key) string {
+		t.Helper()
+		b, err := json.Marshal(encrypt.Keyfile{Keys: keys})
+		require.NoError(t, err)
+		path := filepath.Join(dir, name)
+		_, err = file.OverWriteFile(ctx, path, bytes.NewReader(b), 0o600)
+		require.NoError(t, err)
+		return path
+	}
+	k1 := encrypt.Key{ID: "k1", Key: bytes.Repeat([]byte{1}, 32)}
+	k2 := encrypt.Key{ID: "k2", Key: bytes.Repeat([]byte{2}, 32)}
+
+	bucket := memory.New()
+	newStorage := func(keyfile string) Storage {
+		t.Helper()
+		s, err := New(
+			WithBucket(bucket),
+			WithFilename("index"),
+			WithFilenameSuffix(".tar.gz"),
+			WithCompressAlgorithm("gzip"),
+			WithEncryption(true),
+			WithEncryptionProvider("keyfile"),
+			WithEncryptionKeyfile(keyfile),
+		)
+		require.NoError(t, err)
+		_, err = s.Start(ctx)
+		require.NoError(t, err)
+		return s
+	}
+
+	data := bytes.Repeat([]byte("embedding"), 100)
+	old := newStorage(writeKeyfile("old.json", k1))
+	require.Equal(t, "k1", old.StorageInfo().KeyID)
+	w, err := old.Writer(ctx)
+	require.NoError(t, err)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r, err := bucket.Reader(ctx, "index.tar.gz")
+	require.NoError(t, err)
+	raw, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(raw, []byte("VALDENC")))
+	require.False(t, bytes.Contains(raw, []byte("embedding")))
+
+	// the backup encrypted by k1 is restored after the key is rotated to k2.
+	rotated := newStorage(writeKeyfile("rotated.json", k1, k2))
+	require.Equal(t, "k2", rotated.StorageInfo().KeyID)
+	r, err = rotated.Reader(ctx)
+	require.NoError(t, err)
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, data, got)
+}
+
+func Test_bs_unencryptedRestore(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	keyfile := filepath.Join(t.TempDir(), "keys.json")
+	b, err := json.Marshal(encrypt.Keyfile{Keys: []encrypt.Key{{ID: "k1", Key: bytes.Repeat([]byte{1}, 32)}}})
+	require.NoError(t, err)
+	_, err = file.OverWriteFile(ctx, keyfile, bytes.NewReader(b), 0o600)
+	require.NoError(t, err)
+
+	bucket := memory.New()
+	newStorage := func(opts ...Option) Storage {
+		t.Helper()
+		s, err := New(append([]Option{
+			WithBucket(bucket),
+			WithFilename("index"),
+			WithFilenameSuffix(".tar.gz"),
+			WithCompressAlgorithm("gzip"),
+		}, opts...)...)
+		require.NoError(t, err)
+		_, err = s.Start(ctx)
+		require.NoError(t, err)
+		return s
+	}
+	encrypted := func(allow bool) Storage {
+		return newStorage(
+			WithEncryption(true),
+			WithEncryptionProvider("keyfile"),
+			WithEncryptionKeyfile(keyfile),
+			WithAllowUnencryptedRestore(allow),
+		)
+	}
+
+	// the backup is uploaded before the encryption is enabled.
+	data := bytes.Repeat([]byte("embedding"), 100)
+	w, err := newStorage().Writer(ctx)
+	require.NoError(t, err)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	_, err = encrypted(false).Reader(ctx)
+	require.ErrorIs(t, err, errors.ErrUnencryptedStream)
+
+	r, err := encrypted(true).Reader(ctx)
+	require.NoError(t, err)
+	got, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, data, got)
+}
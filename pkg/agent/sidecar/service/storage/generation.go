@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"slices"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+)
+
+// LatestGeneration is the generation id which selects the newest generation with valid metadata.
+const LatestGeneration = "latest"
+
+const generationIDFormat = "20060102T150405.000000000Z"
+
+// Generation represents an immutable backup object which is never overwritten once uploaded.
+type Generation struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Metadata  *metadata.Metadata `json:"metadata,omitempty"`
+	ID        string             `json:"id"`
+	KeyID     string             `json:"key_id,omitempty"`
+	Bytes     int64              `json:"bytes"`
+}
+
+// Manifest represents the list of the backup generations in the bucket, sorted from the oldest to the newest.
+type Manifest struct {
+	Generations []*Generation `json:"generations"`
+}
+
+// NewGenerationID returns the generation id of the backup started at t.
+// The ids are sortable in the order of the backup time.
+func NewGenerationID(t time.Time) string {
+	return t.UTC().Format(generationIDFormat)
+}
+
+// IsValid returns true when the generation was backed up with the valid metadata of the index.
+func (g *Generation) IsValid() bool {
+	return g != nil && g.Metadata != nil && !g.Metadata.IsInvalid
+}
+
+// Add adds the generation to the manifest.
+func (m *Manifest) Add(g *Generation) {
+	m.Generations = append(m.Generations, g)
+	slices.SortStableFunc(m.Generations, func(a, b *Generation) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		switch {
+		case a.ID < b.ID:
+			return -1
+		case a.ID > b.ID:
+			return 1
+		}
+		return 0
+	})
+}
+
+// Find returns the generation to restore.
+// When id is empty or LatestGeneration, it returns the newest generation with valid metadata,
+// and nil when the manifest has no generation at all.
+func (m *Manifest) Find(id string) (*Generation, error) {
+	if m == nil || len(m.Generations) == 0 {
+		if id == "" || id == LatestGeneration {
+			return nil, nil
+		}
+		return nil, errors.ErrBackupGenerationNotFound(id)
+	}
+	if id == "" || id == LatestGeneration {
+		if g := m.latestValid(); g != nil {
+			return g, nil
+		}
+		return nil, errors.ErrInvalidBackupGeneration(LatestGeneration)
+	}
+	for _, g := range m.Generations {
+		if g.ID != id {
+			continue
+		}
+		if !g.IsValid() {
+			return nil, errors.ErrInvalidBackupGeneration(id)
+		}
+		return g, nil
+	}
+	return nil, errors.ErrBackupGenerationNotFound(id)
+}
+
+// Prune removes the generations beyond the newest maxGenerations and the generations older than maxAge,
+// and returns the removed ones. Zero values disable the respective limit.
+// The newest generation with valid metadata is always kept, so the retention never removes the last good backup.
+func (m *Manifest) Prune(maxGenerations int, maxAge time.Duration, now time.Time) (pruned []*Generation) {
+	if m == nil || (maxGenerations <= 0 && maxAge <= 0) {
+		return nil
+	}
+	keep := m.latestValid()
+	kept := make([]*Generation, 0, len(m.Generations))
+	for i := len(m.Generations) - 1; i >= 0; i-- {
+		g := m.Generations[i]
+		rank := len(m.Generations) - 1 - i
+		if g != keep &&
+			((maxGenerations > 0 && rank >= maxGenerations) ||
+				(maxAge > 0 && now.Sub(g.CreatedAt) > maxAge)) {
+			pruned = append(pruned, g)
+			continue
+		}
+		kept = append(kept, g)
+	}
+	slices.Reverse(kept)
+	slices.Reverse(pruned)
+	m.Generations = kept
+	return pruned
+}
+
+func (m *Manifest) latestValid() *Generation {
+	for i := len(m.Generations) - 1; i >= 0; i-- {
+		if m.Generations[i].IsValid() {
+			return m.Generations[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/pkg/agent/internal/metadata"
+)
+
+var generationBase = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newGeneration(hours int, valid bool) *Generation {
+	t := generationBase.Add(time.Duration(hours) * time.Hour)
+	return &Generation{
+		ID:        NewGenerationID(t),
+		CreatedAt: t,
+		Metadata: &metadata.Metadata{
+			IsInvalid: !valid,
+		},
+	}
+}
+
+func ids(gens []*Generation) []string {
+	res := make([]string, 0, len(gens))
+	for _, g := range gens {
+		res = append(res, g.ID)
+	}
+	return res
+}
+
+func TestManifest_Find(t *testing.T) {
+	g0, g1, g2 := newGeneration(0, true), newGeneration(1, true), newGeneration(2, false)
+	noMeta := &Generation{ID: "no-metadata", CreatedAt: generationBase.Add(3 * time.Hour)}
+
+	type test struct {
+		name    string
+		gens    []*Generation
+		id      string
+		want    *Generation
+		wantErr error
+	}
+
+	tests := []test{
+		{
+			name: "empty manifest returns nothing for the latest generation",
+			id:   LatestGeneration,
+		},
+		{
+			name:    "empty manifest returns an error for the specific generation",
+			id:      g0.ID,
+			wantErr: errors.ErrBackupGenerationNotFound(g0.ID),
+		},
+		{
+			name: "latest skips the generations with invalid or no metadata",
+			gens: []*Generation{g0, g1, g2, noMeta},
+			id:   LatestGeneration,
+			want: g1,
+		},
+		{
+			name: "empty id is the latest generation",
+			gens: []*Generation{g1, g0},
+			want: g1,
+		},
+		{
+			name:    "latest returns an error when no generation is valid",
+			gens:    []*Generation{g2, noMeta},
+			wantErr: errors.ErrInvalidBackupGeneration(LatestGeneration),
+		},
+		{
+			name: "specific generation is found",
+			gens: []*Generation{g0, g1, g2},
+			id:   g0.ID,
+			want: g0,
+		},
+		{
+			name:    "specific generation with invalid metadata is rejected",
+			gens:    []*Generation{g0, g1, g2},
+			id:      g2.ID,
+			wantErr: errors.ErrInvalidBackupGeneration(g2.ID),
+		},
+		{
+			name:    "unknown generation is not found",
+			gens:    []*Generation{g0, g1},
+			id:      "unknown",
+			wantErr: errors.ErrBackupGenerationNotFound("unknown"),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(t *testing.T) {
+			m := new(Manifest)
+			for _, g := range test.gens {
+				m.Add(g)
+			}
+			got, err := m.Find(test.id)
+			if test.wantErr != nil {
+				require.EqualError(t, err, test.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestManifest_Prune(t *testing.T) {
+	now := generationBase.Add(10 * time.Hour)
+
+	type test struct {
+		name           string
+		gens           []*Generation
+		maxGenerations int
+		maxAge         time.Duration
+		wantKept       []string
+		wantPruned     []string
+	}
+
+	g0, g1, g2, g3 := newGeneration(0, true), newGeneration(4, true), newGeneration(8, true), newGeneration(9, true)
+	bad := newGeneration(9, false)
+
+	tests := []test{
+		{
+			name:     "no limit keeps all generations",
+			gens:     []*Generation{g0, g1, g2},
+			wantKept: ids([]*Generation{g0, g1, g2}),
+		},
+		{
+			name:           "only the newest generations are kept",
+			gens:           []*Generation{g2, g0, g3, g1},
+			maxGenerations: 2,
+			wantKept:       ids([]*Generation{g2, g3}),
+			wantPruned:     ids([]*Generation{g0, g1}),
+		},
+		{
+			name:       "old generations are pruned",
+			gens:       []*Generation{g0, g1, g2},
+			maxAge:     5 * time.Hour,
+			wantKept:   ids([]*Generation{g2}),
+			wantPruned: ids([]*Generation{g0, g1}),
+		},
+		{
+			name:           "both limits are applied",
+			gens:           []*Generation{g0, g1, g2, g3},
+			maxGenerations: 3,
+			maxAge:         8 * time.Hour,
+			wantKept:       ids([]*Generation{g1, g2, g3}),
+			wantPruned:     ids([]*Generation{g0}),
+		},
+		{
+			name:           "the newest valid generation is kept beyond the limits",
+			gens:           []*Generation{g0, bad},
+			maxGenerations: 1,
+			maxAge:         time.Hour,
+			wantKept:       ids([]*Generation{g0, bad}),
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(t *testing.T) {
+			m := new(Manifest)
+			for _, g := range test.gens {
+				m.Add(g)
+			}
+			pruned := m.Prune(test.maxGenerations, test.maxAge, now)
+			require.Equal(t, test.wantKept, ids(m.Generations))
+			require.Equal(t, test.wantPruned, func() []string {
+				if len(pruned) == 0 {
+					return nil
+				}
+				return ids(pruned)
+			}())
+		})
+	}
+}
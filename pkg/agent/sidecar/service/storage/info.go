@@ -18,4 +18,6 @@ type StorageInfo struct {
 	Type       string
 	BucketName string
 	Filename   string
+	// KeyID is the id of the key which encrypts the backups. It is empty when the encryption is disabled.
+	KeyID string
 }
@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+)
+
+const (
+	// BackupIDFileName is the name of the first entry of the backup archive, which holds the backup id and is not
+	// restored.
+	BackupIDFileName = ".vald-backup-id"
+
+	maxBackupIDSize = 64
+)
+
+// Integrity represents the sizes and the SHA-256 digests of the files in a backup archive or a delta snapshot.
+// It is uploaded next to the archive and verified after the archive is restored.
+type Integrity struct {
+	// BackupID is the id stored in the archive, or the id of the delta snapshot.
+	// The archive is overwritten before its integrity manifest, so the manifest of another backup is detected by it.
+	BackupID  string        `json:"backup_id,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+	Files     []*FileDigest `json:"files"`
+	// Delta is true for the integrity manifest of the delta snapshot, which is uploaded as it is instead of the archive.
+	Delta bool `json:"delta,omitempty"`
+}
+
+// NewBackupID returns the id of the backup started at t.
+func NewBackupID(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// WriteBackupID writes the backup id to the archive as the entry of BackupIDFileName.
+func WriteBackupID(tw *tar.Writer, id string, t time.Time) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name:     BackupIDFileName,
+		Mode:     0o600,
+		Size:     int64(len(id)),
+		ModTime:  t,
+		Typeflag: tar.TypeReg,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tw.Write([]byte(id))
+	return err
+}
+
+// ReadBackupID reads the backup id from the entry of BackupIDFileName.
+func ReadBackupID(r io.Reader) (string, error) {
+	id, err := io.ReadAll(io.LimitReader(r, maxBackupIDSize))
+	if err != nil {
+		return "", err
+	}
+	return string(id), nil
+}
+
+// Describes returns true when the integrity manifest describes the backup of id.
+// The manifest uploaded before the backup id was introduced describes any backup.
+func (i *Integrity) Describes(id string) bool {
+	return len(i.BackupID) == 0 || i.BackupID == id
+}
+
+// FileDigest represents the size and the SHA-256 digest of a file in a backup archive.
+type FileDigest struct {
+	// Name is the slash-separated path relative to the backup directory.
+	Name   string `json:"name"`
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// Verify returns an error joining all differences between the recorded files and got.
+func (i *Integrity) Verify(got []*FileDigest) (err error) {
+	want := make(map[string]*FileDigest, len(i.Files))
+	for _, fd := range i.Files {
+		want[fd.Name] = fd
+	}
+	for _, g := range got {
+		w, ok := want[g.Name]
+		if !ok {
+			err = errors.Join(err, errors.ErrUnexpectedBackupFile(g.Name))
+			continue
+		}
+		delete(want, g.Name)
+		if w.Size != g.Size || w.SHA256 != g.SHA256 {
+			err = errors.Join(err, errors.ErrBackupFileCorrupted(g.Name, w.Size, g.Size, w.SHA256, g.SHA256))
+		}
+	}
+	missing := make([]string, 0, len(want))
+	for name := range want {
+		missing = append(missing, name)
+	}
+	slices.Sort(missing)
+	for _, name := range missing {
+		err = errors.Join(err, errors.ErrBackupFileMissing(name))
+	}
+	return err
+}
+
+// DigestReader computes the digest of the file read through it.
+type DigestReader struct {
+	r    io.Reader
+	h    hash.Hash
+	name string
+	size int64
+}
+
+// NewDigestReader returns the DigestReader of the file of name which reads from r.
+func NewDigestReader(name string, r io.Reader) *DigestReader {
+	return &DigestReader{
+		r:    r,
+		h:    sha256.New(),
+		name: name,
+	}
+}
+
+func (d *DigestReader) Read(p []byte) (n int, err error) {
+	n, err = d.r.Read(p)
+	if n > 0 {
+		d.h.Write(p[:n])
+		d.size += int64(n)
+	}
+	return n, err
+}
+
+// Digest returns the digest of the data read so far.
+func (d *DigestReader) Digest() *FileDigest {
+	return &FileDigest{
+		Name:   d.name,
+		SHA256: hex.EncodeToString(d.h.Sum(nil)),
+		Size:   d.size,
+	}
+}
@@ -15,10 +15,13 @@
 package storage
 
 import (
+	"github.com/vdaas/vald/internal/db/storage/blob"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage/urlopener"
+	"github.com/vdaas/vald/internal/db/storage/blob/file"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/session"
+	"github.com/vdaas/vald/internal/encrypt"
 	"github.com/vdaas/vald/internal/sync/errgroup"
 )
 
@@ -116,6 +119,33 @@ func WithCloudStorageURLOpenerOpts(opts ...urlopener.Option) Option {
 	}
 }
 
+// WithFileDir returns the option to set the root directory of the buckets for the file storage type.
+func WithFileDir(dir string) Option {
+	return func(b *bs) error {
+		b.fileDir = dir
+		return nil
+	}
+}
+
+// WithFileOpts returns the option to set the options of the file storage type.
+func WithFileOpts(opts ...file.Option) Option {
+	return func(b *bs) error {
+		b.fileOpts = append(b.fileOpts, opts...)
+		return nil
+	}
+}
+
+// WithBucket returns the option to use the bucket instead of the one initialized by the storage type.
+// It is mainly used to run the backup and restore with the in-memory bucket in tests.
+func WithBucket(bucket blob.Bucket) Option {
+	return func(b *bs) error {
+		if bucket != nil {
+			b.bucket = bucket
+		}
+		return nil
+	}
+}
+
 func WithCompressAlgorithm(al string) Option {
 	return func(b *bs) error {
 		b.compressAlgorithm = al
@@ -129,3 +159,61 @@ func WithCompressionLevel(level int) Option {
 		return nil
 	}
 }
+
+// WithEncryption returns the option to enable encrypting the backups before they are uploaded.
+func WithEncryption(enabled bool) Option {
+	return func(b *bs) error {
+		b.encryptionEnabled = enabled
+		return nil
+	}
+}
+
+// WithEncryptionProvider returns the option to set the provider of the encryption keys, keyfile or plugin.
+func WithEncryptionProvider(provider string) Option {
+	return func(b *bs) error {
+		b.encryptionProvider = provider
+		return nil
+	}
+}
+
+// WithEncryptionKeyfile returns the option to set the path of the key file for the keyfile provider.
+func WithEncryptionKeyfile(path string) Option {
+	return func(b *bs) error {
+		b.encryptionKeyfile = path
+		return nil
+	}
+}
+
+// WithEncryptionKeyID returns the option to set the id of the key to encrypt the new backups.
+func WithEncryptionKeyID(id string) Option {
+	return func(b *bs) error {
+		b.encryptionKeyID = id
+		return nil
+	}
+}
+
+// WithEncryptionPluginCommand returns the option to set the command of the plugin provider.
+func WithEncryptionPluginCommand(cmd ...string) Option {
+	return func(b *bs) error {
+		b.encryptionPluginCommand = cmd
+		return nil
+	}
+}
+
+// WithEncryptor returns the option to use the encryptor instead of the one initialized by the provider.
+func WithEncryptor(e encrypt.Encryptor) Option {
+	return func(b *bs) error {
+		if e != nil {
+			b.encryptor = e
+		}
+		return nil
+	}
+}
+
+// WithAllowUnencryptedRestore returns the option to restore the unencrypted backups when the encryption is enabled.
+func WithAllowUnencryptedRestore(allow bool) Option {
+	return func(b *bs) error {
+		b.allowUnencryptedRestore = allow
+		return nil
+	}
+}
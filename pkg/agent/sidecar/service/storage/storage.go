@@ -16,6 +16,7 @@ package storage
 
 import (
 	"context"
+	"path/filepath"
 	"reflect"
 
 	"github.com/vdaas/vald/internal/compress"
@@ -23,24 +24,47 @@ import (
 	"github.com/vdaas/vald/internal/db/storage/blob"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage"
 	"github.com/vdaas/vald/internal/db/storage/blob/cloudstorage/urlopener"
+	"github.com/vdaas/vald/internal/db/storage/blob/file"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3"
 	"github.com/vdaas/vald/internal/db/storage/blob/s3/session"
+	"github.com/vdaas/vald/internal/encoding/json"
+	"github.com/vdaas/vald/internal/encrypt"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/strings"
 	"github.com/vdaas/vald/internal/sync/errgroup"
 )
 
+const (
+	manifestSuffix  = ".manifest.json"
+	integritySuffix = ".integrity.json"
+)
+
 type Storage interface {
 	Start(ctx context.Context) (<-chan error, error)
 	Stop(ctx context.Context) error
 	Reader(ctx context.Context) (io.ReadCloser, error)
 	Writer(ctx context.Context) (io.WriteCloser, error)
+	DeltaReader(ctx context.Context, name string) (io.ReadCloser, error)
+	DeltaWriter(ctx context.Context, name string) (io.WriteCloser, error)
+	GenerationReader(ctx context.Context, id string) (io.ReadCloser, error)
+	GenerationWriter(ctx context.Context, id string) (io.WriteCloser, error)
+	DeleteGeneration(ctx context.Context, id string) error
+	Manifest(ctx context.Context) (*Manifest, error)
+	PutManifest(ctx context.Context, m *Manifest) error
+	ArchiveKey(generationID string) string
+	DeltaKey(name string) string
+	Archives(ctx context.Context) ([]string, error)
+	ArchiveReader(ctx context.Context, key string) (io.ReadCloser, error)
+	Integrity(ctx context.Context, key string) (*Integrity, error)
+	PutIntegrity(ctx context.Context, key string, i *Integrity) error
 	StorageInfo() *StorageInfo
 }
 
 type bs struct {
 	eg                        errgroup.Group
 	compressor                compress.Compressor
+	encryptor                 encrypt.Encryptor
 	bucket                    blob.Bucket
 	compressAlgorithm         string
 	suffix                    string
@@ -51,7 +75,15 @@ type bs struct {
 	s3SessionOpts             []session.Option
 	cloudStorageOpts          []cloudstorage.Option
 	cloudStorageURLOpenerOpts []urlopener.Option
+	fileOpts                  []file.Option
+	fileDir                   string
+	encryptionProvider        string
+	encryptionKeyfile         string
+	encryptionKeyID           string
+	encryptionPluginCommand   []string
 	compressionLevel          int
+	encryptionEnabled         bool
+	allowUnencryptedRestore   bool
 }
 
 func New(opts ...Option) (Storage, error) {
@@ -67,6 +99,11 @@ func New(opts ...Option) (Storage, error) {
 		return nil, err
 	}
 
+	err = b.initEncryptor()
+	if err != nil {
+		return nil, err
+	}
+
 	return b, nil
 }
 
@@ -98,6 +135,32 @@ func (b *bs) initCompressor() (err error) {
 	return err
 }
 
+func (b *bs) initEncryptor() (err error) {
+	// Without encryption
+	if !b.encryptionEnabled || b.encryptor != nil {
+		return nil
+	}
+
+	var kp encrypt.KeyProvider
+	switch config.AToEncryptionKeyProvider(b.encryptionProvider) {
+	case config.KeyfileProvider:
+		kp, err = encrypt.NewKeyfileProvider(b.encryptionKeyfile, b.encryptionKeyID)
+	case config.PluginProvider:
+		kp, err = encrypt.NewPluginProvider(b.encryptionKeyID, b.encryptionPluginCommand...)
+	default:
+		return errors.ErrEncryptionKeyProviderNotFound(b.encryptionProvider)
+	}
+	if err != nil {
+		return err
+	}
+
+	b.encryptor, err = encrypt.New(
+		encrypt.WithKeyProvider(kp),
+		encrypt.WithAllowUnencrypted(b.allowUnencryptedRestore),
+	)
+	return err
+}
+
 func (b *bs) initBucket(ctx context.Context) (err error) {
 	switch config.AtoBST(b.storageType) {
 	case config.S3:
@@ -137,6 +200,16 @@ func (b *bs) initBucket(ctx context.Context) (err error) {
 		if err != nil {
 			return err
 		}
+	case config.File:
+		b.bucket, err = file.New(
+			append(
+				b.fileOpts,
+				file.WithDir(filepath.Join(b.fileDir, b.bucketName)),
+			)...,
+		)
+		if err != nil {
+			return err
+		}
 	default:
 		return errors.ErrInvalidStorageType
 	}
@@ -147,12 +220,14 @@ func (b *bs) initBucket(ctx context.Context) (err error) {
 func (b *bs) Start(ctx context.Context) (<-chan error, error) {
 	ech := make(chan error, 1)
 
-	err := b.initBucket(ctx)
-	if err != nil {
-		return nil, err
+	if b.bucket == nil {
+		err := b.initBucket(ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	err = b.bucket.Open(ctx)
+	err := b.bucket.Open(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -168,10 +243,172 @@ func (b *bs) Stop(context.Context) error {
 }
 
 func (b *bs) Reader(ctx context.Context) (r io.ReadCloser, err error) {
-	r, err = b.bucket.Reader(ctx, b.filename+b.suffix)
+	return b.reader(ctx, b.filename+b.suffix)
+}
+
+func (b *bs) Writer(ctx context.Context) (w io.WriteCloser, err error) {
+	return b.writer(ctx, b.filename+b.suffix)
+}
+
+// DeltaReader returns the reader of the delta snapshot which is stored next to the backup file.
+func (b *bs) DeltaReader(ctx context.Context, name string) (r io.ReadCloser, err error) {
+	return b.reader(ctx, b.DeltaKey(name))
+}
+
+// DeltaWriter returns the writer of the delta snapshot which is stored next to the backup file.
+func (b *bs) DeltaWriter(ctx context.Context, name string) (w io.WriteCloser, err error) {
+	return b.writer(ctx, b.DeltaKey(name))
+}
+
+// DeltaKey returns the key of the delta snapshot of name.
+func (b *bs) DeltaKey(name string) string {
+	return b.filename + "-" + name + b.suffix
+}
+
+// GenerationReader returns the reader of the backup generation.
+func (b *bs) GenerationReader(ctx context.Context, id string) (r io.ReadCloser, err error) {
+	return b.reader(ctx, b.generationKey(id))
+}
+
+// GenerationWriter returns the writer of the backup generation.
+// The generation is not listed until it is added to the manifest by PutManifest.
+func (b *bs) GenerationWriter(ctx context.Context, id string) (w io.WriteCloser, err error) {
+	return b.writer(ctx, b.generationKey(id))
+}
+
+// DeleteGeneration deletes the object of the backup generation and its integrity manifest.
+func (b *bs) DeleteGeneration(ctx context.Context, id string) error {
+	key := b.generationKey(id)
+	err := b.bucket.Delete(ctx, key)
+	if err != nil {
+		return err
+	}
+	return b.bucket.Delete(ctx, b.integrityKey(key))
+}
+
+func (b *bs) generationKey(id string) string {
+	return b.filename + "." + id + b.suffix
+}
+
+// Manifest reads the manifest of the backup generations.
+// It returns an empty manifest when the manifest has not been uploaded yet.
+func (b *bs) Manifest(ctx context.Context) (m *Manifest, err error) {
+	r, err := b.bucket.Reader(ctx, b.manifestKey())
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		e := r.Close()
+		if e != nil {
+			err = errors.Join(err, e)
+		}
+	}()
+
+	m = new(Manifest)
+	err = json.Decode(r, m)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PutManifest uploads the manifest of the backup generations.
+// The manifest is not compressed so that it can be inspected without the sidecar.
+func (b *bs) PutManifest(ctx context.Context, m *Manifest) (err error) {
+	w, err := b.bucket.Writer(ctx, b.manifestKey())
+	if err != nil {
+		return err
+	}
+	err = json.Encode(w, m)
+	return errors.Join(err, w.Close())
+}
+
+func (b *bs) manifestKey() string {
+	return b.filename + manifestSuffix
+}
+
+// ArchiveKey returns the key of the backup generation, or the key of the unversioned backup file when generationID is empty.
+func (b *bs) ArchiveKey(generationID string) string {
+	if generationID == "" {
+		return b.filename + b.suffix
+	}
+	return b.generationKey(generationID)
+}
+
+// Archives returns the keys of all backup archives and delta snapshots in the bucket which have the integrity manifest.
+// It includes the archives of the other agents sharing the bucket.
+func (b *bs) Archives(ctx context.Context) ([]string, error) {
+	keys, err := b.bucket.List(ctx, "")
 	if err != nil {
 		return nil, err
 	}
+	archives := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if strings.HasSuffix(key, integritySuffix) {
+			archives = append(archives, strings.TrimSuffix(key, integritySuffix))
+		}
+	}
+	return archives, nil
+}
+
+// ArchiveReader returns the reader of the backup archive of the key.
+func (b *bs) ArchiveReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	return b.reader(ctx, key)
+}
+
+// Integrity reads the integrity manifest of the backup archive of the key.
+// It returns nil when the archive was uploaded without the integrity manifest.
+func (b *bs) Integrity(ctx context.Context, key string) (i *Integrity, err error) {
+	r, err := b.bucket.Reader(ctx, b.integrityKey(key))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		e := r.Close()
+		if e != nil {
+			err = errors.Join(err, e)
+		}
+	}()
+
+	i = new(Integrity)
+	err = json.Decode(r, i)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return i, nil
+}
+
+// PutIntegrity uploads the integrity manifest of the backup archive of the key.
+// Like the manifest of the generations, it is neither compressed nor encrypted.
+func (b *bs) PutIntegrity(ctx context.Context, key string, i *Integrity) (err error) {
+	w, err := b.bucket.Writer(ctx, b.integrityKey(key))
+	if err != nil {
+		return err
+	}
+	err = json.Encode(w, i)
+	return errors.Join(err, w.Close())
+}
+
+func (b *bs) integrityKey(key string) string {
+	return key + integritySuffix
+}
+
+func (b *bs) reader(ctx context.Context, key string) (r io.ReadCloser, err error) {
+	r, err = b.bucket.Reader(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if b.encryptor != nil {
+		er, eerr := b.encryptor.Reader(ctx, r)
+		if eerr != nil {
+			return nil, errors.Join(eerr, r.Close())
+		}
+		r = er
+	}
 
 	if b.compressor != nil {
 		cr, cerr := b.compressor.Reader(r)
@@ -187,12 +424,21 @@ func (b *bs) Reader(ctx context.Context) (r io.ReadCloser, err error) {
 	return r, nil
 }
 
-func (b *bs) Writer(ctx context.Context) (w io.WriteCloser, err error) {
-	w, err = b.bucket.Writer(ctx, b.filename+b.suffix)
+func (b *bs) writer(ctx context.Context, key string) (w io.WriteCloser, err error) {
+	w, err = b.bucket.Writer(ctx, key)
 	if err != nil {
 		return nil, err
 	}
 
+	// The data is compressed before it is encrypted because the ciphertext is not compressible.
+	if b.encryptor != nil {
+		ew, eerr := b.encryptor.Writer(ctx, w)
+		if eerr != nil {
+			return nil, errors.Join(eerr, w.Close())
+		}
+		w = ew
+	}
+
 	if b.compressor != nil {
 		cw, cerr := b.compressor.Writer(w)
 		if cerr != nil {
@@ -212,5 +458,13 @@ func (b *bs) StorageInfo() *StorageInfo {
 		Type:       config.AtoBST(b.storageType).String(),
 		BucketName: b.bucketName,
 		Filename:   b.filename + b.suffix,
+		KeyID:      b.keyID(),
+	}
+}
+
+func (b *bs) keyID() string {
+	if b.encryptor == nil {
+		return ""
 	}
+	return b.encryptor.KeyID()
 }
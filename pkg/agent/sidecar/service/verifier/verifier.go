@@ -0,0 +1,242 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"reflect"
+	"syscall"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/os"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/pkg/agent/internal/delta"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+// Verifier verifies all backups in the bucket and stops the process when it finishes.
+type Verifier interface {
+	Start(ctx context.Context) (<-chan error, error)
+	PreStop(ctx context.Context) error
+	Verify(ctx context.Context) error
+}
+
+type verifier struct {
+	eg      errgroup.Group
+	storage storage.Storage
+}
+
+func New(opts ...Option) (Verifier, error) {
+	v := new(verifier)
+	for _, opt := range append(defaultOptions, opts...) {
+		if err := opt(v); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	if v.storage == nil {
+		return nil, errors.NewErrInvalidOption("storage", v.storage)
+	}
+	return v, nil
+}
+
+// Start verifies the backups in the background and sends SIGTERM to the process when it finishes.
+// The result is returned to the errgroup, so the process exits with an error when a corrupted backup is found.
+func (v *verifier) Start(ctx context.Context) (<-chan error, error) {
+	sech, err := v.storage.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v.eg.Go(safety.RecoverFunc(func() (err error) {
+		defer func() {
+			p, err := os.FindProcess(os.Getpid())
+			if err != nil {
+				// using Fatal to avoid this process to be zombie
+				// skipcq: RVV-A0003
+				log.Fatalf("failed to find my pid to kill %v", err)
+				return
+			}
+			log.Info("sending SIGTERM to myself to stop this job")
+			if err := p.Signal(syscall.SIGTERM); err != nil {
+				log.Error(err)
+			}
+		}()
+		return v.Verify(ctx)
+	}))
+
+	return sech, nil
+}
+
+func (v *verifier) PreStop(ctx context.Context) error {
+	return v.storage.Stop(ctx)
+}
+
+// Verify verifies every backup archive which has the integrity manifest, including the backup generations,
+// and returns an error listing the keys of the corrupted ones.
+func (v *verifier) Verify(ctx context.Context) error {
+	keys, err := v.storage.Archives(ctx)
+	if err != nil {
+		return err
+	}
+	log.Infof("started to verify %d backups", len(keys))
+
+	var corrupted []string
+	for _, key := range keys {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		err = v.verify(ctx, key)
+		if err != nil {
+			if errors.IsAny(err, context.Canceled, context.DeadlineExceeded) {
+				return err
+			}
+			log.Errorf("backup %s is corrupted: %v", key, err)
+			corrupted = append(corrupted, key)
+			continue
+		}
+		log.Infof("backup %s is verified", key)
+	}
+
+	log.Infof("finished to verify %d backups, %d corrupted", len(keys), len(corrupted))
+	if len(corrupted) > 0 {
+		return errors.ErrCorruptedBackups(corrupted...)
+	}
+	return nil
+}
+
+func (v *verifier) verify(ctx context.Context, key string) (err error) {
+	i, err := v.storage.Integrity(ctx, key)
+	if err != nil {
+		return err
+	}
+	if i == nil {
+		// the archive was deleted with its integrity manifest after it was listed.
+		return nil
+	}
+	if i.Delta {
+		return v.verifyDelta(ctx, key, i)
+	}
+
+	r, err := v.storage.ArchiveReader(ctx, key)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			// the missing archive is reported as the missing files.
+			err = i.Verify(nil)
+			if err != nil {
+				return errors.ErrBackupIntegrityMismatch(key, err)
+			}
+			return nil
+		}
+		return err
+	}
+	defer func() {
+		e := r.Close()
+		if e != nil {
+			log.Errorf("error on closing blob-storage reader: %s", e)
+		}
+	}()
+
+	var (
+		digests  []*storage.FileDigest
+		backupID string
+	)
+	tr := tar.NewReader(r)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if header.Name == storage.BackupIDFileName {
+			backupID, err = storage.ReadBackupID(tr)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		dr := storage.NewDigestReader(header.Name, tr)
+		_, err = io.Copy(io.Discard, dr)
+		if err != nil {
+			return err
+		}
+		digests = append(digests, dr.Digest())
+	}
+
+	if !i.Describes(backupID) {
+		log.Warnf("integrity manifest of backup %s describes backup id %s instead of %s, skipped verifying it", key, i.BackupID, backupID)
+		return nil
+	}
+	err = i.Verify(digests)
+	if err != nil {
+		return errors.ErrBackupIntegrityMismatch(key, err)
+	}
+	return nil
+}
+
+// verifyDelta verifies the delta snapshot of key, which is uploaded as it is instead of the archive.
+func (v *verifier) verifyDelta(ctx context.Context, key string, i *storage.Integrity) (err error) {
+	r, err := v.storage.ArchiveReader(ctx, key)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = i.Verify(nil)
+			if err != nil {
+				return errors.ErrBackupIntegrityMismatch(key, err)
+			}
+			return nil
+		}
+		return err
+	}
+	defer func() {
+		e := r.Close()
+		if e != nil {
+			log.Errorf("error on closing blob-storage reader: %s", e)
+		}
+	}()
+
+	var name string
+	if len(i.Files) != 0 {
+		name = i.Files[0].Name
+	}
+	dr := storage.NewDigestReader(name, r)
+	data, err := io.ReadAll(dr)
+	if err != nil {
+		return err
+	}
+	snap, err := delta.Decode(bytes.NewReader(data))
+	if err != nil {
+		return errors.ErrBackupIntegrityMismatch(key, err)
+	}
+	if !i.Describes(snap.ID()) {
+		log.Warnf("integrity manifest of delta snapshot %s describes id %s instead of %s, skipped verifying it", key, i.BackupID, snap.ID())
+		return nil
+	}
+	err = i.Verify([]*storage.FileDigest{dr.Digest()})
+	if err != nil {
+		return errors.ErrBackupIntegrityMismatch(key, err)
+	}
+	return nil
+}
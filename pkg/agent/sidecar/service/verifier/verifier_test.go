@@ -0,0 +1,224 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/db/storage/blob/memory"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/agent/internal/delta"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+func digest(t *testing.T, name, content string) *storage.FileDigest {
+	t.Helper()
+	dr := storage.NewDigestReader(name, strings.NewReader(content))
+	_, err := io.Copy(io.Discard, dr)
+	require.NoError(t, err)
+	return dr.Digest()
+}
+
+// putArchive uploads the archive of the data file after the entry of the backup id.
+func putArchive(t *testing.T, w io.WriteCloser, id, content string) {
+	t.Helper()
+	tw := tar.NewWriter(w)
+	require.NoError(t, storage.WriteBackupID(tw, id, time.Now()))
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "data",
+		Mode:     0o600,
+		Size:     int64(len(content)),
+		Typeflag: tar.TypeReg,
+	}))
+	_, err := tw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, w.Close())
+}
+
+func Test_verifier_Verify(t *testing.T) {
+	t.Parallel()
+	snap := &delta.Snapshot{
+		Deleted:   []string{"uuid"},
+		BaseID:    1,
+		Seq:       1,
+		Timestamp: 1,
+	}
+	name := delta.FileName(snap.Seq)
+	type test struct {
+		name string
+		// upload uploads the backups and their integrity manifests to st.
+		upload  func(t *testing.T, ctx context.Context, st storage.Storage)
+		wantErr func(st storage.Storage) error
+	}
+
+	tests := []test{
+		{
+			name: "archive matching the integrity manifest is verified",
+			upload: func(t *testing.T, ctx context.Context, st storage.Storage) {
+				w, err := st.Writer(ctx)
+				require.NoError(t, err)
+				putArchive(t, w, "1", "content")
+				require.NoError(t, st.PutIntegrity(ctx, st.ArchiveKey(""), &storage.Integrity{
+					BackupID: "1",
+					Files:    []*storage.FileDigest{digest(t, "data", "content")},
+				}))
+			},
+		},
+		{
+			name: "corrupted file of the archive is reported",
+			upload: func(t *testing.T, ctx context.Context, st storage.Storage) {
+				w, err := st.Writer(ctx)
+				require.NoError(t, err)
+				putArchive(t, w, "1", "content")
+				require.NoError(t, st.PutIntegrity(ctx, st.ArchiveKey(""), &storage.Integrity{
+					BackupID: "1",
+					Files:    []*storage.FileDigest{digest(t, "data", "original content")},
+				}))
+			},
+			wantErr: func(st storage.Storage) error {
+				return errors.ErrCorruptedBackups(st.ArchiveKey(""))
+			},
+		},
+		{
+			name: "missing archive of the integrity manifest is reported",
+			upload: func(t *testing.T, ctx context.Context, st storage.Storage) {
+				require.NoError(t, st.PutIntegrity(ctx, st.ArchiveKey("g1"), &storage.Integrity{
+					BackupID: "1",
+					Files:    []*storage.FileDigest{digest(t, "data", "content")},
+				}))
+			},
+			wantErr: func(st storage.Storage) error {
+				return errors.ErrCorruptedBackups(st.ArchiveKey("g1"))
+			},
+		},
+		{
+			name: "integrity manifest of another backup id is not verified against the archive",
+			upload: func(t *testing.T, ctx context.Context, st storage.Storage) {
+				w, err := st.Writer(ctx)
+				require.NoError(t, err)
+				putArchive(t, w, "2", "content")
+				require.NoError(t, st.PutIntegrity(ctx, st.ArchiveKey(""), &storage.Integrity{
+					BackupID: "1",
+					Files:    []*storage.FileDigest{digest(t, "data", "original content")},
+				}))
+			},
+		},
+		{
+			name: "delta snapshot matching the integrity manifest is verified",
+			upload: func(t *testing.T, ctx context.Context, st storage.Storage) {
+				buf := new(bytes.Buffer)
+				require.NoError(t, delta.Encode(buf, snap))
+				w, err := st.DeltaWriter(ctx, name)
+				require.NoError(t, err)
+				_, err = w.Write(buf.Bytes())
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+				require.NoError(t, st.PutIntegrity(ctx, st.DeltaKey(name), &storage.Integrity{
+					BackupID: snap.ID(),
+					Files:    []*storage.FileDigest{digest(t, name, buf.String())},
+					Delta:    true,
+				}))
+			},
+		},
+		{
+			name: "corrupted delta snapshot is reported",
+			upload: func(t *testing.T, ctx context.Context, st storage.Storage) {
+				w, err := st.DeltaWriter(ctx, name)
+				require.NoError(t, err)
+				require.NoError(t, delta.Encode(w, snap))
+				require.NoError(t, w.Close())
+				require.NoError(t, st.PutIntegrity(ctx, st.DeltaKey(name), &storage.Integrity{
+					BackupID: snap.ID(),
+					Files:    []*storage.FileDigest{digest(t, name, "other")},
+					Delta:    true,
+				}))
+			},
+			wantErr: func(st storage.Storage) error {
+				return errors.ErrCorruptedBackups(st.DeltaKey(name))
+			},
+		},
+		{
+			name: "undecodable delta snapshot is reported",
+			upload: func(t *testing.T, ctx context.Context, st storage.Storage) {
+				w, err := st.DeltaWriter(ctx, name)
+				require.NoError(t, err)
+				_, err = w.Write([]byte("broken"))
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+				require.NoError(t, st.PutIntegrity(ctx, st.DeltaKey(name), &storage.Integrity{
+					BackupID: snap.ID(),
+					Files:    []*storage.FileDigest{digest(t, name, "broken")},
+					Delta:    true,
+				}))
+			},
+			wantErr: func(st storage.Storage) error {
+				return errors.ErrCorruptedBackups(st.DeltaKey(name))
+			},
+		},
+		{
+			name: "archive without the integrity manifest is not listed",
+			upload: func(t *testing.T, ctx context.Context, st storage.Storage) {
+				w, err := st.Writer(ctx)
+				require.NoError(t, err)
+				putArchive(t, w, "1", "content")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			eg, ctx := errgroup.New(ctx)
+
+			st, err := storage.New(
+				storage.WithErrGroup(eg),
+				storage.WithBucket(memory.New()),
+				storage.WithFilename("vald-agent"),
+			)
+			require.NoError(tt, err)
+			_, err = st.Start(ctx)
+			require.NoError(tt, err)
+			test.upload(tt, ctx, st)
+
+			v, err := New(
+				WithErrGroup(eg),
+				WithBlobStorage(st),
+			)
+			require.NoError(tt, err)
+			err = v.Verify(ctx)
+			require.NoError(tt, v.PreStop(ctx))
+			require.NoError(tt, eg.Wait())
+			if test.wantErr != nil {
+				require.EqualError(tt, err, test.wantErr(st).Error())
+				return
+			}
+			require.NoError(tt, err)
+		})
+	}
+}
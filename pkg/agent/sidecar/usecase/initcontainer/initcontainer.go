@@ -134,8 +134,15 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 			cloudstorage.WithWriteContentLanguage(cfg.AgentSidecar.BlobStorage.CloudStorage.WriteContentLanguage),
 			cloudstorage.WithWriteContentType(cfg.AgentSidecar.BlobStorage.CloudStorage.WriteContentType),
 		),
+		storage.WithFileDir(cfg.AgentSidecar.BlobStorage.File.Path),
 		storage.WithCompressAlgorithm(cfg.AgentSidecar.Compress.CompressAlgorithm),
 		storage.WithCompressionLevel(cfg.AgentSidecar.Compress.CompressionLevel),
+		storage.WithEncryption(cfg.AgentSidecar.Encryption.Enabled),
+		storage.WithEncryptionProvider(cfg.AgentSidecar.Encryption.Provider),
+		storage.WithEncryptionKeyfile(cfg.AgentSidecar.Encryption.Keyfile),
+		storage.WithEncryptionKeyID(cfg.AgentSidecar.Encryption.KeyID),
+		storage.WithEncryptionPluginCommand(cfg.AgentSidecar.Encryption.PluginCommand...),
+		storage.WithAllowUnencryptedRestore(cfg.AgentSidecar.Encryption.AllowUnencryptedRestore),
 	)
 	if err != nil {
 		return nil, err
@@ -146,6 +153,9 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 		restorer.WithDir(cfg.AgentSidecar.WatchDir),
 		restorer.WithBlobStorage(bs),
 		restorer.WithBackoff(cfg.AgentSidecar.RestoreBackoffEnabled),
+		restorer.WithDeltaDownload(cfg.AgentSidecar.DeltaBackupEnabled),
+		restorer.WithVersioning(cfg.AgentSidecar.Versioning.Enabled),
+		restorer.WithGeneration(cfg.AgentSidecar.Versioning.RestoreGeneration),
 		restorer.WithBackoffOpts(cfg.AgentSidecar.RestoreBackoff.Opts()...),
 	)
 	if err != nil {
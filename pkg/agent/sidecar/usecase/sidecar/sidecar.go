@@ -134,8 +134,15 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 			cloudstorage.WithWriteContentLanguage(cfg.AgentSidecar.BlobStorage.CloudStorage.WriteContentLanguage),
 			cloudstorage.WithWriteContentType(cfg.AgentSidecar.BlobStorage.CloudStorage.WriteContentType),
 		),
+		storage.WithFileDir(cfg.AgentSidecar.BlobStorage.File.Path),
 		storage.WithCompressAlgorithm(cfg.AgentSidecar.Compress.CompressAlgorithm),
 		storage.WithCompressionLevel(cfg.AgentSidecar.Compress.CompressionLevel),
+		storage.WithEncryption(cfg.AgentSidecar.Encryption.Enabled),
+		storage.WithEncryptionProvider(cfg.AgentSidecar.Encryption.Provider),
+		storage.WithEncryptionKeyfile(cfg.AgentSidecar.Encryption.Keyfile),
+		storage.WithEncryptionKeyID(cfg.AgentSidecar.Encryption.KeyID),
+		storage.WithEncryptionPluginCommand(cfg.AgentSidecar.Encryption.PluginCommand...),
+		storage.WithAllowUnencryptedRestore(cfg.AgentSidecar.Encryption.AllowUnencryptedRestore),
 	)
 	if err != nil {
 		return nil, err
@@ -145,6 +152,10 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 		observer.WithErrGroup(eg),
 		observer.WithWatch(cfg.AgentSidecar.WatchEnabled),
 		observer.WithTicker(cfg.AgentSidecar.AutoBackupEnabled),
+		observer.WithDeltaUpload(cfg.AgentSidecar.DeltaBackupEnabled),
+		observer.WithVersioning(cfg.AgentSidecar.Versioning.Enabled),
+		observer.WithMaxGenerations(cfg.AgentSidecar.Versioning.MaxGenerations),
+		observer.WithMaxGenerationAge(cfg.AgentSidecar.Versioning.MaxAge),
 		observer.WithBackupDuration(cfg.AgentSidecar.AutoBackupDuration),
 		observer.WithPostStopTimeout(cfg.AgentSidecar.PostStopTimeout),
 		observer.WithDir(cfg.AgentSidecar.WatchDir),
@@ -19,12 +19,15 @@ import (
 	"github.com/vdaas/vald/pkg/agent/sidecar/config"
 	"github.com/vdaas/vald/pkg/agent/sidecar/usecase/initcontainer"
 	"github.com/vdaas/vald/pkg/agent/sidecar/usecase/sidecar"
+	"github.com/vdaas/vald/pkg/agent/sidecar/usecase/verify"
 )
 
 func New(cfg *config.Data) (r runner.Interface, err error) {
 	switch config.SidecarMode(cfg.AgentSidecar.Mode) {
 	case config.INITCONTAINER:
 		return initcontainer.New(cfg)
+	case config.VERIFY:
+		return verify.New(cfg)
 	case config.SIDECAR:
 	default:
 	}
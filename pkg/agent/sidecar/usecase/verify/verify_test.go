@@ -0,0 +1,164 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verify
+
+import (
+	"archive/tar"
+	"context"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	iconf "github.com/vdaas/vald/internal/config"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/pkg/agent/internal/delta"
+	"github.com/vdaas/vald/pkg/agent/sidecar/config"
+	"github.com/vdaas/vald/pkg/agent/sidecar/service/storage"
+)
+
+func digest(t *testing.T, name, content string) *storage.FileDigest {
+	t.Helper()
+	dr := storage.NewDigestReader(name, strings.NewReader(content))
+	_, err := io.Copy(io.Discard, dr)
+	require.NoError(t, err)
+	return dr.Digest()
+}
+
+// putArchive uploads the archive of the data file after the entry of the backup id.
+func putArchive(t *testing.T, w io.WriteCloser, id, content string) {
+	t.Helper()
+	tw := tar.NewWriter(w)
+	require.NoError(t, storage.WriteBackupID(tw, id, time.Now()))
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "data",
+		Mode:     0o600,
+		Size:     int64(len(content)),
+		Typeflag: tar.TypeReg,
+	}))
+	_, err := tw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, w.Close())
+}
+
+// Test_run_verify runs the verify mode against the file bucket holding a clean archive, a corrupted generation, the
+// integrity manifest of a missing generation, the integrity manifest of another backup id, and a corrupted delta
+// snapshot. The verify mode uses the global errgroup and stops itself by SIGTERM, so the cases share a single run.
+func Test_run_verify(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dir := t.TempDir()
+	cfg := &config.Data{
+		Server:        new(iconf.Servers),
+		Observability: new(iconf.Observability).Bind(),
+		AgentSidecar: (&iconf.AgentSidecar{
+			Mode:           "verify",
+			Filename:       "vald-agent",
+			FilenameSuffix: ".tar.gz",
+			BlobStorage: &iconf.Blob{
+				StorageType: "file",
+				File: &iconf.FileConfig{
+					Path: dir,
+				},
+			},
+			Compress: &iconf.CompressCore{
+				CompressAlgorithm: "gzip",
+				CompressionLevel:  -1,
+			},
+			Client: new(iconf.Client),
+		}).Bind(),
+	}
+
+	eg, ectx := errgroup.New(ctx)
+	st, err := storage.New(
+		storage.WithErrGroup(eg),
+		storage.WithType("file"),
+		storage.WithFileDir(dir),
+		storage.WithFilename("vald-agent"),
+	)
+	require.NoError(t, err)
+	_, err = st.Start(ectx)
+	require.NoError(t, err)
+
+	w, err := st.Writer(ectx)
+	require.NoError(t, err)
+	putArchive(t, w, "0", "content")
+	require.NoError(t, st.PutIntegrity(ectx, st.ArchiveKey(""), &storage.Integrity{
+		BackupID: "0",
+		Files:    []*storage.FileDigest{digest(t, "data", "content")},
+	}))
+
+	w, err = st.GenerationWriter(ectx, "1")
+	require.NoError(t, err)
+	putArchive(t, w, "1", "content")
+	require.NoError(t, st.PutIntegrity(ectx, st.ArchiveKey("1"), &storage.Integrity{
+		BackupID: "1",
+		Files:    []*storage.FileDigest{digest(t, "data", "original content")},
+	}))
+
+	require.NoError(t, st.PutIntegrity(ectx, st.ArchiveKey("2"), &storage.Integrity{
+		BackupID: "2",
+		Files:    []*storage.FileDigest{digest(t, "data", "content")},
+	}))
+
+	w, err = st.GenerationWriter(ectx, "3")
+	require.NoError(t, err)
+	putArchive(t, w, "4", "content")
+	require.NoError(t, st.PutIntegrity(ectx, st.ArchiveKey("3"), &storage.Integrity{
+		BackupID: "3",
+		Files:    []*storage.FileDigest{digest(t, "data", "original content")},
+	}))
+
+	snap := &delta.Snapshot{
+		Deleted:   []string{"uuid"},
+		BaseID:    1,
+		Seq:       1,
+		Timestamp: 1,
+	}
+	name := delta.FileName(snap.Seq)
+	w, err = st.DeltaWriter(ectx, name)
+	require.NoError(t, err)
+	require.NoError(t, delta.Encode(w, snap))
+	require.NoError(t, w.Close())
+	require.NoError(t, st.PutIntegrity(ectx, st.DeltaKey(name), &storage.Integrity{
+		BackupID: snap.ID(),
+		Files:    []*storage.FileDigest{digest(t, name, "other")},
+		Delta:    true,
+	}))
+	require.NoError(t, st.Stop(ectx))
+	require.NoError(t, eg.Wait())
+
+	r, err := New(cfg)
+	require.NoError(t, err)
+
+	// the verifier sends SIGTERM to the process after the verification, which stops the run like the runner does.
+	sctx, stop := signal.NotifyContext(ctx, syscall.SIGTERM)
+	defer stop()
+	require.NoError(t, r.PreStart(sctx))
+	_, err = r.Start(sctx)
+	require.NoError(t, err)
+	<-sctx.Done()
+	require.NoError(t, r.PreStop(ctx))
+	require.NoError(t, r.Stop(ctx))
+
+	want := errors.ErrCorruptedBackups(st.DeltaKey(name), st.ArchiveKey("1"), st.ArchiveKey("2"))
+	require.EqualError(t, errgroup.Wait(), want.Error())
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/algorithm/fusion"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/info"
 	"github.com/vdaas/vald/internal/log"
@@ -36,7 +37,6 @@ import (
 	"github.com/vdaas/vald/internal/observability/trace"
 	"github.com/vdaas/vald/internal/sync"
 	"github.com/vdaas/vald/internal/sync/errgroup"
-	"github.com/vdaas/vald/pkg/gateway/lb/service"
 )
 
 type Aggregator interface {
@@ -54,11 +54,31 @@ type DistPayload struct {
 
 func (s *server) aggregationSearch(
 	ctx context.Context,
+	vec []float32, // Query Vector to route the search to the partitions, or nil to search all agents
 	aggr Aggregator,
 	bcfg *payload.Search_Config, // Base Config of Request
 	f func(ctx context.Context,
 		fcfg *payload.Search_Config, // Forwarding Config to Agent
 		vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error),
+) (res *payload.Search_Response, attrs []attribute.KeyValue, err error) {
+	return s.targetAggregationSearch(ctx, vec, aggr, bcfg, func(ctx context.Context, _ string,
+		fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption,
+	) (*payload.Search_Response, error) {
+		return f(ctx, fcfg, vc, copts...)
+	})
+}
+
+// targetAggregationSearch is the same as aggregationSearch except that f receives the address of the agent,
+// so the caller can tell the results of each agent.
+func (s *server) targetAggregationSearch(
+	ctx context.Context,
+	vec []float32, // Query Vector to route the search to the partitions, or nil to search all agents
+	aggr Aggregator,
+	bcfg *payload.Search_Config, // Base Config of Request
+	f func(ctx context.Context,
+		target string,
+		fcfg *payload.Search_Config, // Forwarding Config to Agent
+		vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error),
 ) (res *payload.Search_Response, attrs []attribute.KeyValue, err error) {
 	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "aggregationSearch"), apiName+"/aggregationSearch")
 	defer trace.End(span)
@@ -81,15 +101,32 @@ func (s *server) aggregationSearch(
 	fcfg.Num = uint32(aggr.GetFnum())
 	fcfg.MinNum = 0
 
+	// found counts the distinct results to tell the gateway whether min_num is reached before the slow agents answer.
+	// partial records whether any agent returned the partial results of the filtered search.
+	var (
+		found   atomic.Int64
+		visited sync.Map[string, any]
+		done    func() bool
+		partial atomic.Bool
+	)
+	if minNum > 0 {
+		done = func() bool {
+			return found.Load() >= int64(minNum)
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	aggr.Start(ctx)
-	err = s.gateway.BroadCast(ctx, service.READ, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
+	skipped, err := s.gateway.BroadCastSearch(ctx, partitionVector(bcfg.GetCollection(), vec), int(bcfg.GetNprobe()), done, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
 		sctx, sspan := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "BroadCast/"+target), apiName+"/aggregationSearch/"+target)
 		defer trace.End(sspan)
-		r, err := f(sctx, fcfg, vc, copts...)
+		r, err := f(sctx, target, fcfg, vc, copts...)
 		if err != nil {
 			return handleSearchBroadCastError(sspan, target, err)
 		}
+		if r.GetPartial() {
+			partial.Store(true)
+		}
 		if r == nil || len(r.GetResults()) == 0 {
 			select {
 			case <-sctx.Done():
@@ -102,10 +139,13 @@ func (s *server) aggregationSearch(
 				errhandler.RecordSpanError(sspan, codes.NotFound, err)
 				return nil
 			default:
-				r, err = f(sctx, fcfg, vc, copts...)
+				r, err = f(sctx, target, fcfg, vc, copts...)
 				if err != nil {
 					return handleSearchBroadCastError(sspan, target, err)
 				}
+				if r.GetPartial() {
+					partial.Store(true)
+				}
 				if r == nil || len(r.GetResults()) == 0 {
 					err = status.WrapWithNotFound(fmt.Sprintf("failed to process search request from %s", target),
 						errors.ErrEmptySearchResult,
@@ -118,6 +158,13 @@ func (s *server) aggregationSearch(
 				}
 			}
 		}
+		if done != nil {
+			for _, d := range r.GetResults() {
+				if _, loaded := visited.LoadOrStore(d.GetId(), struct{}{}); !loaded {
+					found.Add(1)
+				}
+			}
+		}
 		aggr.Send(sctx, r)
 		return nil
 	})
@@ -237,6 +284,8 @@ func (s *server) aggregationSearch(
 		return nil, attrs, err
 	}
 	res.RequestId = bcfg.GetRequestId()
+	res.SkippedAgents = skipped
+	res.Partial = partial.Load()
 	return res, attrs, nil
 }
 
@@ -575,7 +624,7 @@ func (v *valdSliceAggr) Send(ctx context.Context, data *payload.Search_Response)
 }
 
 func (v *valdSliceAggr) Result() (res *payload.Search_Response) {
-	removeDuplicates(v.result, func(l, r *DistPayload) int {
+	v.result = removeDuplicates(v.result, func(l, r *DistPayload) int {
 		return l.distance.Cmp(r.distance)
 	})
 
@@ -657,7 +706,7 @@ func (v *valdPoolSliceAggr) Send(ctx context.Context, data *payload.Search_Respo
 }
 
 func (v *valdPoolSliceAggr) Result() (res *payload.Search_Response) {
-	removeDuplicates(v.result, func(l, r *DistPayload) int {
+	v.result = removeDuplicates(v.result, func(l, r *DistPayload) int {
 		return l.distance.Cmp(r.distance)
 	})
 
@@ -689,10 +738,79 @@ func (v *valdPoolSliceAggr) GetFnum() int {
 	return 0
 }
 
-func removeDuplicates[S ~[]E, E comparable](x S, less func(left, right E) int) S {
+// hybrid search fusion.
+type valdFusionAggr struct {
+	cfg    *payload.Search_Fusion
+	result []*payload.Object_Distance
+	num    int
+	fnum   int
+	mu     sync.Mutex
+}
+
+// newFusion returns the aggregator which collects the dense and sparse scores of the agents' hybrid search results
+// and fuses them over all agents by the fusion configuration, since the fused scores of each agent are not comparable.
+func newFusion(cfg *payload.Search_Fusion, num, fnum, replica int) Aggregator {
+	return &valdFusionAggr{
+		cfg:    cfg,
+		num:    num,
+		fnum:   fnum,
+		result: make([]*payload.Object_Distance, 0, fnum*replica),
+	}
+}
+
+func (*valdFusionAggr) Start(_ context.Context) {}
+
+func (v *valdFusionAggr) Send(ctx context.Context, data *payload.Search_Response) {
+	result := data.GetResults()
+	if len(result) > v.fnum {
+		result = result[:v.fnum]
+	}
+	select {
+	case <-ctx.Done():
+		return
+	default:
+		v.mu.Lock()
+		v.result = append(v.result, result...)
+		v.mu.Unlock()
+	}
+}
+
+func (v *valdFusionAggr) Result() *payload.Search_Response {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return &payload.Search_Response{
+		Results: fusion.Fuse(v.cfg, v.result, v.num),
+	}
+}
+
+func (v *valdFusionAggr) GetNum() int {
+	if v != nil {
+		return v.num
+	}
+	return 0
+}
+
+func (v *valdFusionAggr) GetFnum() int {
+	if v != nil {
+		return v.fnum
+	}
+	return 0
+}
+
+// removeDuplicates sorts x by less and removes the results of the same id except for the first one,
+// since the same vector or multi-vector document is returned from each replica.
+func removeDuplicates(x []*DistPayload, less func(left, right *DistPayload) int) []*DistPayload {
 	if len(x) < 2 {
 		return x
 	}
 	slices.SortStableFunc(x, less)
-	return slices.Compact(x)
+	visited := make(map[string]struct{}, len(x))
+	return slices.DeleteFunc(x, func(dp *DistPayload) bool {
+		id := dp.raw.GetId()
+		if _, ok := visited[id]; ok {
+			return true
+		}
+		visited[id] = struct{}{}
+		return false
+	})
 }
@@ -0,0 +1,267 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/errdetails"
+	"github.com/vdaas/vald/internal/net/grpc/errhandler"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/pkg/gateway/lb/service"
+)
+
+// CreateCollection creates the named collection on all agents.
+// The agents which already have the collection are skipped, so that the request can be retried after the partial failure.
+func (s *server) CreateCollection(
+	ctx context.Context, req *payload.Collection_CreateRequest,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.CollectionRPCServiceName+"/"+vald.CreateCollectionRPCName), apiName+"/"+vald.CreateCollectionRPCName)
+	defer trace.End(span)
+	if err = s.checkCollection(vald.CreateCollectionRPCName, req.GetConfig().GetName(), req); err != nil {
+		return errhandler.HandleError[payload.Empty](span, codes.InvalidArgument, err)
+	}
+	name := req.GetConfig().GetName()
+	err = s.broadCastCollection(ctx, vald.CreateCollectionRPCName, name, req, codes.AlreadyExists,
+		func(ctx context.Context, vc vald.Client, copts ...grpc.CallOption) error {
+			_, err := vc.CreateCollection(ctx, req, copts...)
+			return err
+		})
+	if err != nil {
+		return nil, s.handleCollectionMethodError(ctx, span, vald.CreateCollectionRPCName, name, req, err)
+	}
+	return new(payload.Empty), nil
+}
+
+// DropCollection drops the named collection on all agents.
+// The agents which do not have the collection are skipped, so that the request can be retried after the partial failure.
+func (s *server) DropCollection(
+	ctx context.Context, req *payload.Collection_DropRequest,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.CollectionRPCServiceName+"/"+vald.DropCollectionRPCName), apiName+"/"+vald.DropCollectionRPCName)
+	defer trace.End(span)
+	if err = s.checkCollection(vald.DropCollectionRPCName, req.GetName(), req); err != nil {
+		return errhandler.HandleError[payload.Empty](span, codes.InvalidArgument, err)
+	}
+	err = s.broadCastCollection(ctx, vald.DropCollectionRPCName, req.GetName(), req, codes.NotFound,
+		func(ctx context.Context, vc vald.Client, copts ...grpc.CallOption) error {
+			_, err := vc.DropCollection(ctx, req, copts...)
+			return err
+		})
+	if err != nil {
+		return nil, s.handleCollectionMethodError(ctx, span, vald.DropCollectionRPCName, req.GetName(), req, err)
+	}
+	return new(payload.Empty), nil
+}
+
+// ListCollections returns the configurations of the collections of all agents.
+func (s *server) ListCollections(
+	ctx context.Context, req *payload.Empty,
+) (res *payload.Collection_List, err error) {
+	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.CollectionRPCServiceName+"/"+vald.ListCollectionsRPCName), apiName+"/"+vald.ListCollectionsRPCName)
+	defer trace.End(span)
+	var (
+		mu   sync.Mutex
+		cfgs = make(map[string]*payload.Collection_Config)
+		sws  = make(map[string]*payload.Collection_Switch)
+	)
+	err = s.gateway.BroadCast(ctx, service.READ, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
+		sctx, sspan := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "BroadCast/"+target), apiName+"/"+vald.ListCollectionsRPCName+"/"+target)
+		defer trace.End(sspan)
+		list, err := vc.ListCollections(sctx, req, copts...)
+		if err != nil {
+			return s.handleIndexBroadCastError(sspan, target, vald.ListCollectionsRPCName, err)
+		}
+		mu.Lock()
+		for _, cfg := range list.GetCollections() {
+			if service.IsReservedCollection(cfg.GetName()) {
+				continue
+			}
+			cfgs[cfg.GetName()] = cfg
+		}
+		for _, sw := range list.GetSwitches() {
+			sws[sw.GetName()] = sw
+		}
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, s.handleCollectionMethodError(ctx, span, vald.ListCollectionsRPCName, "", req, err)
+	}
+	res = &payload.Collection_List{
+		Collections: make([]*payload.Collection_Config, 0, len(cfgs)),
+		Switches:    make([]*payload.Collection_Switch, 0, len(sws)),
+	}
+	for _, cfg := range cfgs {
+		res.Collections = append(res.GetCollections(), cfg)
+	}
+	slices.SortFunc(res.Collections, func(a, b *payload.Collection_Config) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	for _, sw := range sws {
+		res.Switches = append(res.GetSwitches(), sw)
+	}
+	slices.SortFunc(res.Switches, func(a, b *payload.Collection_Switch) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	return res, nil
+}
+
+// SwitchCollection switches the requests of the named collection to the target collection, or fences the write requests to the named collection, on all agents.
+// Each agent switches at once, and the request can be retried after the partial failure because the switch is idempotent.
+func (s *server) SwitchCollection(
+	ctx context.Context, req *payload.Collection_Switch,
+) (res *payload.Empty, err error) {
+	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.CollectionRPCServiceName+"/"+vald.SwitchCollectionRPCName), apiName+"/"+vald.SwitchCollectionRPCName)
+	defer trace.End(span)
+	for _, name := range []string{req.GetName(), req.GetTarget()} {
+		if err = s.checkCollection(vald.SwitchCollectionRPCName, name, req); err != nil {
+			return errhandler.HandleError[payload.Empty](span, codes.InvalidArgument, err)
+		}
+	}
+	err = s.broadCastCollection(ctx, vald.SwitchCollectionRPCName, req.GetName(), req, codes.OK,
+		func(ctx context.Context, vc vald.Client, copts ...grpc.CallOption) error {
+			_, err := vc.SwitchCollection(ctx, req, copts...)
+			return err
+		})
+	if err != nil {
+		return nil, s.handleCollectionMethodError(ctx, span, vald.SwitchCollectionRPCName, req.GetName(), req, err)
+	}
+	return new(payload.Empty), nil
+}
+
+// checkCollection returns the InvalidArgument error when the collection is reserved for the LB gateways, which store
+// the partition tables and the rebalance lease in it, so that the users cannot read or write it.
+func (s *server) checkCollection(rpcName, name string, req any) error {
+	if !service.IsReservedCollection(name) {
+		return nil
+	}
+	err := errors.ErrReservedCollection(name)
+	err = status.WrapWithInvalidArgument(rpcName+" API reserved collection", err,
+		&errdetails.RequestInfo{
+			RequestId:   name,
+			ServingData: errdetails.Serialize(req),
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequestFieldViolation{
+				{
+					Field:       "collection",
+					Description: err.Error(),
+				},
+			},
+		})
+	log.Warn(err)
+	return err
+}
+
+// broadCastCollection calls f on all agents. The error of the agent with the
+// skip code is ignored unless all agents return it, so that the collection
+// operation is idempotent across the agents. No error is skipped when the skip
+// code is codes.OK.
+func (s *server) broadCastCollection(
+	ctx context.Context, rpcName, name string, req any, skip codes.Code,
+	f func(ctx context.Context, vc vald.Client, copts ...grpc.CallOption) error,
+) (err error) {
+	defer s.invalidateCache()
+	var (
+		skipped   atomic.Uint32
+		succeeded atomic.Uint32
+		mu        sync.Mutex
+		skipErr   error
+	)
+	err = s.gateway.BroadCast(ctx, service.WRITE, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
+		sctx, sspan := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "BroadCast/"+target), apiName+"/"+rpcName+"/"+target)
+		defer trace.End(sspan)
+		err := f(sctx, vc, copts...)
+		if err == nil {
+			succeeded.Add(1)
+			return nil
+		}
+		st, msg, err := status.ParseError(err, codes.Internal,
+			"failed to parse "+rpcName+" gRPC error response",
+			&errdetails.RequestInfo{
+				RequestId:   name,
+				ServingData: errdetails.Serialize(req),
+			},
+			&errdetails.ResourceInfo{
+				ResourceType: errdetails.ValdGRPCResourceTypePrefix + "/vald.v1." + rpcName + ".BroadCast/" + target,
+				ResourceName: fmt.Sprintf("%s: %s(%s) to %s", apiName, s.name, s.ip, target),
+			})
+		if st != nil && st.Code() == skip {
+			skipped.Add(1)
+			mu.Lock()
+			skipErr = err
+			mu.Unlock()
+			return nil
+		}
+		if st != nil {
+			errhandler.RecordSpanAttrs(sspan, trace.FromGRPCStatus(st.Code(), msg), err)
+		}
+		log.Error(err)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if succeeded.Load() == 0 && skipped.Load() != 0 {
+		return skipErr
+	}
+	return nil
+}
+
+func (s *server) handleCollectionMethodError(
+	ctx context.Context, span trace.Span, rpcName, name string, req any, err error,
+) error {
+	reqInfo := &errdetails.RequestInfo{
+		RequestId:   name,
+		ServingData: errdetails.Serialize(req),
+	}
+	resInfo := &errdetails.ResourceInfo{
+		ResourceType: errdetails.ValdGRPCResourceTypePrefix + "/vald.v1." + rpcName,
+		ResourceName: fmt.Sprintf("%s: %s(%s) to %v", apiName, s.name, s.ip, s.gateway.Addrs(ctx)),
+	}
+	var attrs trace.Attributes
+	switch {
+	case errors.Is(err, errors.ErrGRPCClientConnNotFound("*")):
+		err = status.WrapWithInternal(rpcName+" API connection not found", err, reqInfo, resInfo)
+		attrs = trace.StatusCodeInternal(err.Error())
+	case errors.Is(err, context.Canceled):
+		err = status.WrapWithCanceled(rpcName+" API canceled", err, reqInfo, resInfo)
+		attrs = trace.StatusCodeCancelled(err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		err = status.WrapWithDeadlineExceeded(rpcName+" API deadline exceeded", err, reqInfo, resInfo)
+		attrs = trace.StatusCodeDeadlineExceeded(err.Error())
+	default:
+		st, _ := status.FromError(err)
+		if st != nil {
+			attrs = trace.FromGRPCStatus(st.Code(), st.Message())
+		}
+	}
+	log.Debug(err)
+	errhandler.RecordSpanAttrs(span, attrs, err)
+	return err
+}
@@ -39,6 +39,7 @@ func (s *server) Flush(
 ) (cnts *payload.Info_Index_Count, err error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.FlushRPCServiceName+"/"+vald.FlushRPCName), apiName+"/"+vald.FlushRPCName)
 	defer trace.End(span)
+	defer s.invalidateCache()
 
 	var (
 		stored      uint32
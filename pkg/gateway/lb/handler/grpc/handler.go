@@ -17,7 +17,12 @@ package grpc
 import (
 	"time"
 
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/algorithm/distance"
+	"github.com/vdaas/vald/internal/conv"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
 	"github.com/vdaas/vald/internal/sync/errgroup"
 	"github.com/vdaas/vald/pkg/gateway/lb/service"
 )
@@ -32,6 +37,12 @@ type server struct {
 	replica           int
 	streamConcurrency int
 	multiConcurrency  int
+	// cache is the cache of the search responses, which is disabled when it is nil.
+	cache service.ResultCache
+	// rerank enables the re-ranking of the search results requested by the search config.
+	rerank         bool
+	rerankDistance distance.Type
+	rerankRatio    float64
 }
 
 const apiName = "vald/gateway/lb"
@@ -44,3 +55,69 @@ func New(opts ...Option) vald.Server {
 	}
 	return s
 }
+
+// vectorDimension returns the dimension of the vector.
+// For the multi-vector document or query, it returns the smallest dimension of the sub-vectors.
+func vectorDimension(vec []float32, subs []*payload.Object_SubVector) int {
+	if len(subs) == 0 {
+		return len(vec)
+	}
+	dim := len(subs[0].GetVector())
+	for _, sv := range subs[1:] {
+		dim = min(dim, len(sv.GetVector()))
+	}
+	return dim
+}
+
+// isSameVector returns true when both the vectors and the sub-vectors of the multi-vector documents are the same.
+func isSameVector(x, y *payload.Object_Vector) bool {
+	if conv.F32stos(x.GetVector()) != conv.F32stos(y.GetVector()) ||
+		len(x.GetSubVectors()) != len(y.GetSubVectors()) {
+		return false
+	}
+	for i, sv := range x.GetSubVectors() {
+		if conv.F32stos(sv.GetVector()) != conv.F32stos(y.GetSubVectors()[i].GetVector()) {
+			return false
+		}
+	}
+	return true
+}
+
+// isClientError reports whether err is the rejection of the request itself, e.g., the invalid re-ranking argument,
+// which the fallback to the agents must not swallow.
+func isClientError(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st == nil {
+		return false
+	}
+	switch st.Code() {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return true
+	}
+	return false
+}
+
+// invalidateCache invalidates the cached search responses, which is called after the vectors are changed.
+func (s *server) invalidateCache() {
+	if s.cache != nil {
+		s.cache.Invalidate()
+	}
+}
+
+// cachedResult returns the cached response of key with the request ID of the current request, since the cached
+// response has the request ID of the request which is cached.
+func (s *server) cachedResult(key, requestID string) (*payload.Search_Response, bool) {
+	res, ok := s.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	res.RequestId = requestID
+	return res, true
+}
+
+// cacheResult caches res for key unless some agents are skipped, since res may lack the results of them.
+func (s *server) cacheResult(key string, res *payload.Search_Response) {
+	if s.cache != nil && len(key) != 0 && len(res.GetSkippedAgents()) == 0 {
+		s.cache.Set(key, res)
+	}
+}
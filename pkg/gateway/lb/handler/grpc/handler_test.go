@@ -13,6 +13,135 @@
 // limitations under the License.
 package grpc
 
+import (
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/test/goleak"
+	"github.com/vdaas/vald/pkg/gateway/lb/service"
+)
+
+func Test_server_cachedResult(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	c, err := service.NewResultCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &server{
+		cache: c,
+	}
+	key := c.Key("Search", []byte("q"), &payload.Search_Config{
+		RequestId: "1",
+	})
+	s.cacheResult(key, &payload.Search_Response{
+		RequestId: "1",
+		Results: []*payload.Object_Distance{
+			{
+				Id:       "a",
+				Distance: 0.1,
+			},
+		},
+	})
+	res, ok := s.cachedResult(key, "2")
+	if !ok {
+		t.Fatal("got no cached response, want the cached response")
+	}
+	if res.GetRequestId() != "2" || len(res.GetResults()) != 1 {
+		t.Errorf("got = %v, want the cached response with the request id 2", res)
+	}
+	if _, ok := s.cachedResult(c.Key("Search", []byte("p"), nil), "3"); ok {
+		t.Error("got the cached response, want no cached response for another query")
+	}
+}
+
+func Test_server_searchCacheKey(t *testing.T) {
+	defer goleak.VerifyNone(t, goleak.IgnoreCurrent())
+	c, err := service.NewResultCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &server{
+		cache: c,
+	}
+	tests := []struct {
+		name    string
+		cfg     *payload.Search_Config
+		wantKey bool
+	}{
+		{
+			name: "return the key of the eventual search",
+			cfg: &payload.Search_Config{
+				Num: 10,
+			},
+			wantKey: true,
+		},
+		{
+			name: "return empty key for the read-your-writes search",
+			cfg: &payload.Search_Config{
+				Num:         10,
+				Consistency: payload.Search_ReadYourWrites,
+			},
+		},
+		{
+			name: "return empty key for the paginated search",
+			cfg: &payload.Search_Config{
+				Num:        10,
+				WithCursor: true,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			key := s.searchCacheKey(&payload.Search_Request{
+				Vector: []float32{1, 2},
+				Config: test.cfg,
+			})
+			if got := len(key) != 0; got != test.wantKey {
+				tt.Errorf("got key = %q, want key: %v", key, test.wantKey)
+			}
+		})
+	}
+}
+
+func Test_isClientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "return true for the invalid argument",
+			err:  status.WrapWithInvalidArgument("invalid rerank argument", errors.New("lambda")),
+			want: true,
+		},
+		{
+			name: "return true for the failed precondition",
+			err:  status.WrapWithFailedPrecondition("re-ranking is not configured", errors.New("rerank")),
+			want: true,
+		},
+		{
+			name: "return false for the unavailable agents",
+			err:  status.WrapWithUnavailable("agents unavailable", errors.New("unavailable")),
+			want: false,
+		},
+		{
+			name: "return false for the not found",
+			err:  status.WrapWithNotFound("not found", errors.New("not found")),
+			want: false,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			if got := isClientError(test.err); got != test.want {
+				tt.Errorf("isClientError() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
 // NOT IMPLEMENTED BELOW
 //
 // func TestNew(t *testing.T) {
@@ -125,8 +125,11 @@ func (s *server) IndexInfo(
 	defer trace.End(span)
 	ech := make(chan error, 1)
 	var (
-		stored, uncommitted atomic.Uint32
-		indexing, saving    atomic.Bool
+		stored, uncommitted                 atomic.Uint32
+		indexing, saving, flushing, loading atomic.Bool
+		brokenIndex                         atomic.Uint64
+		mu                                  sync.Mutex
+		collections                         map[string]*payload.Info_Index_Count
 	)
 	s.eg.Go(safety.RecoverFunc(func() error {
 		defer close(ech)
@@ -146,6 +149,18 @@ func (s *server) IndexInfo(
 				if info.GetSaving() {
 					saving.Store(true)
 				}
+				if info.GetFlushing() {
+					flushing.Store(true)
+				}
+				if info.GetLoading() {
+					loading.Store(true)
+				}
+				brokenIndex.Add(info.GetBrokenIndex())
+				if len(info.GetCollections()) != 0 {
+					mu.Lock()
+					collections = mergeCollectionCounts(collections, info.GetCollections())
+					mu.Unlock()
+				}
 			}
 			return nil
 		})
@@ -159,14 +174,44 @@ func (s *server) IndexInfo(
 	if err != nil {
 		return nil, s.handleIndexMethodError(ctx, span, vald.IndexInfoRPCName, err)
 	}
+	mu.Lock()
+	defer mu.Unlock()
 	return &payload.Info_Index_Count{
 		Stored:      stored.Load(),
 		Uncommitted: uncommitted.Load(),
 		Indexing:    indexing.Load(),
 		Saving:      saving.Load(),
+		Collections: collections,
+		Flushing:    flushing.Load(),
+		Loading:     loading.Load(),
+		BrokenIndex: brokenIndex.Load(),
 	}, nil
 }
 
+// mergeCollectionCounts adds the index counts of each collection of an agent to the total counts.
+func mergeCollectionCounts(
+	total, counts map[string]*payload.Info_Index_Count,
+) map[string]*payload.Info_Index_Count {
+	if total == nil {
+		total = make(map[string]*payload.Info_Index_Count, len(counts))
+	}
+	for name, cnt := range counts {
+		t, ok := total[name]
+		if !ok {
+			t = new(payload.Info_Index_Count)
+			total[name] = t
+		}
+		t.Stored += cnt.GetStored()
+		t.Uncommitted += cnt.GetUncommitted()
+		t.Indexing = t.GetIndexing() || cnt.GetIndexing()
+		t.Saving = t.GetSaving() || cnt.GetSaving()
+		t.Flushing = t.GetFlushing() || cnt.GetFlushing()
+		t.Loading = t.GetLoading() || cnt.GetLoading()
+		t.BrokenIndex += cnt.GetBrokenIndex()
+	}
+	return total
+}
+
 func (s *server) IndexDetail(
 	ctx context.Context, _ *payload.Empty,
 ) (vec *payload.Info_Index_Detail, err error) {
@@ -42,6 +42,10 @@ func (s *server) Insert(
 ) (ce *payload.Object_Location, err error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.InsertRPCServiceName+"/"+vald.InsertRPCName), apiName+"/"+vald.InsertRPCName)
 	defer trace.End(span)
+	if err = s.checkCollection(vald.InsertRPCName, req.GetConfig().GetCollection(), req); err != nil {
+		return errhandler.HandleError[payload.Object_Location](span, codes.InvalidArgument, err)
+	}
+	defer s.invalidateCache()
 	uuid := req.GetVector().GetId()
 	reqInfo := &errdetails.RequestInfo{
 		RequestId:   uuid,
@@ -64,8 +68,7 @@ func (s *server) Insert(
 			})
 		return errhandler.HandleError[payload.Object_Location](span, codes.InvalidArgument, err)
 	}
-	vec := req.GetVector().GetVector()
-	vl := len(vec)
+	vl := vectorDimension(req.GetVector().GetVector(), req.GetVector().GetSubVectors())
 	if vl < algorithm.MinimumVectorDimensionSize {
 		err = errors.ErrInvalidDimensionSize(vl, 0)
 		err = status.WrapWithInvalidArgument(vald.InsertRPCName+" API invalid vector argument", err, reqInfo, resInfo,
@@ -80,7 +83,7 @@ func (s *server) Insert(
 		return errhandler.HandleError[payload.Object_Location](span, codes.InvalidArgument, err)
 	}
 	if !req.GetConfig().GetSkipStrictExistCheck() {
-		id, err := s.exists(ctx, uuid)
+		id, err := s.exists(ctx, uuid, req.GetConfig().GetCollection())
 		var attrs trace.Attributes
 		if err != nil {
 			switch {
@@ -129,7 +132,7 @@ func (s *server) Insert(
 	}
 	emu := new(sync.Mutex)
 	var errs error
-	err = s.gateway.DoMulti(ctx, s.replica, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
+	err = s.gateway.DoMultiByVector(ctx, uuid, partitionVector(req.GetConfig().GetCollection(), req.GetVector().GetVector()), s.replica, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
 		ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "DoMulti/"+target), apiName+"/"+vald.InsertRPCName+"/"+target)
 		defer trace.End(span)
 		loc, err := vc.Insert(ctx, req, copts...)
@@ -266,7 +269,7 @@ func (s *server) MultiInsert(
 		Locations: make([]*payload.Object_Location, len(reqs.GetRequests())),
 	}
 	for i, r := range reqs.GetRequests() {
-		if r != nil && r.GetVector() != nil && len(r.GetVector().GetVector()) >= algorithm.MinimumVectorDimensionSize && r.GetVector().GetId() != "" {
+		if r != nil && r.GetVector() != nil && vectorDimension(r.GetVector().GetVector(), r.GetVector().GetSubVectors()) >= algorithm.MinimumVectorDimensionSize && r.GetVector().GetId() != "" {
 			idx := i
 			req := r
 			eg.Go(safety.RecoverFunc(func() (err error) {
@@ -296,8 +299,8 @@ func (s *server) MultiInsert(
 				field string
 			)
 			switch {
-			case r.GetVector() == nil, len(r.GetVector().GetVector()) < algorithm.MinimumVectorDimensionSize:
-				err = errors.ErrInvalidDimensionSize(len(r.GetVector().GetVector()), 0)
+			case r.GetVector() == nil, vectorDimension(r.GetVector().GetVector(), r.GetVector().GetSubVectors()) < algorithm.MinimumVectorDimensionSize:
+				err = errors.ErrInvalidDimensionSize(vectorDimension(r.GetVector().GetVector(), r.GetVector().GetSubVectors()), 0)
 				field = "vector"
 			case r.GetVector().GetId() == "":
 				err = errors.ErrInvalidUUID(r.GetVector().GetId())
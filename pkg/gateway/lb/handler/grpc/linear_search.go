@@ -57,7 +57,7 @@ func (s *server) LinearSearch(
 			})
 		return errhandler.HandleError[payload.Search_Response](span, codes.InvalidArgument, err)
 	}
-	res, attrs, err := s.doSearch(ctx, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
+	res, attrs, err := s.doSearch(ctx, nil, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
 		req.Config = fcfg
 		return vc.LinearSearch(ctx, req, copts...)
 	})
@@ -99,6 +99,7 @@ func (s *server) LinearSearchByID(
 		Id: &payload.Object_ID{
 			Id: uuid,
 		},
+		Collection: req.GetConfig().GetCollection(),
 	})
 	if err != nil {
 		st, _ := status.FromError(err)
@@ -109,7 +110,7 @@ func (s *server) LinearSearchByID(
 		}
 		// try search by using agent's LinearSearchByID method this operation is emergency fallback, the search quality is not same as usual LinearSearchByID operation.
 		var attrs []attribute.KeyValue
-		res, attrs, err = s.doSearch(ctx, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
+		res, attrs, err = s.doSearch(ctx, nil, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
 			req.Config = fcfg
 			return vc.LinearSearchByID(ctx, req, copts...)
 		})
@@ -125,7 +126,7 @@ func (s *server) LinearSearchByID(
 	})
 	if err != nil {
 		var attrs []attribute.KeyValue
-		res, attrs, err = s.doSearch(ctx, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
+		res, attrs, err = s.doSearch(ctx, nil, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
 			req.Config = fcfg
 			return vc.LinearSearchByID(ctx, req, copts...)
 		})
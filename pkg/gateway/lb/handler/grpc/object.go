@@ -96,7 +96,8 @@ func (s *server) handleObjectBroadCastError(
 	return nil
 }
 
-func (s *server) exists(ctx context.Context, uuid string) (id *payload.Object_ID, err error) {
+// exists checks whether the uuid exists in any agent. For the named collection, it checks the timestamp of the uuid in the collection because the ID request has no collection.
+func (s *server) exists(ctx context.Context, uuid, collection string) (id *payload.Object_ID, err error) {
 	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "exists"), apiName+"/"+vald.ExistsRPCName+"/exists")
 	defer trace.End(span)
 
@@ -113,13 +114,27 @@ func (s *server) exists(ctx context.Context, uuid string) (id *payload.Object_ID
 		defer close(ich)
 		defer close(ech)
 		var once sync.Once
-		ech <- s.gateway.BroadCast(ctx, service.READ, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
+		ech <- s.gateway.BroadCastByID(ctx, uuid, service.READ, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
 			sctx, sspan := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "BroadCast/"+target), apiName+"/exists/BroadCast/"+target)
 			defer trace.End(sspan)
 			meta := &payload.Object_ID{
 				Id: uuid,
 			}
-			oid, err := vc.Exists(sctx, meta, copts...)
+			var oid *payload.Object_ID
+			if len(collection) == 0 {
+				oid, err = vc.Exists(sctx, meta, copts...)
+			} else {
+				var ts *payload.Object_Timestamp
+				ts, err = vc.GetTimestamp(sctx, &payload.Object_TimestampRequest{
+					Id:         meta,
+					Collection: collection,
+				}, copts...)
+				if ts != nil {
+					oid = &payload.Object_ID{
+						Id: ts.GetId(),
+					}
+				}
+			}
 			if err != nil {
 				return s.handleObjectBroadCastError(sspan, target, vald.ExistsRPCName, uuid, meta, err)
 			}
@@ -168,7 +183,7 @@ func (s *server) Exists(
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.ObjectRPCServiceName+"/"+vald.ExistsRPCName), apiName+"/"+vald.ExistsRPCName)
 	defer trace.End(span)
 	uuid := meta.GetId()
-	id, err = s.exists(ctx, uuid)
+	id, err = s.exists(ctx, uuid, "")
 	if err == nil {
 		return id, nil
 	}
@@ -226,7 +241,7 @@ func (s *server) Exists(
 }
 
 func (s *server) getObject(
-	ctx context.Context, uuid string,
+	ctx context.Context, uuid, collection string,
 ) (vec *payload.Object_Vector, err error) {
 	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "getObject"), apiName+"/"+vald.GetObjectRPCName+"/getObject")
 	defer trace.End(span)
@@ -238,13 +253,14 @@ func (s *server) getObject(
 		defer close(vch)
 		defer close(ech)
 		var once sync.Once
-		ech <- s.gateway.BroadCast(ctx, service.READ, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
+		ech <- s.gateway.BroadCastByID(ctx, uuid, service.READ, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
 			sctx, sspan := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "BroadCast/"+target), apiName+"/getObject/BroadCast/"+target)
 			defer trace.End(sspan)
 			req := &payload.Object_VectorRequest{
 				Id: &payload.Object_ID{
 					Id: uuid,
 				},
+				Collection: collection,
 			}
 			ovec, err := vc.GetObject(sctx, req, copts...)
 			if err != nil {
@@ -295,8 +311,11 @@ func (s *server) GetObject(
 ) (vec *payload.Object_Vector, err error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.ObjectRPCServiceName+"/"+vald.GetObjectRPCName), apiName+"/"+vald.GetObjectRPCName)
 	defer trace.End(span)
+	if err = s.checkCollection(vald.GetObjectRPCName, req.GetCollection(), req); err != nil {
+		return errhandler.HandleError[payload.Object_Vector](span, codes.InvalidArgument, err)
+	}
 	uuid := req.GetId().GetId()
-	vec, err = s.getObject(ctx, uuid)
+	vec, err = s.getObject(ctx, uuid, req.GetCollection())
 	if err == nil {
 		if vec != nil && vec.GetId() != "" && vec.GetVector() != nil {
 			return vec, nil
@@ -387,6 +406,10 @@ func (s *server) StreamListObject(
 ) error {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(stream.Context(), vald.PackageName+"."+vald.ObjectRPCServiceName+"/"+vald.StreamListObjectRPCName), apiName+"/"+vald.StreamListObjectRPCName)
 	defer trace.End(span)
+	if err := s.checkCollection(vald.StreamListObjectRPCName, req.GetCollection(), req); err != nil {
+		errhandler.RecordSpanError(span, codes.InvalidArgument, err)
+		return err
+	}
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -473,6 +496,9 @@ func (s *server) GetTimestamp(
 ) (ts *payload.Object_Timestamp, err error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.ObjectRPCServiceName+"/"+vald.GetTimestampRPCName), apiName+"/"+vald.GetTimestampRPCName)
 	defer trace.End(span)
+	if err = s.checkCollection(vald.GetTimestampRPCName, req.GetCollection(), req); err != nil {
+		return errhandler.HandleError[payload.Object_Timestamp](span, codes.InvalidArgument, err)
+	}
 	uuid := req.GetId().GetId()
 	tch := make(chan *payload.Object_Timestamp, 1)
 	ech := make(chan error, 1)
@@ -482,13 +508,14 @@ func (s *server) GetTimestamp(
 		defer close(tch)
 		defer close(ech)
 		var once sync.Once
-		ech <- s.gateway.BroadCast(ctx, service.READ, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
+		ech <- s.gateway.BroadCastByID(ctx, uuid, service.READ, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
 			sctx, sspan := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "BroadCast/"+target), apiName+"/getTimestamp/BroadCast/"+target)
 			defer trace.End(sspan)
 			req := &payload.Object_TimestampRequest{
 				Id: &payload.Object_ID{
 					Id: uuid,
 				},
+				Collection: req.GetCollection(),
 			}
 			ots, err := vc.GetTimestamp(sctx, req, copts...)
 			if err != nil {
@@ -18,6 +18,7 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/vdaas/vald/internal/algorithm/distance"
 	"github.com/vdaas/vald/internal/log"
 	"github.com/vdaas/vald/internal/net"
 	"github.com/vdaas/vald/internal/os"
@@ -44,6 +45,9 @@ var defaultOptions = []Option{
 	WithIP(net.LoadLocalIP()),
 }
 
+// defaultRerankRatio is the ratio of the number of the candidates to the number of the results of the re-ranking.
+const defaultRerankRatio = 2
+
 // WithIP returns the option to set the IP for server.
 func WithIP(ip string) Option {
 	return func(s *server) {
@@ -111,3 +115,25 @@ func WithMultiConcurrency(c int) Option {
 		}
 	}
 }
+
+// WithResultCache returns the option to set the cache of the search responses.
+func WithResultCache(c service.ResultCache) Option {
+	return func(s *server) {
+		if c != nil {
+			s.cache = c
+		}
+	}
+}
+
+// WithRerank returns the option to enable the re-ranking of the search results by the exact distances of the distance
+// type, over-fetching the candidates by the ratio when the request does not set it.
+func WithRerank(dt distance.Type, ratio float64) Option {
+	return func(s *server) {
+		s.rerank = true
+		s.rerankDistance = dt
+		s.rerankRatio = defaultRerankRatio
+		if ratio >= 1 {
+			s.rerankRatio = ratio
+		}
+	}
+}
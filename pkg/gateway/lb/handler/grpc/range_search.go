@@ -0,0 +1,259 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/algorithm/rangesearch"
+	"github.com/vdaas/vald/internal/core/algorithm"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/errdetails"
+	"github.com/vdaas/vald/internal/net/grpc/errhandler"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/pkg/gateway/lb/service"
+)
+
+// RangeSearch returns a page of the vectors within the radius over all agents.
+// Each agent returns at most a page of its results following the cursor, so the page of the whole cluster
+// is the head of the merged pages, and the next cursor is set when any agent has more results.
+func (s *server) RangeSearch(
+	ctx context.Context, req *payload.Search_RangeRequest,
+) (res *payload.Search_RangeResponse, err error) {
+	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.SearchRPCServiceName+"/"+vald.RangeSearchRPCName), apiName+"/"+vald.RangeSearchRPCName)
+	defer trace.End(span)
+	if err = s.validateRangeSearch(ctx, span, vald.RangeSearchRPCName, req); err != nil {
+		return nil, err
+	}
+	timeout := s.timeout
+	if to := req.GetConfig().GetTimeout(); to != 0 {
+		timeout = time.Duration(to)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var (
+		mu      sync.Mutex
+		results []*payload.Object_Distance
+		more    bool
+		partial bool
+	)
+	err = s.gateway.BroadCast(ctx, service.READ, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
+		sctx, sspan := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "BroadCast/"+target), apiName+"/"+vald.RangeSearchRPCName+"/"+target)
+		defer trace.End(sspan)
+		r, err := vc.RangeSearch(sctx, req, copts...)
+		if err != nil {
+			return handleSearchBroadCastError(sspan, target, err)
+		}
+		mu.Lock()
+		results = append(results, r.GetResults()...)
+		more = more || len(r.GetNextCursor()) != 0
+		partial = partial || r.GetPartial()
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, s.handleRangeSearchError(ctx, span, vald.RangeSearchRPCName, req, err)
+	}
+	page, next, err := rangesearch.Page(rangesearch.Sort(results), "", req.GetConfig().GetPageSize())
+	if err != nil {
+		return nil, s.handleRangeSearchError(ctx, span, vald.RangeSearchRPCName, req, err)
+	}
+	if len(next) == 0 && more && len(page) != 0 {
+		next = rangesearch.Encode(page[len(page)-1])
+	}
+	return &payload.Search_RangeResponse{
+		RequestId:  req.GetConfig().GetRequestId(),
+		Results:    page,
+		NextCursor: next,
+		Partial:    partial,
+	}, nil
+}
+
+// StreamRangeSearch streams the pages of the vectors within the radius over all agents.
+// The pages streamed from the agents are merged in order as they are received, so only the current page
+// of each agent is held in the gateway.
+func (s *server) StreamRangeSearch(
+	req *payload.Search_RangeRequest, stream vald.Search_StreamRangeSearchServer,
+) (err error) {
+	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(stream.Context(), vald.PackageName+"."+vald.SearchRPCServiceName+"/"+vald.StreamRangeSearchRPCName), apiName+"/"+vald.StreamRangeSearchRPCName)
+	defer trace.End(span)
+	if err = s.validateRangeSearch(ctx, span, vald.StreamRangeSearchRPCName, req); err != nil {
+		return err
+	}
+	var cancel context.CancelFunc
+	if to := req.GetConfig().GetTimeout(); to != 0 {
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(to))
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	var (
+		mu      sync.Mutex
+		srcs    []rangesearch.Source
+		partial atomic.Bool
+	)
+	err = s.gateway.BroadCast(ctx, service.READ, func(bctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) error {
+		_, sspan := trace.StartSpan(grpc.WrapGRPCMethod(bctx, "BroadCast/"+target), apiName+"/"+vald.StreamRangeSearchRPCName+"/"+target)
+		defer trace.End(sspan)
+		// the agent stream is bound to ctx instead of bctx, since it is received after the BroadCast returns.
+		client, err := vc.StreamRangeSearch(ctx, req, copts...)
+		if err != nil {
+			return handleSearchBroadCastError(sspan, target, err)
+		}
+		// receive the first page here to skip the agent which fails to start the range search like the other search APIs.
+		first, err := client.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return handleSearchBroadCastError(sspan, target, err)
+		}
+		mu.Lock()
+		srcs = append(srcs, func() ([]*payload.Object_Distance, error) {
+			if first != nil {
+				res := first
+				first = nil
+				if res.GetPartial() {
+					partial.Store(true)
+				}
+				return res.GetResults(), nil
+			}
+			res, err := client.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil, err
+				}
+				return nil, errors.ErrServerStreamClientRecv(err)
+			}
+			if res.GetPartial() {
+				partial.Store(true)
+			}
+			return res.GetResults(), nil
+		})
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		return s.handleRangeSearchError(ctx, span, vald.StreamRangeSearchRPCName, req, err)
+	}
+
+	var sent bool
+	send := func(page []*payload.Object_Distance, next string) error {
+		sent = true
+		// the agents report partial with their last pages, which have been received when the last page is sent.
+		err := stream.Send(&payload.Search_RangeResponse{
+			RequestId:  req.GetConfig().GetRequestId(),
+			Results:    page,
+			NextCursor: next,
+			Partial:    len(next) == 0 && partial.Load(),
+		})
+		if err != nil {
+			return errors.ErrServerStreamServerSend(err)
+		}
+		return nil
+	}
+	err = rangesearch.Merge(srcs, req.GetConfig().GetPageSize(), send)
+	if err == nil && !sent {
+		err = send(nil, "")
+	}
+	if err != nil {
+		return s.handleRangeSearchError(ctx, span, vald.StreamRangeSearchRPCName, req, err)
+	}
+	return nil
+}
+
+func (s *server) validateRangeSearch(
+	ctx context.Context, span trace.Span, rpcName string, req *payload.Search_RangeRequest,
+) error {
+	field := "cursor"
+	_, err := rangesearch.Decode(req.GetConfig().GetCursor())
+	if vl := len(req.GetVector()); vl < algorithm.MinimumVectorDimensionSize {
+		field = "vector dimension size"
+		err = errors.ErrInvalidDimensionSize(vl, 0)
+	}
+	if name := req.GetConfig().GetCollection(); service.IsReservedCollection(name) {
+		field = "collection"
+		err = errors.ErrReservedCollection(name)
+	}
+	if err == nil {
+		return nil
+	}
+	err = status.WrapWithInvalidArgument(rpcName+" API invalid argument", err,
+		&errdetails.RequestInfo{
+			RequestId:   req.GetConfig().GetRequestId(),
+			ServingData: errdetails.Serialize(req),
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequestFieldViolation{
+				{
+					Field:       field,
+					Description: err.Error(),
+				},
+			},
+		},
+		&errdetails.ResourceInfo{
+			ResourceType: errdetails.ValdGRPCResourceTypePrefix + "/vald.v1." + rpcName,
+			ResourceName: fmt.Sprintf("%s: %s(%s) to %v", apiName, s.name, s.ip, s.gateway.Addrs(ctx)),
+		})
+	log.Warn(err)
+	errhandler.RecordSpanError(span, codes.InvalidArgument, err)
+	return err
+}
+
+func (s *server) handleRangeSearchError(
+	ctx context.Context, span trace.Span, rpcName string, req *payload.Search_RangeRequest, err error,
+) error {
+	reqInfo := &errdetails.RequestInfo{
+		RequestId:   req.GetConfig().GetRequestId(),
+		ServingData: errdetails.Serialize(req),
+	}
+	resInfo := &errdetails.ResourceInfo{
+		ResourceType: errdetails.ValdGRPCResourceTypePrefix + "/vald.v1." + rpcName,
+		ResourceName: fmt.Sprintf("%s: %s(%s) to %v", apiName, s.name, s.ip, s.gateway.Addrs(ctx)),
+	}
+	var attrs trace.Attributes
+	switch {
+	case errors.Is(err, errors.ErrGRPCClientConnNotFound("*")):
+		err = status.WrapWithInternal(rpcName+" API connection not found", err, reqInfo, resInfo)
+		attrs = trace.StatusCodeInternal(err.Error())
+	case errors.Is(err, context.Canceled):
+		err = status.WrapWithCanceled(rpcName+" API canceled", err, reqInfo, resInfo)
+		attrs = trace.StatusCodeCancelled(err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		err = status.WrapWithDeadlineExceeded(rpcName+" API deadline exceeded", err, reqInfo, resInfo)
+		attrs = trace.StatusCodeDeadlineExceeded(err.Error())
+	default:
+		var (
+			st  *status.Status
+			msg string
+		)
+		st, msg, err = status.ParseError(err, codes.Internal, "failed to parse "+rpcName+" gRPC error response", reqInfo, resInfo)
+		attrs = trace.FromGRPCStatus(st.Code(), msg)
+	}
+	log.Debug(err)
+	errhandler.RecordSpanAttrs(span, attrs, err)
+	return err
+}
@@ -43,6 +43,10 @@ func (s *server) Remove(
 ) (locs *payload.Object_Location, err error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.RemoveRPCServiceName+"/"+vald.RemoveRPCName), apiName+"/"+vald.RemoveRPCName)
 	defer trace.End(span)
+	if err = s.checkCollection(vald.RemoveRPCName, req.GetConfig().GetCollection(), req); err != nil {
+		return errhandler.HandleError[payload.Object_Location](span, codes.InvalidArgument, err)
+	}
+	defer s.invalidateCache()
 
 	id := req.GetId()
 	uuid := id.GetId()
@@ -55,7 +59,7 @@ func (s *server) Remove(
 		ResourceName: fmt.Sprintf("%s: %s(%s) to %v", apiName, s.name, s.ip, s.gateway.Addrs(ctx)),
 	}
 	if !req.GetConfig().GetSkipStrictExistCheck() {
-		_, err := s.exists(ctx, uuid)
+		_, err := s.exists(ctx, uuid, req.GetConfig().GetCollection())
 		if err != nil {
 			var attrs trace.Attributes
 			switch {
@@ -120,7 +124,7 @@ func (s *server) Remove(
 		Ips:  make([]string, 0, s.replica),
 	}
 	ls := make([]string, 0, s.replica)
-	err = s.gateway.BroadCast(ctx, service.WRITE, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
+	err = s.gateway.BroadCastByID(ctx, uuid, service.WRITE, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
 		ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "BroadCast/"+target), apiName+"/"+vald.RemoveRPCName+"/"+target)
 		defer trace.End(span)
 		loc, err := vc.Remove(ctx, req, copts...)
@@ -282,6 +286,7 @@ func (s *server) RemoveByTimestamp(
 ) (locs *payload.Object_Locations, errs error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.RemoveRPCServiceName+"/"+vald.RemoveByTimestampRPCName), apiName+"/"+vald.RemoveByTimestampRPCName)
 	defer trace.End(span)
+	defer s.invalidateCache()
 
 	var mu sync.Mutex
 	var emu sync.Mutex
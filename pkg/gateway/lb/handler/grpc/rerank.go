@@ -0,0 +1,295 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/algorithm/distance"
+	"github.com/vdaas/vald/internal/algorithm/fusion"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/errdetails"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/attribute"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+)
+
+// defaultMMRLambda is the lambda of the maximal marginal relevance when it is not set.
+const defaultMMRLambda = 0.5
+
+// rerankError returns the error and its code when req cannot be re-ranked or diversified by the exact distances of the
+// dense vectors.
+func (s *server) rerankError(req *payload.Search_Request) (codes.Code, error) {
+	field := "rerank"
+	if req.GetConfig().GetMmr().GetEnabled() {
+		field = "mmr"
+	}
+	var err error
+	switch cfg := req.GetConfig(); {
+	case !s.rerank:
+		err = errors.ErrRerankNotSupported("the gateway without the re-ranking distance type")
+		return codes.FailedPrecondition, status.WrapWithFailedPrecondition(vald.SearchRPCName+" API re-ranking is not configured", err,
+			&errdetails.RequestInfo{
+				RequestId:   cfg.GetRequestId(),
+				ServingData: errdetails.Serialize(req),
+			})
+	case fusion.Enabled(cfg.GetFusion()):
+		err = errors.ErrRerankNotSupported("the fused search results")
+	case cfg.GetWithCursor():
+		err = errors.ErrRerankNotSupported("the paginated search results")
+	case len(req.GetSubVectors()) != 0:
+		err = errors.ErrRerankNotSupported("the multi-vector query")
+	case cfg.GetMmr().GetEnabled() && cfg.GetMmr().Lambda != nil &&
+		// the negated range check also rejects NaN.
+		!(cfg.GetMmr().GetLambda() >= 0 && cfg.GetMmr().GetLambda() <= 1):
+		field, err = "mmr.lambda", errors.ErrInvalidMMRLambda(cfg.GetMmr().GetLambda())
+	default:
+		return codes.OK, nil
+	}
+	return codes.InvalidArgument, status.WrapWithInvalidArgument(vald.SearchRPCName+" API invalid rerank argument", err,
+		&errdetails.RequestInfo{
+			RequestId:   req.GetConfig().GetRequestId(),
+			ServingData: errdetails.Serialize(req),
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequestFieldViolation{
+				{
+					Field:       field,
+					Description: err.Error(),
+				},
+			},
+		})
+}
+
+// rerankNum returns the number of the candidates to be re-ranked for num results, which is never less than num.
+func (s *server) rerankNum(cfg *payload.Search_Config) uint32 {
+	num := cfg.GetNum()
+	if n := cfg.GetMmr().GetCandidates(); cfg.GetMmr().GetEnabled() && n != 0 {
+		return max(n, num)
+	}
+	r := float64(cfg.GetRerank().GetRatio())
+	if r <= 0 {
+		r = s.rerankRatio
+	}
+	return uint32(math.Ceil(float64(num) * max(r, 1)))
+}
+
+// rerankDistanceOf returns the distance type to re-rank the search results of req, which is the distance type of the
+// collection named by req when the agents report it, or the configured re-ranking distance type otherwise.
+func (s *server) rerankDistanceOf(ctx context.Context, req *payload.Search_Request) (distance.Type, error) {
+	name := req.GetConfig().GetCollection()
+	if name == "" {
+		return s.rerankDistance, nil
+	}
+	list, err := s.ListCollections(ctx, new(payload.Empty))
+	if err != nil {
+		return 0, err
+	}
+	dt, err := collectionDistance(list, name, s.rerankDistance)
+	if err != nil {
+		field := "rerank"
+		if req.GetConfig().GetMmr().GetEnabled() {
+			field = "mmr"
+		}
+		err = errors.ErrRerankNotSupported("the collection " + name + ": " + err.Error())
+		return 0, status.WrapWithInvalidArgument(vald.SearchRPCName+" API invalid rerank argument", err,
+			&errdetails.RequestInfo{
+				RequestId:   req.GetConfig().GetRequestId(),
+				ServingData: errdetails.Serialize(req),
+			},
+			&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequestFieldViolation{
+					{
+						Field:       field,
+						Description: err.Error(),
+					},
+				},
+			})
+	}
+	return dt, nil
+}
+
+// collectionDistance returns the distance type of the named collection of list, or of the collection which its requests
+// are switched to. It returns def when the collection is not listed or does not report its distance type, since the
+// search of the collection fails or uses the distance type of the default collection then.
+func collectionDistance(list *payload.Collection_List, name string, def distance.Type) (distance.Type, error) {
+	for _, sw := range list.GetSwitches() {
+		if sw.GetName() == name && sw.GetTarget() != "" {
+			name = sw.GetTarget()
+			break
+		}
+	}
+	for _, cfg := range list.GetCollections() {
+		if cfg.GetName() == name && cfg.GetDistanceType() != "" {
+			return distance.Parse(cfg.GetDistanceType())
+		}
+	}
+	return def, nil
+}
+
+// searchRerank searches the candidates over-fetched from the agents by the aggregation algorithm of req, and returns
+// the top num of them re-ranked by the exact distances between the query and their vectors got from the agents, or
+// selected by the maximal marginal relevance when it is enabled.
+// The distances are those of the distance type of the collection searched by req, which is resolved by rerankDistanceOf.
+// The candidates removed before their vectors are got are left out of the results.
+func (s *server) searchRerank(
+	ctx context.Context, req *payload.Search_Request,
+) (res *payload.Search_Response, attrs []attribute.KeyValue, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "searchRerank"), apiName+"/searchRerank")
+	defer trace.End(span)
+
+	dt, err := s.rerankDistanceOf(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	cfg := req.GetConfig()
+	ccfg := cfg.CloneVT()
+	ccfg.Num = s.rerankNum(cfg)
+	ccfg.Rerank = nil
+	ccfg.Mmr = nil
+	creq := &payload.Search_Request{
+		Vector: req.GetVector(),
+		Sparse: req.GetSparse(),
+		Config: ccfg,
+	}
+	res, attrs, err = s.doSearch(ctx, creq.GetVector(), ccfg, func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
+		creq.Config = fcfg
+		return vc.Search(ctx, creq, copts...)
+	})
+	if err != nil {
+		return nil, attrs, err
+	}
+
+	results := res.GetResults()
+	cands := make([]*payload.Object_Distance, len(results))
+	vecs := make([][]float32, len(results))
+	eg, ectx := errgroup.New(ctx)
+	eg.SetLimit(s.multiConcurrency)
+	for i, r := range results {
+		eg.Go(safety.RecoverFunc(func() error {
+			vec, err := s.getObject(ectx, r.GetId(), cfg.GetCollection())
+			if err != nil || len(vec.GetVector()) == 0 {
+				if ectx.Err() != nil {
+					return ectx.Err()
+				}
+				log.Debugf("left candidate %s out of the re-ranked results: %v", r.GetId(), err)
+				return nil
+			}
+			cands[i] = &payload.Object_Distance{
+				Id:       r.GetId(),
+				Distance: dt.Distance(req.GetVector(), vec.GetVector()),
+			}
+			vecs[i] = vec.GetVector()
+			return nil
+		}))
+	}
+	if err = eg.Wait(); err != nil {
+		return nil, nil, status.WrapWithCanceled(vald.SearchRPCName+" API re-ranking canceled", err)
+	}
+	vecs = slices.DeleteFunc(vecs, func(vec []float32) bool {
+		return vec == nil
+	})
+	cands = slices.DeleteFunc(cands, func(d *payload.Object_Distance) bool {
+		return d == nil
+	})
+	if len(results) != 0 && len(cands) == 0 {
+		err = errors.ErrObjectNotFound(nil, results[0].GetId())
+		return nil, nil, status.WrapWithNotFound(vald.SearchRPCName+" API vectors of the candidates not found", err)
+	}
+	if mmr := cfg.GetMmr(); mmr.GetEnabled() {
+		lambda := float32(defaultMMRLambda)
+		if mmr.Lambda != nil {
+			lambda = mmr.GetLambda()
+		}
+		res.Results = selectMMR(dt, cands, vecs, int(cfg.GetNum()), lambda)
+	} else {
+		res.Results = topDistances(cands, int(cfg.GetNum()))
+	}
+	res.Reranked = true
+	return res, nil, nil
+}
+
+// topDistances returns the num results of the smallest distances in the stable order.
+func topDistances(results []*payload.Object_Distance, num int) []*payload.Object_Distance {
+	slices.SortStableFunc(results, func(a, b *payload.Object_Distance) int {
+		return cmp.Compare(a.GetDistance(), b.GetDistance())
+	})
+	if len(results) > num {
+		results = results[:num]
+	}
+	return results
+}
+
+// selectMMR selects num results from the candidates by the maximal marginal relevance in the selected order.
+// Each step selects the candidate of the largest lambda * sim(query, c) - (1 - lambda) * max sim(c, selected), where
+// vecs are the vectors of the candidates and sim is the similarity of the distance type, so the lambda of 1 returns the
+// nearest candidates and the smaller lambda prefers the candidates dissimilar to the ones already selected.
+// The similarities are used instead of the distances, since the distances of InnerProduct are negative and those of
+// L1 and L2 are unbounded, either of which skews the balance of the relevance and the diversity.
+func selectMMR(
+	dt distance.Type, cands []*payload.Object_Distance, vecs [][]float32, num int, lambda float32,
+) []*payload.Object_Distance {
+	num = min(num, len(cands))
+	// the candidates are visited in the distance order, so the ties are broken by the relevance.
+	order := make([]int, len(cands))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(cands[a].GetDistance(), cands[b].GetDistance())
+	})
+	// similar is the largest similarity of each candidate to the selected ones.
+	similar := make([]float32, len(cands))
+	selected := make([]bool, len(cands))
+	results := make([]*payload.Object_Distance, 0, num)
+	for len(results) < num {
+		best := -1
+		var score float32
+		for _, i := range order {
+			if selected[i] {
+				continue
+			}
+			sc := lambda * dt.Similarity(cands[i].GetDistance())
+			if len(results) != 0 {
+				sc -= (1 - lambda) * similar[i]
+			}
+			if best < 0 || sc > score {
+				best, score = i, sc
+			}
+		}
+		selected[best] = true
+		results = append(results, cands[best])
+		for _, i := range order {
+			if selected[i] {
+				continue
+			}
+			sim := dt.Similarity(dt.Distance(vecs[i], vecs[best]))
+			if len(results) == 1 || sim > similar[i] {
+				similar[i] = sim
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,369 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/algorithm/distance"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func Test_server_rerankNum(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  *payload.Search_Config
+		want uint32
+	}{
+		{
+			name: "return the number by the ratio of the gateway when the request does not set it",
+			cfg:  &payload.Search_Config{Num: 10},
+			want: 20,
+		},
+		{
+			name: "return the number by the ratio of the request rounded up",
+			cfg: &payload.Search_Config{
+				Num:    10,
+				Rerank: &payload.Search_Rerank{Enabled: true, Ratio: 1.25},
+			},
+			want: 13,
+		},
+		{
+			name: "return num when the ratio of the request is less than 1",
+			cfg: &payload.Search_Config{
+				Num:    10,
+				Rerank: &payload.Search_Rerank{Enabled: true, Ratio: 0.5},
+			},
+			want: 10,
+		},
+		{
+			name: "return the candidates of the maximal marginal relevance",
+			cfg: &payload.Search_Config{
+				Num: 10,
+				Mmr: &payload.Search_MMR{Enabled: true, Candidates: 50},
+			},
+			want: 50,
+		},
+		{
+			name: "return num when the candidates of the maximal marginal relevance are less than num",
+			cfg: &payload.Search_Config{
+				Num: 10,
+				Mmr: &payload.Search_MMR{Enabled: true, Candidates: 5},
+			},
+			want: 10,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := new(server)
+			WithRerank(distance.L2, 0)(s)
+			if got := s.rerankNum(test.cfg); got != test.want {
+				tt.Errorf("got = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_server_rerankError(t *testing.T) {
+	lambda := func(l float32) *float32 {
+		return &l
+	}
+	tests := []struct {
+		name   string
+		rerank bool
+		req    *payload.Search_Request
+		want   codes.Code
+	}{
+		{
+			name:   "return OK for the dense vector query",
+			rerank: true,
+			req: &payload.Search_Request{
+				Vector: []float32{0.1, 0.2, 0.3},
+				Config: &payload.Search_Config{Num: 10},
+			},
+			want: codes.OK,
+		},
+		{
+			name: "return FailedPrecondition when the gateway has no distance type",
+			req: &payload.Search_Request{
+				Vector: []float32{0.1, 0.2, 0.3},
+				Config: &payload.Search_Config{Num: 10},
+			},
+			want: codes.FailedPrecondition,
+		},
+		{
+			name:   "return InvalidArgument for the paginated search",
+			rerank: true,
+			req: &payload.Search_Request{
+				Vector: []float32{0.1, 0.2, 0.3},
+				Config: &payload.Search_Config{Num: 10, WithCursor: true},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			name:   "return InvalidArgument for the fused search",
+			rerank: true,
+			req: &payload.Search_Request{
+				Vector: []float32{0.1, 0.2, 0.3},
+				Config: &payload.Search_Config{
+					Num: 10,
+					Fusion: &payload.Search_Fusion{
+						Algorithm: payload.Search_Fusion_RRF,
+					},
+				},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			name:   "return InvalidArgument for the fused search with the maximal marginal relevance",
+			rerank: true,
+			req: &payload.Search_Request{
+				Vector: []float32{0.1, 0.2, 0.3},
+				Sparse: &payload.Object_SparseVector{
+					Indices: []uint32{1},
+					Values:  []float32{0.5},
+				},
+				Config: &payload.Search_Config{
+					Num: 10,
+					Fusion: &payload.Search_Fusion{
+						Algorithm: payload.Search_Fusion_RRF,
+					},
+					Mmr: &payload.Search_MMR{Enabled: true},
+				},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			name:   "return OK for the maximal marginal relevance without lambda",
+			rerank: true,
+			req: &payload.Search_Request{
+				Vector: []float32{0.1, 0.2, 0.3},
+				Config: &payload.Search_Config{
+					Num: 10,
+					Mmr: &payload.Search_MMR{Enabled: true},
+				},
+			},
+			want: codes.OK,
+		},
+		{
+			name:   "return InvalidArgument for the lambda out of [0, 1]",
+			rerank: true,
+			req: &payload.Search_Request{
+				Vector: []float32{0.1, 0.2, 0.3},
+				Config: &payload.Search_Config{
+					Num: 10,
+					Mmr: &payload.Search_MMR{Enabled: true, Lambda: lambda(1.5)},
+				},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			name:   "return InvalidArgument for the lambda of NaN",
+			rerank: true,
+			req: &payload.Search_Request{
+				Vector: []float32{0.1, 0.2, 0.3},
+				Config: &payload.Search_Config{
+					Num: 10,
+					Mmr: &payload.Search_MMR{Enabled: true, Lambda: lambda(float32(math.NaN()))},
+				},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			name:   "return InvalidArgument for the multi-vector query",
+			rerank: true,
+			req: &payload.Search_Request{
+				SubVectors: []*payload.Object_SubVector{
+					{Vector: []float32{0.1, 0.2, 0.3}},
+				},
+				Config: &payload.Search_Config{Num: 10},
+			},
+			want: codes.InvalidArgument,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			s := new(server)
+			if test.rerank {
+				WithRerank(distance.L2, 0)(s)
+			}
+			code, err := s.rerankError(test.req)
+			if code != test.want {
+				tt.Errorf("code = %v, want %v", code, test.want)
+			}
+			if (err == nil) != (test.want == codes.OK) {
+				tt.Errorf("err = %v, want code %v", err, test.want)
+			}
+		})
+	}
+}
+
+func Test_selectMMR(t *testing.T) {
+	// b is a near-duplicate of a, and c is a bit farther from the query in the other direction.
+	cands := []*payload.Object_Distance{
+		{Id: "c", Distance: 1.5},
+		{Id: "a", Distance: 1},
+		{Id: "b", Distance: 1.01},
+	}
+	vecs := [][]float32{
+		{-1.5, 0},
+		{1, 0},
+		{1.01, 0},
+	}
+	// the query of the inner product is (1, 0), and b is similar to a while c is not.
+	ipCands := []*payload.Object_Distance{
+		{Id: "c", Distance: -0.7},
+		{Id: "a", Distance: -0.9},
+		{Id: "b", Distance: -0.89},
+	}
+	ipVecs := [][]float32{
+		{0.7, -0.7},
+		{0.9, 0.1},
+		{0.89, 0.12},
+	}
+	tests := []struct {
+		name   string
+		dt     distance.Type
+		num    int
+		lambda float32
+		want   []string
+	}{
+		{
+			name:   "return the nearest candidates when lambda is 1",
+			dt:     distance.L2,
+			num:    2,
+			lambda: 1,
+			want:   []string{"a", "b"},
+		},
+		{
+			name:   "return the diverse candidates when lambda is 0.5",
+			dt:     distance.L2,
+			num:    2,
+			lambda: 0.5,
+			want:   []string{"a", "c"},
+		},
+		{
+			name:   "return all candidates when num is larger than them",
+			dt:     distance.L2,
+			num:    5,
+			lambda: 0.5,
+			want:   []string{"a", "c", "b"},
+		},
+		{
+			name:   "return the nearest candidates of the inner product when lambda is 1",
+			dt:     distance.InnerProduct,
+			num:    2,
+			lambda: 1,
+			want:   []string{"a", "b"},
+		},
+		{
+			name:   "return the diverse candidates of the inner product when lambda is 0.5",
+			dt:     distance.InnerProduct,
+			num:    2,
+			lambda: 0.5,
+			want:   []string{"a", "c"},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			cs, vs := cands, vecs
+			if test.dt == distance.InnerProduct {
+				cs, vs = ipCands, ipVecs
+			}
+			res := selectMMR(test.dt, slices.Clone(cs), vs, test.num, test.lambda)
+			got := make([]string, 0, len(res))
+			for _, r := range res {
+				got = append(got, r.GetId())
+			}
+			if !slices.Equal(got, test.want) {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_collectionDistance(t *testing.T) {
+	list := &payload.Collection_List{
+		Collections: []*payload.Collection_Config{
+			{Name: "cos", DistanceType: "cosine"},
+			{Name: "ip", DistanceType: "innerproduct"},
+			{Name: "default"},
+			{Name: "bits", DistanceType: "hamming"},
+		},
+		Switches: []*payload.Collection_Switch{
+			{Name: "alias", Target: "ip"},
+			{Name: "fenced", Fence: true},
+		},
+	}
+	tests := []struct {
+		name    string
+		coll    string
+		want    distance.Type
+		wantErr bool
+	}{
+		{
+			name: "return the distance type of the collection",
+			coll: "cos",
+			want: distance.Cosine,
+		},
+		{
+			name: "return the distance type of the collection which the requests are switched to",
+			coll: "alias",
+			want: distance.InnerProduct,
+		},
+		{
+			name: "return the default distance type when the collection does not report it",
+			coll: "default",
+			want: distance.L2,
+		},
+		{
+			name: "return the default distance type when the collection is not listed",
+			coll: "fenced",
+			want: distance.L2,
+		},
+		{
+			name:    "return an error when the distance type of the collection is not supported",
+			coll:    "bits",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			got, err := collectionDistance(list, test.coll, distance.L2)
+			if (err != nil) != test.wantErr {
+				tt.Fatalf("err = %v, wantErr %v", err, test.wantErr)
+			}
+			if err == nil && got != test.want {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
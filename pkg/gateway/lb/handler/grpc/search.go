@@ -22,6 +22,7 @@ import (
 
 	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/algorithm/fusion"
 	"github.com/vdaas/vald/internal/core/algorithm"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/net/grpc"
@@ -40,7 +41,24 @@ func (s *server) Search(
 ) (res *payload.Search_Response, err error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.SearchRPCServiceName+"/"+vald.SearchRPCName), apiName+"/"+vald.SearchRPCName)
 	defer trace.End(span)
-	vl := len(req.GetVector())
+	if key := s.searchCacheKey(req); len(key) != 0 {
+		if res, ok := s.cachedResult(key, req.GetConfig().GetRequestId()); ok {
+			return res, nil
+		}
+		defer func() {
+			if err == nil {
+				s.cacheResult(key, res)
+			}
+		}()
+	}
+	return s.search(ctx, span, req)
+}
+
+// search searches the vectors by req without the cache, so that SearchByID caches the response by the ID only.
+func (s *server) search(
+	ctx context.Context, span trace.Span, req *payload.Search_Request,
+) (res *payload.Search_Response, err error) {
+	vl := vectorDimension(req.GetVector(), req.GetSubVectors())
 	if vl < algorithm.MinimumVectorDimensionSize {
 		err = errors.ErrInvalidDimensionSize(vl, 0)
 		err = status.WrapWithInvalidArgument(vald.SearchRPCName+" API invalid vector argument", err,
@@ -58,10 +76,48 @@ func (s *server) Search(
 			})
 		return errhandler.HandleError[payload.Search_Response](span, codes.InvalidArgument, err)
 	}
-	res, attrs, err := s.doSearch(ctx, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
-		req.Config = fcfg
-		return vc.Search(ctx, req, copts...)
-	})
+	if len(req.GetSparse().GetIndices()) != 0 && !fusion.Enabled(req.GetConfig().GetFusion()) && req.GetConfig() != nil {
+		// the agents fuse the hybrid search results by the reciprocal rank fusion by default, so do the aggregation.
+		req.Config = req.GetConfig().CloneVT()
+		req.Config.Fusion = &payload.Search_Fusion{
+			Algorithm: payload.Search_Fusion_RRF,
+		}
+	}
+	rerank := req.GetConfig().GetRerank().GetEnabled() || req.GetConfig().GetMmr().GetEnabled()
+	if rerank {
+		if code, err := s.rerankError(req); err != nil {
+			return errhandler.HandleError[payload.Search_Response](span, code, err)
+		}
+	}
+	var attrs []attribute.KeyValue
+	if req.GetConfig().GetWithCursor() {
+		if fusion.Enabled(req.GetConfig().GetFusion()) {
+			err = status.WrapWithInvalidArgument(vald.SearchRPCName+" API invalid cursor argument", errors.ErrSearchCursorWithFusion,
+				&errdetails.RequestInfo{
+					RequestId:   req.GetConfig().GetRequestId(),
+					ServingData: errdetails.Serialize(req),
+				},
+				&errdetails.BadRequest{
+					FieldViolations: []*errdetails.BadRequestFieldViolation{
+						{
+							Field:       "with_cursor",
+							Description: errors.ErrSearchCursorWithFusion.Error(),
+						},
+					},
+				})
+			return errhandler.HandleError[payload.Search_Response](span, codes.InvalidArgument, err)
+		}
+		res, attrs, err = s.searchPage(ctx, &searchCursor{
+			req: req,
+		})
+	} else if rerank {
+		res, attrs, err = s.searchRerank(ctx, req)
+	} else {
+		res, attrs, err = s.doSearch(ctx, req.GetVector(), req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
+			req.Config = fcfg
+			return vc.Search(ctx, req, copts...)
+		})
+	}
 	if err != nil {
 		if attrs != nil && span != nil {
 			span.RecordError(err)
@@ -100,12 +156,28 @@ func (s *server) SearchByID(
 			})
 		return errhandler.HandleError[payload.Search_Response](span, codes.InvalidArgument, err)
 	}
+	if s.cache != nil && req.GetConfig().GetConsistency() != payload.Search_ReadYourWrites {
+		// the search by the id is cached by the id, so the cache hit does not even need to get the vector.
+		key := s.cache.Key(vald.SearchByIDRPCName, []byte(uuid), req.GetConfig())
+		if res, ok := s.cachedResult(key, req.GetConfig().GetRequestId()); ok {
+			return res, nil
+		}
+		defer func() {
+			if err == nil {
+				s.cacheResult(key, res)
+			}
+		}()
+	}
 	vec, err := s.GetObject(ctx, &payload.Object_VectorRequest{
 		Id: &payload.Object_ID{
 			Id: uuid,
 		},
+		Collection: req.GetConfig().GetCollection(),
 	})
 	if err != nil {
+		if isClientError(err) {
+			return nil, err
+		}
 		st, _ := status.FromError(err)
 		if span != nil && st != nil && st.Code() != codes.NotFound {
 			span.RecordError(err)
@@ -114,7 +186,7 @@ func (s *server) SearchByID(
 		}
 		// try search by using agent's SearchByID method this operation is emergency fallback, the search quality is not same as usual SearchByID operation.
 		var attrs []attribute.KeyValue
-		res, attrs, err = s.doSearch(ctx, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
+		res, attrs, err = s.doSearch(ctx, nil, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
 			req.Config = fcfg
 			return vc.SearchByID(ctx, req, copts...)
 		})
@@ -128,13 +200,17 @@ func (s *server) SearchByID(
 		}
 		return nil, err
 	}
-	res, err = s.Search(ctx, &payload.Search_Request{
+	res, err = s.search(ctx, span, &payload.Search_Request{
 		Vector: vec.GetVector(),
 		Config: req.GetConfig(),
 	})
 	if err != nil {
+		if isClientError(err) {
+			// the rejection of the request, e.g., the invalid re-ranking argument, is not recovered by the agents.
+			return nil, err
+		}
 		var attrs []attribute.KeyValue
-		res, attrs, err = s.doSearch(ctx, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
+		res, attrs, err = s.doSearch(ctx, nil, req.GetConfig(), func(ctx context.Context, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
 			req.Config = fcfg
 			return vc.SearchByID(ctx, req, copts...)
 		})
@@ -161,8 +237,48 @@ func (s *server) calculateNum(ctx context.Context, num uint32, ratio float32) (n
 	return n - 1
 }
 
+// searchCacheKey returns the key of the cached response of req, or an empty string when req is not cached.
+// The paginated search is not cached, since its cursor records the positions in the results of the agents answering it.
+// The read-your-writes search is not cached either, since it must return the vectors written after the response is cached.
+func (s *server) searchCacheKey(req *payload.Search_Request) string {
+	if s.cache == nil || req.GetConfig().GetWithCursor() ||
+		req.GetConfig().GetConsistency() == payload.Search_ReadYourWrites {
+		return ""
+	}
+	q, err := (&payload.Search_Request{
+		Vector:     req.GetVector(),
+		SubVectors: req.GetSubVectors(),
+		Sparse:     req.GetSparse(),
+	}).MarshalVT()
+	if err != nil {
+		return ""
+	}
+	return s.cache.Key(vald.SearchRPCName, q, req.GetConfig())
+}
+
+// partitionVector returns the vector to route the request to the partitions, which are trained on the default collection only.
+func partitionVector(collection string, vec []float32) []float32 {
+	if len(collection) != 0 {
+		return nil
+	}
+	return vec
+}
+
+// searchNum returns the number of the results and the number of the results forwarded to each agent calculated by the search ratio.
+func (s *server) searchNum(ctx context.Context, cfg *payload.Search_Config) (num, fnum int) {
+	num = int(cfg.GetNum())
+	if cfg.GetRatio() != nil {
+		fnum = int(s.calculateNum(ctx, cfg.GetNum(), cfg.GetRatio().GetValue()))
+	}
+	if fnum <= 0 {
+		fnum = num
+	}
+	return num, fnum
+}
+
 func (s *server) doSearch(
 	ctx context.Context,
+	vec []float32,
 	cfg *payload.Search_Config,
 	f func(ctx context.Context, cfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error),
 ) (res *payload.Search_Response, attrs []attribute.KeyValue, err error) {
@@ -187,21 +303,20 @@ func (s *server) doSearch(
 		errhandler.RecordSpanError(span, codes.InvalidArgument, err)
 		return nil, nil, err
 	}
+	if err = s.checkCollection(apiName+"/doSearch", cfg.GetCollection(), cfg); err != nil {
+		errhandler.RecordSpanError(span, codes.InvalidArgument, err)
+		return nil, nil, err
+	}
 
 	var (
-		num     = int(cfg.GetNum())
-		fnum    int
-		replica = s.gateway.GetAgentCount(ctx)
+		num, fnum = s.searchNum(ctx, cfg)
+		replica   = s.gateway.GetAgentCount(ctx)
 	)
 
-	if cfg.GetRatio() != nil {
-		fnum = int(s.calculateNum(ctx, cfg.GetNum(), cfg.GetRatio().GetValue()))
-	}
-	if fnum <= 0 {
-		fnum = num
+	if fusion.Enabled(cfg.GetFusion()) {
+		return s.aggregationSearch(ctx, vec, newFusion(cfg.GetFusion(), num, fnum, replica), cfg, f)
 	}
-
-	return s.aggregationSearch(ctx, selectAggregator(cfg.GetAggregationAlgorithm(), num, fnum, replica), cfg, f)
+	return s.aggregationSearch(ctx, vec, selectAggregator(cfg.GetAggregationAlgorithm(), num, fnum, replica), cfg, f)
 }
 
 func selectAggregator(algo payload.Search_AggregationAlgorithm, num, fnum, replica int) Aggregator {
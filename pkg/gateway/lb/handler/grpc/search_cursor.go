@@ -0,0 +1,292 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+	"slices"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/hash"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/errdetails"
+	"github.com/vdaas/vald/internal/net/grpc/errhandler"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/attribute"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/sync"
+)
+
+// searchCursor is the continuation state of the paginated search results.
+// The cursor carries the request itself, so any LB gateway can serve the next page without sharing any state.
+type searchCursor struct {
+	// req is the first request. The num of its config is the page size.
+	req *payload.Search_Request
+	// done holds the sorted hashes of the agent addresses which have no more result.
+	done []uint64
+	// seen holds the IDs of the returned results of the last distance, since the results of the same distance are not ordered.
+	seen []string
+	// returned is the number of the returned results. The cursor of the first page has no returned result.
+	returned uint64
+	// distance is the distance of the last returned result.
+	distance float32
+}
+
+// agentPage is the search results of an agent and the number of the requested results.
+type agentPage struct {
+	results []*payload.Object_Distance
+	num     int
+}
+
+// after reports whether d is not returned yet. Every result is located after the cursor of the first page.
+func (c *searchCursor) after(d *payload.Object_Distance) bool {
+	if c.returned == 0 || d.GetDistance() > c.distance {
+		return true
+	}
+	return d.GetDistance() == c.distance && !slices.Contains(c.seen, d.GetId())
+}
+
+// exhausted reports whether the agent has no more result after the cursor.
+func (c *searchCursor) exhausted(target string) bool {
+	_, ok := slices.BinarySearch(c.done, hash.String(target))
+	return ok
+}
+
+// config returns the search config of the agent, which requests the results located after the cursor.
+// The agent skips the results up to the distance and the seen IDs of the cursor by itself, so the number of the
+// results transferred from the agent does not grow with the depth of the page.
+func (c *searchCursor) config(fcfg *payload.Search_Config) *payload.Search_Config {
+	acfg := fcfg.CloneVT()
+	if c.returned != 0 {
+		distance := c.distance
+		acfg.AfterDistance = &distance
+		acfg.AfterIds = slices.Clone(c.seen)
+	}
+	return acfg
+}
+
+// next returns the cursor located after the last result of the page.
+func (c *searchCursor) next(page []*payload.Object_Distance, pages map[string]agentPage) *searchCursor {
+	last := page[len(page)-1]
+	nc := &searchCursor{
+		req:      c.req,
+		done:     slices.Clone(c.done),
+		returned: c.returned + uint64(len(page)),
+		distance: last.GetDistance(),
+	}
+	if c.returned != 0 && c.distance == nc.distance {
+		nc.seen = slices.Clone(c.seen)
+	}
+	for _, d := range page {
+		if d.GetDistance() == nc.distance {
+			nc.seen = append(nc.seen, d.GetId())
+		}
+	}
+	// the agents which have no more result are not requested again. The agent has no more result when it returned
+	// less results than requested and all of them are returned by the page.
+	for target, p := range pages {
+		if len(p.results) >= p.num || slices.ContainsFunc(p.results, nc.after) {
+			continue
+		}
+		if k := hash.String(target); !slices.Contains(nc.done, k) {
+			nc.done = append(nc.done, k)
+		}
+	}
+	slices.Sort(nc.done)
+	return nc
+}
+
+// encodeSearchCursor encodes the cursor into the opaque string.
+func encodeSearchCursor(c *searchCursor) (string, error) {
+	req, err := c.req.MarshalVT()
+	if err != nil {
+		return "", err
+	}
+	buf := binary.AppendUvarint(make([]byte, 0, len(req)+64), uint64(len(req)))
+	buf = append(buf, req...)
+	buf = binary.BigEndian.AppendUint32(buf, math.Float32bits(c.distance))
+	buf = binary.AppendUvarint(buf, c.returned)
+	buf = binary.AppendUvarint(buf, uint64(len(c.seen)))
+	for _, id := range c.seen {
+		buf = binary.AppendUvarint(buf, uint64(len(id)))
+		buf = append(buf, id...)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(c.done)))
+	for _, k := range c.done {
+		buf = binary.BigEndian.AppendUint64(buf, k)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// decodeSearchCursor decodes the cursor encoded by encodeSearchCursor.
+func decodeSearchCursor(cursor string) (c *searchCursor, err error) {
+	buf, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(buf) == 0 {
+		return nil, errors.ErrInvalidSearchCursor
+	}
+	uvarint := func() uint64 {
+		v, n := binary.Uvarint(buf)
+		if n <= 0 {
+			err = errors.ErrInvalidSearchCursor
+			return 0
+		}
+		buf = buf[n:]
+		return v
+	}
+	bytes := func(l uint64) []byte {
+		if err != nil || uint64(len(buf)) < l {
+			err = errors.ErrInvalidSearchCursor
+			return nil
+		}
+		b := buf[:l]
+		buf = buf[l:]
+		return b
+	}
+	c = &searchCursor{
+		req: new(payload.Search_Request),
+	}
+	if uerr := c.req.UnmarshalVT(bytes(uvarint())); err != nil || uerr != nil || c.req.GetConfig().GetNum() == 0 {
+		return nil, errors.ErrInvalidSearchCursor
+	}
+	if b := bytes(4); err == nil {
+		c.distance = math.Float32frombits(binary.BigEndian.Uint32(b))
+	}
+	c.returned = uvarint()
+	for range min(uvarint(), uint64(len(buf))) {
+		c.seen = append(c.seen, string(bytes(uvarint())))
+	}
+	dn := uvarint()
+	if err != nil || dn > uint64(len(buf))/8 {
+		return nil, errors.ErrInvalidSearchCursor
+	}
+	for range dn {
+		c.done = append(c.done, binary.BigEndian.Uint64(bytes(8)))
+	}
+	if err != nil || len(buf) != 0 || !slices.IsSorted(c.done) {
+		return nil, errors.ErrInvalidSearchCursor
+	}
+	return c, nil
+}
+
+// SearchNext returns the page of the search results following the cursor.
+func (s *server) SearchNext(
+	ctx context.Context, req *payload.Search_NextRequest,
+) (res *payload.Search_Response, err error) {
+	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.SearchRPCServiceName+"/"+vald.SearchNextRPCName), apiName+"/"+vald.SearchNextRPCName)
+	defer trace.End(span)
+	c, err := decodeSearchCursor(req.GetCursor())
+	if err != nil {
+		err = status.WrapWithInvalidArgument(vald.SearchNextRPCName+" API invalid cursor argument", err,
+			&errdetails.RequestInfo{
+				ServingData: errdetails.Serialize(req),
+			},
+			&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequestFieldViolation{
+					{
+						Field:       "cursor",
+						Description: err.Error(),
+					},
+				},
+			})
+		return errhandler.HandleError[payload.Search_Response](span, codes.InvalidArgument, err)
+	}
+	res, attrs, err := s.searchPage(ctx, c)
+	if err != nil {
+		errhandler.RecordSpanAttrs(span, attrs, err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// searchPage returns the page of the search results following the cursor and the cursor of the next page.
+// Each agent is requested the results after the cursor to fill a page, and only the results after the cursor are
+// aggregated by the aggregation algorithm of the request, so the pages follow each other in the distance order.
+func (s *server) searchPage(
+	ctx context.Context, c *searchCursor,
+) (res *payload.Search_Response, attrs []attribute.KeyValue, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "searchPage"), apiName+"/searchPage")
+	defer trace.End(span)
+
+	cfg := c.req.GetConfig()
+	if c.returned != 0 {
+		cfg = cfg.CloneVT()
+		cfg.MinNum = 0
+	}
+	var (
+		num, fnum = s.searchNum(ctx, cfg)
+		replica   = s.gateway.GetAgentCount(ctx)
+		mu        sync.Mutex
+		pages     = make(map[string]agentPage, replica)
+	)
+	res, attrs, err = s.targetAggregationSearch(ctx, c.req.GetVector(), selectAggregator(cfg.GetAggregationAlgorithm(), num, fnum, replica), cfg,
+		func(ctx context.Context, target string, fcfg *payload.Search_Config, vc vald.Client, copts ...grpc.CallOption) (*payload.Search_Response, error) {
+			if c.exhausted(target) {
+				return nil, errors.ErrEmptySearchResult
+			}
+			acfg := c.config(fcfg)
+			r, err := vc.Search(ctx, &payload.Search_Request{
+				Vector:     c.req.GetVector(),
+				Config:     acfg,
+				Sparse:     c.req.GetSparse(),
+				SubVectors: c.req.GetSubVectors(),
+			}, copts...)
+			if err != nil {
+				return nil, err
+			}
+			mu.Lock()
+			pages[target] = agentPage{
+				results: r.GetResults(),
+				num:     int(acfg.GetNum()),
+			}
+			mu.Unlock()
+			results := make([]*payload.Object_Distance, 0, len(r.GetResults()))
+			for _, d := range r.GetResults() {
+				if c.after(d) {
+					results = append(results, d)
+				}
+			}
+			if len(results) == 0 {
+				// do not let the aggregation retry the agent which has no more result.
+				return nil, errors.ErrEmptySearchResult
+			}
+			return &payload.Search_Response{
+				RequestId: r.GetRequestId(),
+				Results:   results,
+			}, nil
+		})
+	if err != nil {
+		return nil, attrs, err
+	}
+	if num == 0 || len(res.GetResults()) < num {
+		return res, attrs, nil
+	}
+	res.NextCursor, err = encodeSearchCursor(c.next(res.GetResults(), pages))
+	if err != nil {
+		err = status.WrapWithInternal("failed to encode the search cursor", err,
+			&errdetails.RequestInfo{
+				RequestId:   cfg.GetRequestId(),
+				ServingData: errdetails.Serialize(cfg),
+			})
+		attrs = trace.StatusCodeInternal(err.Error())
+		errhandler.RecordSpanAttrs(span, attrs, err)
+		return nil, attrs, err
+	}
+	return res, attrs, nil
+}
@@ -0,0 +1,311 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grpc
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/hash"
+	"github.com/vdaas/vald/internal/test/comparator"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+var searchCursorComparators = []comparator.Option{
+	comparator.AllowUnexported(searchCursor{}),
+	comparator.Comparer(func(x, y *payload.Search_Request) bool {
+		return x.EqualVT(y)
+	}),
+}
+
+func sortedHashes(targets ...string) (hashes []uint64) {
+	for _, target := range targets {
+		hashes = append(hashes, hash.String(target))
+	}
+	slices.Sort(hashes)
+	return hashes
+}
+
+func newSearchCursorRequest(num uint32) *payload.Search_Request {
+	return &payload.Search_Request{
+		Vector: []float32{0.1, 0.2, 0.3},
+		Config: &payload.Search_Config{
+			RequestId:  "req",
+			Num:        num,
+			WithCursor: true,
+		},
+	}
+}
+
+func Test_searchCursor_encode(t *testing.T) {
+	type test struct {
+		name   string
+		cursor *searchCursor
+	}
+	tests := []test{
+		{
+			name: "return the same cursor of the first page",
+			cursor: &searchCursor{
+				req: newSearchCursorRequest(10),
+			},
+		},
+		{
+			name: "return the same cursor with the seen ids and the agents which have no more result",
+			cursor: &searchCursor{
+				req:      newSearchCursorRequest(10),
+				done:     sortedHashes("agent-0", "agent-1"),
+				seen:     []string{"a", "b"},
+				returned: 20,
+				distance: 0.5,
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			cursor, err := encodeSearchCursor(test.cursor)
+			if err != nil {
+				tt.Fatalf("encode error = %v", err)
+			}
+			got, err := decodeSearchCursor(cursor)
+			if err != nil {
+				tt.Fatalf("decode error = %v", err)
+			}
+			if diff := comparator.Diff(test.cursor, got, searchCursorComparators...); diff != "" {
+				tt.Errorf("cursor diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_decodeSearchCursor(t *testing.T) {
+	valid, err := encodeSearchCursor(&searchCursor{
+		req:      newSearchCursorRequest(10),
+		done:     sortedHashes("agent-0"),
+		seen:     []string{"a"},
+		returned: 10,
+		distance: 0.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	zero, err := encodeSearchCursor(&searchCursor{
+		req: newSearchCursorRequest(0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{
+			name:   "return error when the cursor is empty",
+			cursor: "",
+		},
+		{
+			name:   "return error when the cursor is not base64",
+			cursor: "!!!",
+		},
+		{
+			name:   "return error when the cursor is truncated",
+			cursor: valid[:len(valid)-4],
+		},
+		{
+			name:   "return error when the cursor has trailing bytes",
+			cursor: valid + "AAAA",
+		},
+		{
+			name:   "return error when the page size of the cursor is 0",
+			cursor: zero,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if _, err := decodeSearchCursor(test.cursor); !errors.Is(err, errors.ErrInvalidSearchCursor) {
+				tt.Errorf("error = %v, want %v", err, errors.ErrInvalidSearchCursor)
+			}
+		})
+	}
+}
+
+func Test_searchCursor_exhausted(t *testing.T) {
+	c := &searchCursor{
+		req:      newSearchCursorRequest(10),
+		done:     sortedHashes("agent-1"),
+		returned: 10,
+	}
+	for target, want := range map[string]bool{
+		"agent-0": false,
+		"agent-1": true,
+	} {
+		if got := c.exhausted(target); got != want {
+			t.Errorf("target %s: got = %v, want %v", target, got, want)
+		}
+	}
+}
+
+func Test_searchCursor_config(t *testing.T) {
+	fcfg := &payload.Search_Config{
+		RequestId: "req",
+		Num:       20,
+	}
+	distance := float32(0.5)
+	tests := []struct {
+		cursor *searchCursor
+		want   *payload.Search_Config
+		name   string
+	}{
+		{
+			name: "return the config without the cursor position for the first page",
+			cursor: &searchCursor{
+				req: newSearchCursorRequest(10),
+			},
+			want: fcfg,
+		},
+		{
+			name: "return the config with the cursor position for the following page",
+			cursor: &searchCursor{
+				req:      newSearchCursorRequest(10),
+				seen:     []string{"a", "b"},
+				returned: 10,
+				distance: distance,
+			},
+			want: &payload.Search_Config{
+				RequestId:     "req",
+				Num:           20,
+				AfterDistance: &distance,
+				AfterIds:      []string{"a", "b"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if got := test.cursor.config(fcfg); !got.EqualVT(test.want) {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_searchCursor_next(t *testing.T) {
+	dist := func(id string, d float32) *payload.Object_Distance {
+		return &payload.Object_Distance{
+			Id:       id,
+			Distance: d,
+		}
+	}
+	req := newSearchCursorRequest(3)
+	tests := []struct {
+		cursor *searchCursor
+		pages  map[string]agentPage
+		want   *searchCursor
+		name   string
+		page   []*payload.Object_Distance
+	}{
+		{
+			name: "return the cursor after the first page",
+			cursor: &searchCursor{
+				req: req,
+			},
+			page: []*payload.Object_Distance{dist("a", 0.1), dist("b", 0.2), dist("c", 0.2)},
+			pages: map[string]agentPage{
+				"agent-0": {
+					results: []*payload.Object_Distance{dist("a", 0.1), dist("c", 0.2), dist("d", 0.2)},
+					num:     3,
+				},
+				"agent-1": {
+					results: []*payload.Object_Distance{dist("b", 0.2)},
+					num:     3,
+				},
+			},
+			want: &searchCursor{
+				req:      req,
+				done:     sortedHashes("agent-1"),
+				seen:     []string{"b", "c"},
+				returned: 3,
+				distance: 0.2,
+			},
+		},
+		{
+			name: "return the cursor keeping the seen ids of the same distance and the agents which have no more result",
+			cursor: &searchCursor{
+				req:      req,
+				done:     sortedHashes("agent-1"),
+				seen:     []string{"b", "c"},
+				returned: 3,
+				distance: 0.2,
+			},
+			page: []*payload.Object_Distance{dist("d", 0.2), dist("e", 0.2), dist("f", 0.2)},
+			pages: map[string]agentPage{
+				"agent-0": {
+					results: []*payload.Object_Distance{dist("a", 0.1), dist("c", 0.2), dist("d", 0.2), dist("e", 0.2), dist("f", 0.2)},
+					num:     5,
+				},
+			},
+			want: &searchCursor{
+				req:      req,
+				done:     sortedHashes("agent-1"),
+				seen:     []string{"b", "c", "d", "e", "f"},
+				returned: 6,
+				distance: 0.2,
+			},
+		},
+		{
+			name: "return the cursor keeping the agent which has the result after the cursor",
+			cursor: &searchCursor{
+				req: req,
+			},
+			page: []*payload.Object_Distance{dist("a", 0.1), dist("b", 0.2), dist("c", 0.3)},
+			pages: map[string]agentPage{
+				"agent-0": {
+					results: []*payload.Object_Distance{dist("a", 0.1), dist("c", 0.3)},
+					num:     3,
+				},
+				"agent-1": {
+					results: []*payload.Object_Distance{dist("b", 0.2), dist("d", 0.4)},
+					num:     3,
+				},
+			},
+			want: &searchCursor{
+				req:      req,
+				done:     sortedHashes("agent-0"),
+				seen:     []string{"c"},
+				returned: 3,
+				distance: 0.3,
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			got := test.cursor.next(test.page, test.pages)
+			if diff := comparator.Diff(test.want, got, searchCursorComparators...); diff != "" {
+				tt.Errorf("cursor diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,7 +23,6 @@ import (
 
 	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/apis/grpc/v1/vald"
-	"github.com/vdaas/vald/internal/conv"
 	"github.com/vdaas/vald/internal/core/algorithm"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/info"
@@ -46,6 +45,10 @@ func (s *server) Update(
 ) (res *payload.Object_Location, err error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.UpdateRPCServiceName+"/"+vald.UpdateRPCName), apiName+"/"+vald.UpdateRPCName)
 	defer trace.End(span)
+	if err = s.checkCollection(vald.UpdateRPCName, req.GetConfig().GetCollection(), req); err != nil {
+		return errhandler.HandleError[payload.Object_Location](span, codes.InvalidArgument, err)
+	}
+	defer s.invalidateCache()
 	uuid := req.GetVector().GetId()
 	reqInfo := &errdetails.RequestInfo{
 		RequestId:   uuid,
@@ -68,8 +71,7 @@ func (s *server) Update(
 			})
 		return errhandler.HandleError[payload.Object_Location](span, codes.InvalidArgument, err)
 	}
-	vec := req.GetVector().GetVector()
-	vl := len(vec)
+	vl := vectorDimension(req.GetVector().GetVector(), req.GetVector().GetSubVectors())
 	if vl < algorithm.MinimumVectorDimensionSize {
 		err = errors.ErrInvalidDimensionSize(vl, 0)
 		err = status.WrapWithInvalidArgument(vald.UpdateRPCName+" API invalid vector argument", err, reqInfo, resInfo,
@@ -96,7 +98,7 @@ func (s *server) Update(
 				Ips:  make([]string, 0, s.replica),
 			}
 		)
-		err = s.gateway.BroadCast(ctx, service.WRITE, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
+		err = s.gateway.BroadCastByID(ctx, uuid, service.WRITE, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
 			ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "BroadCast/"+target), apiName+"/"+vald.UpdateRPCName+"/"+target)
 			defer trace.End(span)
 			loc, err := vc.Update(ctx, req, copts...)
@@ -159,7 +161,7 @@ func (s *server) Update(
 			return errhandler.HandleError[payload.Object_Location](span, codes.NotFound, err)
 		case updated.Load()+aeCount.Load() < uint64(s.replica):
 			shortage := s.replica - int(updated.Load()+aeCount.Load())
-			err = s.gateway.DoMulti(ctx, shortage, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
+			err = s.gateway.DoMultiByVector(ctx, uuid, partitionVector(req.GetConfig().GetCollection(), req.GetVector().GetVector()), shortage, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
 				mu.RLock()
 				tf, ok := visited[target]
 				mu.RUnlock()
@@ -174,6 +176,8 @@ func (s *server) Update(
 						SkipStrictExistCheck: true,
 						Filters:              req.GetConfig().GetFilters(),
 						Timestamp:            req.GetConfig().GetTimestamp(),
+						Attributes:           req.GetConfig().GetAttributes(),
+						Collection:           req.GetConfig().GetCollection(),
 					},
 				}, copts...)
 				if err != nil {
@@ -195,7 +199,7 @@ func (s *server) Update(
 				return nil
 			})
 		case updated.Load() == 0 && aeCount.Load() > 0:
-			err = errors.ErrSameVectorAlreadyExists(uuid, vec, vec)
+			err = errors.ErrSameVectorAlreadyExists(uuid, req.GetVector().GetVector(), req.GetVector().GetVector())
 			err = status.WrapWithAlreadyExists(vald.UpdateRPCName+" API update target same vector already exists", err, reqInfo, resInfo)
 			return errhandler.HandleError[payload.Object_Location](span, codes.AlreadyExists, err)
 
@@ -210,13 +214,14 @@ func (s *server) Update(
 			Id: &payload.Object_ID{
 				Id: uuid,
 			},
+			Collection: req.GetConfig().GetCollection(),
 		})
 		if err != nil {
 			st, _ := status.FromError(err)
 			errhandler.RecordSpanStatus(span, st, err)
 			return nil, err
 		}
-		if conv.F32stos(vec.GetVector()) == conv.F32stos(req.GetVector().GetVector()) {
+		if isSameVector(vec, req.GetVector()) {
 			if vec.GetTimestamp() < req.GetVector().GetTimestamp() {
 				return s.UpdateTimestamp(ctx, &payload.Update_TimestampRequest{
 					Id:        uuid,
@@ -261,6 +266,7 @@ func (s *server) Update(
 		Config: &payload.Remove_Config{
 			SkipStrictExistCheck: true,
 			Timestamp:            now,
+			Collection:           req.GetConfig().GetCollection(),
 		},
 	}
 	res, err = s.Remove(ctx, rreq)
@@ -289,6 +295,8 @@ func (s *server) Update(
 			SkipStrictExistCheck: true,
 			Filters:              req.GetConfig().GetFilters(),
 			Timestamp:            now,
+			Attributes:           req.GetConfig().GetAttributes(),
+			Collection:           req.GetConfig().GetCollection(),
 		},
 	}
 	res, err = s.Insert(ctx, ireq)
@@ -358,7 +366,7 @@ func (s *server) MultiUpdate(
 		Locations: make([]*payload.Object_Location, len(reqs.GetRequests())),
 	}
 	for i, r := range reqs.GetRequests() {
-		if r != nil && r.GetVector() != nil && len(r.GetVector().GetVector()) >= algorithm.MinimumVectorDimensionSize && r.GetVector().GetId() != "" {
+		if r != nil && r.GetVector() != nil && vectorDimension(r.GetVector().GetVector(), r.GetVector().GetSubVectors()) >= algorithm.MinimumVectorDimensionSize && r.GetVector().GetId() != "" {
 			idx := i
 			req := r
 			eg.Go(safety.RecoverFunc(func() (err error) {
@@ -388,8 +396,8 @@ func (s *server) MultiUpdate(
 				field string
 			)
 			switch {
-			case r.GetVector() == nil, len(r.GetVector().GetVector()) < algorithm.MinimumVectorDimensionSize:
-				err = errors.ErrInvalidDimensionSize(len(r.GetVector().GetVector()), 0)
+			case r.GetVector() == nil, vectorDimension(r.GetVector().GetVector(), r.GetVector().GetSubVectors()) < algorithm.MinimumVectorDimensionSize:
+				err = errors.ErrInvalidDimensionSize(vectorDimension(r.GetVector().GetVector(), r.GetVector().GetSubVectors()), 0)
 				field = "vector"
 			case r.GetVector().GetId() == "":
 				err = errors.ErrInvalidUUID(r.GetVector().GetId())
@@ -446,6 +454,10 @@ func (s *server) UpdateTimestamp(
 ) (res *payload.Object_Location, err error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.UpdateRPCServiceName+"/"+vald.UpdateTimestampRPCName), apiName+"/"+vald.UpdateTimestampRPCName)
 	defer trace.End(span)
+	if err = s.checkCollection(vald.UpdateTimestampRPCName, req.GetCollection(), req); err != nil {
+		return errhandler.HandleError[payload.Object_Location](span, codes.InvalidArgument, err)
+	}
+	defer s.invalidateCache()
 	uuid := req.GetId()
 	reqInfo := &errdetails.RequestInfo{
 		RequestId:   uuid,
@@ -493,7 +505,7 @@ func (s *server) UpdateTimestamp(
 			Ips:  make([]string, 0, s.replica),
 		}
 	)
-	err = s.gateway.BroadCast(ctx, service.WRITE, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
+	err = s.gateway.BroadCastByID(ctx, uuid, service.WRITE, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
 		ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "BroadCast/"+target), apiName+"/"+vald.UpdateTimestampRPCName+"/"+target)
 		defer trace.End(span)
 		loc, err := vc.UpdateTimestamp(ctx, req, copts...)
@@ -555,6 +567,7 @@ func (s *server) UpdateTimestamp(
 			Id: &payload.Object_ID{
 				Id: uuid,
 			},
+			Collection: req.GetCollection(),
 		})
 		if err != nil {
 			st, _ := status.FromError(err)
@@ -562,7 +575,7 @@ func (s *server) UpdateTimestamp(
 			return nil, err
 		}
 
-		err = s.gateway.DoMulti(ctx, shortage, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
+		err = s.gateway.DoMultiByID(ctx, uuid, shortage, func(ctx context.Context, target string, vc vald.Client, copts ...grpc.CallOption) (err error) {
 			mu.RLock()
 			tf, ok := visited[target]
 			mu.RUnlock()
@@ -576,6 +589,7 @@ func (s *server) UpdateTimestamp(
 				Config: &payload.Insert_Config{
 					SkipStrictExistCheck: true,
 					Timestamp:            ts,
+					Collection:           req.GetCollection(),
 				},
 			}, copts...)
 			if err != nil {
@@ -20,7 +20,6 @@ import (
 
 	"github.com/vdaas/vald/apis/grpc/v1/payload"
 	"github.com/vdaas/vald/apis/grpc/v1/vald"
-	"github.com/vdaas/vald/internal/conv"
 	"github.com/vdaas/vald/internal/core/algorithm"
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/info"
@@ -40,6 +39,10 @@ func (s *server) Upsert(
 ) (loc *payload.Object_Location, err error) {
 	ctx, span := trace.StartSpan(grpc.WithGRPCMethod(ctx, vald.PackageName+"."+vald.UpsertRPCServiceName+"/"+vald.UpsertRPCName), apiName+"/"+vald.UpsertRPCName)
 	defer trace.End(span)
+	if err = s.checkCollection(vald.UpsertRPCName, req.GetConfig().GetCollection(), req); err != nil {
+		return errhandler.HandleError[payload.Object_Location](span, codes.InvalidArgument, err)
+	}
+	defer s.invalidateCache()
 
 	vec := req.GetVector()
 	uuid := vec.GetId()
@@ -64,7 +67,7 @@ func (s *server) Upsert(
 		return errhandler.HandleError[payload.Object_Location](span, codes.InvalidArgument, err)
 	}
 
-	vl := len(vec.GetVector())
+	vl := vectorDimension(vec.GetVector(), vec.GetSubVectors())
 	if vl < algorithm.MinimumVectorDimensionSize {
 		err = errors.ErrInvalidDimensionSize(vl, 0)
 		err = status.WrapWithInvalidArgument(vald.UpsertRPCName+" API invalid vector argument", err, reqInfo, resInfo,
@@ -84,6 +87,7 @@ func (s *server) Upsert(
 			Id: &payload.Object_ID{
 				Id: uuid,
 			},
+			Collection: req.GetConfig().GetCollection(),
 		})
 		var attrs trace.Attributes
 		if err != nil || vec == nil {
@@ -95,7 +99,7 @@ func (s *server) Upsert(
 					err = nil
 				}
 			}
-		} else if conv.F32stos(vec.GetVector()) == conv.F32stos(req.GetVector().GetVector()) {
+		} else if isSameVector(vec, req.GetVector()) {
 			err = status.WrapWithAlreadyExists(
 				vald.GetObjectRPCName+" API for "+vald.UpsertRPCName+" API ID = "+uuid+"'s same vector data already exists",
 				errors.ErrSameVectorAlreadyExists(uuid, vec.GetVector(), req.GetVector().GetVector()),
@@ -109,7 +113,7 @@ func (s *server) Upsert(
 			return nil, err
 		}
 	} else {
-		id, err := s.exists(ctx, uuid)
+		id, err := s.exists(ctx, uuid, req.GetConfig().GetCollection())
 		if err != nil {
 			var attrs trace.Attributes
 			switch {
@@ -142,6 +146,8 @@ func (s *server) Upsert(
 				SkipStrictExistCheck: true,
 				Filters:              req.GetConfig().GetFilters(),
 				Timestamp:            req.GetConfig().GetTimestamp(),
+				Attributes:           req.GetConfig().GetAttributes(),
+				Collection:           req.GetConfig().GetCollection(),
 			},
 		})
 	} else {
@@ -153,6 +159,8 @@ func (s *server) Upsert(
 				Filters:               req.GetConfig().GetFilters(),
 				Timestamp:             req.GetConfig().GetTimestamp(),
 				DisableBalancedUpdate: req.GetConfig().GetDisableBalancedUpdate(),
+				Attributes:            req.GetConfig().GetAttributes(),
+				Collection:            req.GetConfig().GetCollection(),
 			},
 		})
 	}
@@ -226,7 +234,7 @@ func (s *server) MultiUpsert(
 		Locations: make([]*payload.Object_Location, len(reqs.GetRequests())),
 	}
 	for i, r := range reqs.GetRequests() {
-		if r != nil && r.GetVector() != nil && len(r.GetVector().GetVector()) >= algorithm.MinimumVectorDimensionSize && r.GetVector().GetId() != "" {
+		if r != nil && r.GetVector() != nil && vectorDimension(r.GetVector().GetVector(), r.GetVector().GetSubVectors()) >= algorithm.MinimumVectorDimensionSize && r.GetVector().GetId() != "" {
 			idx := i
 			req := r
 			eg.Go(safety.RecoverFunc(func() (err error) {
@@ -256,8 +264,8 @@ func (s *server) MultiUpsert(
 				field string
 			)
 			switch {
-			case r.GetVector() == nil, len(r.GetVector().GetVector()) < algorithm.MinimumVectorDimensionSize:
-				err = errors.ErrInvalidDimensionSize(len(r.GetVector().GetVector()), 0)
+			case r.GetVector() == nil, vectorDimension(r.GetVector().GetVector(), r.GetVector().GetSubVectors()) < algorithm.MinimumVectorDimensionSize:
+				err = errors.ErrInvalidDimensionSize(vectorDimension(r.GetVector().GetVector(), r.GetVector().GetSubVectors()), 0)
 				field = "vector"
 			case r.GetVector().GetId() == "":
 				err = errors.ErrInvalidUUID(r.GetVector().GetId())
@@ -34,6 +34,8 @@ type Handler interface {
 	LinearSearchByID(w http.ResponseWriter, r *http.Request) (int, error)
 	MultiLinearSearch(w http.ResponseWriter, r *http.Request) (int, error)
 	MultiLinearSearchByID(w http.ResponseWriter, r *http.Request) (int, error)
+	RangeSearch(w http.ResponseWriter, r *http.Request) (int, error)
+	SearchNext(w http.ResponseWriter, r *http.Request) (int, error)
 	Insert(w http.ResponseWriter, r *http.Request) (int, error)
 	MultiInsert(w http.ResponseWriter, r *http.Request) (int, error)
 	Update(w http.ResponseWriter, r *http.Request) (int, error)
@@ -124,6 +126,20 @@ func (h *handler) MultiLinearSearchByID(
 	})
 }
 
+func (h *handler) RangeSearch(w http.ResponseWriter, r *http.Request) (code int, err error) {
+	var req *payload.Search_RangeRequest
+	return json.Handler(w, r, &req, func() (any, error) {
+		return h.vald.RangeSearch(r.Context(), req)
+	})
+}
+
+func (h *handler) SearchNext(w http.ResponseWriter, r *http.Request) (code int, err error) {
+	var req *payload.Search_NextRequest
+	return json.Handler(w, r, &req, func() (any, error) {
+		return h.vald.SearchNext(r.Context(), req)
+	})
+}
+
 func (h *handler) Insert(w http.ResponseWriter, r *http.Request) (code int, err error) {
 	var req *payload.Insert_Request
 	return json.Handler(w, r, &req, func() (any, error) {
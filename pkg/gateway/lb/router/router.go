@@ -79,6 +79,22 @@ func New(opts ...Option) http.Handler {
 				Pattern:     "/search/multi/{id}",
 				HandlerFunc: h.MultiSearchByID,
 			},
+			{
+				Name: "Range Search",
+				Methods: []string{
+					http.MethodPost,
+				},
+				Pattern:     "/search/range",
+				HandlerFunc: h.RangeSearch,
+			},
+			{
+				Name: "Search Next",
+				Methods: []string{
+					http.MethodPost,
+				},
+				Pattern:     "/search/next",
+				HandlerFunc: h.SearchNext,
+			},
 			{
 				Name: "Insert",
 				Methods: []string{
@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"context"
+	"encoding/binary"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/cache"
+	"github.com/vdaas/vald/internal/cache/cacher"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/zeebo/xxh3"
+)
+
+// ResultCache caches the search responses of the LB gateway for the TTL.
+type ResultCache interface {
+	Start(ctx context.Context)
+	// Key returns the key of the search response for the query of the kind and the search config.
+	// The key changes when Invalidate is called, so the responses cached before it are never returned again.
+	Key(kind string, query []byte, cfg *payload.Search_Config) string
+	Get(key string) (*payload.Search_Response, bool)
+	// Set caches res unless the cached responses reach the max memory.
+	Set(key string, res *payload.Search_Response)
+	// Invalidate advances the generation of the cache, which invalidates all cached responses.
+	Invalidate()
+	Stats() ResultCacheStats
+}
+
+// ResultCacheStats is the statistics of ResultCache.
+type ResultCacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int64
+	Bytes   int64
+}
+
+// cachedResultOverhead is the approximate bytes used by an entry of the cache besides the key and the response.
+const cachedResultOverhead = 64
+
+type cachedResult struct {
+	res  *payload.Search_Response
+	size int64
+}
+
+type resultCache struct {
+	cache cacher.Cache[*cachedResult]
+	// mu serializes Set, so the concurrent searches of the same key do not count the entry twice.
+	mu  sync.Mutex
+	ttl time.Duration
+	// maxBytes is the max memory of the cached responses, which is unlimited when it is 0.
+	maxBytes   int64
+	generation atomic.Uint64
+	entries    atomic.Int64
+	bytes      atomic.Int64
+	hits       atomic.Uint64
+	misses     atomic.Uint64
+}
+
+// NewResultCache returns the ResultCache.
+func NewResultCache(opts ...ResultCacheOption) (ResultCache, error) {
+	c := new(resultCache)
+	for _, opt := range append(defaultResultCacheOpts, opts...) {
+		if err := opt(c); err != nil {
+			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+		}
+	}
+	var err error
+	c.cache, err = cache.New(
+		cache.WithType[*cachedResult](cacher.GACHE.String()),
+		cache.WithExpireDuration[*cachedResult](c.ttl.String()),
+		cache.WithExpireCheckDuration[*cachedResult](c.ttl.String()),
+		cache.WithExpiredHook(func(_ context.Context, _ string, cr *cachedResult) {
+			c.entries.Add(-1)
+			c.bytes.Add(-cr.size)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Start starts deleting the expired responses, which is required to release the memory of them.
+func (c *resultCache) Start(ctx context.Context) {
+	c.cache.Start(ctx)
+}
+
+func (c *resultCache) Key(kind string, query []byte, cfg *payload.Search_Config) string {
+	if cfg != nil {
+		// the request ID is unique to each request, which does not change the response.
+		cfg = cfg.CloneVT()
+		cfg.RequestId = ""
+	}
+	b, err := cfg.MarshalVT()
+	if err != nil {
+		b = nil
+	}
+	h := xxh3.New()
+	var l [8]byte
+	for _, p := range [][]byte{[]byte(kind), query, b} {
+		binary.LittleEndian.PutUint64(l[:], uint64(len(p)))
+		h.Write(l[:])
+		h.Write(p)
+	}
+	sum := h.Sum128()
+	return strconv.FormatUint(c.generation.Load(), 10) + ":" +
+		strconv.FormatUint(sum.Hi, 16) + strconv.FormatUint(sum.Lo, 16)
+}
+
+func (c *resultCache) Get(key string) (*payload.Search_Response, bool) {
+	cr, ok := c.cache.Get(key)
+	if !ok || cr == nil {
+		c.misses.Add(1)
+		return nil, false
+	}
+	c.hits.Add(1)
+	// the caller may modify the response, which is shared by the following hits.
+	return cr.res.CloneVT(), true
+}
+
+func (c *resultCache) Set(key string, res *payload.Search_Response) {
+	if res == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the expired entry is deleted by Get with the expired hook, so Set never overwrites the counted entry.
+	if _, ok := c.cache.Get(key); ok {
+		// the concurrent search has already cached the response.
+		return
+	}
+	size := int64(res.SizeVT() + len(key) + cachedResultOverhead)
+	if c.maxBytes > 0 && c.bytes.Add(size) > c.maxBytes {
+		c.bytes.Add(-size)
+		return
+	}
+	if c.maxBytes <= 0 {
+		c.bytes.Add(size)
+	}
+	c.entries.Add(1)
+	c.cache.Set(key, &cachedResult{
+		res:  res.CloneVT(),
+		size: size,
+	})
+}
+
+func (c *resultCache) Invalidate() {
+	c.generation.Add(1)
+}
+
+func (c *resultCache) Stats() ResultCacheStats {
+	return ResultCacheStats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Entries: c.entries.Load(),
+		Bytes:   c.bytes.Load(),
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/timeutil"
+	"github.com/vdaas/vald/internal/unit"
+)
+
+// ResultCacheOption represents the functional option for ResultCache.
+type ResultCacheOption func(c *resultCache) error
+
+var defaultResultCacheOpts = []ResultCacheOption{
+	WithResultCacheTTL("30s"),
+	WithResultCacheMaxMemory("256MB"),
+}
+
+// WithResultCacheTTL returns the option to set the duration to keep the search responses in the cache.
+func WithResultCacheTTL(dur string) ResultCacheOption {
+	return func(c *resultCache) error {
+		if len(dur) == 0 {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return errors.NewErrInvalidOption("resultCacheTTL", dur, err)
+		}
+		if d <= 0 {
+			return errors.NewErrInvalidOption("resultCacheTTL", dur)
+		}
+		c.ttl = d
+		return nil
+	}
+}
+
+// WithResultCacheMaxMemory returns the option to set the max memory of the search responses in the cache, e.g. 256MB.
+// The memory is unlimited when it is 0.
+func WithResultCacheMaxMemory(size string) ResultCacheOption {
+	return func(c *resultCache) error {
+		if len(size) == 0 {
+			return nil
+		}
+		b, err := unit.ParseBytes(size)
+		if err != nil {
+			return errors.NewErrInvalidOption("resultCacheMaxMemory", size, err)
+		}
+		c.maxBytes = int64(b)
+		return nil
+	}
+}
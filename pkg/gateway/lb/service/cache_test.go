@@ -0,0 +1,173 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func Test_resultCache(t *testing.T) {
+	res := &payload.Search_Response{
+		Results: []*payload.Object_Distance{
+			{Id: "a", Distance: 0.1},
+			{Id: "b", Distance: 0.2},
+		},
+	}
+	cfg := &payload.Search_Config{
+		RequestId: "1",
+		Num:       10,
+	}
+	type want struct {
+		hit   bool
+		stats ResultCacheStats
+	}
+	tests := []struct {
+		name      string
+		maxMemory string
+		// do caches res and returns the key to get it.
+		do   func(c ResultCache) string
+		want want
+	}{
+		{
+			name: "return the response cached for the same query with another request id",
+			do: func(c ResultCache) string {
+				c.Set(c.Key("Search", []byte("q"), cfg), res)
+				return c.Key("Search", []byte("q"), &payload.Search_Config{
+					RequestId: "2",
+					Num:       10,
+				})
+			},
+			want: want{
+				hit: true,
+				stats: ResultCacheStats{
+					Hits:    1,
+					Entries: 1,
+				},
+			},
+		},
+		{
+			name: "return nothing for the query with another config",
+			do: func(c ResultCache) string {
+				c.Set(c.Key("Search", []byte("q"), cfg), res)
+				return c.Key("Search", []byte("q"), &payload.Search_Config{
+					Num: 5,
+				})
+			},
+			want: want{
+				stats: ResultCacheStats{
+					Misses:  1,
+					Entries: 1,
+				},
+			},
+		},
+		{
+			name: "return nothing for the same query of another kind",
+			do: func(c ResultCache) string {
+				c.Set(c.Key("Search", []byte("q"), cfg), res)
+				return c.Key("SearchByID", []byte("q"), cfg)
+			},
+			want: want{
+				stats: ResultCacheStats{
+					Misses:  1,
+					Entries: 1,
+				},
+			},
+		},
+		{
+			name: "return nothing after the cache is invalidated",
+			do: func(c ResultCache) string {
+				key := c.Key("Search", []byte("q"), cfg)
+				c.Set(key, res)
+				c.Invalidate()
+				// the search which started before the invalidation does not cache the response for the new key.
+				c.Set(key, res)
+				return c.Key("Search", []byte("q"), cfg)
+			},
+			want: want{
+				stats: ResultCacheStats{
+					Misses:  1,
+					Entries: 1,
+				},
+			},
+		},
+		{
+			name: "count the response once when the concurrent searches cache it",
+			do: func(c ResultCache) string {
+				key := c.Key("Search", []byte("q"), cfg)
+				var wg sync.WaitGroup
+				for range 16 {
+					wg.Go(func() {
+						c.Set(key, res)
+					})
+				}
+				wg.Wait()
+				return key
+			},
+			want: want{
+				hit: true,
+				stats: ResultCacheStats{
+					Hits:    1,
+					Entries: 1,
+				},
+			},
+		},
+		{
+			name:      "return nothing when the response exceeds the max memory",
+			maxMemory: "16B",
+			do: func(c ResultCache) string {
+				key := c.Key("Search", []byte("q"), cfg)
+				c.Set(key, res)
+				return key
+			},
+			want: want{
+				stats: ResultCacheStats{
+					Misses: 1,
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			c, err := NewResultCache(WithResultCacheMaxMemory(test.maxMemory))
+			if err != nil {
+				tt.Fatal(err)
+			}
+			got, ok := c.Get(test.do(c))
+			if ok != test.want.hit {
+				tt.Errorf("hit = %v, want %v", ok, test.want.hit)
+			}
+			if ok && (got == res || len(got.GetResults()) != len(res.GetResults())) {
+				tt.Errorf("got = %v, want a copy of %v", got, res)
+			}
+			st := c.Stats()
+			// the bytes are approximate, so only whether any response is cached is checked.
+			if (st.Bytes != 0) != (st.Entries != 0) {
+				tt.Errorf("bytes = %d, entries = %d", st.Bytes, st.Entries)
+			}
+			st.Bytes = 0
+			if st != test.want.stats {
+				tt.Errorf("stats = %+v, want %+v", st, test.want.stats)
+			}
+		})
+	}
+}
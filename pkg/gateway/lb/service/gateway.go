@@ -19,6 +19,7 @@ import (
 	"context"
 	"reflect"
 	"sync/atomic"
+	"time"
 
 	"github.com/vdaas/vald/apis/grpc/v1/vald"
 	"github.com/vdaas/vald/internal/client/v1/client/discoverer"
@@ -26,6 +27,7 @@ import (
 	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/net/grpc"
 	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/internal/sync"
 	"github.com/vdaas/vald/internal/sync/errgroup"
 )
@@ -36,8 +38,26 @@ type Gateway interface {
 	Addrs(ctx context.Context) []string
 	DoMulti(ctx context.Context, num int,
 		f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error) error
+	// DoMultiByID is the same as DoMulti except that only the agents owning the id are used under the rendezvous placement.
+	DoMultiByID(ctx context.Context, id string, num int,
+		f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error) error
 	BroadCast(ctx context.Context, kind BroadCastKind,
 		f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error) error
+	// BroadCastByID is the same as BroadCast except that only the agents owning the id are called under the rendezvous placement.
+	BroadCastByID(ctx context.Context, id string, kind BroadCastKind,
+		f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error) error
+	// DoMultiByVector is the same as DoMultiByID except that the agents owning the partition nearest to vec are used first
+	// when the partition routing is enabled.
+	DoMultiByVector(ctx context.Context, id string, vec []float32, num int,
+		f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error) error
+	// BroadCastByVector is the same as BroadCast except that only the agents owning the nprobe partitions nearest to vec
+	// are called when the partition routing is enabled.
+	BroadCastByVector(ctx context.Context, vec []float32, nprobe int, kind BroadCastKind,
+		f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error) error
+	// BroadCastSearch is the same as BroadCastByVector with READ except that it stops waiting for the slow agents once the
+	// other agents cover the searched vectors and done returns true, and returns the addresses of the agents not waited for.
+	BroadCastSearch(ctx context.Context, vec []float32, nprobe int, done func() bool,
+		f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error) (skipped []string, err error)
 }
 
 type BroadCastKind int
@@ -48,22 +68,74 @@ const (
 )
 
 type gateway struct {
-	client discoverer.Client
-	eg     errgroup.Group
+	client    discoverer.Client
+	eg        errgroup.Group
+	placement Placement
+	replica   int
+	// rebalanceDuration is the interval to check the changes of the agents to rebalance the vectors under the rendezvous placement.
+	rebalanceDuration time.Duration
+	// rebalancer is the random ID of the gateway to hold the lease of the rebalancing.
+	rebalancer uint64
+
+	// partitions is the number of the partitions of the partition routing, which is disabled when it is 0.
+	partitions int
+	// nprobe is the number of the partitions searched when the request does not specify it.
+	nprobe int
+	// trainPartition enables training the partition table and migrating the vectors on this gateway.
+	trainPartition  bool
+	trainSampleSize int
+	// imbalanceRatio is the ratio of the vectors of the largest agent to the average to retrain the partition table.
+	imbalanceRatio         float64
+	partitionCheckDuration time.Duration
+	tables                 atomic.Pointer[partitionTables]
+	// loadedAt is the time in unix nanoseconds when the last successful reload of the partition tables started.
+	loadedAt atomic.Int64
+	// trainedTotal is the number of the vectors when the partition table is trained last time on this gateway.
+	trainedTotal atomic.Uint64
+
+	// skipStragglers stops waiting for the slow agents of the search once the other agents cover the searched vectors.
+	skipStragglers bool
+	// hedge calls the agents of the search holding the same vectors one by one after the hedge delay.
+	hedge bool
+	// hedgePercentile is the percentile of the latencies of the agent used as the hedge delay.
+	hedgePercentile float64
+	hedgeMinDelay   time.Duration
+	latencies       latencies
 }
 
 func NewGateway(opts ...Option) (gw Gateway, err error) {
-	g := new(gateway)
+	g := &gateway{
+		rebalancer: newRebalancer(),
+	}
 	for _, opt := range append(defaultGWOpts, opts...) {
 		if err := opt(g); err != nil {
 			return nil, errors.ErrOptionFailed(err, reflect.ValueOf(opt))
 		}
 	}
+	if g.partitions > 0 && g.placement == RendezvousPlacement {
+		return nil, errors.ErrPartitionWithRendezvousPlacement
+	}
 	return g, nil
 }
 
 func (g *gateway) Start(ctx context.Context) (<-chan error, error) {
-	return g.client.Start(ctx)
+	ech, err := g.client.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if g.placement == RendezvousPlacement && g.rebalanceDuration > 0 {
+		g.eg.Go(safety.RecoverFunc(func() error {
+			g.startRebalance(ctx)
+			return nil
+		}))
+	}
+	if g.partitions > 0 {
+		g.eg.Go(safety.RecoverFunc(func() error {
+			g.startPartition(ctx)
+			return nil
+		}))
+	}
+	return ech, nil
 }
 
 func (g *gateway) BroadCast(
@@ -102,6 +174,35 @@ func (g *gateway) BroadCast(
 	})
 }
 
+// BroadCastByID calls f with the agents owning the id under the rendezvous placement, and with all agents otherwise.
+// The owners are called via the primary agent client even for READ, since the read replica client is not addressable by agent.
+func (g *gateway) BroadCastByID(
+	ctx context.Context,
+	id string,
+	kind BroadCastKind,
+	f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error,
+) (err error) {
+	if g.placement != RendezvousPlacement {
+		return g.BroadCast(ctx, kind, f)
+	}
+	fctx, span := trace.StartSpan(ctx, "vald/gateway-lb/service/Gateway.BroadCastByID")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return g.client.GetClient().OrderedRangeConcurrent(fctx, g.owners(fctx, id), -1, func(ictx context.Context,
+		addr string, conn *grpc.ClientConn, copts ...grpc.CallOption,
+	) (err error) {
+		select {
+		case <-ictx.Done():
+			return nil
+		default:
+			return f(ictx, addr, vc.NewFromConn(conn), copts...)
+		}
+	})
+}
+
 func (g *gateway) DoMulti(
 	ctx context.Context,
 	num int,
@@ -113,8 +214,101 @@ func (g *gateway) DoMulti(
 			span.End()
 		}
 	}()
+	return g.doMulti(sctx, g.client.GetAddrs(sctx), num, f)
+}
+
+// DoMultiByID calls f with num agents among the owners of the id under the rendezvous placement. It does not fall
+// through to the other agents when an owner fails, since the requests for the id are sent to its owners only and the
+// vector written to a non-owner would not be found. It is the same as DoMulti otherwise.
+func (g *gateway) DoMultiByID(
+	ctx context.Context,
+	id string,
+	num int,
+	f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error,
+) (err error) {
+	if g.placement != RendezvousPlacement {
+		return g.DoMulti(ctx, num, f)
+	}
+	sctx, span := trace.StartSpan(ctx, "vald/gateway-lb/service/Gateway.DoMultiByID")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return g.doMulti(sctx, g.owners(sctx, id), num, f)
+}
+
+// DoMultiByVector calls f with num agents in the order of the rendezvous hashing score of the partition nearest to vec
+// when the partition routing is enabled and the partition table is trained. It is the same as DoMultiByID otherwise.
+// It returns the Unavailable error without calling f when the partition tables are stale, since the vector may be
+// routed by the outdated table which is being migrated.
+func (g *gateway) DoMultiByVector(
+	ctx context.Context,
+	id string,
+	vec []float32,
+	num int,
+	f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error,
+) (err error) {
+	sctx, span := trace.StartSpan(ctx, "vald/gateway-lb/service/Gateway.DoMultiByVector")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	addrs, err := g.partitionOrder(sctx, vec)
+	if err != nil {
+		return err
+	}
+	if addrs == nil {
+		return g.DoMultiByID(sctx, id, num, f)
+	}
+	return g.doMulti(sctx, addrs, num, f)
+}
+
+// BroadCastByVector calls f with the agents owning the nprobe partitions nearest to vec when the partition routing is
+// enabled and the partition table is trained. The default nprobe of the gateway is used when nprobe is 0.
+// It is the same as BroadCast otherwise, except that the busy agents are skipped for READ while the other agents
+// cover their vectors.
+func (g *gateway) BroadCastByVector(
+	ctx context.Context,
+	vec []float32,
+	nprobe int,
+	kind BroadCastKind,
+	f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error,
+) (err error) {
+	fctx, span := trace.StartSpan(ctx, "vald/gateway-lb/service/Gateway.BroadCastByVector")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	targets := g.partitionTargets(fctx, vec, nprobe, kind)
+	if targets == nil && kind == READ {
+		targets = g.idleTargets(fctx)
+	}
+	if targets == nil {
+		return g.BroadCast(fctx, kind, f)
+	}
+	return g.client.GetClient().OrderedRangeConcurrent(fctx, targets, -1, func(ictx context.Context,
+		addr string, conn *grpc.ClientConn, copts ...grpc.CallOption,
+	) (err error) {
+		select {
+		case <-ictx.Done():
+			return nil
+		default:
+			return f(ictx, addr, vc.NewFromConn(conn), copts...)
+		}
+	})
+}
+
+// doMulti calls f with the first num agents of addrs which succeed.
+func (g *gateway) doMulti(
+	sctx context.Context,
+	addrs []string,
+	num int,
+	f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error,
+) (err error) {
 	var cur uint32 = 0
-	addrs := g.client.GetAddrs(sctx)
 	var limit uint32
 	if len(addrs) < num {
 		limit = uint32(len(addrs))
@@ -169,3 +363,47 @@ func (g *gateway) GetAgentCount(ctx context.Context) int {
 func (g *gateway) Addrs(ctx context.Context) []string {
 	return g.client.GetAddrs(ctx)
 }
+
+// owners returns the addresses of the agents owning the id by the rendezvous hashing.
+func (g *gateway) owners(ctx context.Context, id string) []string {
+	return g.topReplica(rendezvous(id, g.client.GetAddrs(ctx)))
+}
+
+// coverage returns the number of the agents which cover all vectors among n agents, since each vector is stored in the
+// index replica agents.
+func (g *gateway) coverage(n int) int {
+	if g.replica > 0 && g.replica < n {
+		return n - g.replica + 1
+	}
+	return n
+}
+
+// avoidBusy returns addrs without the busy agents as long as need agents remain, keeping the order of addrs.
+func (g *gateway) avoidBusy(addrs []string, need int) []string {
+	idle := make([]string, 0, len(addrs))
+	var busy []string
+	for _, addr := range addrs {
+		if g.client.IsBusy(addr) {
+			busy = append(busy, addr)
+		} else {
+			idle = append(idle, addr)
+		}
+	}
+	if len(busy) == 0 {
+		return addrs
+	}
+	if len(idle) < need {
+		idle = append(idle, busy[:min(need-len(idle), len(busy))]...)
+	}
+	return idle
+}
+
+// idleTargets returns the addresses of the agents to be searched without the busy agents, or nil when no agent is skipped.
+func (g *gateway) idleTargets(ctx context.Context) []string {
+	addrs := g.client.GetClient().ConnectedAddrs(ctx)
+	targets := g.avoidBusy(addrs, g.coverage(len(addrs)))
+	if len(targets) == len(addrs) {
+		return nil
+	}
+	return targets
+}
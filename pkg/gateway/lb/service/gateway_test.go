@@ -15,6 +15,73 @@
 // Package service
 package service
 
+import (
+	"slices"
+	"testing"
+
+	"github.com/vdaas/vald/internal/client/v1/client/discoverer"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+// busyClient is a discoverer.Client which reports the agents in busy as busy.
+type busyClient struct {
+	discoverer.Client
+	busy []string
+}
+
+func (c *busyClient) IsBusy(addr string) bool {
+	return slices.Contains(c.busy, addr)
+}
+
+func Test_gateway_avoidBusy(t *testing.T) {
+	addrs := []string{"a", "b", "c", "d"}
+	tests := []struct {
+		name string
+		busy []string
+		need int
+		want []string
+	}{
+		{
+			name: "return the addrs as they are when no agent is busy",
+			need: 3,
+			want: addrs,
+		},
+		{
+			name: "return the addrs without the busy agents when the rest has need agents",
+			busy: []string{"a", "c"},
+			need: 2,
+			want: []string{"b", "d"},
+		},
+		{
+			name: "return the idle agents and the first busy agents to have need agents",
+			busy: []string{"a", "b", "c"},
+			need: 2,
+			want: []string{"d", "a"},
+		},
+		{
+			name: "return the busy agents when all agents are busy",
+			busy: addrs,
+			need: 4,
+			want: addrs,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			g := &gateway{
+				client: &busyClient{
+					busy: test.busy,
+				},
+			}
+			if got := g.avoidBusy(addrs, test.need); !slices.Equal(got, test.want) {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
 // NOT IMPLEMENTED BELOW
 //
 // func TestNewGateway(t *testing.T) {
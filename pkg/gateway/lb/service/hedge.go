@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	vc "github.com/vdaas/vald/internal/client/v1/client/vald"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/internal/sync"
+)
+
+// searchGroup is a group of the agents holding the same vectors, which is covered when need agents of it answer.
+type searchGroup struct {
+	addrs []string
+	need  int
+}
+
+// searchGroups returns the groups of the agents to be searched for vec and the client to call them.
+// Under the partition routing, each owner list of the nprobe partitions nearest to vec is a group covered by one owner.
+// Otherwise, all agents are a group covered by any len(agents) - index replica + 1 agents, since each vector is stored in
+// the index replica agents. The busy agents are left out of the group as long as the rest of it covers the group.
+func (g *gateway) searchGroups(ctx context.Context, vec []float32, nprobe int) (grpc.Client, []searchGroup) {
+	if g.partitions > 0 {
+		pt := g.tables.Load()
+		if pt != nil && pt.cur != nil && len(vec) == pt.cur.dim() &&
+			(pt.prev == nil || len(pt.prev.centroids) != 0) {
+			if nprobe <= 0 {
+				nprobe = g.nprobe
+			}
+			addrs := g.client.GetAddrs(ctx)
+			var groups []searchGroup
+			for _, t := range []*partitionTable{pt.cur, pt.prev} {
+				if t == nil || len(vec) != t.dim() {
+					continue
+				}
+				for _, p := range t.nearest(vec, nprobe) {
+					groups = append(groups, searchGroup{
+						addrs: g.avoidBusy(g.topReplica(rendezvous(t.key(p), addrs)), 1),
+						need:  1,
+					})
+				}
+			}
+			return g.client.GetClient(), groups
+		}
+	}
+	client := g.client.GetReadClient()
+	addrs := client.ConnectedAddrs(ctx)
+	need := g.coverage(len(addrs))
+	if client == g.client.GetClient() {
+		// the busy state is known only for the primary agents.
+		addrs = g.avoidBusy(addrs, need)
+	}
+	return client, []searchGroup{{
+		addrs: addrs,
+		need:  need,
+	}}
+}
+
+// BroadCastSearch calls f with the agents to be searched for vec as BroadCastByVector does with READ, and stops waiting
+// for the other agents once the agents which answered cover the searched vectors and done returns true when the
+// straggler skipping or the hedging is enabled. The addresses of the agents not waited for are returned.
+func (g *gateway) BroadCastSearch(
+	ctx context.Context,
+	vec []float32,
+	nprobe int,
+	done func() bool,
+	f func(ctx context.Context, target string, ac vald.Client, copts ...grpc.CallOption) error,
+) (skipped []string, err error) {
+	if !g.skipStragglers && !g.hedge {
+		return nil, g.BroadCastByVector(ctx, vec, nprobe, READ, f)
+	}
+	fctx, span := trace.StartSpan(ctx, "vald/gateway-lb/service/Gateway.BroadCastSearch")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	client, groups := g.searchGroups(fctx, vec, nprobe)
+	if len(groups) == 0 || len(groups[0].addrs) == 0 {
+		return nil, errors.ErrGRPCClientConnNotFound("*")
+	}
+	return g.hedgedCast(fctx, groups, done, func(ctx context.Context, addr string) error {
+		_, err := client.Do(ctx, addr, func(ctx context.Context,
+			conn *grpc.ClientConn, copts ...grpc.CallOption,
+		) (any, error) {
+			return nil, f(ctx, addr, vc.NewFromConn(conn), copts...)
+		})
+		return err
+	})
+}
+
+// hedgedCast calls call with the agents of the groups and returns when no call is in flight, or once every group is
+// covered and done returns true, canceling the calls in flight and returning their addresses.
+// When the hedging is enabled, only the need agents of each group with the lowest latency are called first, and the
+// next agent of the group is called when they fail or do not answer within the hedge delay.
+func (g *gateway) hedgedCast(
+	ctx context.Context,
+	groups []searchGroup,
+	done func() bool,
+	call func(ctx context.Context, addr string) error,
+) (skipped []string, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		addr string
+		err  error
+	}
+	var (
+		wg       sync.WaitGroup
+		results  chan result
+		hedges   = make(chan int)
+		timers   []*time.Timer
+		called   = make(map[string]bool)
+		answered = make(map[string]bool)
+		inflight = make(map[string]bool)
+	)
+	defer func() {
+		for _, t := range timers {
+			t.Stop()
+		}
+	}()
+	for i := range groups {
+		groups[i].addrs = slices.Clone(groups[i].addrs)
+		slices.SortStableFunc(groups[i].addrs, func(a, b string) int {
+			return cmp.Compare(g.latencies.quantile(a, g.hedgePercentile), g.latencies.quantile(b, g.hedgePercentile))
+		})
+		groups[i].need = min(max(groups[i].need, 1), len(groups[i].addrs))
+		for _, addr := range groups[i].addrs {
+			called[addr] = false
+		}
+	}
+	// the results channel has room for all agents, so the calls never block after the loop below returns.
+	results = make(chan result, len(called))
+	launch := func(addr string) {
+		called[addr] = true
+		inflight[addr] = true
+		wg.Add(1)
+		go safety.RecoverFunc(func() error {
+			defer wg.Done()
+			start := time.Now()
+			err := call(ctx, addr)
+			if err == nil || ctx.Err() != nil {
+				// the latency of the canceled call is a lower bound, which still tells the agent is slow.
+				g.latencies.observe(addr, time.Since(start))
+			}
+			results <- result{addr, err}
+			return nil
+		})()
+	}
+	// next calls the next agent of the group i, and schedules the hedge of the group after its delay.
+	next := func(i int) bool {
+		for _, addr := range groups[i].addrs {
+			if called[addr] {
+				continue
+			}
+			launch(addr)
+			if g.hedge {
+				delay := max(g.latencies.quantile(addr, g.hedgePercentile), g.hedgeMinDelay)
+				timers = append(timers, time.AfterFunc(delay, func() {
+					select {
+					case hedges <- i:
+					case <-ctx.Done():
+					}
+				}))
+			}
+			return true
+		}
+		return false
+	}
+	covered := func(i int) bool {
+		var n int
+		for _, addr := range groups[i].addrs {
+			if answered[addr] {
+				n++
+			}
+		}
+		return n >= groups[i].need
+	}
+	for i := range groups {
+		if !g.hedge {
+			for _, addr := range groups[i].addrs {
+				if !called[addr] {
+					launch(addr)
+				}
+			}
+			continue
+		}
+		var n int
+		for _, addr := range groups[i].addrs {
+			if called[addr] {
+				n++
+			}
+		}
+		for n < groups[i].need && next(i) {
+			n++
+		}
+	}
+
+	for len(inflight) > 0 {
+		select {
+		case i := <-hedges:
+			if !covered(i) {
+				next(i)
+			}
+			continue
+		case r := <-results:
+			delete(inflight, r.addr)
+			if r.err != nil {
+				err = errors.Join(err, r.err)
+				if g.hedge {
+					for i := range groups {
+						if slices.Contains(groups[i].addrs, r.addr) && !covered(i) {
+							next(i)
+						}
+					}
+				}
+				continue
+			}
+			answered[r.addr] = true
+		}
+		all := true
+		for i := range groups {
+			if !covered(i) {
+				all = false
+				break
+			}
+		}
+		if all && (done == nil || done()) {
+			break
+		}
+	}
+	for addr := range inflight {
+		skipped = append(skipped, addr)
+	}
+	cancel()
+	wg.Wait()
+	slices.Sort(skipped)
+	return skipped, err
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func Test_gateway_hedgedCast(t *testing.T) {
+	type want struct {
+		skipped []string
+		called  []string
+	}
+	// slow blocks until the call is canceled, and fail fails at once.
+	type agents struct {
+		slow []string
+		fail []string
+	}
+	tests := []struct {
+		name   string
+		hedge  bool
+		groups []searchGroup
+		agents agents
+		done   func() bool
+		want   want
+	}{
+		{
+			name: "return the slow agent when the other agents cover the group",
+			groups: []searchGroup{
+				{addrs: []string{"a", "b", "c"}, need: 2},
+			},
+			agents: agents{slow: []string{"c"}},
+			want: want{
+				skipped: []string{"c"},
+				called:  []string{"a", "b", "c"},
+			},
+		},
+		{
+			name: "return the slow agents when every group is covered by another owner",
+			groups: []searchGroup{
+				{addrs: []string{"a", "b"}, need: 1},
+				{addrs: []string{"b", "c"}, need: 1},
+			},
+			agents: agents{slow: []string{"a", "c"}},
+			want: want{
+				skipped: []string{"a", "c"},
+				called:  []string{"a", "b", "c"},
+			},
+		},
+		{
+			name: "return nothing when the group is not covered until all agents answer",
+			groups: []searchGroup{
+				{addrs: []string{"a", "b", "c"}, need: 3},
+			},
+			want: want{
+				called: []string{"a", "b", "c"},
+			},
+		},
+		{
+			name: "return nothing when done does not return true until all agents answer",
+			groups: []searchGroup{
+				{addrs: []string{"a", "b", "c"}, need: 1},
+			},
+			done: func() bool {
+				return false
+			},
+			want: want{
+				called: []string{"a", "b", "c"},
+			},
+		},
+		{
+			name:  "return the slow agent hedged by the next owner after the hedge delay",
+			hedge: true,
+			groups: []searchGroup{
+				{addrs: []string{"a", "b", "c"}, need: 1},
+			},
+			agents: agents{slow: []string{"a"}},
+			want: want{
+				skipped: []string{"a"},
+				called:  []string{"a", "b"},
+			},
+		},
+		{
+			name:  "return nothing when the next owner is called for the failed agent",
+			hedge: true,
+			groups: []searchGroup{
+				{addrs: []string{"a", "b", "c"}, need: 1},
+			},
+			agents: agents{fail: []string{"a"}},
+			want: want{
+				called: []string{"a", "b"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			g := &gateway{
+				skipStragglers:  true,
+				hedge:           test.hedge,
+				hedgePercentile: 0.95,
+				hedgeMinDelay:   10 * time.Millisecond,
+			}
+			var (
+				mu     sync.Mutex
+				called []string
+			)
+			skipped, _ := g.hedgedCast(context.Background(), test.groups, test.done,
+				func(ctx context.Context, addr string) error {
+					mu.Lock()
+					called = append(called, addr)
+					mu.Unlock()
+					if slices.Contains(test.agents.fail, addr) {
+						return errors.New("failed")
+					}
+					if slices.Contains(test.agents.slow, addr) {
+						<-ctx.Done()
+						return ctx.Err()
+					}
+					return nil
+				})
+			if !slices.Equal(skipped, test.want.skipped) {
+				tt.Errorf("skipped = %v, want %v", skipped, test.want.skipped)
+			}
+			slices.Sort(called)
+			if !slices.Equal(called, test.want.called) {
+				tt.Errorf("called = %v, want %v", called, test.want.called)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"math"
+	"math/bits"
+	"time"
+
+	"github.com/vdaas/vald/internal/sync"
+)
+
+const (
+	// latencyBuckets is the number of the buckets of the latency histogram.
+	// The bucket i holds the latencies in (2^(i-1), 2^i] microseconds, and the last one holds the longer latencies too.
+	latencyBuckets = 32
+	// latencyWindow is the number of the observations after which the histogram is halved,
+	// so the recent latencies weigh more than the old ones.
+	latencyWindow = 1024
+)
+
+// latencyHistogram is a histogram of the latencies of an agent.
+type latencyHistogram struct {
+	mu      sync.Mutex
+	buckets [latencyBuckets]uint64
+	total   uint64
+}
+
+func latencyBucket(d time.Duration) int {
+	us := d.Microseconds()
+	if us <= 1 {
+		return 0
+	}
+	return min(bits.Len64(uint64(us-1)), latencyBuckets-1)
+}
+
+func (h *latencyHistogram) observe(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.total >= latencyWindow {
+		h.total = 0
+		for i := range h.buckets {
+			h.buckets[i] /= 2
+			h.total += h.buckets[i]
+		}
+	}
+	h.buckets[latencyBucket(d)]++
+	h.total++
+}
+
+// quantile returns the q quantile of the latencies interpolated in its bucket, or 0 when nothing is observed.
+func (h *latencyHistogram) quantile(q float64) time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.total == 0 {
+		return 0
+	}
+	rank := max(math.Ceil(q*float64(h.total)), 1)
+	var cum float64
+	for i, n := range h.buckets {
+		if n == 0 {
+			continue
+		}
+		if cum+float64(n) >= rank {
+			lo, hi := 0.0, 1.0
+			if i > 0 {
+				lo, hi = float64(uint64(1)<<(i-1)), float64(uint64(1)<<i)
+			}
+			return time.Duration((lo + (hi-lo)*(rank-cum)/float64(n)) * float64(time.Microsecond))
+		}
+		cum += float64(n)
+	}
+	return time.Duration(uint64(1)<<(latencyBuckets-1)) * time.Microsecond
+}
+
+// latencies holds the latency histogram of each agent.
+type latencies struct {
+	hists sync.Map[string, *latencyHistogram]
+}
+
+func (l *latencies) observe(addr string, d time.Duration) {
+	h, ok := l.hists.Load(addr)
+	if !ok {
+		h, _ = l.hists.LoadOrStore(addr, new(latencyHistogram))
+	}
+	h.observe(d)
+}
+
+// quantile returns the q quantile of the latencies of the agent at addr, or 0 when the agent is not observed yet.
+func (l *latencies) quantile(addr string, q float64) time.Duration {
+	h, ok := l.hists.Load(addr)
+	if !ok {
+		return 0
+	}
+	return h.quantile(q)
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func Test_latencyHistogram_quantile(t *testing.T) {
+	tests := []struct {
+		name     string
+		observed map[time.Duration]int
+		q        float64
+		min, max time.Duration
+	}{
+		{
+			name: "return 0 when nothing is observed",
+			q:    0.95,
+		},
+		{
+			name: "return the latency in the bucket of the quantile",
+			observed: map[time.Duration]int{
+				time.Millisecond:       95,
+				100 * time.Millisecond: 5,
+			},
+			q:   0.9,
+			min: 512 * time.Microsecond,
+			max: 1024 * time.Microsecond,
+		},
+		{
+			name: "return the latency of the slow observations for the high quantile",
+			observed: map[time.Duration]int{
+				time.Millisecond:       90,
+				100 * time.Millisecond: 10,
+			},
+			q:   0.95,
+			min: 65536 * time.Microsecond,
+			max: 131072 * time.Microsecond,
+		},
+		{
+			name: "return the latency weighted to the recent observations",
+			observed: map[time.Duration]int{
+				100 * time.Millisecond: latencyWindow,
+				time.Millisecond:       latencyWindow * 4,
+			},
+			q:   0.95,
+			min: 512 * time.Microsecond,
+			max: 1024 * time.Microsecond,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			h := new(latencyHistogram)
+			// observe the slow latencies first, so the last case sees them halved.
+			for _, d := range []time.Duration{100 * time.Millisecond, time.Millisecond} {
+				for range test.observed[d] {
+					h.observe(d)
+				}
+			}
+			if got := h.quantile(test.q); got < test.min || got > test.max {
+				tt.Errorf("got = %v, want in [%v, %v]", got, test.min, test.max)
+			}
+		})
+	}
+}
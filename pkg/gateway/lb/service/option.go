@@ -17,13 +17,21 @@ package service
 
 import (
 	"github.com/vdaas/vald/internal/client/v1/client/discoverer"
+	"github.com/vdaas/vald/internal/errors"
 	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/internal/timeutil"
 )
 
 type Option func(g *gateway) error
 
 var defaultGWOpts = []Option{
 	WithErrGroup(errgroup.Get()),
+	WithPartitionNprobe(1),
+	WithPartitionTrainSampleSize(100000),
+	WithPartitionImbalanceRatio(2.5),
+	WithPartitionCheckDuration("1m"),
+	WithHedgePercentile(0.95),
+	WithHedgeMinDelay("10ms"),
 }
 
 func WithDiscoverer(c discoverer.Client) Option {
@@ -43,3 +51,155 @@ func WithErrGroup(eg errgroup.Group) Option {
 		return nil
 	}
 }
+
+// WithPlacement returns the option to set the placement strategy of the vectors by the name.
+func WithPlacement(name string) Option {
+	return func(g *gateway) error {
+		g.placement = PlacementFromString(name)
+		return nil
+	}
+}
+
+// WithIndexReplica returns the option to set the number of the agents owning a vector under the rendezvous placement.
+func WithIndexReplica(n int) Option {
+	return func(g *gateway) error {
+		if n > 0 {
+			g.replica = n
+		}
+		return nil
+	}
+}
+
+// WithRebalanceDuration returns the option to set the interval to check the changes of the agents to rebalance the vectors.
+func WithRebalanceDuration(dur string) Option {
+	return func(g *gateway) error {
+		if dur == "" {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return errors.NewErrInvalidOption("rebalanceDuration", dur, err)
+		}
+		g.rebalanceDuration = d
+		return nil
+	}
+}
+
+// WithPartitions returns the option to set the number of the partitions of the partition routing.
+// The partition routing is disabled when it is 0.
+func WithPartitions(n int) Option {
+	return func(g *gateway) error {
+		if n >= 0 {
+			g.partitions = n
+		}
+		return nil
+	}
+}
+
+// WithPartitionNprobe returns the option to set the number of the partitions searched when the request does not specify it.
+func WithPartitionNprobe(n int) Option {
+	return func(g *gateway) error {
+		if n > 0 {
+			g.nprobe = n
+		}
+		return nil
+	}
+}
+
+// WithPartitionTraining returns the option to enable training the partition table and migrating the vectors on the gateway.
+func WithPartitionTraining(enabled bool) Option {
+	return func(g *gateway) error {
+		g.trainPartition = enabled
+		return nil
+	}
+}
+
+// WithPartitionTrainSampleSize returns the option to set the number of the vectors sampled to train the partition table.
+func WithPartitionTrainSampleSize(n int) Option {
+	return func(g *gateway) error {
+		if n > 0 {
+			g.trainSampleSize = n
+		}
+		return nil
+	}
+}
+
+// WithPartitionImbalanceRatio returns the option to set the ratio of the vectors of the largest agent to the average
+// to retrain the partition table.
+func WithPartitionImbalanceRatio(ratio float64) Option {
+	return func(g *gateway) error {
+		if ratio > 0 {
+			g.imbalanceRatio = ratio
+		}
+		return nil
+	}
+}
+
+// WithPartitionCheckDuration returns the option to set the interval to reload the partition table and check the partitions.
+func WithPartitionCheckDuration(dur string) Option {
+	return func(g *gateway) error {
+		if dur == "" {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return errors.NewErrInvalidOption("partitionCheckDuration", dur, err)
+		}
+		if d <= 0 {
+			return errors.NewErrInvalidOption("partitionCheckDuration", dur)
+		}
+		g.partitionCheckDuration = d
+		return nil
+	}
+}
+
+// WithStragglerSkipping returns the option to stop waiting for the slow agents of the search once the other agents
+// cover the searched vectors and the min_num of the request.
+func WithStragglerSkipping(enabled bool) Option {
+	return func(g *gateway) error {
+		g.skipStragglers = enabled
+		return nil
+	}
+}
+
+// WithHedging returns the option to call the agents of the search holding the same vectors one by one after the hedge
+// delay, instead of calling all of them at once. The slow agents are not waited for when it is enabled.
+func WithHedging(enabled bool) Option {
+	return func(g *gateway) error {
+		g.hedge = enabled
+		return nil
+	}
+}
+
+// WithHedgePercentile returns the option to set the percentile of the latencies of the agent used as the hedge delay.
+func WithHedgePercentile(p float64) Option {
+	return func(g *gateway) error {
+		if p == 0 {
+			return nil
+		}
+		if p < 0 || p > 1 {
+			return errors.NewErrInvalidOption("hedgePercentile", p)
+		}
+		g.hedgePercentile = p
+		return nil
+	}
+}
+
+// WithHedgeMinDelay returns the option to set the minimum hedge delay, which is used for the agents whose latencies are
+// not observed yet.
+func WithHedgeMinDelay(dur string) Option {
+	return func(g *gateway) error {
+		if dur == "" {
+			return nil
+		}
+		d, err := timeutil.Parse(dur)
+		if err != nil {
+			return errors.NewErrInvalidOption("hedgeMinDelay", dur, err)
+		}
+		if d < 0 {
+			return errors.NewErrInvalidOption("hedgeMinDelay", dur)
+		}
+		g.hedgeMinDelay = d
+		return nil
+	}
+}
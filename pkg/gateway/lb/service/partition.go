@@ -0,0 +1,707 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/internal/sync"
+
+	vc "github.com/vdaas/vald/internal/client/v1/client/vald"
+)
+
+const (
+	// reservedCollectionPrefix is the prefix of the collections of the agents used by the LB gateways, whose vectors
+	// are not moved by the rebalancing.
+	reservedCollectionPrefix = "_vald_"
+	// partitionCollection is the collection of the agents storing the partition tables, so that all LB gateways route
+	// the vectors by the same table.
+	partitionCollection = reservedCollectionPrefix + "partitions"
+	// partitionHeadID is the ID of the vector holding the version and the number of the centroids of the current table.
+	partitionHeadID = "_head"
+	// partitionPrevID is the ID of the vector holding the version and the number of the centroids of the previous table,
+	// which exists while the vectors are migrated to the current table.
+	partitionPrevID = "_prev"
+	// minTrainVectorsPerPartition is the number of the vectors per partition required to train the first table.
+	minTrainVectorsPerPartition = 10
+	// partitionTrainIterations is the maximum number of the iterations of the k-means clustering.
+	partitionTrainIterations = 25
+	// partitionRetrainGrowth is the growth of the vectors since the last training required to retrain the partition
+	// table by the imbalance, which prevents retraining repeatedly on the data which cannot be balanced.
+	partitionRetrainGrowth = 0.1
+	// partitionStaleTerms is the number of the check durations since the last reload after which the partition tables
+	// are stale and the writes routed by the partitions are rejected. The training gateway waits for one more check
+	// duration after storing a new table before migrating the vectors, so that every gateway accepting the writes
+	// routes them by the new table by then.
+	partitionStaleTerms = 2
+	// partitionHeadBits is the number of the bits of the integer held by an element of the head vector, which float32
+	// represents exactly.
+	partitionHeadBits = 24
+)
+
+// IsReservedCollection reports whether the collection is reserved for the LB gateways, which is hidden from the users.
+func IsReservedCollection(name string) bool {
+	return strings.HasPrefix(name, reservedCollectionPrefix)
+}
+
+// partitionTable is the coarse centroids of the partitions of the default collection.
+type partitionTable struct {
+	version   uint64
+	centroids [][]float32
+}
+
+// partitionTables is the partition tables used by the routing.
+type partitionTables struct {
+	cur *partitionTable
+	// prev is the table whose vectors are being migrated to cur, or nil. The table without the centroids represents
+	// the vectors placed without the partitions before the first table.
+	prev *partitionTable
+}
+
+// dim returns the dimension of the centroids.
+func (t *partitionTable) dim() int {
+	if t == nil || len(t.centroids) == 0 {
+		return 0
+	}
+	return len(t.centroids[0])
+}
+
+// key returns the key of the partition p to rank the agents by the rendezvous hashing.
+func (t *partitionTable) key(p int) string {
+	return strconv.FormatUint(t.version, 10) + "/" + strconv.Itoa(p)
+}
+
+// nearest returns the indexes of the n centroids nearest to vec in ascending order of the distance.
+func (t *partitionTable) nearest(vec []float32, n int) []int {
+	ps := make([]int, len(t.centroids))
+	ds := make([]float32, len(t.centroids))
+	for i, c := range t.centroids {
+		ps[i] = i
+		ds[i] = sqL2(vec, c)
+	}
+	slices.SortStableFunc(ps, func(a, b int) int {
+		return cmp.Compare(ds[a], ds[b])
+	})
+	if n > 0 && n < len(ps) {
+		return ps[:n]
+	}
+	return ps
+}
+
+// sqL2 returns the squared euclidean distance between x and y.
+func sqL2(x, y []float32) (d float32) {
+	for i := range min(len(x), len(y)) {
+		diff := x[i] - y[i]
+		d += diff * diff
+	}
+	return d
+}
+
+// trainPartitions clusters the samples into k centroids by the k-means clustering with the k-means++ seeding.
+// The seed makes the training deterministic for the same samples.
+func trainPartitions(samples [][]float32, k int, seed uint64) [][]float32 {
+	if k <= 0 || len(samples) < k {
+		return nil
+	}
+	rnd := rand.New(rand.NewPCG(seed, seed))
+	centroids := make([][]float32, 0, k)
+	centroids = append(centroids, slices.Clone(samples[rnd.IntN(len(samples))]))
+	ds := make([]float64, len(samples))
+	for i := range ds {
+		ds[i] = math.Inf(1)
+	}
+	for len(centroids) < k {
+		var sum float64
+		last := centroids[len(centroids)-1]
+		for i, s := range samples {
+			ds[i] = min(ds[i], float64(sqL2(s, last)))
+			sum += ds[i]
+		}
+		next := len(samples) - 1
+		if sum > 0 {
+			r := rnd.Float64() * sum
+			for i, d := range ds {
+				if r -= d; r <= 0 {
+					next = i
+					break
+				}
+			}
+		} else {
+			next = rnd.IntN(len(samples))
+		}
+		centroids = append(centroids, slices.Clone(samples[next]))
+	}
+	t := &partitionTable{
+		centroids: centroids,
+	}
+	assign := make([]int, len(samples))
+	for i := range assign {
+		assign[i] = -1
+	}
+	dim := len(samples[0])
+	for range partitionTrainIterations {
+		var changed bool
+		for i, s := range samples {
+			if p := t.nearest(s, 1)[0]; p != assign[i] {
+				assign[i] = p
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+		sums := make([][]float64, k)
+		counts := make([]int, k)
+		for i, s := range samples {
+			p := assign[i]
+			if sums[p] == nil {
+				sums[p] = make([]float64, dim)
+			}
+			for j, v := range s {
+				sums[p][j] += float64(v)
+			}
+			counts[p]++
+		}
+		for p, sum := range sums {
+			// the centroid of the empty partition is kept as it is.
+			if counts[p] == 0 {
+				continue
+			}
+			for j := range centroids[p] {
+				centroids[p][j] = float32(sum[j] / float64(counts[p]))
+			}
+		}
+	}
+	return centroids
+}
+
+// partitionOrder returns the addresses in the order of the rendezvous hashing score of the partition nearest to vec,
+// or nil when the vector is not routed by the partitions. It returns the Unavailable error when the partition tables
+// are stale.
+func (g *gateway) partitionOrder(ctx context.Context, vec []float32) ([]string, error) {
+	if g.partitions == 0 || len(vec) == 0 {
+		return nil, nil
+	}
+	if g.partitionsStale(time.Now()) {
+		return nil, status.WrapWithUnavailable("partition tables are stale", errors.ErrStalePartitionTable)
+	}
+	pt := g.tables.Load()
+	if pt == nil || pt.cur == nil || len(vec) != pt.cur.dim() {
+		return nil, nil
+	}
+	return rendezvous(pt.cur.key(pt.cur.nearest(vec, 1)[0]), g.client.GetAddrs(ctx)), nil
+}
+
+// partitionsStale reports whether the partition tables have not been reloaded for partitionStaleTerms check durations,
+// in which case the other gateways may have stored a newer table.
+func (g *gateway) partitionsStale(now time.Time) bool {
+	return now.UnixNano()-g.loadedAt.Load() > int64(partitionStaleTerms*g.partitionCheckDuration)
+}
+
+// partitionTargets returns the addresses of the agents owning the nprobe partitions nearest to vec under the current
+// and the previous tables, or nil when the vector is not routed by the partitions.
+// The busy owners are left out for READ when another owner of the partition is not busy.
+func (g *gateway) partitionTargets(ctx context.Context, vec []float32, nprobe int, kind BroadCastKind) []string {
+	if g.partitions == 0 {
+		return nil
+	}
+	pt := g.tables.Load()
+	if pt == nil || pt.cur == nil || len(vec) != pt.cur.dim() {
+		return nil
+	}
+	if nprobe <= 0 {
+		nprobe = g.nprobe
+	}
+	if pt.prev != nil && len(pt.prev.centroids) == 0 {
+		// the vectors are being migrated from the agents chosen without the partitions.
+		return nil
+	}
+	addrs := g.client.GetAddrs(ctx)
+	targets := make([]string, 0, len(addrs))
+	for _, t := range []*partitionTable{pt.cur, pt.prev} {
+		if t == nil || len(vec) != t.dim() {
+			continue
+		}
+		for _, p := range t.nearest(vec, nprobe) {
+			owners := g.topReplica(rendezvous(t.key(p), addrs))
+			if kind == READ {
+				owners = g.avoidBusy(owners, 1)
+			}
+			for _, addr := range owners {
+				if !slices.Contains(targets, addr) {
+					targets = append(targets, addr)
+				}
+			}
+		}
+	}
+	return targets
+}
+
+// topReplica returns the first index replica addresses of the ranked addresses.
+func (g *gateway) topReplica(addrs []string) []string {
+	if g.replica > 0 && len(addrs) > g.replica {
+		return addrs[:g.replica]
+	}
+	return addrs
+}
+
+// startPartition reloads the partition tables every partitionCheckDuration. When the training is enabled, it also
+// trains the table when the agents are imbalanced, and migrates the vectors when the agents or the table change.
+// The training runs apart from the reloading, so the tables of the training gateway do not go stale while it waits
+// for the other gateways or migrates the vectors.
+func (g *gateway) startPartition(ctx context.Context) {
+	if g.trainPartition {
+		g.eg.Go(safety.RecoverFunc(func() error {
+			g.startPartitionTraining(ctx)
+			return nil
+		}))
+	}
+	tick := time.NewTicker(g.partitionCheckDuration)
+	defer tick.Stop()
+	for {
+		if err := g.loadPartitions(ctx); err != nil {
+			log.Warnf("failed to load the partition tables: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+// startPartitionTraining checks the partitions every partitionCheckDuration once the partition tables are loaded.
+func (g *gateway) startPartitionTraining(ctx context.Context) {
+	tick := time.NewTicker(g.partitionCheckDuration)
+	defer tick.Stop()
+	var last []string
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+		}
+		if g.loadedAt.Load() == 0 {
+			continue
+		}
+		addrs := slices.Sorted(slices.Values(g.client.GetAddrs(ctx)))
+		changed := last != nil && !slices.Equal(last, addrs)
+		if len(addrs) != 0 {
+			last = addrs
+		}
+		if err := g.checkPartitions(ctx, changed); err != nil {
+			log.Errorf("failed to check the partitions: %v", err)
+		}
+	}
+}
+
+// checkPartitions finishes the interrupted migration, trains a new table when there is no table and enough vectors or
+// the agents are imbalanced, and migrates the vectors when the agents change.
+func (g *gateway) checkPartitions(ctx context.Context, changed bool) error {
+	pt := g.tables.Load()
+	if pt != nil && pt.prev != nil {
+		return g.migratePartitions(ctx, pt)
+	}
+	counts, err := g.agentCounts(ctx)
+	if err != nil {
+		return err
+	}
+	var total, maxCount uint64
+	for _, c := range counts {
+		total += c
+		maxCount = max(maxCount, c)
+	}
+	switch {
+	case pt == nil || pt.cur == nil:
+		if total < uint64(g.partitions*minTrainVectorsPerPartition) {
+			return nil
+		}
+	case len(counts) > 0 && total > 0 &&
+		float64(total) >= float64(g.trainedTotal.Load())*(1+partitionRetrainGrowth) &&
+		float64(maxCount)*float64(len(counts))/float64(total) > g.imbalanceRatio:
+		log.Infof("partitions are imbalanced: the largest agent has %d of %d vectors on %d agents", maxCount, total, len(counts))
+	default:
+		if changed {
+			return g.migratePartitions(ctx, pt)
+		}
+		return nil
+	}
+	err = g.retrainPartitions(ctx, pt)
+	if err != nil {
+		return err
+	}
+	g.trainedTotal.Store(total)
+	return nil
+}
+
+// agentCounts returns the number of the vectors of each agent.
+func (g *gateway) agentCounts(ctx context.Context) (counts map[string]uint64, err error) {
+	var mu sync.Mutex
+	counts = make(map[string]uint64)
+	err = g.client.GetClient().RangeConcurrent(ctx, -1, func(ctx context.Context,
+		addr string, conn *grpc.ClientConn, copts ...grpc.CallOption,
+	) error {
+		info, err := vc.NewFromConn(conn).IndexInfo(ctx, new(payload.Empty), copts...)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		counts[addr] = uint64(info.GetStored()) + uint64(info.GetUncommitted())
+		mu.Unlock()
+		return nil
+	})
+	return counts, err
+}
+
+// retrainPartitions trains a new table from the sampled vectors, stores it to the agents and migrates the vectors.
+func (g *gateway) retrainPartitions(ctx context.Context, pt *partitionTables) (err error) {
+	ctx, span := trace.StartSpan(ctx, "vald/gateway-lb/service/Gateway.retrainPartitions")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	samples, err := g.samplePartitions(ctx)
+	if err != nil {
+		return err
+	}
+	prev := new(partitionTable)
+	if pt != nil && pt.cur != nil {
+		prev = pt.cur
+	}
+	t := &partitionTable{
+		version: prev.version + 1,
+	}
+	t.centroids = trainPartitions(samples, g.partitions, t.version)
+	if len(t.centroids) == 0 {
+		log.Warnf("%d vectors are not enough to train %d partitions", len(samples), g.partitions)
+		return nil
+	}
+	err = g.storePartitions(ctx, t, prev)
+	if err != nil {
+		return err
+	}
+	log.Infof("partition table %d trained from %d vectors", t.version, len(samples))
+	pt = &partitionTables{
+		cur:  t,
+		prev: prev,
+	}
+	g.tables.Store(pt)
+	// the other gateways reload the new table or reject the writes by then, so no vector is written by the previous
+	// table after the migration lists the vectors.
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After((partitionStaleTerms + 1) * g.partitionCheckDuration):
+	}
+	return g.migratePartitions(ctx, pt)
+}
+
+// samplePartitions samples the vectors of the default collection of the agents by the reservoir sampling.
+func (g *gateway) samplePartitions(ctx context.Context) (samples [][]float32, err error) {
+	addrs := g.client.GetAddrs(ctx)
+	if len(addrs) == 0 {
+		return nil, errors.ErrGRPCClientConnNotFound("*")
+	}
+	var (
+		mu    sync.Mutex
+		quota = max(g.trainSampleSize/len(addrs), 1)
+	)
+	err = g.client.GetClient().RangeConcurrent(ctx, -1, func(ctx context.Context,
+		addr string, conn *grpc.ClientConn, copts ...grpc.CallOption,
+	) error {
+		stream, err := vc.NewFromConn(conn).StreamListObject(ctx, new(payload.Object_List_Request), copts...)
+		if err != nil {
+			return err
+		}
+		var (
+			rnd     = rand.New(rand.NewPCG(uint64(len(addr)), uint64(time.Now().UnixNano())))
+			sampled = make([][]float32, 0, quota)
+			seen    int
+		)
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return errors.ErrStreamListObjectStreamFinishedUnexpectedly(err)
+			}
+			vec := res.GetVector().GetVector()
+			if len(vec) == 0 {
+				continue
+			}
+			seen++
+			if len(sampled) < quota {
+				sampled = append(sampled, vec)
+			} else if i := rnd.IntN(seen); i < quota {
+				sampled[i] = vec
+			}
+		}
+		mu.Lock()
+		samples = append(samples, sampled...)
+		mu.Unlock()
+		return nil
+	})
+	return samples, err
+}
+
+// migratePartitions moves the vectors of the default collection to the owners of their partitions of the current
+// table, and removes the previous table when all vectors are moved.
+// The vectors are moved by the rebalancer, so their timestamps and attributes are kept on the new owners.
+func (g *gateway) migratePartitions(ctx context.Context, pt *partitionTables) (err error) {
+	if pt == nil || pt.cur == nil {
+		return nil
+	}
+	addrs := g.client.GetAddrs(ctx)
+	err = g.rebalance(ctx, func(collection string) func(vec *payload.Object_Vector) []string {
+		if collection != "" {
+			return nil
+		}
+		return func(vec *payload.Object_Vector) []string {
+			if len(vec.GetVector()) != pt.cur.dim() {
+				return nil
+			}
+			return g.topReplica(rendezvous(pt.cur.key(pt.cur.nearest(vec.GetVector(), 1)[0]), addrs))
+		}
+	})
+	if err != nil || pt.prev == nil {
+		return err
+	}
+	err = g.removePartitions(ctx, pt.prev)
+	if err != nil {
+		return err
+	}
+	log.Infof("vectors migrated from partition table %d to %d", pt.prev.version, pt.cur.version)
+	g.tables.CompareAndSwap(pt, &partitionTables{
+		cur: pt.cur,
+	})
+	return nil
+}
+
+// head returns the vector of the dimension holding the number of the centroids in the first element and the version
+// in the following elements by partitionHeadBits bits, which float32 represents exactly.
+func (t *partitionTable) head(dim int) ([]float32, error) {
+	if dim < 2 {
+		return nil, errors.ErrInvalidDimensionSize(dim, 0)
+	}
+	if len(t.centroids) >= 1<<partitionHeadBits {
+		return nil, errors.ErrPartitionHeadOverflow(t.version, len(t.centroids), dim)
+	}
+	head := make([]float32, dim)
+	head[0] = float32(len(t.centroids))
+	v := t.version
+	for i := 1; i < dim && v != 0; i++ {
+		head[i] = float32(v & (1<<partitionHeadBits - 1))
+		v >>= partitionHeadBits
+	}
+	if v != 0 {
+		return nil, errors.ErrPartitionHeadOverflow(t.version, len(t.centroids), dim)
+	}
+	return head, nil
+}
+
+// parseHead returns the version and the number of the centroids held by the head vector.
+func parseHead(head []float32) (version uint64, n int, err error) {
+	if len(head) < 2 {
+		return 0, 0, errors.ErrInvalidDimensionSize(len(head), 0)
+	}
+	for _, h := range head {
+		if h < 0 || h >= 1<<partitionHeadBits || h != float32(uint32(h)) {
+			return 0, 0, errors.ErrInvalidPartitionHead(head)
+		}
+	}
+	for i, h := range head[1:] {
+		shift := i * partitionHeadBits
+		if shift >= 64 && h != 0 || shift < 64 && uint64(h)>>(64-shift) != 0 {
+			return 0, 0, errors.ErrInvalidPartitionHead(head)
+		}
+		if shift < 64 {
+			version |= uint64(h) << shift
+		}
+	}
+	return version, int(head[0]), nil
+}
+
+// storePartitions stores the table to all agents. The head of the previous table is kept while the vectors are migrated.
+// The centroids are stored before the heads, so the readers never see the head without its centroids.
+func (g *gateway) storePartitions(ctx context.Context, t, prev *partitionTable) error {
+	return g.client.GetClient().RangeConcurrent(ctx, -1, func(ctx context.Context,
+		addr string, conn *grpc.ClientConn, copts ...grpc.CallOption,
+	) error {
+		ac := vc.NewFromConn(conn)
+		_, err := ac.CreateCollection(ctx, &payload.Collection_CreateRequest{
+			Config: &payload.Collection_Config{
+				Name: partitionCollection,
+			},
+		}, copts...)
+		if err != nil {
+			if st, ok := status.FromError(err); !ok || st == nil || st.Code() != codes.AlreadyExists {
+				return err
+			}
+		}
+		upsert := func(id string, vec []float32) error {
+			_, err := ac.Upsert(ctx, &payload.Upsert_Request{
+				Vector: &payload.Object_Vector{
+					Id:     id,
+					Vector: vec,
+				},
+				Config: &payload.Upsert_Config{
+					SkipStrictExistCheck: true,
+					Collection:           partitionCollection,
+				},
+			}, copts...)
+			return err
+		}
+		for p, c := range t.centroids {
+			if err := upsert(t.key(p), c); err != nil {
+				return err
+			}
+		}
+		if prev != nil {
+			head, err := prev.head(t.dim())
+			if err != nil {
+				return err
+			}
+			if err := upsert(partitionPrevID, head); err != nil {
+				return err
+			}
+		}
+		head, err := t.head(t.dim())
+		if err != nil {
+			return err
+		}
+		return upsert(partitionHeadID, head)
+	})
+}
+
+// removePartitions removes the previous table from all agents.
+func (g *gateway) removePartitions(ctx context.Context, prev *partitionTable) error {
+	return g.client.GetClient().RangeConcurrent(ctx, -1, func(ctx context.Context,
+		addr string, conn *grpc.ClientConn, copts ...grpc.CallOption,
+	) error {
+		ac := vc.NewFromConn(conn)
+		ids := []string{partitionPrevID}
+		for p := range prev.centroids {
+			ids = append(ids, prev.key(p))
+		}
+		for _, id := range ids {
+			_, err := ac.Remove(ctx, &payload.Remove_Request{
+				Id: &payload.Object_ID{
+					Id: id,
+				},
+				Config: &payload.Remove_Config{
+					SkipStrictExistCheck: true,
+					Collection:           partitionCollection,
+				},
+			}, copts...)
+			if err != nil {
+				if st, ok := status.FromError(err); !ok || st == nil || st.Code() != codes.NotFound {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// loadPartitions loads the partition tables from an agent.
+func (g *gateway) loadPartitions(ctx context.Context) error {
+	start := time.Now().UnixNano()
+	pt, err := grpc.RoundRobin(ctx, g.client.GetClient(), func(ctx context.Context,
+		conn *grpc.ClientConn, copts ...grpc.CallOption,
+	) (*partitionTables, error) {
+		ac := vc.NewFromConn(conn)
+		cur, err := readPartitionTable(ctx, ac, partitionHeadID, copts...)
+		if err != nil {
+			return nil, err
+		}
+		if cur == nil {
+			return new(partitionTables), nil
+		}
+		prev, err := readPartitionTable(ctx, ac, partitionPrevID, copts...)
+		if err != nil {
+			return nil, err
+		}
+		return &partitionTables{
+			cur:  cur,
+			prev: prev,
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+	g.loadedAt.Store(start)
+	if old := g.tables.Load(); old != nil && old.cur != nil && (pt.cur == nil || old.cur.version > pt.cur.version) {
+		// the agent has not stored the latest table yet.
+		return nil
+	}
+	g.tables.Store(pt)
+	return nil
+}
+
+// readPartitionTable reads the table of the head from the agent. It returns nil when the head does not exist.
+func readPartitionTable(
+	ctx context.Context, ac vc.Client, headID string, copts ...grpc.CallOption,
+) (*partitionTable, error) {
+	get := func(id string) (*payload.Object_Vector, error) {
+		return ac.GetObject(ctx, &payload.Object_VectorRequest{
+			Id: &payload.Object_ID{
+				Id: id,
+			},
+			Collection: partitionCollection,
+		}, copts...)
+	}
+	head, err := get(headID)
+	if err != nil {
+		if st, ok := status.FromError(err); ok && st != nil && st.Code() == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	version, n, err := parseHead(head.GetVector())
+	if err != nil {
+		return nil, err
+	}
+	t := &partitionTable{
+		version:   version,
+		centroids: make([][]float32, n),
+	}
+	for p := range t.centroids {
+		c, err := get(t.key(p))
+		if err != nil {
+			return nil, err
+		}
+		t.centroids[p] = c.GetVector()
+	}
+	return t, nil
+}
@@ -0,0 +1,335 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func Test_partitionTable_nearest(t *testing.T) {
+	table := &partitionTable{
+		version: 1,
+		centroids: [][]float32{
+			{0, 0},
+			{10, 0},
+			{0, 10},
+			{10, 10},
+		},
+	}
+	tests := []struct {
+		name string
+		vec  []float32
+		n    int
+		want []int
+	}{
+		{
+			name: "return the nearest centroid",
+			vec:  []float32{9, 1},
+			n:    1,
+			want: []int{1},
+		},
+		{
+			name: "return the n nearest centroids in ascending order of the distance",
+			vec:  []float32{1, 8},
+			n:    3,
+			want: []int{2, 0, 3},
+		},
+		{
+			name: "return all centroids when n is larger than the number of the centroids",
+			vec:  []float32{9, 9},
+			n:    10,
+			want: []int{3, 1, 2, 0},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if got := table.nearest(test.vec, test.n); !slices.Equal(got, test.want) {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_trainPartitions(t *testing.T) {
+	centers := [][]float32{{0, 0}, {100, 0}, {0, 100}}
+	rnd := rand.New(rand.NewPCG(1, 1))
+	samples := make([][]float32, 0, 300)
+	for i := range 300 {
+		c := centers[i%len(centers)]
+		samples = append(samples, []float32{c[0] + rnd.Float32()*2 - 1, c[1] + rnd.Float32()*2 - 1})
+	}
+	tests := []struct {
+		name      string
+		samples   [][]float32
+		k         int
+		checkFunc func(tt *testing.T, got [][]float32)
+	}{
+		{
+			name:    "return a centroid near each cluster",
+			samples: samples,
+			k:       len(centers),
+			checkFunc: func(tt *testing.T, got [][]float32) {
+				tt.Helper()
+				if len(got) != len(centers) {
+					tt.Fatalf("got %d centroids, want %d", len(got), len(centers))
+				}
+				table := &partitionTable{
+					centroids: got,
+				}
+				seen := make(map[int]bool, len(centers))
+				for _, c := range centers {
+					p := table.nearest(c, 1)[0]
+					if d := sqL2(c, got[p]); d > 1 {
+						tt.Errorf("centroid %v is far from the cluster %v", got[p], c)
+					}
+					seen[p] = true
+				}
+				if len(seen) != len(centers) {
+					tt.Errorf("clusters share the centroids: %v", got)
+				}
+			},
+		},
+		{
+			name:    "return the same centroids for the same samples",
+			samples: samples,
+			k:       5,
+			checkFunc: func(tt *testing.T, got [][]float32) {
+				tt.Helper()
+				want := trainPartitions(samples, 5, 1)
+				if !slices.EqualFunc(got, want, slices.Equal[[]float32]) {
+					tt.Errorf("got = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name:    "return nil when the samples are less than k",
+			samples: samples[:2],
+			k:       3,
+			checkFunc: func(tt *testing.T, got [][]float32) {
+				tt.Helper()
+				if got != nil {
+					tt.Errorf("got = %v, want nil", got)
+				}
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			test.checkFunc(tt, trainPartitions(test.samples, test.k, 1))
+		})
+	}
+}
+
+func Test_gateway_partitionsStale(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tests := []struct {
+		name     string
+		loadedAt time.Time
+		want     bool
+	}{
+		{
+			name:     "return false when the tables are reloaded within the stale terms",
+			loadedAt: now.Add(-2 * time.Minute),
+			want:     false,
+		},
+		{
+			name:     "return true when the tables are not reloaded within the stale terms",
+			loadedAt: now.Add(-2*time.Minute - time.Nanosecond),
+			want:     true,
+		},
+		{
+			name: "return true when the tables are never reloaded",
+			want: true,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			g := &gateway{
+				partitionCheckDuration: time.Minute,
+			}
+			if !test.loadedAt.IsZero() {
+				g.loadedAt.Store(test.loadedAt.UnixNano())
+			}
+			if got := g.partitionsStale(now); got != test.want {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIsReservedCollection(t *testing.T) {
+	tests := []struct {
+		name       string
+		collection string
+		want       bool
+	}{
+		{
+			name:       "return true for the partition collection",
+			collection: partitionCollection,
+			want:       true,
+		},
+		{
+			name:       "return true for the rebalance collection",
+			collection: rebalanceCollection,
+			want:       true,
+		},
+		{
+			name:       "return false for the default collection",
+			collection: "",
+			want:       false,
+		},
+		{
+			name:       "return false for the user collection",
+			collection: "vald_partitions",
+			want:       false,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if got := IsReservedCollection(test.collection); got != test.want {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_partitionTable_head(t *testing.T) {
+	tests := []struct {
+		name    string
+		table   *partitionTable
+		dim     int
+		wantErr bool
+	}{
+		{
+			name: "return the head holding the version and the number of the centroids",
+			table: &partitionTable{
+				version:   3,
+				centroids: make([][]float32, 64),
+			},
+			dim: 2,
+		},
+		{
+			name: "return the head holding the version which float32 cannot represent exactly",
+			table: &partitionTable{
+				version:   1<<24 + 1,
+				centroids: make([][]float32, 64),
+			},
+			dim: 3,
+		},
+		{
+			name: "return the head holding the max version",
+			table: &partitionTable{
+				version:   math.MaxUint64,
+				centroids: make([][]float32, 64),
+			},
+			dim: 8,
+		},
+		{
+			name: "return an error when the dimension is less than 2",
+			table: &partitionTable{
+				version:   1,
+				centroids: make([][]float32, 64),
+			},
+			dim:     1,
+			wantErr: true,
+		},
+		{
+			name: "return an error when the version cannot be held by the dimension",
+			table: &partitionTable{
+				version:   1 << 24,
+				centroids: make([][]float32, 64),
+			},
+			dim:     2,
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			head, err := test.table.head(test.dim)
+			if (err != nil) != test.wantErr {
+				tt.Fatalf("got error = %v, want error %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(head) != test.dim {
+				tt.Errorf("got dimension = %d, want %d", len(head), test.dim)
+			}
+			version, n, err := parseHead(head)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			if version != test.table.version || n != len(test.table.centroids) {
+				tt.Errorf("got version = %d, n = %d, want version = %d, n = %d", version, n, test.table.version, len(test.table.centroids))
+			}
+		})
+	}
+}
+
+func Test_parseHead(t *testing.T) {
+	tests := []struct {
+		name string
+		head []float32
+	}{
+		{
+			name: "return an error when the dimension is less than 2",
+			head: []float32{1},
+		},
+		{
+			name: "return an error when an element is not an integer",
+			head: []float32{1, 1.5},
+		},
+		{
+			name: "return an error when an element is negative",
+			head: []float32{1, -1},
+		},
+		{
+			name: "return an error when the version overflows",
+			head: []float32{1, 0, 0, 1 << 16},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if _, _, err := parseHead(test.head); err == nil {
+				tt.Error("got no error, want an error")
+			}
+		})
+	}
+}
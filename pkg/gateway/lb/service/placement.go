@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/vdaas/vald/internal/hash"
+	"github.com/vdaas/vald/internal/strings"
+)
+
+// Placement represents the strategy to place the vectors on the agents.
+type Placement uint8
+
+const (
+	// MemoryPlacement places a vector on the agents in the order of the discoverer, which is sorted by the memory usage.
+	// The owners of an ID are not derivable, so the requests for an ID are broadcast to all agents.
+	MemoryPlacement Placement = iota
+	// RendezvousPlacement places a vector on the agents which have the highest rendezvous hashing scores of the ID,
+	// so the requests for an ID are sent to its owners only.
+	RendezvousPlacement
+)
+
+// String returns the name of the placement.
+func (p Placement) String() string {
+	switch p {
+	case MemoryPlacement:
+		return "memory"
+	case RendezvousPlacement:
+		return "rendezvous"
+	}
+	return "unknown"
+}
+
+// PlacementFromString returns the placement of the name. MemoryPlacement is returned for the empty or unknown name.
+func PlacementFromString(str string) Placement {
+	switch strings.ToLower(str) {
+	case RendezvousPlacement.String():
+		return RendezvousPlacement
+	}
+	return MemoryPlacement
+}
+
+// rendezvous returns the addresses sorted by the rendezvous hashing score of the id in descending order.
+// The order of an address relative to the others depends only on the id and the two addresses, so adding or removing
+// an agent moves only the IDs owned by the agent.
+func rendezvous(id string, addrs []string) []string {
+	type scored struct {
+		addr  string
+		score uint64
+	}
+	ss := make([]scored, 0, len(addrs))
+	for _, addr := range addrs {
+		ss = append(ss, scored{
+			addr:  addr,
+			score: hash.String(addr + "\x00" + id),
+		})
+	}
+	slices.SortFunc(ss, func(a, b scored) int {
+		if c := cmp.Compare(b.score, a.score); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.addr, b.addr)
+	})
+	sorted := make([]string, 0, len(ss))
+	for _, s := range ss {
+		sorted = append(sorted, s.addr)
+	}
+	return sorted
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/vdaas/vald/internal/test/goleak"
+)
+
+func TestPlacementFromString(t *testing.T) {
+	tests := []struct {
+		name string
+		str  string
+		want Placement
+	}{
+		{
+			name: "return MemoryPlacement when the name is empty",
+			str:  "",
+			want: MemoryPlacement,
+		},
+		{
+			name: "return MemoryPlacement when the name is memory",
+			str:  "memory",
+			want: MemoryPlacement,
+		},
+		{
+			name: "return RendezvousPlacement when the name is Rendezvous",
+			str:  "Rendezvous",
+			want: RendezvousPlacement,
+		},
+		{
+			name: "return MemoryPlacement when the name is unknown",
+			str:  "ring",
+			want: MemoryPlacement,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			if got := PlacementFromString(test.str); got != test.want {
+				tt.Errorf("got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func Test_rendezvous(t *testing.T) {
+	const (
+		ids     = 1000
+		replica = 2
+	)
+	addrs := []string{"10.0.0.1:8081", "10.0.0.2:8081", "10.0.0.3:8081", "10.0.0.4:8081"}
+	added := append(slices.Clone(addrs), "10.0.0.5:8081")
+	owners := func(id string, addrs []string) []string {
+		return rendezvous(id, addrs)[:replica]
+	}
+	tests := []struct {
+		name      string
+		checkFunc func(tt *testing.T)
+	}{
+		{
+			name: "return the same order regardless of the order of the addresses",
+			checkFunc: func(tt *testing.T) {
+				tt.Helper()
+				reversed := slices.Clone(addrs)
+				slices.Reverse(reversed)
+				for i := range ids {
+					id := strconv.Itoa(i)
+					got := rendezvous(id, reversed)
+					if want := rendezvous(id, addrs); !slices.Equal(got, want) {
+						tt.Fatalf("id %s: got = %v, want %v", id, got, want)
+					}
+					if len(got) != len(addrs) {
+						tt.Fatalf("id %s: got %d addresses, want %d", id, len(got), len(addrs))
+					}
+				}
+			},
+		},
+		{
+			name: "return the owners changed only by the added agent",
+			checkFunc: func(tt *testing.T) {
+				tt.Helper()
+				var moved int
+				for i := range ids {
+					id := strconv.Itoa(i)
+					before, after := owners(id, addrs), owners(id, added)
+					for _, addr := range after {
+						if !slices.Contains(before, addr) {
+							if addr != added[len(added)-1] {
+								tt.Fatalf("id %s: owners changed from %v to %v", id, before, after)
+							}
+							moved++
+						}
+					}
+				}
+				// each agent owns replica/len(added) of the ids on average.
+				if want := ids * replica / len(added); moved < want/2 || moved > want*3/2 {
+					tt.Errorf("moved = %d, want about %d", moved, want)
+				}
+			},
+		},
+		{
+			name: "return the owners changed only for the ids owned by the removed agent",
+			checkFunc: func(tt *testing.T) {
+				tt.Helper()
+				removed := addrs[1:]
+				for i := range ids {
+					id := strconv.Itoa(i)
+					before, after := owners(id, addrs), owners(id, removed)
+					if !slices.Contains(before, addrs[0]) && !slices.Equal(before, after) {
+						tt.Fatalf("id %s: owners changed from %v to %v", id, before, after)
+					}
+				}
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			test.checkFunc(tt)
+		})
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
+
+	vc "github.com/vdaas/vald/internal/client/v1/client/vald"
+)
+
+const (
+	// rebalanceCollection is the collection of the agents storing the lease of the rebalancing, so that only one LB
+	// gateway rebalances the vectors at a time.
+	rebalanceCollection = reservedCollectionPrefix + "rebalance"
+	// rebalanceLeaseID is the ID of the vector holding the ID of the LB gateway holding the lease. The timestamp of the
+	// vector is the expiry of the lease.
+	rebalanceLeaseID = "_lease"
+	// rebalanceLeaseTerms is the number of the rebalance durations for which the lease is valid without renewal.
+	rebalanceLeaseTerms = 3
+	// leaseDimension is the dimension of the lease vector, each element of which holds 16 bits of the gateway ID.
+	leaseDimension = 4
+)
+
+// newRebalancer returns a random ID of the gateway to hold the lease of the rebalancing.
+func newRebalancer() uint64 {
+	return rand.Uint64()
+}
+
+// startRebalance checks the discovered agents every rebalanceDuration and rebalances the vectors when they change.
+// The first observation is only recorded, since the vectors are already placed on the agents at the start.
+// Only the gateway holding the lease rebalances the vectors, and the other gateways keep the change pending until
+// they take over the lease.
+func (g *gateway) startRebalance(ctx context.Context) {
+	tick := time.NewTicker(g.rebalanceDuration)
+	defer tick.Stop()
+	var (
+		last    []string
+		pending bool
+		running atomic.Bool
+	)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick.C:
+			addrs := slices.Sorted(slices.Values(g.client.GetAddrs(ctx)))
+			if len(addrs) == 0 {
+				continue
+			}
+			if last != nil && !slices.Equal(last, addrs) {
+				log.Infof("agents changed from %v to %v", last, addrs)
+				pending = true
+			}
+			last = addrs
+			held, err := g.acquireRebalanceLease(ctx, addrs)
+			if err != nil {
+				log.Warnf("failed to acquire the rebalance lease: %v", err)
+				continue
+			}
+			if !held || !pending || running.Load() {
+				continue
+			}
+			pending = false
+			running.Store(true)
+			g.eg.Go(safety.RecoverFunc(func() error {
+				defer running.Store(false)
+				log.Infof("start rebalancing the vectors to the agents %v", addrs)
+				err := g.rebalance(ctx, func(collection string) func(vec *payload.Object_Vector) []string {
+					if IsReservedCollection(collection) {
+						return nil
+					}
+					return func(vec *payload.Object_Vector) []string {
+						return g.topReplica(rendezvous(vec.GetId(), addrs))
+					}
+				})
+				if err != nil {
+					log.Errorf("failed to rebalance the vectors: %v", err)
+				}
+				return nil
+			}))
+		}
+	}
+}
+
+// acquireRebalanceLease acquires or renews the lease of the rebalancing stored on the agent with the highest
+// rendezvous hashing score of the lease, and reports whether the gateway holds it. The expired lease is taken over by
+// overwriting its holder, and is held only when the holder read back is this gateway, since the other gateways may
+// take it over at the same time.
+func (g *gateway) acquireRebalanceLease(ctx context.Context, addrs []string) (held bool, err error) {
+	now := time.Now()
+	expiry := now.Add(rebalanceLeaseTerms * g.rebalanceDuration).UnixNano()
+	vec := leaseVector(g.rebalancer)
+	_, err = g.client.GetClient().Do(ctx, rendezvous(rebalanceLeaseID, addrs)[0], func(ctx context.Context,
+		conn *grpc.ClientConn, copts ...grpc.CallOption,
+	) (any, error) {
+		ac := vc.NewFromConn(conn)
+		get := func() (*payload.Object_Vector, error) {
+			return ac.GetObject(ctx, &payload.Object_VectorRequest{
+				Id: &payload.Object_ID{
+					Id: rebalanceLeaseID,
+				},
+				Collection: rebalanceCollection,
+			}, copts...)
+		}
+		lease, err := get()
+		if err != nil {
+			if st, ok := status.FromError(err); !ok || st == nil || st.Code() != codes.NotFound {
+				return nil, err
+			}
+			_, err = ac.CreateCollection(ctx, &payload.Collection_CreateRequest{
+				Config: &payload.Collection_Config{
+					Name:         rebalanceCollection,
+					Dimension:    leaseDimension,
+					DistanceType: "l2",
+					ObjectType:   "float",
+				},
+			}, copts...)
+			if err != nil {
+				if st, ok := status.FromError(err); !ok || st == nil || st.Code() != codes.AlreadyExists {
+					return nil, err
+				}
+			}
+			_, err = ac.Insert(ctx, &payload.Insert_Request{
+				Vector: &payload.Object_Vector{
+					Id:     rebalanceLeaseID,
+					Vector: vec,
+				},
+				Config: &payload.Insert_Config{
+					Timestamp:  expiry,
+					Collection: rebalanceCollection,
+				},
+			}, copts...)
+			if err != nil {
+				if st, ok := status.FromError(err); ok && st != nil && st.Code() == codes.AlreadyExists {
+					return nil, nil
+				}
+				return nil, err
+			}
+			held = true
+			return nil, nil
+		}
+		holder, ok := leaseHolder(lease.GetVector())
+		switch {
+		case ok && holder == g.rebalancer:
+			_, err = ac.UpdateTimestamp(ctx, &payload.Update_TimestampRequest{
+				Id:         rebalanceLeaseID,
+				Timestamp:  expiry,
+				Collection: rebalanceCollection,
+			}, copts...)
+			if err != nil {
+				return nil, err
+			}
+			held = true
+			return nil, nil
+		case lease.GetTimestamp() > now.UnixNano():
+			return nil, nil
+		}
+		_, err = ac.Update(ctx, &payload.Update_Request{
+			Vector: &payload.Object_Vector{
+				Id:     rebalanceLeaseID,
+				Vector: vec,
+			},
+			Config: &payload.Update_Config{
+				Timestamp:  expiry,
+				Collection: rebalanceCollection,
+			},
+		}, copts...)
+		if err != nil {
+			return nil, err
+		}
+		lease, err = get()
+		if err != nil {
+			return nil, err
+		}
+		holder, ok = leaseHolder(lease.GetVector())
+		held = ok && holder == g.rebalancer
+		if held {
+			log.Infof("the rebalance lease taken over by the gateway %d", g.rebalancer)
+		}
+		return nil, nil
+	})
+	return held, err
+}
+
+// leaseVector returns the lease vector holding the gateway ID. Each element holds 16 bits of the ID plus one, which is
+// represented exactly by float32 and never makes the vector zero.
+func leaseVector(id uint64) []float32 {
+	vec := make([]float32, leaseDimension)
+	for i := range vec {
+		vec[i] = float32(id>>(16*i)&0xffff) + 1
+	}
+	return vec
+}
+
+// leaseHolder returns the gateway ID held by the lease vector.
+func leaseHolder(vec []float32) (id uint64, ok bool) {
+	if len(vec) != leaseDimension {
+		return 0, false
+	}
+	for i, v := range vec {
+		if v < 1 || v > 0x10000 || v != float32(uint32(v)) {
+			return 0, false
+		}
+		id |= uint64(v-1) << (16 * i)
+	}
+	return id, true
+}
+
+// rebalance moves the vectors of each agent to their owners returned by the owners function of the collection.
+// The vector is inserted into the owners which do not have it, and removed from the agent when the agent is not an
+// owner of it and all owners have it. The collection whose owners function is nil is skipped.
+func (g *gateway) rebalance(
+	ctx context.Context, owners func(collection string) func(vec *payload.Object_Vector) []string,
+) (err error) {
+	ctx, span := trace.StartSpan(ctx, "vald/gateway-lb/service/Gateway.rebalance")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	return g.client.GetClient().RangeConcurrent(ctx, -1, func(ctx context.Context,
+		addr string, conn *grpc.ClientConn, copts ...grpc.CallOption,
+	) error {
+		ac := vc.NewFromConn(conn)
+		cl, err := ac.ListCollections(ctx, new(payload.Empty), copts...)
+		if err != nil {
+			return err
+		}
+		names := []string{""}
+		for _, c := range cl.GetCollections() {
+			if c.GetName() != "" {
+				names = append(names, c.GetName())
+			}
+		}
+		var errs error
+		for _, name := range names {
+			of := owners(name)
+			if of == nil {
+				continue
+			}
+			if err := g.rebalanceCollection(ctx, addr, name, of, ac, copts...); err != nil {
+				errs = errors.Join(errs, err)
+			}
+		}
+		return errs
+	})
+}
+
+// rebalanceCollection moves the vectors of the collection of the agent at addr to their owners.
+// The vector whose owners are empty is kept as it is.
+func (g *gateway) rebalanceCollection(
+	ctx context.Context,
+	addr, collection string,
+	owners func(vec *payload.Object_Vector) []string,
+	ac vc.Client,
+	copts ...grpc.CallOption,
+) error {
+	stream, err := ac.StreamListObject(ctx, &payload.Object_List_Request{
+		Collection: collection,
+	}, copts...)
+	if err != nil {
+		return err
+	}
+	var moved int
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return errors.ErrStreamListObjectStreamFinishedUnexpectedly(err)
+		}
+		vec := res.GetVector()
+		if vec == nil || vec.GetId() == "" {
+			continue
+		}
+		to := owners(vec)
+		if len(to) == 0 {
+			continue
+		}
+		owned := slices.Contains(to, addr)
+		var ierr error
+		for _, owner := range to {
+			if owner == addr {
+				continue
+			}
+			_, err := g.client.GetClient().Do(ctx, owner, func(ctx context.Context,
+				conn *grpc.ClientConn, copts ...grpc.CallOption,
+			) (any, error) {
+				return nil, moveVector(ctx, vc.NewFromConn(conn), collection, vec, copts...)
+			})
+			if err != nil {
+				ierr = errors.Join(ierr, err)
+			}
+		}
+		if ierr != nil {
+			log.Warnf("failed to move the vector %s of the agent %s: %v", vec.GetId(), addr, ierr)
+			continue
+		}
+		if owned {
+			continue
+		}
+		_, err = ac.Remove(ctx, &payload.Remove_Request{
+			Id: &payload.Object_ID{
+				Id: vec.GetId(),
+			},
+			Config: &payload.Remove_Config{
+				SkipStrictExistCheck: true,
+				Timestamp:            vec.GetTimestamp(),
+				Collection:           collection,
+			},
+		}, copts...)
+		if err != nil {
+			log.Warnf("failed to remove the moved vector %s from the agent %s: %v", vec.GetId(), addr, err)
+			continue
+		}
+		moved++
+	}
+	if moved > 0 {
+		log.Infof("%d vectors of the collection %q moved from the agent %s", moved, collection, addr)
+	}
+	return nil
+}
+
+// moveVector writes the vector into the owner unless the owner has the vector of the same or a newer timestamp.
+// The vector is upserted with its timestamp and attributes, so the older vector of the owner is replaced by the vector, while
+// the newer vector of the owner is kept and the vector is removed from the agent which does not own it.
+func moveVector(
+	ctx context.Context, ac vc.Client, collection string, vec *payload.Object_Vector, copts ...grpc.CallOption,
+) error {
+	ts, err := ac.GetTimestamp(ctx, &payload.Object_TimestampRequest{
+		Id: &payload.Object_ID{
+			Id: vec.GetId(),
+		},
+		Collection: collection,
+	}, copts...)
+	if err == nil && ts.GetTimestamp() >= vec.GetTimestamp() {
+		return nil
+	}
+	if err != nil {
+		if st, ok := status.FromError(err); !ok || st == nil || st.Code() != codes.NotFound {
+			return err
+		}
+	}
+	_, err = ac.Upsert(ctx, &payload.Upsert_Request{
+		Vector: vec,
+		Config: &payload.Upsert_Config{
+			SkipStrictExistCheck: true,
+			Timestamp:            vec.GetTimestamp(),
+			Attributes:           vec.GetAttributes(),
+			Collection:           collection,
+		},
+	}, copts...)
+	if err != nil {
+		// the owner has the same vector, whose timestamp is updated by the upsert.
+		if st, ok := status.FromError(err); ok && st != nil && st.Code() == codes.AlreadyExists {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package service
+package service
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/test/goleak"
+
+	vc "github.com/vdaas/vald/internal/client/v1/client/vald"
+)
+
+func Test_leaseHolder(t *testing.T) {
+	tests := []struct {
+		name   string
+		vec    []float32
+		want   uint64
+		wantOK bool
+	}{
+		{
+			name:   "return the ID of the lease vector of 0",
+			vec:    leaseVector(0),
+			want:   0,
+			wantOK: true,
+		},
+		{
+			name:   "return the ID of the lease vector of the max uint64",
+			vec:    leaseVector(math.MaxUint64),
+			want:   math.MaxUint64,
+			wantOK: true,
+		},
+		{
+			name:   "return the ID of the lease vector of an ID using all elements",
+			vec:    leaseVector(0x0123456789abcdef),
+			want:   0x0123456789abcdef,
+			wantOK: true,
+		},
+		{
+			name:   "return false when the dimension is not the lease dimension",
+			vec:    []float32{1, 1},
+			wantOK: false,
+		},
+		{
+			name:   "return false when an element is not the lease element",
+			vec:    []float32{1, 1.5, 1, 1},
+			wantOK: false,
+		},
+		{
+			name:   "return false when an element is zero",
+			vec:    []float32{1, 0, 1, 1},
+			wantOK: false,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			got, ok := leaseHolder(test.vec)
+			if ok != test.wantOK {
+				tt.Fatalf("got ok = %v, want %v", ok, test.wantOK)
+			}
+			if ok && got != test.want {
+				tt.Errorf("got = %x, want %x", got, test.want)
+			}
+		})
+	}
+}
+
+type moveClient struct {
+	vc.Client
+	ts      int64
+	upserts []*payload.Upsert_Request
+}
+
+func (c *moveClient) GetTimestamp(
+	_ context.Context, req *payload.Object_TimestampRequest, _ ...grpc.CallOption,
+) (*payload.Object_Timestamp, error) {
+	if c.ts == 0 {
+		return nil, status.WrapWithNotFound("not found", nil)
+	}
+	return &payload.Object_Timestamp{
+		Id:        req.GetId().GetId(),
+		Timestamp: c.ts,
+	}, nil
+}
+
+func (c *moveClient) Upsert(
+	_ context.Context, req *payload.Upsert_Request, _ ...grpc.CallOption,
+) (*payload.Object_Location, error) {
+	c.upserts = append(c.upserts, req)
+	return new(payload.Object_Location), nil
+}
+
+func Test_moveVector(t *testing.T) {
+	attrs := map[string]*payload.Attribute_Value{
+		"genre": {
+			Value: &payload.Attribute_Value_StringValue{
+				StringValue: "jazz",
+			},
+		},
+		"year": {
+			Value: &payload.Attribute_Value_IntValue{
+				IntValue: 1959,
+			},
+		},
+	}
+	vec := &payload.Object_Vector{
+		Id:         "uuid-1",
+		Vector:     []float32{1, 2, 3},
+		Timestamp:  100,
+		Attributes: attrs,
+	}
+	tests := []struct {
+		name        string
+		ts          int64
+		wantUpserts int
+	}{
+		{
+			name:        "upsert the vector with its timestamp and attributes when the owner does not have it",
+			wantUpserts: 1,
+		},
+		{
+			name:        "upsert the vector with its timestamp and attributes when the owner has the older one",
+			ts:          50,
+			wantUpserts: 1,
+		},
+		{
+			name: "keep the vector of the owner when the owner has the newer one",
+			ts:   200,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			defer goleak.VerifyNone(tt, goleak.IgnoreCurrent())
+			c := &moveClient{
+				ts: test.ts,
+			}
+			if err := moveVector(context.Background(), c, "c1", vec); err != nil {
+				tt.Fatalf("moveVector() error = %v", err)
+			}
+			if len(c.upserts) != test.wantUpserts {
+				tt.Fatalf("got %d upserts, want %d", len(c.upserts), test.wantUpserts)
+			}
+			for _, req := range c.upserts {
+				cfg := req.GetConfig()
+				if cfg.GetTimestamp() != vec.GetTimestamp() || cfg.GetCollection() != "c1" {
+					tt.Errorf("got config = %v, want the timestamp %d in the collection c1", cfg, vec.GetTimestamp())
+				}
+				if len(cfg.GetAttributes()) != len(attrs) {
+					tt.Fatalf("got attributes = %v, want %v", cfg.GetAttributes(), attrs)
+				}
+				for k, v := range attrs {
+					if !v.EqualVT(cfg.GetAttributes()[k]) {
+						tt.Errorf("got attribute %s = %v, want %v", k, cfg.GetAttributes()[k], v)
+					}
+				}
+			}
+		})
+	}
+}
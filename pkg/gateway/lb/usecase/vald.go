@@ -18,11 +18,14 @@ import (
 	"context"
 
 	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/algorithm/distance"
 	"github.com/vdaas/vald/internal/client/v1/client/discoverer"
 	"github.com/vdaas/vald/internal/net/grpc"
 	"github.com/vdaas/vald/internal/observability"
+	"github.com/vdaas/vald/internal/observability/metrics"
 	backoffmetrics "github.com/vdaas/vald/internal/observability/metrics/backoff"
 	cbmetrics "github.com/vdaas/vald/internal/observability/metrics/circuitbreaker"
+	cachemetrics "github.com/vdaas/vald/internal/observability/metrics/gateway/cache"
 	"github.com/vdaas/vald/internal/runner"
 	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/internal/servers/server"
@@ -41,6 +44,7 @@ type run struct {
 	server        starter.Server
 	observability observability.Observability
 	gateway       service.Gateway
+	cache         service.ResultCache
 }
 
 func discovererClient(
@@ -58,6 +62,7 @@ func discovererClient(
 		discoverer.WithOptions(aopts...),
 		discoverer.WithNodeName(cfg.Gateway.NodeName),
 		discoverer.WithReadReplicaReplicas(cfg.Gateway.ReadReplicaReplicas),
+		discoverer.WithIndexStateCheck(cfg.Gateway.AvoidBusyAgents),
 	)
 
 	rrOpts, err := cfg.Gateway.ReadReplicaClient.Client.Opts()
@@ -104,21 +109,63 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 		return nil, err
 	}
 
-	gateway, err = service.NewGateway(
+	gopts := []service.Option{
 		service.WithErrGroup(eg),
 		service.WithDiscoverer(client),
-	)
+		service.WithPlacement(cfg.Gateway.Placement),
+		service.WithIndexReplica(cfg.Gateway.IndexReplica),
+		service.WithRebalanceDuration(cfg.Gateway.RebalanceDuration),
+	}
+	if p := cfg.Gateway.Partition; p != nil {
+		gopts = append(gopts,
+			service.WithPartitions(p.Partitions),
+			service.WithPartitionNprobe(p.Nprobe),
+			service.WithPartitionTraining(p.EnableTraining),
+			service.WithPartitionTrainSampleSize(p.TrainSampleSize),
+			service.WithPartitionImbalanceRatio(p.ImbalanceRatio),
+			service.WithPartitionCheckDuration(p.CheckDuration),
+		)
+	}
+	if st := cfg.Gateway.Straggler; st != nil {
+		gopts = append(gopts,
+			service.WithStragglerSkipping(st.Enabled),
+			service.WithHedging(st.Hedge),
+			service.WithHedgePercentile(st.Percentile),
+			service.WithHedgeMinDelay(st.MinDelay),
+		)
+	}
+	gateway, err = service.NewGateway(gopts...)
 	if err != nil {
 		return nil, err
 	}
 
-	v := handler.New(
+	var cache service.ResultCache
+	if rc := cfg.Gateway.ResultCache; rc != nil && rc.Enabled {
+		cache, err = service.NewResultCache(
+			service.WithResultCacheTTL(rc.TTL),
+			service.WithResultCacheMaxMemory(rc.MaxMemory),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hopts := []handler.Option{
 		handler.WithGateway(gateway),
+		handler.WithResultCache(cache),
 		handler.WithErrGroup(eg),
 		handler.WithReplicationCount(cfg.Gateway.IndexReplica),
 		handler.WithStreamConcurrency(cfg.Server.GetGRPCStreamConcurrency()),
 		handler.WithMultiConcurrency(cfg.Gateway.MultiOperationConcurrency),
-	)
+	}
+	if rr := cfg.Gateway.Rerank; rr != nil && len(rr.DistanceType) != 0 {
+		dt, err := distance.Parse(rr.DistanceType)
+		if err != nil {
+			return nil, err
+		}
+		hopts = append(hopts, handler.WithRerank(dt, rr.Ratio))
+	}
+	v := handler.New(hopts...)
 
 	grpcServerOptions := []server.Option{
 		server.WithGRPCRegisterar(func(srv *grpc.Server) {
@@ -132,10 +179,16 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 
 	var obs observability.Observability
 	if cfg.Observability.Enabled {
-		obs, err = observability.NewWithConfig(
-			cfg.Observability,
+		mets := []metrics.Metric{
 			backoffmetrics.New(),
 			cbmetrics.New(),
+		}
+		if cache != nil {
+			mets = append(mets, cachemetrics.New(cache))
+		}
+		obs, err = observability.NewWithConfig(
+			cfg.Observability,
+			mets...,
 		)
 		if err != nil {
 			return nil, err
@@ -171,6 +224,7 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 		server:        srv,
 		observability: obs,
 		gateway:       gateway,
+		cache:         cache,
 	}, nil
 }
 
@@ -195,6 +249,9 @@ func (r *run) Start(ctx context.Context) (<-chan error, error) {
 			return nil, err
 		}
 	}
+	if r.cache != nil {
+		r.cache.Start(ctx)
+	}
 	sech = r.server.ListenAndServe(ctx)
 	r.eg.Go(safety.RecoverFunc(func() (err error) {
 		defer close(ech)
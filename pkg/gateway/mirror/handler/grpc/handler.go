@@ -48,6 +48,7 @@ type server struct {
 	eg                errgroup.Group
 	gateway           service.Gateway
 	mirror            service.Mirror
+	replicator        service.Replicator
 	vAddr             string
 	name              string
 	ip                string
@@ -946,6 +947,10 @@ func (s *server) Insert(
 
 	// If this condition is matched, it means the request from user.
 	// So this component sends requests to other Mirror gateways and the Vald gateway (LB gateway) of its own cluster.
+	// In the asynchronous replication mode, the requests to other Mirror gateways are shipped in the background.
+	if s.replicator != nil {
+		return s.replicateInsert(ctx, req)
+	}
 	return s.handleInsert(ctx, req)
 }
 
@@ -1067,7 +1072,8 @@ func (s *server) handleInsert(
 	resLoc, err := s.handleInsertResult(ctx, alreadyExistsTgts, &payload.Update_Request{
 		Vector: req.GetVector(),
 		Config: &payload.Update_Config{
-			Timestamp: req.GetConfig().GetTimestamp(),
+			Timestamp:  req.GetConfig().GetTimestamp(),
+			Attributes: req.GetConfig().GetAttributes(),
 		},
 	}, &result)
 	if err != nil {
@@ -1410,6 +1416,9 @@ func (s *server) Update(
 
 	// If this condition is matched, it means the request from user.
 	// So this component sends requests to other Mirror gateways and the Vald gateway (LB gateway) of its own cluster.
+	if s.replicator != nil {
+		return s.replicateUpdate(ctx, req)
+	}
 	return s.handleUpdate(ctx, req)
 }
 
@@ -1540,7 +1549,8 @@ func (s *server) handleUpdate(
 	resLoc, err := s.handleUpdateResult(ctx, notFoundTgts, &payload.Insert_Request{
 		Vector: req.GetVector(),
 		Config: &payload.Insert_Config{
-			Timestamp: req.GetConfig().GetTimestamp(),
+			Timestamp:  req.GetConfig().GetTimestamp(),
+			Attributes: req.GetConfig().GetAttributes(),
 		},
 	}, &result)
 	if err != nil {
@@ -1892,6 +1902,9 @@ func (s *server) Upsert(
 
 	// If this condition is matched, it means the request from user.
 	// So this component sends requests to other Mirror gateways and the Vald gateway (LB gateway) of its own cluster.
+	if s.replicator != nil {
+		return s.replicateUpsert(ctx, req)
+	}
 	return s.handleUpsert(ctx, req)
 }
 
@@ -2221,6 +2234,9 @@ func (s *server) Remove(
 
 	// If this condition is matched, it means the request from user.
 	// So this component sends requests to other Mirror gateways and the Vald gateway (LB gateway) of its own cluster.
+	if s.replicator != nil {
+		return s.replicateRemove(ctx, req)
+	}
 	return s.handleRemove(ctx, req)
 }
 
@@ -2547,6 +2563,9 @@ func (s *server) RemoveByTimestamp(
 
 	// If this condition is matched, it means the request from user.
 	// So this component sends requests to other Mirror gateways and the Vald gateway (LB gateway) of its own cluster.
+	if s.replicator != nil {
+		return s.replicateRemoveByTimestamp(ctx, req)
+	}
 	return s.handleRemoveByTimestamp(ctx, req)
 }
 
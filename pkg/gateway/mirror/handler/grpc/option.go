@@ -77,6 +77,17 @@ func WithMirror(m service.Mirror) Option {
 	}
 }
 
+// WithReplicator returns the option to set the Replicator to replicate the write requests to the other Mirror gateways asynchronously.
+// If it is not set, the write requests are forwarded to the other Mirror gateways synchronously.
+func WithReplicator(r service.Replicator) Option {
+	return func(s *server) error {
+		if r != nil {
+			s.replicator = r
+		}
+		return nil
+	}
+}
+
 func WithErrGroup(eg errgroup.Group) Option {
 	return func(s *server) error {
 		if eg != nil {
@@ -0,0 +1,230 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/errdetails"
+	"github.com/vdaas/vald/internal/net/grpc/errhandler"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/pkg/gateway/mirror/service"
+)
+
+// The replicateXxx functions handle the write requests from a user in the asynchronous replication mode.
+// The request is sent only to the Vald gateway (LB gateway) of its own cluster, and after it succeeds,
+// the request is appended to the outbox and shipped to the other Mirror gateways in the background.
+// The timestamp of the request is fixed before it is sent, so the shipped request does not overwrite
+// a newer object even if it is delayed or shipped again.
+
+func (s *server) replicateInsert(
+	ctx context.Context, req *payload.Insert_Request,
+) (loc *payload.Object_Location, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "replicateInsert"), apiName+"/replicateInsert")
+	defer trace.End(span)
+
+	req = req.CloneVT()
+	if req.GetConfig() == nil {
+		req.Config = new(payload.Insert_Config)
+	}
+	if req.GetConfig().GetTimestamp() == 0 {
+		req.Config.Timestamp = time.Now().UnixNano()
+	}
+	loc, err = s.doInsert(ctx, req, func(ctx context.Context) (*payload.Object_Location, error) {
+		_, derr := s.gateway.Do(ctx, s.vAddr, func(ctx context.Context, _ string, vc service.MirrorClient, copts ...grpc.CallOption) (any, error) {
+			loc, err = vc.Insert(ctx, req, copts...)
+			return loc, err
+		})
+		return loc, errors.Join(derr, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	cfg := req.GetConfig()
+	err = s.replicator.ReplicateUpsert(ctx, &payload.Upsert_Request{
+		Vector: req.GetVector(),
+		Config: &payload.Upsert_Config{
+			SkipStrictExistCheck: cfg.GetSkipStrictExistCheck(),
+			Filters:              cfg.GetFilters(),
+			Timestamp:            cfg.GetTimestamp(),
+			Attributes:           cfg.GetAttributes(),
+			Collection:           cfg.GetCollection(),
+		},
+	})
+	if err != nil {
+		return nil, s.replicationError(span, vald.InsertRPCName, req.GetVector().GetId(), req, err)
+	}
+	log.Debugf("Insert API succeeded to %#v", loc)
+	return loc, nil
+}
+
+func (s *server) replicateUpdate(
+	ctx context.Context, req *payload.Update_Request,
+) (loc *payload.Object_Location, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "replicateUpdate"), apiName+"/replicateUpdate")
+	defer trace.End(span)
+
+	req = req.CloneVT()
+	if req.GetConfig() == nil {
+		req.Config = new(payload.Update_Config)
+	}
+	if req.GetConfig().GetTimestamp() == 0 {
+		req.Config.Timestamp = time.Now().UnixNano()
+	}
+	loc, err = s.doUpdate(ctx, req, func(ctx context.Context) (*payload.Object_Location, error) {
+		_, derr := s.gateway.Do(ctx, s.vAddr, func(ctx context.Context, _ string, vc service.MirrorClient, copts ...grpc.CallOption) (any, error) {
+			loc, err = vc.Update(ctx, req, copts...)
+			return loc, err
+		})
+		return loc, errors.Join(derr, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	cfg := req.GetConfig()
+	err = s.replicator.ReplicateUpsert(ctx, &payload.Upsert_Request{
+		Vector: req.GetVector(),
+		Config: &payload.Upsert_Config{
+			SkipStrictExistCheck:  cfg.GetSkipStrictExistCheck(),
+			Filters:               cfg.GetFilters(),
+			Timestamp:             cfg.GetTimestamp(),
+			DisableBalancedUpdate: cfg.GetDisableBalancedUpdate(),
+			Attributes:            cfg.GetAttributes(),
+			Collection:            cfg.GetCollection(),
+		},
+	})
+	if err != nil {
+		return nil, s.replicationError(span, vald.UpdateRPCName, req.GetVector().GetId(), req, err)
+	}
+	log.Debugf("Update API succeeded to %#v", loc)
+	return loc, nil
+}
+
+func (s *server) replicateUpsert(
+	ctx context.Context, req *payload.Upsert_Request,
+) (loc *payload.Object_Location, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "replicateUpsert"), apiName+"/replicateUpsert")
+	defer trace.End(span)
+
+	req = req.CloneVT()
+	if req.GetConfig() == nil {
+		req.Config = new(payload.Upsert_Config)
+	}
+	if req.GetConfig().GetTimestamp() == 0 {
+		req.Config.Timestamp = time.Now().UnixNano()
+	}
+	loc, err = s.doUpsert(ctx, req, func(ctx context.Context) (*payload.Object_Location, error) {
+		_, derr := s.gateway.Do(ctx, s.vAddr, func(ctx context.Context, _ string, vc service.MirrorClient, copts ...grpc.CallOption) (any, error) {
+			loc, err = vc.Upsert(ctx, req, copts...)
+			return loc, err
+		})
+		return loc, errors.Join(derr, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = s.replicator.ReplicateUpsert(ctx, req)
+	if err != nil {
+		return nil, s.replicationError(span, vald.UpsertRPCName, req.GetVector().GetId(), req, err)
+	}
+	log.Debugf("Upsert API succeeded to %#v", loc)
+	return loc, nil
+}
+
+func (s *server) replicateRemove(
+	ctx context.Context, req *payload.Remove_Request,
+) (loc *payload.Object_Location, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "replicateRemove"), apiName+"/replicateRemove")
+	defer trace.End(span)
+
+	req = req.CloneVT()
+	if req.GetConfig() == nil {
+		req.Config = new(payload.Remove_Config)
+	}
+	if req.GetConfig().GetTimestamp() == 0 {
+		req.Config.Timestamp = time.Now().UnixNano()
+	}
+	loc, err = s.doRemove(ctx, req, func(ctx context.Context) (*payload.Object_Location, error) {
+		_, derr := s.gateway.Do(ctx, s.vAddr, func(ctx context.Context, _ string, vc service.MirrorClient, copts ...grpc.CallOption) (any, error) {
+			loc, err = vc.Remove(ctx, req, copts...)
+			return loc, err
+		})
+		return loc, errors.Join(derr, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = s.replicator.ReplicateRemove(ctx, req)
+	if err != nil {
+		return nil, s.replicationError(span, vald.RemoveRPCName, req.GetId().GetId(), req, err)
+	}
+	log.Debugf("Remove API succeeded to %#v", loc)
+	return loc, nil
+}
+
+func (s *server) replicateRemoveByTimestamp(
+	ctx context.Context, req *payload.Remove_TimestampRequest,
+) (locs *payload.Object_Locations, err error) {
+	ctx, span := trace.StartSpan(grpc.WrapGRPCMethod(ctx, "replicateRemoveByTimestamp"), apiName+"/replicateRemoveByTimestamp")
+	defer trace.End(span)
+
+	locs, err = s.doRemoveByTimestamp(ctx, req, func(ctx context.Context) (*payload.Object_Locations, error) {
+		_, derr := s.gateway.Do(ctx, s.vAddr, func(ctx context.Context, _ string, vc service.MirrorClient, copts ...grpc.CallOption) (any, error) {
+			locs, err = vc.RemoveByTimestamp(ctx, req, copts...)
+			return locs, err
+		})
+		return locs, errors.Join(derr, err)
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = s.replicator.ReplicateRemoveByTimestamp(ctx, req)
+	if err != nil {
+		return nil, s.replicationError(span, vald.RemoveByTimestampRPCName, "", req, err)
+	}
+	log.Debugf("RemoveByTimestamp API succeeded to %#v", locs)
+	return locs, nil
+}
+
+// replicationError wraps the error of appending the request to the outbox.
+// The request has already been applied to its own cluster, so the caller may retry it safely.
+// RESOURCE_EXHAUSTED is returned when the outbox is full with the reject overflow policy.
+func (s *server) replicationError(span trace.Span, rpcName, id string, req any, err error) error {
+	msg := rpcName + " API failed to replicate the request to the other mirror gateways"
+	details := []any{
+		&errdetails.RequestInfo{
+			RequestId:   id,
+			ServingData: errdetails.Serialize(req),
+		},
+		s.resourceInfo(errdetails.ValdGRPCResourceTypePrefix + "/vald.v1." + rpcName),
+	}
+	var attrs trace.Attributes
+	if errors.Is(err, errors.ErrOutboxFull) {
+		err = status.WrapWithResourceExhausted(msg, err, details...)
+		attrs = trace.StatusCodeResourceExhausted(err.Error())
+	} else {
+		err = status.WrapWithInternal(msg, err, details...)
+		attrs = trace.StatusCodeInternal(err.Error())
+	}
+	log.Error(err)
+	errhandler.RecordSpanAttrs(span, attrs, err)
+	return err
+}
@@ -0,0 +1,705 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package service
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/internal/sync"
+)
+
+// ReplicationOp represents the operation of the write request replicated to the other Mirror gateways.
+type ReplicationOp uint8
+
+const (
+	// ReplicationOpUpsert replicates Insert, Update and Upsert requests as Upsert requests,
+	// so they can be shipped again after a retry without failing with ALREADY_EXISTS or NOT_FOUND.
+	ReplicationOpUpsert ReplicationOp = iota + 1
+	// ReplicationOpRemove replicates Remove requests.
+	ReplicationOpRemove
+	// ReplicationOpRemoveByTimestamp replicates RemoveByTimestamp requests.
+	ReplicationOpRemoveByTimestamp
+)
+
+// OutboxOverflowPolicy represents the policy applied to the write request appended to the outbox which reaches the max size.
+type OutboxOverflowPolicy string
+
+const (
+	// OutboxOverflowDrop drops the oldest pending records to append the write request.
+	OutboxOverflowDrop OutboxOverflowPolicy = "drop"
+	// OutboxOverflowReject rejects the write request.
+	OutboxOverflowReject OutboxOverflowPolicy = "reject"
+)
+
+const (
+	// outboxFileName is the name of the log of the outbox in the outbox directory.
+	outboxFileName = "replication.outbox"
+	// outboxCursorSuffix is the suffix of the cursor files of the targets in the outbox directory.
+	outboxCursorSuffix = ".cursor"
+	// outboxHeaderSize is the size of the record header which consists of the body length and the crc32 checksum.
+	outboxHeaderSize = 8
+	// outboxEntryHeaderSize is the size of the fixed fields of the record body, which are the op, the sequence number and the enqueued time.
+	outboxEntryHeaderSize = 17
+	// outboxCursorSize is the size of the cursor file which consists of the sequence number and its crc32 checksum.
+	outboxCursorSize = 12
+	// outboxMaxRecordSize is the upper limit of the record body size to detect the corrupted header.
+	outboxMaxRecordSize = 1 << 30
+	// outboxCompactionThreshold is the number of the trimmed records to rewrite the outbox with the retained ones.
+	outboxCompactionThreshold = 1024
+)
+
+var outboxCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+// outboxEntry is the in-memory index of a record in the outbox.
+// The payload is kept only in the file and read when the record is shipped.
+type outboxEntry struct {
+	op         ReplicationOp
+	seq        uint64
+	key        string
+	enqueuedAt int64
+	offset     int64
+	size       int
+	length     int64
+}
+
+// outboxCursor is the position of a target in the outbox, which is the sequence number of the next record shipped to it.
+type outboxCursor struct {
+	target string
+	path   string
+	next   uint64 // guarded by the mutex of the outbox
+	notify chan struct{}
+
+	shipped atomic.Uint64
+	dropped atomic.Uint64
+	alarm   atomic.Bool
+	// seen is the time when the target was found in the mirror set last, in unix nanoseconds.
+	seen atomic.Int64
+	// cancel stops the shipper of the target, and done is closed when it returns.
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// outbox is the durable, append-only log of the write requests replicated to the targets.
+// A write request is appended once as a single record whatever the number of the targets, and each target ships it
+// from its own cursor, so a target discovered later starts from the oldest record retained for the other targets.
+//
+// Each record is framed as | body length (uint32) | crc32c of body (uint32) | body |, and the body is encoded as
+// | op (uint8) | sequence number (uint64) | enqueued at (int64) | key length (uvarint) | key | payload |.
+// The cursor of a target is kept in its own file as | sequence number (uint64) | crc32c of sequence number (uint32) |.
+// The records shipped to all the targets are trimmed from the head, and removed from the file when the log becomes empty
+// or by the compaction, so the records shipped after the last update of the cursor may be shipped again after a restart.
+type outbox struct {
+	// syncMu serializes the fsync of the file and the compaction, which replaces the file.
+	syncMu  sync.Mutex
+	mu      sync.Mutex
+	f       *os.File
+	dir     string
+	path    string
+	maxSize int64
+	policy  OutboxOverflowPolicy
+	entries []*outboxEntry // the retained records in the order of the sequence number
+	cursors map[string]*outboxCursor
+	size    int64  // the size of the file
+	pending int64  // the size of the retained records
+	head    uint64 // the sequence number of the first retained record, or of the next record when nothing is retained
+	synced  uint64 // the records before this sequence number are flushed to the storage
+	trimmed int    // the number of the records trimmed from the head after the last compaction
+	buf     []byte
+	closed  bool
+}
+
+// openOutbox opens the outbox in dir and the cursors of the targets.
+// The records beyond maxSize are handled by policy, and the size is unlimited when maxSize is 0.
+func openOutbox(dir string, maxSize int64, policy OutboxOverflowPolicy) (o *outbox, err error) {
+	o = &outbox{
+		dir:     dir,
+		path:    filepath.Join(dir, outboxFileName),
+		maxSize: maxSize,
+		policy:  policy,
+		cursors: make(map[string]*outboxCursor),
+	}
+	o.f, err = file.Open(o.path, os.O_RDWR|os.O_CREATE|os.O_APPEND, fs.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	err = o.load()
+	if err == nil {
+		err = o.loadCursors()
+	}
+	if err != nil {
+		return nil, errors.Join(err, o.f.Close())
+	}
+	o.synced = o.tail()
+	return o, nil
+}
+
+// load builds the index of the records in the file.
+// When a torn or corrupted record is found, the file is truncated at the last valid record.
+func (o *outbox) load() (err error) {
+	br := bufio.NewReader(o.f)
+	var (
+		header [outboxHeaderSize]byte
+		body   []byte
+	)
+	for {
+		_, err = io.ReadFull(br, header[:])
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err == nil {
+			size := binary.LittleEndian.Uint32(header[:4])
+			if size > outboxMaxRecordSize {
+				err = errors.ErrOutboxRecordCorrupted(o.path, o.size)
+			} else {
+				if cap(body) < int(size) {
+					body = make([]byte, size)
+				}
+				body = body[:size]
+				_, err = io.ReadFull(br, body)
+				if err == nil && crc32.Checksum(body, outboxCRCTable) != binary.LittleEndian.Uint32(header[4:]) {
+					err = errors.ErrOutboxRecordCorrupted(o.path, o.size)
+				}
+			}
+		}
+		var e *outboxEntry
+		if err == nil {
+			e, err = decodeOutboxEntry(body, o.size+outboxHeaderSize)
+			if err != nil {
+				err = errors.Join(errors.ErrOutboxRecordCorrupted(o.path, o.size), err)
+			} else if len(o.entries) != 0 && e.seq != o.tail() {
+				err = errors.ErrOutboxRecordCorrupted(o.path, o.size)
+			}
+		}
+		if err != nil {
+			// the tail of the outbox was not written completely, drop it to keep appending valid records.
+			log.Warnf("mirror replication outbox %s is truncated at offset %d because of the invalid record, err: %v", o.path, o.size, err)
+			return o.f.Truncate(o.size)
+		}
+		if len(o.entries) == 0 {
+			o.head = e.seq
+		}
+		o.entries = append(o.entries, e)
+		o.size += e.length
+		o.pending += e.length
+	}
+}
+
+// loadCursors loads the cursor files of the targets and trims the records shipped to all of them.
+// The cursors behind the head are moved to the head, since their records were shipped or dropped before.
+func (o *outbox) loadCursors() error {
+	paths, err := filepath.Glob(filepath.Join(o.dir, "*"+outboxCursorSuffix))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		target, err := url.PathUnescape(strings.TrimSuffix(filepath.Base(path), outboxCursorSuffix))
+		if err != nil {
+			log.Warnf("ignored the mirror replication cursor %s of the invalid name: %v", path, err)
+			continue
+		}
+		next, err := readOutboxCursor(path)
+		if err != nil {
+			// the records retained for the target are shipped again from the head.
+			log.Warnf("mirror replication cursor %s is reset to the head because it is invalid, err: %v", path, err)
+			next = o.head
+		}
+		o.cursors[target] = newOutboxCursor(target, path, next)
+	}
+	if len(o.entries) == 0 {
+		// the sequence number continues from the cursors, so that the new records are not skipped by them.
+		for _, c := range o.cursors {
+			o.head = max(o.head, c.next)
+		}
+	}
+	for _, c := range o.cursors {
+		c.next = min(max(c.next, o.head), o.tail())
+	}
+	o.trim()
+	return nil
+}
+
+// tail returns the sequence number of the next record. It must be called with o.mu held.
+func (o *outbox) tail() uint64 {
+	return o.head + uint64(len(o.entries))
+}
+
+// append appends the record to the file and returns after it is flushed to the storage.
+// The concurrent appends are flushed together by a single fsync.
+func (o *outbox) append(op ReplicationOp, key string, payload []byte, now time.Time) (err error) {
+	o.mu.Lock()
+	if o.closed {
+		o.mu.Unlock()
+		return errors.ErrOutboxClosed
+	}
+	e := &outboxEntry{
+		op:         op,
+		seq:        o.tail(),
+		key:        key,
+		enqueuedAt: now.UnixNano(),
+	}
+	o.buf = appendOutboxRecord(o.buf[:0], e, payload)
+	e.length = int64(len(o.buf))
+	if o.maxSize > 0 && o.pending+e.length > o.maxSize {
+		if o.policy != OutboxOverflowDrop || e.length > o.maxSize {
+			o.mu.Unlock()
+			return errors.Wrapf(errors.ErrOutboxFull, "%d bytes are pending and the record of %d bytes exceeds the max size %d", o.pending, e.length, o.maxSize)
+		}
+		o.drop(e.length)
+	}
+	_, err = o.f.Write(o.buf)
+	if err != nil {
+		err = errors.Join(err, o.f.Truncate(o.size))
+		o.mu.Unlock()
+		return err
+	}
+	e.offset = o.size + e.length - int64(len(payload))
+	e.size = len(payload)
+	o.entries = append(o.entries, e)
+	o.size += e.length
+	o.pending += e.length
+	compact := o.needsCompaction()
+	o.mu.Unlock()
+
+	err = o.sync(e.seq)
+	if err != nil {
+		return err
+	}
+	if compact {
+		return o.compact()
+	}
+	return nil
+}
+
+// drop drops the oldest records until the record of length fits in the max size, and moves the cursors behind them to the head.
+// It must be called with o.mu held.
+func (o *outbox) drop(length int64) {
+	var (
+		n    int
+		size int64
+	)
+	for ; n < len(o.entries) && o.pending-size+length > o.maxSize; n++ {
+		size += o.entries[n].length
+	}
+	o.trimHead(n)
+	log.Errorf("dropped %d mirror replication requests because the outbox exceeds the max size %d", n, o.maxSize)
+	for _, c := range o.cursors {
+		if c.next < o.head {
+			c.dropped.Add(o.head - c.next)
+			c.next = o.head
+		}
+	}
+}
+
+// trim trims the records shipped to all the targets from the head. It must be called with o.mu held.
+// Nothing is trimmed without the targets, so the records are kept until a target is discovered.
+func (o *outbox) trim() {
+	if len(o.cursors) == 0 {
+		return
+	}
+	next := o.tail()
+	for _, c := range o.cursors {
+		next = min(next, c.next)
+	}
+	o.trimHead(int(next - o.head))
+}
+
+// trimHead trims n records from the head. It must be called with o.mu held.
+func (o *outbox) trimHead(n int) {
+	if n <= 0 {
+		return
+	}
+	for _, e := range o.entries[:n] {
+		o.pending -= e.length
+	}
+	clear(o.entries[:n])
+	o.entries = o.entries[n:]
+	o.head += uint64(n)
+	o.trimmed += n
+}
+
+// needsCompaction reports whether the trimmed records should be removed from the file. It must be called with o.mu held.
+func (o *outbox) needsCompaction() bool {
+	return o.trimmed >= outboxCompactionThreshold && o.trimmed >= len(o.entries)
+}
+
+// sync flushes the file to the storage unless the record of seq is already flushed.
+// The records appended while the preceding fsync runs are flushed together by the next one.
+func (o *outbox) sync(seq uint64) error {
+	o.syncMu.Lock()
+	defer o.syncMu.Unlock()
+	o.mu.Lock()
+	if o.synced > seq {
+		o.mu.Unlock()
+		return nil
+	}
+	if o.closed {
+		o.mu.Unlock()
+		return errors.ErrOutboxClosed
+	}
+	f, tail := o.f, o.tail()
+	o.mu.Unlock()
+
+	err := f.Sync()
+	if err != nil {
+		return err
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.synced = max(o.synced, tail)
+	for _, c := range o.cursors {
+		select {
+		case c.notify <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// cursor returns the cursor of the target. The cursor of a new target starts from the head and is saved to the file,
+// so the records retained for it are not trimmed after a restart.
+func (o *outbox) cursor(target string) (c *outboxCursor, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return nil, errors.ErrOutboxClosed
+	}
+	c, ok := o.cursors[target]
+	if ok {
+		return c, nil
+	}
+	c = newOutboxCursor(target, filepath.Join(o.dir, url.PathEscape(target)+outboxCursorSuffix), o.head)
+	err = writeOutboxCursor(c.path, c.next)
+	if err != nil {
+		return nil, err
+	}
+	o.cursors[target] = c
+	return c, nil
+}
+
+// rangeCursors calls f sequentially for each cursor. If f returns false, range stops the iteration.
+func (o *outbox) rangeCursors(f func(c *outboxCursor) bool) {
+	o.mu.Lock()
+	cursors := make([]*outboxCursor, 0, len(o.cursors))
+	for _, c := range o.cursors {
+		cursors = append(cursors, c)
+	}
+	o.mu.Unlock()
+	for _, c := range cursors {
+		if !f(c) {
+			return
+		}
+	}
+}
+
+// peek returns up to n records flushed to the storage from the cursor and their payloads.
+func (o *outbox) peek(c *outboxCursor, n int) (entries []*outboxEntry, payloads [][]byte, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return nil, nil, errors.ErrOutboxClosed
+	}
+	start := int(c.next - o.head)
+	end := min(start+n, int(min(o.synced, o.tail())-o.head))
+	if end <= start {
+		return nil, nil, nil
+	}
+	entries = make([]*outboxEntry, end-start)
+	copy(entries, o.entries[start:end])
+	payloads = make([][]byte, len(entries))
+	for i, e := range entries {
+		payloads[i] = make([]byte, e.size)
+		_, err = o.f.ReadAt(payloads[i], e.offset)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return entries, payloads, nil
+}
+
+// ack moves the cursor past n records shipped from seq, and saves it to the file.
+// The records shipped to all the targets are trimmed, and removed from the file when the log becomes empty or by the compaction.
+func (o *outbox) ack(c *outboxCursor, seq uint64, n int) (err error) {
+	if n <= 0 {
+		return nil
+	}
+	o.mu.Lock()
+	if o.closed {
+		o.mu.Unlock()
+		return errors.ErrOutboxClosed
+	}
+	c.shipped.Add(uint64(n))
+	if seq+uint64(n) <= c.next {
+		// the records were dropped while they were shipped.
+		o.mu.Unlock()
+		return nil
+	}
+	c.next = seq + uint64(n)
+	next := c.next
+	compact, err := o.release()
+	o.mu.Unlock()
+
+	err = errors.Join(err, writeOutboxCursor(c.path, next))
+	if err == nil && compact {
+		return o.compact()
+	}
+	return err
+}
+
+// removeCursor removes the cursor and its file, and trims the records retained only for its target.
+func (o *outbox) removeCursor(c *outboxCursor) (err error) {
+	o.mu.Lock()
+	if o.closed {
+		o.mu.Unlock()
+		return errors.ErrOutboxClosed
+	}
+	if o.cursors[c.target] != c {
+		o.mu.Unlock()
+		return nil
+	}
+	delete(o.cursors, c.target)
+	err = os.Remove(c.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		err = nil
+	}
+	compact, rerr := o.release()
+	o.mu.Unlock()
+
+	err = errors.Join(err, rerr, syncDir(o.dir))
+	if err == nil && compact {
+		return o.compact()
+	}
+	return err
+}
+
+// release trims the records shipped to all the targets, and truncates the file when nothing is retained.
+// It reports whether the file should be compacted. It must be called with o.mu held.
+func (o *outbox) release() (compact bool, err error) {
+	o.trim()
+	if len(o.entries) == 0 && o.size != 0 {
+		o.size = 0
+		o.trimmed = 0
+		err = o.f.Truncate(0)
+	}
+	return o.needsCompaction(), err
+}
+
+// compact rewrites the outbox with the retained records.
+func (o *outbox) compact() (err error) {
+	o.syncMu.Lock()
+	defer o.syncMu.Unlock()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed || !o.needsCompaction() {
+		return nil
+	}
+	tmp := o.path + ".tmp"
+	f, err := file.Open(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	entries := make([]*outboxEntry, 0, len(o.entries))
+	var (
+		size    int64
+		payload []byte
+	)
+	bw := bufio.NewWriter(f)
+	for _, e := range o.entries {
+		if cap(payload) < e.size {
+			payload = make([]byte, e.size)
+		}
+		payload = payload[:e.size]
+		_, err = o.f.ReadAt(payload, e.offset)
+		if err != nil {
+			break
+		}
+		o.buf = appendOutboxRecord(o.buf[:0], e, payload)
+		_, err = bw.Write(o.buf)
+		if err != nil {
+			break
+		}
+		ne := *e
+		ne.offset = size + e.length - int64(e.size)
+		entries = append(entries, &ne)
+		size += e.length
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); cerr != nil {
+		err = errors.Join(err, cerr)
+	}
+	if err != nil {
+		return errors.Join(err, os.Remove(tmp))
+	}
+	err = os.Rename(tmp, o.path)
+	if err != nil {
+		return errors.Join(err, os.Remove(tmp))
+	}
+	// the rename is persisted by the fsync of the directory, otherwise the old file may come back after a crash.
+	err = syncDir(o.dir)
+	if err != nil {
+		return err
+	}
+	nf, err := file.Open(o.path, os.O_RDWR|os.O_CREATE|os.O_APPEND, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	err = o.f.Close()
+	o.f = nf
+	o.entries = entries
+	o.size = size
+	o.trimmed = 0
+	// all the retained records are flushed with the new file.
+	o.synced = o.tail()
+	return err
+}
+
+// stats returns the number of the records pending for the cursor and the enqueued time of the oldest one.
+func (o *outbox) stats(c *outboxCursor) (pending int, oldest time.Time) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	pending = int(o.tail() - c.next)
+	if pending == 0 {
+		return 0, time.Time{}
+	}
+	return pending, time.Unix(0, o.entries[c.next-o.head].enqueuedAt)
+}
+
+func (o *outbox) close() error {
+	o.syncMu.Lock()
+	defer o.syncMu.Unlock()
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closed {
+		return nil
+	}
+	o.closed = true
+	return o.f.Close()
+}
+
+func newOutboxCursor(target, path string, next uint64) *outboxCursor {
+	c := &outboxCursor{
+		target: target,
+		path:   path,
+		next:   next,
+		notify: make(chan struct{}, 1),
+	}
+	// the records retained for the target are shipped as soon as it starts.
+	c.notify <- struct{}{}
+	return c
+}
+
+func readOutboxCursor(path string) (next uint64, err error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) != outboxCursorSize ||
+		crc32.Checksum(buf[:8], outboxCRCTable) != binary.LittleEndian.Uint32(buf[8:]) {
+		return 0, errors.ErrOutboxCursorCorrupted(path)
+	}
+	return binary.LittleEndian.Uint64(buf[:8]), nil
+}
+
+// writeOutboxCursor replaces the cursor file with a temporary file, so the cursor is not torn by a crash.
+func writeOutboxCursor(path string, next uint64) (err error) {
+	buf := binary.LittleEndian.AppendUint64(make([]byte, 0, outboxCursorSize), next)
+	buf = binary.LittleEndian.AppendUint32(buf, crc32.Checksum(buf, outboxCRCTable))
+	tmp := path + ".tmp"
+	f, err := file.Open(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fs.ModePerm)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(buf)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); cerr != nil {
+		err = errors.Join(err, cerr)
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		return errors.Join(err, os.Remove(tmp))
+	}
+	return syncDir(filepath.Dir(path))
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	return errors.Join(d.Sync(), d.Close())
+}
+
+func appendOutboxRecord(buf []byte, e *outboxEntry, payload []byte) []byte {
+	start := len(buf)
+	buf = append(buf, make([]byte, outboxHeaderSize)...)
+	buf = append(buf, byte(e.op))
+	buf = binary.LittleEndian.AppendUint64(buf, e.seq)
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(e.enqueuedAt))
+	buf = binary.AppendUvarint(buf, uint64(len(e.key)))
+	buf = append(buf, e.key...)
+	buf = append(buf, payload...)
+	body := buf[start+outboxHeaderSize:]
+	binary.LittleEndian.PutUint32(buf[start:], uint32(len(body)))
+	binary.LittleEndian.PutUint32(buf[start+4:], crc32.Checksum(body, outboxCRCTable))
+	return buf
+}
+
+// decodeOutboxEntry decodes the body of the record at offset of the file except for the payload.
+func decodeOutboxEntry(body []byte, offset int64) (e *outboxEntry, err error) {
+	if len(body) < outboxEntryHeaderSize {
+		return nil, io.ErrUnexpectedEOF
+	}
+	e = &outboxEntry{
+		op:         ReplicationOp(body[0]),
+		seq:        binary.LittleEndian.Uint64(body[1:9]),
+		enqueuedAt: int64(binary.LittleEndian.Uint64(body[9:outboxEntryHeaderSize])),
+		length:     int64(outboxHeaderSize + len(body)),
+	}
+	switch e.op {
+	case ReplicationOpUpsert, ReplicationOpRemove, ReplicationOpRemoveByTimestamp:
+	default:
+		return nil, errors.Errorf("unknown mirror replication operation: %d", e.op)
+	}
+	l, n := binary.Uvarint(body[outboxEntryHeaderSize:])
+	if n <= 0 || uint64(len(body)-outboxEntryHeaderSize-n) < l {
+		return nil, io.ErrUnexpectedEOF
+	}
+	head := outboxEntryHeaderSize + n + int(l)
+	e.key = string(body[outboxEntryHeaderSize+n : head])
+	e.offset = offset + int64(head)
+	e.size = len(body) - head
+	return e, nil
+}
@@ -0,0 +1,411 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/sync"
+)
+
+func Test_outbox_reload(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	now := time.Now()
+
+	ob, err := openOutbox(dir, 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	_, err = ob.cursor("target")
+	require.NoError(t, err)
+	require.NoError(t, ob.append(ReplicationOpUpsert, "c/1", []byte("upsert-1"), now))
+	require.NoError(t, ob.append(ReplicationOpRemove, "c/2", []byte("remove-2"), now.Add(time.Second)))
+	require.NoError(t, ob.append(ReplicationOpRemoveByTimestamp, "", []byte("rbt"), now.Add(2*time.Second)))
+	require.NoError(t, ob.close())
+	require.True(t, errors.Is(ob.append(ReplicationOpUpsert, "c/3", nil, now), errors.ErrOutboxClosed))
+
+	ob, err = openOutbox(dir, 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	defer ob.close()
+	c, err := ob.cursor("target")
+	require.NoError(t, err)
+	entries, payloads, err := ob.peek(c, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, ReplicationOpUpsert, entries[0].op)
+	require.Equal(t, "c/1", entries[0].key)
+	require.Equal(t, ReplicationOpRemove, entries[1].op)
+	require.Equal(t, "c/2", entries[1].key)
+	require.Equal(t, ReplicationOpRemoveByTimestamp, entries[2].op)
+	require.Empty(t, entries[2].key)
+	require.Equal(t, [][]byte{[]byte("upsert-1"), []byte("remove-2"), []byte("rbt")}, payloads)
+
+	pending, oldest := ob.stats(c)
+	require.Equal(t, 3, pending)
+	require.Equal(t, now.UnixNano(), oldest.UnixNano())
+}
+
+func Test_outbox_load_torn_tail(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		// tear corrupts the file whose last record starts at offset.
+		tear func(t *testing.T, path string, offset int64)
+	}{
+		{
+			name: "partially written record is truncated",
+			tear: func(t *testing.T, path string, offset int64) {
+				require.NoError(t, os.Truncate(path, offset+outboxHeaderSize+3))
+			},
+		},
+		{
+			name: "record with the broken checksum is truncated",
+			tear: func(t *testing.T, path string, offset int64) {
+				f, err := os.OpenFile(path, os.O_WRONLY, 0)
+				require.NoError(t, err)
+				_, err = f.WriteAt([]byte{0xff}, offset+outboxHeaderSize+1)
+				require.NoError(t, err)
+				require.NoError(t, f.Close())
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			path := filepath.Join(dir, outboxFileName)
+			ob, err := openOutbox(dir, 0, OutboxOverflowDrop)
+			require.NoError(t, err)
+			require.NoError(t, ob.append(ReplicationOpUpsert, "c/1", []byte("upsert-1"), time.Now()))
+			offset := ob.size
+			require.NoError(t, ob.append(ReplicationOpUpsert, "c/2", []byte("upsert-2"), time.Now()))
+			require.NoError(t, ob.close())
+
+			test.tear(t, path, offset)
+
+			ob, err = openOutbox(dir, 0, OutboxOverflowDrop)
+			require.NoError(t, err)
+			defer ob.close()
+			c, err := ob.cursor("target")
+			require.NoError(t, err)
+			entries, payloads, err := ob.peek(c, 10)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			require.Equal(t, []byte("upsert-1"), payloads[0])
+
+			fi, err := os.Stat(path)
+			require.NoError(t, err)
+			require.Equal(t, offset, fi.Size())
+
+			// the records appended after the truncation are loaded again.
+			require.NoError(t, ob.append(ReplicationOpUpsert, "c/3", []byte("upsert-3"), time.Now()))
+			require.NoError(t, ob.close())
+			ob, err = openOutbox(dir, 0, OutboxOverflowDrop)
+			require.NoError(t, err)
+			c, err = ob.cursor("target")
+			require.NoError(t, err)
+			pending, _ := ob.stats(c)
+			require.Equal(t, 2, pending)
+			require.NoError(t, ob.close())
+		})
+	}
+}
+
+func Test_outbox_ack(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, outboxFileName)
+	ob, err := openOutbox(dir, 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	defer ob.close()
+	c1, err := ob.cursor("target-1")
+	require.NoError(t, err)
+	c2, err := ob.cursor("target-2")
+	require.NoError(t, err)
+
+	const n = outboxCompactionThreshold + 10
+	for i := range n {
+		require.NoError(t, ob.append(ReplicationOpUpsert, "c/"+strconv.Itoa(i), []byte(strconv.Itoa(i)), time.Now()))
+	}
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	before := fi.Size()
+
+	// the records are retained until they are shipped to all the targets.
+	require.NoError(t, ob.ack(c1, 0, n))
+	pending, _ := ob.stats(c1)
+	require.Zero(t, pending)
+	pending, _ = ob.stats(c2)
+	require.Equal(t, n, pending)
+	require.Len(t, ob.entries, n)
+
+	// the shipped records are kept in the file until the compaction.
+	require.NoError(t, ob.ack(c2, 0, outboxCompactionThreshold-1))
+	pending, _ = ob.stats(c2)
+	require.Equal(t, n-outboxCompactionThreshold+1, pending)
+	fi, err = os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, before, fi.Size())
+
+	// the compaction rewrites the file with the retained records.
+	require.NoError(t, ob.ack(c2, outboxCompactionThreshold-1, 1))
+	fi, err = os.Stat(path)
+	require.NoError(t, err)
+	require.Less(t, fi.Size(), before)
+	require.Equal(t, ob.size, fi.Size())
+	entries, payloads, err := ob.peek(c2, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(outboxCompactionThreshold), entries[0].seq)
+	require.Equal(t, "c/"+strconv.Itoa(outboxCompactionThreshold), entries[0].key)
+	require.Equal(t, []byte(strconv.Itoa(outboxCompactionThreshold)), payloads[0])
+	require.Equal(t, uint64(outboxCompactionThreshold), c2.shipped.Load())
+	_, err = os.Stat(path + ".tmp")
+	require.True(t, errors.Is(err, os.ErrNotExist), err)
+
+	// the file is emptied when all the records are shipped.
+	require.NoError(t, ob.ack(c2, outboxCompactionThreshold, n-outboxCompactionThreshold))
+	pending, _ = ob.stats(c2)
+	require.Zero(t, pending)
+	fi, err = os.Stat(path)
+	require.NoError(t, err)
+	require.Zero(t, fi.Size())
+	require.Equal(t, uint64(n), c2.shipped.Load())
+
+	// the cursors and the sequence number survive the restart with the empty file.
+	require.NoError(t, ob.close())
+	ob, err = openOutbox(dir, 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	defer ob.close()
+	require.NoError(t, ob.append(ReplicationOpUpsert, "c/new", []byte("new"), time.Now()))
+	for _, target := range []string{"target-1", "target-2"} {
+		c, err := ob.cursor(target)
+		require.NoError(t, err)
+		entries, _, err := ob.peek(c, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, uint64(n), entries[0].seq)
+	}
+}
+
+func Test_outbox_cursor(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	ob, err := openOutbox(dir, 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	defer ob.close()
+
+	// the records appended before any target is discovered are retained for it.
+	require.NoError(t, ob.append(ReplicationOpUpsert, "c/1", []byte("1"), time.Now()))
+	c1, err := ob.cursor("target-1")
+	require.NoError(t, err)
+	pending, _ := ob.stats(c1)
+	require.Equal(t, 1, pending)
+
+	// a target discovered later starts from the oldest record retained for the other targets.
+	require.NoError(t, ob.append(ReplicationOpUpsert, "c/2", []byte("2"), time.Now()))
+	c2, err := ob.cursor("target-2")
+	require.NoError(t, err)
+	pending, _ = ob.stats(c2)
+	require.Equal(t, 2, pending)
+
+	// a target discovered after the records are shipped to all the targets starts from the next record.
+	require.NoError(t, ob.ack(c1, 0, 2))
+	require.NoError(t, ob.ack(c2, 0, 2))
+	c3, err := ob.cursor("target-3")
+	require.NoError(t, err)
+	pending, _ = ob.stats(c3)
+	require.Zero(t, pending)
+
+	// the cursor of a target is reloaded after the restart.
+	require.NoError(t, ob.append(ReplicationOpUpsert, "c/3", []byte("3"), time.Now()))
+	require.NoError(t, ob.ack(c1, 2, 1))
+	require.NoError(t, ob.close())
+	ob, err = openOutbox(dir, 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	defer ob.close()
+	var targets []string
+	ob.rangeCursors(func(c *outboxCursor) bool {
+		targets = append(targets, c.target)
+		return true
+	})
+	require.ElementsMatch(t, []string{"target-1", "target-2", "target-3"}, targets)
+	c1, err = ob.cursor("target-1")
+	require.NoError(t, err)
+	pending, _ = ob.stats(c1)
+	require.Zero(t, pending)
+	c2, err = ob.cursor("target-2")
+	require.NoError(t, err)
+	entries, _, err := ob.peek(c2, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "c/3", entries[0].key)
+}
+
+func Test_outbox_overflow(t *testing.T) {
+	t.Parallel()
+	payload := make([]byte, 100)
+	// the max size holds two records.
+	size := int64(len(appendOutboxRecord(nil, &outboxEntry{op: ReplicationOpUpsert, key: "c/0"}, payload)))
+	maxSize := 2*size + size/2
+
+	t.Run("drop policy drops the oldest records of the lagging targets", func(t *testing.T) {
+		t.Parallel()
+		ob, err := openOutbox(t.TempDir(), maxSize, OutboxOverflowDrop)
+		require.NoError(t, err)
+		defer ob.close()
+		lagging, err := ob.cursor("lagging")
+		require.NoError(t, err)
+		for i := range 2 {
+			require.NoError(t, ob.append(ReplicationOpUpsert, "c/"+strconv.Itoa(i), payload, time.Now()))
+		}
+		following, err := ob.cursor("following")
+		require.NoError(t, err)
+		require.NoError(t, ob.ack(following, 0, 2))
+
+		require.NoError(t, ob.append(ReplicationOpUpsert, "c/2", payload, time.Now()))
+		entries, _, err := ob.peek(lagging, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, "c/1", entries[0].key)
+		require.Equal(t, uint64(1), lagging.dropped.Load())
+		require.Zero(t, following.dropped.Load())
+		require.LessOrEqual(t, ob.pending, maxSize)
+
+		// the ack of the dropped records does not move the cursor back.
+		require.NoError(t, ob.ack(lagging, 0, 1))
+		pending, _ := ob.stats(lagging)
+		require.Equal(t, 2, pending)
+	})
+
+	t.Run("reject policy rejects the new record", func(t *testing.T) {
+		t.Parallel()
+		ob, err := openOutbox(t.TempDir(), maxSize, OutboxOverflowReject)
+		require.NoError(t, err)
+		defer ob.close()
+		c, err := ob.cursor("target")
+		require.NoError(t, err)
+		for i := range 2 {
+			require.NoError(t, ob.append(ReplicationOpUpsert, "c/"+strconv.Itoa(i), payload, time.Now()))
+		}
+		err = ob.append(ReplicationOpUpsert, "c/2", payload, time.Now())
+		require.True(t, errors.Is(err, errors.ErrOutboxFull), err)
+		pending, _ := ob.stats(c)
+		require.Equal(t, 2, pending)
+		require.Zero(t, c.dropped.Load())
+
+		// the record is appended after the pending records are shipped.
+		require.NoError(t, ob.ack(c, 0, 1))
+		require.NoError(t, ob.append(ReplicationOpUpsert, "c/2", payload, time.Now()))
+	})
+
+	t.Run("record larger than the max size is rejected", func(t *testing.T) {
+		t.Parallel()
+		ob, err := openOutbox(t.TempDir(), maxSize, OutboxOverflowDrop)
+		require.NoError(t, err)
+		defer ob.close()
+		err = ob.append(ReplicationOpUpsert, "c/0", make([]byte, maxSize), time.Now())
+		require.True(t, errors.Is(err, errors.ErrOutboxFull), err)
+		require.Zero(t, ob.size)
+	})
+}
+
+func Test_outbox_append_concurrently(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	ob, err := openOutbox(dir, 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	c, err := ob.cursor("target")
+	require.NoError(t, err)
+
+	const workers, n = 8, 50
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range n {
+				key := "c/" + strconv.Itoa(w) + "-" + strconv.Itoa(i)
+				if err := ob.append(ReplicationOpUpsert, key, []byte(key), time.Now()); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// every record is flushed and readable in the order of the sequence number.
+	entries, payloads, err := ob.peek(c, workers*n)
+	require.NoError(t, err)
+	require.Len(t, entries, workers*n)
+	for i, e := range entries {
+		require.Equal(t, uint64(i), e.seq)
+		require.Equal(t, e.key, string(payloads[i]))
+	}
+	require.NoError(t, ob.close())
+
+	ob, err = openOutbox(dir, 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	defer ob.close()
+	c, err = ob.cursor("target")
+	require.NoError(t, err)
+	pending, _ := ob.stats(c)
+	require.Equal(t, workers*n, pending)
+}
+
+func Test_outbox_removeCursor(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	ob, err := openOutbox(dir, 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	defer ob.close()
+	c1, err := ob.cursor("target-1")
+	require.NoError(t, err)
+	c2, err := ob.cursor("target-2")
+	require.NoError(t, err)
+	for i := range 3 {
+		require.NoError(t, ob.append(ReplicationOpUpsert, "c/"+strconv.Itoa(i), []byte(strconv.Itoa(i)), time.Now()))
+	}
+
+	// the records shipped to target-1 are retained for target-2.
+	require.NoError(t, ob.ack(c1, 0, 3))
+	require.Len(t, ob.entries, 3)
+
+	// the records retained only for the removed target are trimmed.
+	require.NoError(t, ob.removeCursor(c2))
+	require.Empty(t, ob.entries)
+	fi, err := os.Stat(filepath.Join(dir, outboxFileName))
+	require.NoError(t, err)
+	require.Zero(t, fi.Size())
+	_, err = os.Stat(c2.path)
+	require.True(t, errors.Is(err, os.ErrNotExist), err)
+
+	// the removed cursor is not loaded after the restart.
+	require.NoError(t, ob.close())
+	ob, err = openOutbox(dir, 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	defer ob.close()
+	var targets []string
+	ob.rangeCursors(func(c *outboxCursor) bool {
+		targets = append(targets, c.target)
+		return true
+	})
+	require.Equal(t, []string{"target-1"}, targets)
+}
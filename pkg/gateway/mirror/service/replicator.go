@@ -0,0 +1,526 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package service
+
+import (
+	"context"
+	"io/fs"
+	"reflect"
+	"time"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/backoff"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/file"
+	"github.com/vdaas/vald/internal/log"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+)
+
+// Replicator represents an interface for the asynchronous replication of the write requests to the other Mirror gateways.
+// The write requests are appended once to the durable outbox and shipped to each target in batches in the background.
+type Replicator interface {
+	Start(ctx context.Context) (<-chan error, error)
+	ReplicateUpsert(ctx context.Context, req *payload.Upsert_Request) error
+	ReplicateRemove(ctx context.Context, req *payload.Remove_Request) error
+	ReplicateRemoveByTimestamp(ctx context.Context, req *payload.Remove_TimestampRequest) error
+	RangeStats(f func(target string, st *ReplicationStats) bool)
+	Close() error
+}
+
+// ReplicationStats represents the replication state of a target.
+type ReplicationStats struct {
+	// Pending is the number of the write requests not shipped yet.
+	Pending int
+	// Lag is the time elapsed since the oldest pending write request was appended, which is 0 when nothing is pending.
+	Lag time.Duration
+	// Shipped is the number of the write requests shipped to the target.
+	Shipped uint64
+	// Dropped is the number of the write requests rejected by the target as invalid or dropped by the overflow of the outbox,
+	// which are never shipped again.
+	Dropped uint64
+	// Alarm reports whether the lag exceeds the max lag.
+	Alarm bool
+}
+
+type replicator struct {
+	eg        errgroup.Group
+	gateway   Gateway
+	mirr      Mirror
+	bo        backoff.Backoff
+	bopts     []backoff.Option
+	path      string
+	batchSize int
+	flushDur  time.Duration
+	maxLag    time.Duration
+	maxSize   int64
+	policy    OutboxOverflowPolicy
+	retention time.Duration
+
+	mu      sync.Mutex
+	ctx     context.Context
+	ech     chan error
+	ob      *outbox
+	cursors sync.Map[string, *outboxCursor] // map[target addr: cursor]
+}
+
+// NewReplicator creates the Replicator object with optional configuration options.
+// It returns the initialized Replicator object and an error if the creation process fails.
+func NewReplicator(opts ...ReplicatorOption) (Replicator, error) {
+	r := new(replicator)
+	for _, opt := range append(defaultReplicatorOpts, opts...) {
+		if err := opt(r); err != nil {
+			oerr := errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+			e := &errors.ErrCriticalOption{}
+			if errors.As(err, &e) {
+				log.Error(oerr)
+				return nil, oerr
+			}
+			log.Warn(oerr)
+		}
+	}
+	if r.gateway == nil || r.mirr == nil || r.path == "" {
+		return nil, errors.NewErrCriticalOption("replicator", r)
+	}
+	r.bo = backoff.New(r.bopts...)
+	return r, nil
+}
+
+// Start opens the outbox in the outbox directory and starts shipping it to the targets of the cursors left there.
+// The cursors of the new targets are created when the first write request is replicated after they are discovered.
+func (r *replicator) Start(ctx context.Context) (<-chan error, error) {
+	err := file.MkdirAll(r.path, fs.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+	ob, err := openOutbox(r.path, r.maxSize, r.policy)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ctx = ctx
+	r.ech = make(chan error, startErrChanBufferSize)
+	r.ob = ob
+	ob.rangeCursors(func(c *outboxCursor) bool {
+		r.start(c)
+		return true
+	})
+	r.eg.Go(safety.RecoverFunc(func() error {
+		return r.expire(ctx)
+	}))
+	return r.ech, nil
+}
+
+// ReplicateUpsert appends the Upsert request to the outbox for every target.
+func (r *replicator) ReplicateUpsert(ctx context.Context, req *payload.Upsert_Request) error {
+	data, err := req.MarshalVT()
+	if err != nil {
+		return err
+	}
+	return r.replicate(ctx, ReplicationOpUpsert,
+		replicationKey(req.GetConfig().GetCollection(), req.GetVector().GetId()), data)
+}
+
+// ReplicateRemove appends the Remove request to the outbox for every target.
+func (r *replicator) ReplicateRemove(ctx context.Context, req *payload.Remove_Request) error {
+	data, err := req.MarshalVT()
+	if err != nil {
+		return err
+	}
+	return r.replicate(ctx, ReplicationOpRemove,
+		replicationKey(req.GetConfig().GetCollection(), req.GetId().GetId()), data)
+}
+
+// ReplicateRemoveByTimestamp appends the RemoveByTimestamp request to the outbox for every target.
+func (r *replicator) ReplicateRemoveByTimestamp(ctx context.Context, req *payload.Remove_TimestampRequest) error {
+	data, err := req.MarshalVT()
+	if err != nil {
+		return err
+	}
+	return r.replicate(ctx, ReplicationOpRemoveByTimestamp, "", data)
+}
+
+func replicationKey(collection, id string) string {
+	return collection + "/" + id
+}
+
+// replicate appends the write request to the outbox as a single record for all the targets.
+// The cursors of the targets registered to the Mirror service are created before the append,
+// so the record is shipped to them even if the write request is appended while they are discovered.
+// It returns after the request is flushed to the storage, and the request is shipped in the background.
+func (r *replicator) replicate(ctx context.Context, op ReplicationOp, key string, data []byte) (err error) {
+	_, span := trace.StartSpan(ctx, "vald/gateway/mirror/service/Replicator.replicate")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+
+	r.mu.Lock()
+	ob := r.ob
+	r.mu.Unlock()
+	if ob == nil {
+		return errors.ErrReplicatorNotStarted
+	}
+	now := time.Now().UnixNano()
+	r.mirr.RangeMirrorAddr(func(addr string, _ any) bool {
+		if c, ok := r.cursors.Load(addr); ok {
+			c.seen.Store(now)
+		} else {
+			err = r.open(addr)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	return ob.append(op, key, data, time.Now())
+}
+
+// open creates the cursor of the target and starts shipping the outbox to it.
+func (r *replicator) open(target string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.cursors.Load(target); ok {
+		return nil
+	}
+	c, err := r.ob.cursor(target)
+	if err != nil {
+		return err
+	}
+	r.start(c)
+	return nil
+}
+
+// start starts shipping the outbox to the target of the cursor. It must be called with r.mu held.
+func (r *replicator) start(c *outboxCursor) {
+	r.cursors.Store(c.target, c)
+	if pending, _ := r.ob.stats(c); pending > 0 {
+		log.Infof("mirror replication outbox has %d pending requests for %s", pending, c.target)
+	}
+	c.seen.Store(time.Now().UnixNano())
+	var ctx context.Context
+	ctx, c.cancel = context.WithCancel(r.ctx)
+	c.done = make(chan struct{})
+	r.eg.Go(safety.RecoverFunc(func() error {
+		defer close(c.done)
+		return r.ship(ctx, c)
+	}))
+}
+
+// expire removes the cursors of the targets which have left the mirror set for longer than the retention
+// every flush duration, so their shippers stop retrying and the records retained only for them are trimmed.
+func (r *replicator) expire(ctx context.Context) error {
+	tick := time.NewTicker(r.flushDur)
+	defer tick.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-tick.C:
+			if err := r.expireCursors(now); err != nil && !errors.Is(err, errors.ErrOutboxClosed) {
+				log.Warn(err)
+			}
+		}
+	}
+}
+
+// expireCursors updates the time when each target was found in the mirror set,
+// and removes the cursors of the targets not found for longer than the retention at now.
+func (r *replicator) expireCursors(now time.Time) (err error) {
+	r.mirr.RangeMirrorAddr(func(addr string, _ any) bool {
+		if c, ok := r.cursors.Load(addr); ok {
+			c.seen.Store(now.UnixNano())
+		}
+		return true
+	})
+	r.cursors.Range(func(target string, c *outboxCursor) bool {
+		gone := now.Sub(time.Unix(0, c.seen.Load()))
+		if gone <= r.retention {
+			return true
+		}
+		pending, _ := r.ob.stats(c)
+		log.Warnf("mirror replication cursor of %s is removed because it left the mirror set %s ago, %d pending requests are discarded", target, gone, pending)
+		err = errors.Join(err, r.remove(c))
+		return true
+	})
+	return err
+}
+
+// remove stops shipping the outbox to the target of the cursor and removes the cursor.
+func (r *replicator) remove(c *outboxCursor) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cursors.Delete(c.target)
+	c.cancel()
+	<-c.done
+	return r.ob.removeCursor(c)
+}
+
+// ship ships the outbox to the target of the cursor whenever a write request is appended or the flush duration elapses.
+// After a failure, it waits for the flush duration before shipping again, so a partitioned target is not retried
+// on every write request.
+func (r *replicator) ship(ctx context.Context, c *outboxCursor) error {
+	tick := time.NewTicker(r.flushDur)
+	defer tick.Stop()
+	failed := false
+	for {
+		if failed {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-tick.C:
+			}
+		} else {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-c.notify:
+			case <-tick.C:
+			}
+		}
+		err := r.flush(ctx, c)
+		r.checkLag(c)
+		failed = err != nil
+		if err != nil {
+			if errors.Is(err, errors.ErrOutboxClosed) || errors.IsAny(err, context.Canceled, context.DeadlineExceeded) {
+				return nil
+			}
+			err = errors.Wrapf(err, "failed to ship mirror replication outbox to %s", c.target)
+			log.Warn(err)
+			select {
+			case <-ctx.Done():
+				return nil
+			case r.ech <- err:
+			}
+		}
+	}
+}
+
+// flush ships the pending write requests of the outbox to the target of the cursor in batches until nothing is pending.
+func (r *replicator) flush(ctx context.Context, c *outboxCursor) error {
+	for {
+		entries, payloads, err := r.ob.peek(c, r.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			return nil
+		}
+		_, err = r.bo.Do(backoff.WithBackoffName(ctx, "mirror replication/"+c.target),
+			func(ctx context.Context) (any, bool, error) {
+				seq := entries[0].seq
+				n, err := r.send(ctx, c, entries, payloads)
+				entries, payloads = entries[n:], payloads[n:]
+				if aerr := r.ob.ack(c, seq, n); aerr != nil {
+					return nil, false, errors.Join(err, aerr)
+				}
+				if err != nil {
+					return nil, !errors.IsAny(err, context.Canceled, context.DeadlineExceeded), err
+				}
+				return nil, false, nil
+			})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// send ships the write requests to the target and returns the number of the requests which do not have to be shipped again.
+// The consecutive requests of the same operation are shipped by a Multi API call unless they contain the same object,
+// and the requests of a failed call are shipped one by one to find the failed one.
+// The requests rejected as invalid are dropped, since they never succeed.
+func (r *replicator) send(ctx context.Context, c *outboxCursor, entries []*outboxEntry, payloads [][]byte) (n int, err error) {
+	for n < len(entries) {
+		end := runEnd(entries, n)
+		err = r.sendRun(ctx, c.target, entries[n:end], payloads[n:end])
+		if err == nil {
+			n = end
+			continue
+		}
+		if end-n == 1 {
+			if !isDroppable(err) {
+				return n, err
+			}
+			log.Errorf("dropped the mirror replication request for %s of %s: %v", entries[n].key, c.target, err)
+			c.dropped.Add(1)
+			n = end
+			continue
+		}
+		for ; n < end; n++ {
+			err = r.sendRun(ctx, c.target, entries[n:n+1], payloads[n:n+1])
+			if err != nil {
+				if !isDroppable(err) {
+					return n, err
+				}
+				log.Errorf("dropped the mirror replication request for %s of %s: %v", entries[n].key, c.target, err)
+				c.dropped.Add(1)
+			}
+		}
+	}
+	return n, nil
+}
+
+// runEnd returns the end of the requests shipped together from the start.
+func runEnd(entries []*outboxEntry, start int) int {
+	op := entries[start].op
+	if op == ReplicationOpRemoveByTimestamp {
+		return start + 1
+	}
+	keys := map[string]struct{}{
+		entries[start].key: {},
+	}
+	end := start + 1
+	for ; end < len(entries) && entries[end].op == op; end++ {
+		if _, ok := keys[entries[end].key]; ok {
+			break
+		}
+		keys[entries[end].key] = struct{}{}
+	}
+	return end
+}
+
+func isDroppable(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st != nil && st.Code() == codes.InvalidArgument
+}
+
+// sendRun ships the write requests of the same operation to the target.
+// ALREADY_EXISTS of Upsert and NOT_FOUND of Remove are treated as success because the object is already in the requested state.
+func (r *replicator) sendRun(ctx context.Context, target string, entries []*outboxEntry, payloads [][]byte) (err error) {
+	var f func(ctx context.Context, vc MirrorClient, copts ...grpc.CallOption) error
+	switch entries[0].op {
+	case ReplicationOpUpsert:
+		reqs := make([]*payload.Upsert_Request, len(payloads))
+		for i, data := range payloads {
+			reqs[i] = new(payload.Upsert_Request)
+			if err = reqs[i].UnmarshalVT(data); err != nil {
+				return status.WrapWithInvalidArgument("failed to decode mirror replication request", err)
+			}
+		}
+		f = func(ctx context.Context, vc MirrorClient, copts ...grpc.CallOption) error {
+			if len(reqs) == 1 {
+				_, err := vc.Upsert(ctx, reqs[0], copts...)
+				return ignoreCode(err, codes.AlreadyExists)
+			}
+			_, err := vc.MultiUpsert(ctx, &payload.Upsert_MultiRequest{Requests: reqs}, copts...)
+			return err
+		}
+	case ReplicationOpRemove:
+		reqs := make([]*payload.Remove_Request, len(payloads))
+		for i, data := range payloads {
+			reqs[i] = new(payload.Remove_Request)
+			if err = reqs[i].UnmarshalVT(data); err != nil {
+				return status.WrapWithInvalidArgument("failed to decode mirror replication request", err)
+			}
+		}
+		f = func(ctx context.Context, vc MirrorClient, copts ...grpc.CallOption) error {
+			if len(reqs) == 1 {
+				_, err := vc.Remove(ctx, reqs[0], copts...)
+				return ignoreCode(err, codes.NotFound)
+			}
+			_, err := vc.MultiRemove(ctx, &payload.Remove_MultiRequest{Requests: reqs}, copts...)
+			return err
+		}
+	case ReplicationOpRemoveByTimestamp:
+		req := new(payload.Remove_TimestampRequest)
+		if err = req.UnmarshalVT(payloads[0]); err != nil {
+			return status.WrapWithInvalidArgument("failed to decode mirror replication request", err)
+		}
+		f = func(ctx context.Context, vc MirrorClient, copts ...grpc.CallOption) error {
+			_, err := vc.RemoveByTimestamp(ctx, req, copts...)
+			return ignoreCode(err, codes.NotFound)
+		}
+	}
+
+	// DoMulti does not return the errors of the targets and skips the targets which are not connected,
+	// so the result is captured by the function.
+	called := false
+	derr := r.gateway.DoMulti(ctx, []string{target}, func(ctx context.Context, _ string, vc MirrorClient, copts ...grpc.CallOption) error {
+		called = true
+		err = f(ctx, vc, copts...)
+		return err
+	})
+	if !called {
+		return errors.Join(derr, errors.ErrGRPCClientConnNotFound(target))
+	}
+	return err
+}
+
+func ignoreCode(err error, code codes.Code) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if ok && st != nil && st.Code() == code {
+		return nil
+	}
+	return err
+}
+
+// checkLag raises the alarm when the lag of the target exceeds the max lag, and clears it when the lag recovers.
+func (r *replicator) checkLag(c *outboxCursor) {
+	if r.maxLag <= 0 {
+		return
+	}
+	pending, oldest := r.ob.stats(c)
+	var lag time.Duration
+	if pending > 0 {
+		lag = time.Since(oldest)
+	}
+	if lag > r.maxLag {
+		if !c.alarm.Swap(true) {
+			log.Errorf("mirror replication lag of %s is %s, which exceeds the max lag %s, %d requests are pending", c.target, lag, r.maxLag, pending)
+		}
+		return
+	}
+	if c.alarm.Swap(false) {
+		log.Infof("mirror replication lag of %s recovered to %s", c.target, lag)
+	}
+}
+
+// RangeStats calls f sequentially for the replication state of each target. If f returns false, range stops the iteration.
+func (r *replicator) RangeStats(f func(target string, st *ReplicationStats) bool) {
+	r.cursors.Range(func(target string, c *outboxCursor) bool {
+		pending, oldest := r.ob.stats(c)
+		st := &ReplicationStats{
+			Pending: pending,
+			Shipped: c.shipped.Load(),
+			Dropped: c.dropped.Load(),
+			Alarm:   c.alarm.Load(),
+		}
+		if pending > 0 {
+			st.Lag = time.Since(oldest)
+		}
+		return f(target, st)
+	})
+}
+
+// Close closes the outbox. The write requests not shipped yet are shipped after the restart.
+func (r *replicator) Close() (err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.ob != nil {
+		err = r.ob.close()
+	}
+	r.bo.Close()
+	return err
+}
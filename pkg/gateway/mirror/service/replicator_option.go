@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package service
+
+import (
+	"github.com/vdaas/vald/internal/backoff"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+	"github.com/vdaas/vald/internal/timeutil"
+	"github.com/vdaas/vald/internal/unit"
+)
+
+// ReplicatorOption represents the functional option for replicator.
+type ReplicatorOption func(r *replicator) error
+
+var defaultReplicatorOpts = []ReplicatorOption{
+	WithReplicatorErrGroup(errgroup.Get()),
+	WithReplicatorBatchSize(100),
+	WithReplicatorFlushDuration("1s"),
+	WithReplicatorMaxOutboxSize("1GB"),
+	WithReplicatorOverflowPolicy(string(OutboxOverflowDrop)),
+	WithReplicatorRetentionDuration("1h"),
+}
+
+// WithReplicatorErrGroup returns the option to set the error group.
+func WithReplicatorErrGroup(eg errgroup.Group) ReplicatorOption {
+	return func(r *replicator) error {
+		if eg != nil {
+			r.eg = eg
+		}
+		return nil
+	}
+}
+
+// WithReplicatorGateway returns the option to set the Gateway service to ship the write requests.
+func WithReplicatorGateway(g Gateway) ReplicatorOption {
+	return func(r *replicator) error {
+		if g == nil {
+			return errors.NewErrCriticalOption("replicatorGateway", g)
+		}
+		r.gateway = g
+		return nil
+	}
+}
+
+// WithReplicatorMirror returns the option to set the Mirror service to list the targets.
+func WithReplicatorMirror(m Mirror) ReplicatorOption {
+	return func(r *replicator) error {
+		if m == nil {
+			return errors.NewErrCriticalOption("replicatorMirror", m)
+		}
+		r.mirr = m
+		return nil
+	}
+}
+
+// WithReplicatorOutboxPath returns the option to set the directory of the outbox.
+func WithReplicatorOutboxPath(path string) ReplicatorOption {
+	return func(r *replicator) error {
+		if path == "" {
+			return errors.NewErrCriticalOption("replicatorOutboxPath", path)
+		}
+		r.path = path
+		return nil
+	}
+}
+
+// WithReplicatorBatchSize returns the option to set the max number of the write requests shipped at once.
+func WithReplicatorBatchSize(n int) ReplicatorOption {
+	return func(r *replicator) error {
+		if n <= 0 {
+			return errors.NewErrInvalidOption("replicatorBatchSize", n)
+		}
+		r.batchSize = n
+		return nil
+	}
+}
+
+// WithReplicatorFlushDuration returns the option to set the interval to ship the write requests remaining in the outbox.
+func WithReplicatorFlushDuration(s string) ReplicatorOption {
+	return func(r *replicator) error {
+		if s == "" {
+			return errors.NewErrInvalidOption("replicatorFlushDuration", s)
+		}
+		dur, err := timeutil.Parse(s)
+		if err != nil {
+			return errors.NewErrInvalidOption("replicatorFlushDuration", s, err)
+		}
+		if dur <= 0 {
+			return errors.NewErrInvalidOption("replicatorFlushDuration", s)
+		}
+		r.flushDur = dur
+		return nil
+	}
+}
+
+// WithReplicatorMaxLag returns the option to set the replication lag to raise the alarm.
+func WithReplicatorMaxLag(s string) ReplicatorOption {
+	return func(r *replicator) error {
+		if s == "" {
+			return nil
+		}
+		dur, err := timeutil.Parse(s)
+		if err != nil {
+			return errors.NewErrInvalidOption("replicatorMaxLag", s, err)
+		}
+		r.maxLag = dur
+		return nil
+	}
+}
+
+// WithReplicatorMaxOutboxSize returns the option to set the max size of the write requests pending in the outbox, e.g. 1GB.
+// The size is unlimited when it is 0.
+func WithReplicatorMaxOutboxSize(size string) ReplicatorOption {
+	return func(r *replicator) error {
+		if size == "" {
+			return nil
+		}
+		b, err := unit.ParseBytes(size)
+		if err != nil {
+			return errors.NewErrInvalidOption("replicatorMaxOutboxSize", size, err)
+		}
+		r.maxSize = int64(b)
+		return nil
+	}
+}
+
+// WithReplicatorOverflowPolicy returns the option to set the policy applied when the outbox reaches the max size.
+// drop drops the oldest pending write requests, and reject rejects the new write request.
+func WithReplicatorOverflowPolicy(policy string) ReplicatorOption {
+	return func(r *replicator) error {
+		if policy == "" {
+			return nil
+		}
+		switch p := OutboxOverflowPolicy(strings.ToLower(policy)); p {
+		case OutboxOverflowDrop, OutboxOverflowReject:
+			r.policy = p
+			return nil
+		}
+		return errors.NewErrCriticalOption("replicatorOverflowPolicy", policy)
+	}
+}
+
+// WithReplicatorRetentionDuration returns the option to set the duration to keep the pending write requests of a target
+// after it leaves the mirror set. The cursor of the target is removed after the duration.
+func WithReplicatorRetentionDuration(s string) ReplicatorOption {
+	return func(r *replicator) error {
+		if s == "" {
+			return nil
+		}
+		dur, err := timeutil.Parse(s)
+		if err != nil {
+			return errors.NewErrInvalidOption("replicatorRetentionDuration", s, err)
+		}
+		if dur < 0 {
+			return errors.NewErrInvalidOption("replicatorRetentionDuration", s)
+		}
+		r.retention = dur
+		return nil
+	}
+}
+
+// WithReplicatorBackoffOpts returns the option to set the backoff options to retry shipping the write requests.
+func WithReplicatorBackoffOpts(opts ...backoff.Option) ReplicatorOption {
+	return func(r *replicator) error {
+		if r.bopts == nil {
+			r.bopts = opts
+			return nil
+		}
+		r.bopts = append(r.bopts, opts...)
+		return nil
+	}
+}
@@ -0,0 +1,485 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package service
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/internal/backoff"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/strings"
+	"github.com/vdaas/vald/internal/sync"
+)
+
+// replicationClientMock records the write requests shipped to a target.
+// errFunc returns the error of the call with the method name and the IDs of the requests.
+type replicationClientMock struct {
+	MirrorClient
+	mu      sync.Mutex
+	calls   []string
+	errFunc func(method string, ids ...string) error
+}
+
+func (c *replicationClientMock) call(method string, ids ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.errFunc != nil {
+		if err := c.errFunc(method, ids...); err != nil {
+			return err
+		}
+	}
+	for _, id := range ids {
+		c.calls = append(c.calls, method+":"+id)
+	}
+	return nil
+}
+
+func (c *replicationClientMock) Calls() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.calls...)
+}
+
+func (c *replicationClientMock) Upsert(
+	_ context.Context, in *payload.Upsert_Request, _ ...grpc.CallOption,
+) (*payload.Object_Location, error) {
+	return nil, c.call("Upsert", in.GetVector().GetId())
+}
+
+func (c *replicationClientMock) MultiUpsert(
+	_ context.Context, in *payload.Upsert_MultiRequest, _ ...grpc.CallOption,
+) (*payload.Object_Locations, error) {
+	ids := make([]string, 0, len(in.GetRequests()))
+	for _, req := range in.GetRequests() {
+		ids = append(ids, req.GetVector().GetId())
+	}
+	return nil, c.call("MultiUpsert", ids...)
+}
+
+func (c *replicationClientMock) Remove(
+	_ context.Context, in *payload.Remove_Request, _ ...grpc.CallOption,
+) (*payload.Object_Location, error) {
+	return nil, c.call("Remove", in.GetId().GetId())
+}
+
+func (c *replicationClientMock) MultiRemove(
+	_ context.Context, in *payload.Remove_MultiRequest, _ ...grpc.CallOption,
+) (*payload.Object_Locations, error) {
+	ids := make([]string, 0, len(in.GetRequests()))
+	for _, req := range in.GetRequests() {
+		ids = append(ids, req.GetId().GetId())
+	}
+	return nil, c.call("MultiRemove", ids...)
+}
+
+func (c *replicationClientMock) RemoveByTimestamp(
+	_ context.Context, _ *payload.Remove_TimestampRequest, _ ...grpc.CallOption,
+) (*payload.Object_Locations, error) {
+	return nil, c.call("RemoveByTimestamp", "")
+}
+
+// newReplicationGatewayMock returns the Gateway which ships the requests to the clients of the targets.
+// The targets without the client are treated as not connected.
+func newReplicationGatewayMock(clients map[string]*replicationClientMock) Gateway {
+	return &GatewayMock{
+		DoMultiFunc: func(ctx context.Context, targets []string,
+			f func(ctx context.Context, target string, vc MirrorClient, copts ...grpc.CallOption) error,
+		) error {
+			for _, target := range targets {
+				if c, ok := clients[target]; ok {
+					_ = f(ctx, target, c)
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// shippedIDs returns the IDs of the requests shipped to the client in order.
+func shippedIDs(c *replicationClientMock) []string {
+	calls := c.Calls()
+	ids := make([]string, 0, len(calls))
+	for _, call := range calls {
+		ids = append(ids, call[strings.Index(call, ":")+1:])
+	}
+	return ids
+}
+
+func upsertPayload(t *testing.T, id string) []byte {
+	t.Helper()
+	data, err := (&payload.Upsert_Request{
+		Vector: &payload.Object_Vector{Id: id},
+	}).MarshalVT()
+	require.NoError(t, err)
+	return data
+}
+
+func removePayload(t *testing.T, id string) []byte {
+	t.Helper()
+	data, err := (&payload.Remove_Request{
+		Id: &payload.Object_ID{Id: id},
+	}).MarshalVT()
+	require.NoError(t, err)
+	return data
+}
+
+func Test_replicator_send(t *testing.T) {
+	t.Parallel()
+	type entry struct {
+		op ReplicationOp
+		id string
+	}
+	entries := []entry{
+		{op: ReplicationOpUpsert, id: "1"},
+		{op: ReplicationOpUpsert, id: "2"},
+		{op: ReplicationOpUpsert, id: "1"},
+		{op: ReplicationOpRemove, id: "3"},
+		{op: ReplicationOpRemove, id: "4"},
+		{op: ReplicationOpRemoveByTimestamp},
+	}
+	tests := []struct {
+		name        string
+		errFunc     func(method string, ids ...string) error
+		wantN       int
+		wantCalls   []string
+		wantDropped uint64
+		wantErr     bool
+	}{
+		{
+			name:  "consecutive requests of the same operation are shipped together unless they contain the same object",
+			wantN: len(entries),
+			wantCalls: []string{
+				"MultiUpsert:1", "MultiUpsert:2",
+				"Upsert:1",
+				"MultiRemove:3", "MultiRemove:4",
+				"RemoveByTimestamp:",
+			},
+		},
+		{
+			name: "already existing and not found objects are treated as shipped",
+			errFunc: func(method string, _ ...string) error {
+				switch method {
+				case "Upsert":
+					return status.Error(codes.AlreadyExists, "exists")
+				case "RemoveByTimestamp":
+					return status.Error(codes.NotFound, "not found")
+				}
+				return nil
+			},
+			wantN: len(entries),
+			wantCalls: []string{
+				"MultiUpsert:1", "MultiUpsert:2",
+				"MultiRemove:3", "MultiRemove:4",
+			},
+		},
+		{
+			name: "invalid request is dropped after the failed batch is shipped one by one",
+			errFunc: func(method string, ids ...string) error {
+				if method == "MultiRemove" || (method == "Remove" && ids[0] == "3") {
+					return status.Error(codes.InvalidArgument, "invalid")
+				}
+				return nil
+			},
+			wantN: len(entries),
+			wantCalls: []string{
+				"MultiUpsert:1", "MultiUpsert:2",
+				"Upsert:1",
+				"Remove:4",
+				"RemoveByTimestamp:",
+			},
+			wantDropped: 1,
+		},
+		{
+			name: "shipping stops at the request failed by the unavailable target",
+			errFunc: func(method string, ids ...string) error {
+				if method == "MultiRemove" || (method == "Remove" && ids[0] == "4") {
+					return status.Error(codes.Unavailable, "unavailable")
+				}
+				return nil
+			},
+			wantN: 4,
+			wantCalls: []string{
+				"MultiUpsert:1", "MultiUpsert:2",
+				"Upsert:1",
+				"Remove:3",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			ob, err := openOutbox(t.TempDir(), 0, OutboxOverflowDrop)
+			require.NoError(t, err)
+			defer ob.close()
+			oc, err := ob.cursor("target")
+			require.NoError(t, err)
+			for _, e := range entries {
+				var data []byte
+				switch e.op {
+				case ReplicationOpUpsert:
+					data = upsertPayload(t, e.id)
+				case ReplicationOpRemove:
+					data = removePayload(t, e.id)
+				case ReplicationOpRemoveByTimestamp:
+					data, err = new(payload.Remove_TimestampRequest).MarshalVT()
+					require.NoError(t, err)
+				}
+				require.NoError(t, ob.append(e.op, replicationKey("", e.id), data, time.Now()))
+			}
+			es, payloads, err := ob.peek(oc, len(entries))
+			require.NoError(t, err)
+
+			c := &replicationClientMock{errFunc: test.errFunc}
+			r := &replicator{
+				gateway: newReplicationGatewayMock(map[string]*replicationClientMock{"target": c}),
+			}
+			n, err := r.send(context.Background(), oc, es, payloads)
+			require.Equal(t, test.wantErr, err != nil, err)
+			require.Equal(t, test.wantN, n)
+			require.Equal(t, test.wantCalls, c.Calls())
+			require.Equal(t, test.wantDropped, oc.dropped.Load())
+		})
+	}
+}
+
+func Test_replicator_send_not_connected(t *testing.T) {
+	t.Parallel()
+	ob, err := openOutbox(t.TempDir(), 0, OutboxOverflowDrop)
+	require.NoError(t, err)
+	defer ob.close()
+	oc, err := ob.cursor("target")
+	require.NoError(t, err)
+	require.NoError(t, ob.append(ReplicationOpUpsert, "/1", upsertPayload(t, "1"), time.Now()))
+	es, payloads, err := ob.peek(oc, 1)
+	require.NoError(t, err)
+
+	r := &replicator{
+		gateway: newReplicationGatewayMock(nil),
+	}
+	n, err := r.send(context.Background(), oc, es, payloads)
+	require.Zero(t, n)
+	require.True(t, errors.Is(err, errors.ErrGRPCClientConnNotFound("target")), err)
+}
+
+func Test_replicator_replicate(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	targets := []string{"mirror-1:8081", "mirror-2:8081", "mirror-3:8081"}
+	// mirror-3 is discovered after the first write requests are replicated.
+	var discovered atomic.Int32
+	discovered.Store(2)
+	mirr := &MirrorMock{
+		RangeMirrorAddrFunc: func(f func(addr string, _ any) bool) {
+			for _, target := range targets[:discovered.Load()] {
+				if !f(target, nil) {
+					return
+				}
+			}
+		},
+	}
+	// mirror-2 is partitioned until connected is set.
+	var connected atomic.Bool
+	clients := map[string]*replicationClientMock{
+		targets[0]: {},
+		targets[2]: {},
+		targets[1]: {
+			errFunc: func(string, ...string) error {
+				if !connected.Load() {
+					return status.Error(codes.Unavailable, "unavailable")
+				}
+				return nil
+			},
+		},
+	}
+	newReplicator := func(t *testing.T) Replicator {
+		t.Helper()
+		r, err := NewReplicator(
+			WithReplicatorGateway(newReplicationGatewayMock(clients)),
+			WithReplicatorMirror(mirr),
+			WithReplicatorOutboxPath(dir),
+			WithReplicatorFlushDuration("10ms"),
+			WithReplicatorMaxLag("50ms"),
+			WithReplicatorBackoffOpts(
+				backoff.WithInitialDuration("1ms"),
+				backoff.WithMaximumDuration("2ms"),
+				backoff.WithRetryCount(2),
+			),
+		)
+		require.NoError(t, err)
+		return r
+	}
+	stats := func(r Replicator) map[string]ReplicationStats {
+		m := make(map[string]ReplicationStats)
+		r.RangeStats(func(target string, st *ReplicationStats) bool {
+			m[target] = *st
+			return true
+		})
+		return m
+	}
+
+	r := newReplicator(t)
+	req := &payload.Upsert_Request{Vector: &payload.Object_Vector{Id: "1"}}
+	require.True(t, errors.Is(r.ReplicateUpsert(context.Background(), req), errors.ErrReplicatorNotStarted))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := r.Start(ctx)
+	require.NoError(t, err)
+	require.NoError(t, r.ReplicateUpsert(ctx, req))
+	require.NoError(t, r.ReplicateRemove(ctx, &payload.Remove_Request{Id: &payload.Object_ID{Id: "2"}}))
+
+	// the write requests are shipped to the available target, and kept for the partitioned one.
+	require.Eventually(t, func() bool {
+		return len(clients[targets[0]].Calls()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		st := stats(r)
+		return st[targets[0]].Shipped == 2 && st[targets[0]].Pending == 0 &&
+			st[targets[1]].Pending == 2 && st[targets[1]].Alarm
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Positive(t, stats(r)[targets[1]].Lag)
+
+	// the target discovered later receives the write requests retained for the partitioned one.
+	discovered.Store(3)
+	require.NoError(t, r.ReplicateRemove(ctx, &payload.Remove_Request{Id: &payload.Object_ID{Id: "3"}}))
+	require.Eventually(t, func() bool {
+		st := stats(r)
+		return st[targets[2]].Shipped == 3 && st[targets[2]].Pending == 0 && st[targets[1]].Pending == 3
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"1", "2", "3"}, shippedIDs(clients[targets[2]]))
+
+	// the pending requests survive the restart.
+	cancel()
+	require.NoError(t, r.Close())
+	connected.Store(true)
+
+	r = newReplicator(t)
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	_, err = r.Start(ctx)
+	require.NoError(t, err)
+	defer r.Close()
+	require.Eventually(t, func() bool {
+		st := stats(r)
+		return st[targets[1]].Shipped == 3 && st[targets[1]].Pending == 0 && !st[targets[1]].Alarm
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{"1", "2", "3"}, shippedIDs(clients[targets[1]]))
+	require.Equal(t, []string{"1", "2", "3"}, shippedIDs(clients[targets[0]]))
+	require.Equal(t, []string{"1", "2", "3"}, shippedIDs(clients[targets[2]]))
+}
+
+func Test_replicator_expireCursors(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	targets := []string{"mirror-1:8081", "mirror-2:8081"}
+	// mirror-2 leaves the mirror set when left is set.
+	var left atomic.Bool
+	mirr := &MirrorMock{
+		RangeMirrorAddrFunc: func(f func(addr string, _ any) bool) {
+			for _, target := range targets {
+				if target == targets[1] && left.Load() {
+					continue
+				}
+				if !f(target, nil) {
+					return
+				}
+			}
+		},
+	}
+	// mirror-2 never accepts the write requests.
+	clients := map[string]*replicationClientMock{
+		targets[0]: {},
+		targets[1]: {
+			errFunc: func(string, ...string) error {
+				return status.Error(codes.Unavailable, "unavailable")
+			},
+		},
+	}
+	r, err := NewReplicator(
+		WithReplicatorGateway(newReplicationGatewayMock(clients)),
+		WithReplicatorMirror(mirr),
+		WithReplicatorOutboxPath(dir),
+		WithReplicatorFlushDuration("1h"),
+		WithReplicatorRetentionDuration("1m"),
+		WithReplicatorBackoffOpts(
+			backoff.WithInitialDuration("1ms"),
+			backoff.WithMaximumDuration("2ms"),
+			backoff.WithRetryCount(2),
+		),
+	)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, err = r.Start(ctx)
+	require.NoError(t, err)
+	defer r.Close()
+	rp := r.(*replicator)
+
+	for _, id := range []string{"1", "2"} {
+		require.NoError(t, r.ReplicateUpsert(ctx, &payload.Upsert_Request{Vector: &payload.Object_Vector{Id: id}}))
+	}
+	require.Eventually(t, func() bool {
+		pending, _ := rp.ob.stats(mustLoadCursor(t, rp, targets[0]))
+		return pending == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	// the records are retained for mirror-2.
+	require.Len(t, rp.ob.entries, 2)
+
+	// the cursor of the target in the mirror set is kept however long it lags.
+	now := time.Now()
+	require.NoError(t, rp.expireCursors(now.Add(time.Hour)))
+	c := mustLoadCursor(t, rp, targets[1])
+
+	// the cursor of the target which left the mirror set is kept for the retention duration.
+	left.Store(true)
+	require.NoError(t, rp.expireCursors(now.Add(time.Hour+30*time.Second)))
+	mustLoadCursor(t, rp, targets[1])
+
+	// after the retention duration, the cursor and its file are removed, its shipper stops,
+	// and the records retained only for it are trimmed.
+	require.NoError(t, rp.expireCursors(now.Add(time.Hour+2*time.Minute)))
+	_, ok := rp.cursors.Load(targets[1])
+	require.False(t, ok)
+	select {
+	case <-c.done:
+	default:
+		t.Fatal("the shipper of the removed cursor is still running")
+	}
+	_, err = os.Stat(c.path)
+	require.True(t, errors.Is(err, os.ErrNotExist), err)
+	require.Empty(t, rp.ob.entries)
+	st := make(map[string]struct{})
+	r.RangeStats(func(target string, _ *ReplicationStats) bool {
+		st[target] = struct{}{}
+		return true
+	})
+	require.Equal(t, map[string]struct{}{targets[0]: {}}, st)
+}
+
+func mustLoadCursor(t *testing.T, r *replicator, target string) *outboxCursor {
+	t.Helper()
+	c, ok := r.cursors.Load(target)
+	require.True(t, ok, target)
+	return c
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/vdaas/vald/internal/net"
 	"github.com/vdaas/vald/internal/net/grpc"
 	"github.com/vdaas/vald/internal/observability"
+	"github.com/vdaas/vald/internal/observability/metrics"
 	backoffmetrics "github.com/vdaas/vald/internal/observability/metrics/backoff"
 	cbmetrics "github.com/vdaas/vald/internal/observability/metrics/circuitbreaker"
 	mirrormetrics "github.com/vdaas/vald/internal/observability/metrics/gateway/mirror"
@@ -30,6 +31,7 @@ import (
 	"github.com/vdaas/vald/internal/safety"
 	"github.com/vdaas/vald/internal/servers/server"
 	"github.com/vdaas/vald/internal/servers/starter"
+	"github.com/vdaas/vald/internal/strings"
 	"github.com/vdaas/vald/internal/sync/errgroup"
 	"github.com/vdaas/vald/pkg/gateway/mirror/config"
 	handler "github.com/vdaas/vald/pkg/gateway/mirror/handler/grpc"
@@ -47,6 +49,7 @@ type run struct {
 	gateway       service.Gateway
 	mirror        service.Mirror
 	discover      service.Discovery
+	replicator    service.Replicator
 	observability observability.Observability
 }
 
@@ -110,11 +113,38 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 		return nil, err
 	}
 
+	var replicator service.Replicator
+	if cfg.Mirror.Replication != nil {
+		switch mode := strings.ToLower(cfg.Mirror.Replication.Mode); mode {
+		case "", "sync":
+		case "async":
+			replicator, err = service.NewReplicator(
+				service.WithReplicatorErrGroup(eg),
+				service.WithReplicatorGateway(gateway),
+				service.WithReplicatorMirror(m),
+				service.WithReplicatorOutboxPath(cfg.Mirror.Replication.OutboxPath),
+				service.WithReplicatorBatchSize(cfg.Mirror.Replication.BatchSize),
+				service.WithReplicatorFlushDuration(cfg.Mirror.Replication.FlushDuration),
+				service.WithReplicatorMaxOutboxSize(cfg.Mirror.Replication.MaxOutboxSize),
+				service.WithReplicatorOverflowPolicy(cfg.Mirror.Replication.OverflowPolicy),
+				service.WithReplicatorRetentionDuration(cfg.Mirror.Replication.RetentionDuration),
+				service.WithReplicatorMaxLag(cfg.Mirror.Replication.MaxLag),
+				service.WithReplicatorBackoffOpts(cfg.Mirror.Replication.Backoff.Opts()...),
+			)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.ErrInvalidReplicationMode(mode)
+		}
+	}
+
 	v, err := handler.New(
 		handler.WithValdAddr(cfg.Mirror.GatewayAddr),
 		handler.WithErrGroup(eg),
 		handler.WithGateway(gateway),
 		handler.WithMirror(m),
+		handler.WithReplicator(replicator),
 		handler.WithStreamConcurrency(cfg.Server.GetGRPCStreamConcurrency()),
 	)
 	if err != nil {
@@ -133,11 +163,17 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 
 	var obs observability.Observability
 	if cfg.Observability.Enabled {
-		obs, err = observability.NewWithConfig(
-			cfg.Observability,
+		mets := []metrics.Metric{
 			backoffmetrics.New(),
 			cbmetrics.New(),
 			mirrormetrics.New(m),
+		}
+		if replicator != nil {
+			mets = append(mets, mirrormetrics.NewReplication(replicator))
+		}
+		obs, err = observability.NewWithConfig(
+			cfg.Observability,
+			mets...,
 		)
 		if err != nil {
 			return nil, err
@@ -176,6 +212,7 @@ func New(cfg *config.Data) (r runner.Interface, err error) {
 		gateway:       gateway,
 		mirror:        m,
 		discover:      discover,
+		replicator:    replicator,
 		observability: obs,
 	}, nil
 }
@@ -194,9 +231,17 @@ func (r *run) PreStart(ctx context.Context) error {
 // Start is a method used to initiate an operation in the run, and it returns a channel for receiving errors
 // during the operation and an error representing any initialization errors.
 func (r *run) Start(ctx context.Context) (_ <-chan error, err error) { // skipcq: GO-R1005
-	ech := make(chan error, 6)
-	var mech, dech, cech, sech, oech <-chan error
+	ech := make(chan error, 7)
+	var mech, dech, cech, sech, oech, rech <-chan error
 
+	if r.replicator != nil {
+		// the outbox is loaded before the server starts accepting the write requests.
+		rech, err = r.replicator.Start(ctx)
+		if err != nil {
+			close(ech)
+			return nil, err
+		}
+	}
 	sech = r.server.ListenAndServe(ctx)
 	if r.client != nil {
 		cech, err = r.client.Start(ctx)
@@ -230,6 +275,7 @@ func (r *run) Start(ctx context.Context) (_ <-chan error, err error) { // skipcq
 			case err = <-cech:
 			case err = <-sech:
 			case err = <-oech:
+			case err = <-rech:
 			}
 			if err != nil {
 				select {
@@ -260,6 +306,11 @@ func (r *run) Stop(ctx context.Context) (errs error) {
 			errs = errors.Join(errs, err)
 		}
 	}
+	if r.replicator != nil {
+		if err := r.replicator.Close(); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
 	return errs
 }
 
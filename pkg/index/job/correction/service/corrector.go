@@ -135,6 +135,8 @@ func (c *correct) StartClient(ctx context.Context) (_ <-chan error, err error) {
 
 //nolint:maintidx // the agent-by-agent stream-and-correct pipeline is one long-lived sequential flow; splitting it would scatter the shared cursor state
 func (c *correct) Start(ctx context.Context) (err error) {
+	// the correction streams every vector of the agents, so it yields them to the user-facing requests under load.
+	ctx = grpc.WithPriority(ctx, grpc.LowPriority)
 	detail, err := c.gateway.IndexDetail(ctx, new(payload.Empty))
 	if err != nil {
 		return err
@@ -79,6 +79,8 @@ func (idx *index) Start(ctx context.Context) error {
 			span.End()
 		}
 	}()
+	// the deletion job is sent as the low priority as well as the other index jobs.
+	ctx = grpc.WithPriority(ctx, grpc.LowPriority)
 
 	err := idx.doDeleteIndex(
 		ctx,
@@ -129,6 +129,8 @@ func (e *export) StartClient(ctx context.Context) (<-chan error, error) {
 }
 
 func (e *export) Start(ctx context.Context) error {
+	// the exportation reads every vector of the agents, so it is throttled first when they are loaded.
+	ctx = igrpc.WithPriority(ctx, igrpc.LowPriority)
 	err := e.doExportIndex(ctx)
 	return err
 }
@@ -0,0 +1,440 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package service
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/client/v1/client/filter/ingress"
+	vc "github.com/vdaas/vald/internal/client/v1/client/vald"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/log"
+	igrpc "github.com/vdaas/vald/internal/net/grpc"
+	"github.com/vdaas/vald/internal/net/grpc/codes"
+	"github.com/vdaas/vald/internal/net/grpc/status"
+	"github.com/vdaas/vald/internal/observability/trace"
+	"github.com/vdaas/vald/internal/safety"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+	"google.golang.org/grpc"
+)
+
+const (
+	apiName        = "vald/index/job/migrate"
+	grpcMethodName = "vald.v1.StreamListObject/" + vald.StreamListObjectRPCName
+
+	// progressLogInterval is the number of the listed vectors between the progress logs.
+	progressLogInterval = 10000
+)
+
+// Migrator represents an interface for migrating the vectors of a collection into another collection.
+type Migrator interface {
+	StartClient(ctx context.Context) (<-chan error, error)
+	Start(ctx context.Context) error
+}
+
+type migrator struct {
+	eg            errgroup.Group
+	gateway       vc.Client
+	transformer   ingress.Client
+	source        string
+	target        *payload.Collection_Config
+	concurrency   int
+	catchUpPasses int
+	switchSearch  bool
+
+	mu       sync.Mutex
+	migrated map[string]progress
+}
+
+// progress represents the timestamp of the migrated vector and the last pass which listed the vector.
+type progress struct {
+	timestamp int64
+	pass      int
+}
+
+// New returns Migrator object if no error occurs.
+func New(opts ...Option) (Migrator, error) {
+	m := &migrator{
+		target:   new(payload.Collection_Config),
+		migrated: make(map[string]progress),
+	}
+	for _, opt := range append(defaultOpts, opts...) {
+		if err := opt(m); err != nil {
+			oerr := errors.ErrOptionFailed(err, reflect.ValueOf(opt))
+			e := &errors.ErrCriticalOption{}
+			if errors.As(oerr, &e) {
+				log.Error(err)
+				return nil, oerr
+			}
+			log.Warn(oerr)
+		}
+	}
+	if m.target.GetName() == "" {
+		return nil, errors.NewErrCriticalOption("targetCollection", m.target.GetName())
+	}
+	if m.target.GetName() == m.source {
+		return nil, errors.ErrSameMigrationCollection(m.source)
+	}
+	return m, nil
+}
+
+// StartClient starts the gRPC clients of the gateway and the transformer.
+func (m *migrator) StartClient(ctx context.Context) (<-chan error, error) {
+	ech := make(chan error, 1)
+	gch, err := m.gateway.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tch, err := m.transformer.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m.eg.Go(safety.RecoverFunc(func() (err error) {
+		defer close(ech)
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case err = <-gch:
+			case err = <-tch:
+			}
+			if err != nil {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case ech <- err:
+				}
+			}
+		}
+	}))
+	return ech, nil
+}
+
+// Start migrates the vectors of the source collection into the target collection.
+// The first pass backfills all vectors, and the catch-up passes migrate the vectors written during the previous pass until no vector is migrated.
+// When the requests are switched, the write requests to the source collection are fenced during the final pass so that the target collection has all vectors of the source collection at the switch.
+// Otherwise the job fails when the catch-up passes do not converge, because the target collection misses the vectors written during the last pass.
+func (m *migrator) Start(ctx context.Context) (err error) {
+	ctx, span := trace.StartSpan(ctx, apiName+"/service/migrator.Start")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+	// the backfill and the catch-up passes are throttled first when the gateway and the agents are loaded.
+	ctx = igrpc.WithPriority(ctx, igrpc.LowPriority)
+
+	err = m.createTarget(ctx)
+	if err != nil {
+		return err
+	}
+	var pass int
+	for {
+		migrated, err := m.migrate(ctx, pass)
+		if err != nil {
+			return err
+		}
+		log.Infof("migration pass %d finished, %d vectors migrated from collection %q to %s", pass, migrated, m.source, m.target.GetName())
+		if migrated == 0 {
+			break
+		}
+		if pass >= m.catchUpPasses {
+			if !m.switchSearch {
+				return errors.ErrMigrationNotConverged(m.source, m.target.GetName(), pass)
+			}
+			break
+		}
+		pass++
+	}
+	if !m.switchSearch {
+		err = m.sweep(ctx, pass)
+		if err != nil {
+			return err
+		}
+		log.Infof("backfill from collection %q to %s completed", m.source, m.target.GetName())
+		return nil
+	}
+
+	err = m.fence(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			// the fence is removed even when the job is canceled, otherwise the writers are blocked until the next job.
+			err = errors.Join(err, m.fence(context.WithoutCancel(ctx), false))
+		}
+	}()
+	pass++
+	migrated, err := m.migrate(ctx, pass)
+	if err != nil {
+		return err
+	}
+	log.Infof("final migration pass %d finished, %d vectors migrated from collection %q to %s", pass, migrated, m.source, m.target.GetName())
+	err = m.sweep(ctx, pass)
+	if err != nil {
+		return err
+	}
+	log.Infof("backfill from collection %q to %s completed", m.source, m.target.GetName())
+
+	// the switch also removes the fence of the source collection.
+	_, err = m.gateway.SwitchCollection(ctx, &payload.Collection_Switch{
+		Name:   m.source,
+		Target: m.target.GetName(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to switch requests of collection %q to %s", m.source, m.target.GetName())
+	}
+	log.Infof("requests of collection %q switched to %s", m.source, m.target.GetName())
+	return nil
+}
+
+// fence fences or unfences the write requests to the source collection.
+func (m *migrator) fence(ctx context.Context, enabled bool) error {
+	_, err := m.gateway.SwitchCollection(ctx, &payload.Collection_Switch{
+		Name:  m.source,
+		Fence: enabled,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to set fence of collection %q to %v", m.source, enabled)
+	}
+	log.Infof("fence of collection %q set to %v", m.source, enabled)
+	return nil
+}
+
+// createTarget creates the target collection. The existing target collection is used as it is so that the failed migration can be retried.
+func (m *migrator) createTarget(ctx context.Context) error {
+	_, err := m.gateway.CreateCollection(ctx, &payload.Collection_CreateRequest{
+		Config: m.target,
+	})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok || st == nil || st.Code() != codes.AlreadyExists {
+			return errors.Wrapf(err, "failed to create target collection %s", m.target.GetName())
+		}
+		log.Infof("target collection %s already exists", m.target.GetName())
+	}
+	return nil
+}
+
+// migrate lists all vectors of the source collection and upserts the vectors which are not migrated yet or updated since the last migration into the target collection.
+// It returns the number of the migrated vectors.
+func (m *migrator) migrate(ctx context.Context, pass int) (migrated uint64, err error) {
+	ctx, span := trace.StartSpan(igrpc.WrapGRPCMethod(ctx, grpcMethodName), apiName+"/service/migrator.migrate")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+
+	eg, egctx := errgroup.WithContext(ctx)
+	eg.SetLimit(m.concurrency)
+
+	// the stream is bound to the errgroup context so that an upsert error aborts the listing.
+	stream, err := m.gateway.StreamListObject(egctx, &payload.Object_List_Request{
+		Collection: m.source,
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		return 0, errors.Join(err, eg.Wait())
+	}
+
+	var listed, count atomic.Uint64
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return 0, errors.Join(errors.ErrStreamListObjectStreamFinishedUnexpectedly(err), eg.Wait())
+		}
+		vec := res.GetVector()
+		if vec == nil || vec.GetId() == "" {
+			st := res.GetStatus()
+			log.Warnf("received empty vector: code %v: details %v: message %v",
+				st.GetCode(),
+				st.GetDetails(),
+				st.GetMessage(),
+			)
+			continue
+		}
+		if n := listed.Add(1); n%progressLogInterval == 0 {
+			log.Infof("migration pass %d: %d vectors listed, %d vectors migrated", pass, n, count.Load())
+		}
+		if !m.claim(vec.GetId(), vec.GetTimestamp(), pass) {
+			continue
+		}
+		eg.Go(safety.RecoverFunc(func() error {
+			err := m.upsert(egctx, vec)
+			if err != nil {
+				return err
+			}
+			count.Add(1)
+			return nil
+		}))
+	}
+	err = eg.Wait()
+	if err != nil {
+		return 0, err
+	}
+	return count.Load(), nil
+}
+
+// claim records that the vector is listed in the pass, and reports whether the vector should be migrated.
+// The vector is migrated when it is not migrated yet or it is updated since the last migration.
+func (m *migrator) claim(id string, ts int64, pass int) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p, ok := m.migrated[id]
+	if ok && p.timestamp >= ts {
+		p.pass = pass
+		m.migrated[id] = p
+		return false
+	}
+	m.migrated[id] = progress{
+		timestamp: ts,
+		pass:      pass,
+	}
+	return true
+}
+
+// listed reports whether the vector is listed in the pass.
+func (m *migrator) listed(id string, pass int) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p, ok := m.migrated[id]
+	return ok && p.pass == pass
+}
+
+// transform generates the vector of the target collection from the source vector with the transformer.
+// The transformer receives the marshaled source vector as the object of the blob.
+// The sparse vector and the attributes of the source vector are kept unless the transformer generates them.
+func (m *migrator) transform(ctx context.Context, src *payload.Object_Vector) (*payload.Object_Vector, error) {
+	obj, err := src.MarshalVT()
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.transformer.GenVector(ctx, &payload.Object_Blob{
+		Id:     src.GetId(),
+		Object: obj,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to transform vector %s", src.GetId())
+	}
+	if dim := int(m.target.GetDimension()); dim != 0 && len(res.GetVector()) != 0 && len(res.GetVector()) != dim {
+		return nil, errors.ErrMigratedVectorDimensionMismatch(src.GetId(), len(res.GetVector()), dim)
+	}
+	vec := res.CloneVT()
+	vec.Id = src.GetId()
+	vec.Timestamp = src.GetTimestamp()
+	if vec.GetSparse() == nil {
+		vec.Sparse = src.GetSparse()
+	}
+	if len(vec.GetAttributes()) == 0 {
+		vec.Attributes = src.GetAttributes()
+	}
+	return vec, nil
+}
+
+// upsert transforms the source vector and upserts it into the target collection with the timestamp and the attributes
+// of the source vector.
+func (m *migrator) upsert(ctx context.Context, src *payload.Object_Vector) error {
+	vec, err := m.transform(ctx, src)
+	if err != nil {
+		return err
+	}
+	_, err = m.gateway.Upsert(ctx, &payload.Upsert_Request{
+		Vector: vec,
+		Config: &payload.Upsert_Config{
+			SkipStrictExistCheck: true,
+			Timestamp:            vec.GetTimestamp(),
+			Attributes:           vec.GetAttributes(),
+			Collection:           m.target.GetName(),
+		},
+	})
+	if err != nil {
+		// the vector migrated by the previous job is already stored.
+		if st, ok := status.FromError(err); ok && st != nil && st.Code() == codes.AlreadyExists {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to upsert vector %s into collection %s", vec.GetId(), m.target.GetName())
+	}
+	return nil
+}
+
+// sweep lists the target collection and removes the vectors which are not listed from the source collection in the pass.
+// The vectors left by the previous job are removed as well as the vectors removed from the source collection during the migration.
+func (m *migrator) sweep(ctx context.Context, pass int) (err error) {
+	ctx, span := trace.StartSpan(igrpc.WrapGRPCMethod(ctx, grpcMethodName), apiName+"/service/migrator.sweep")
+	defer func() {
+		if span != nil {
+			span.End()
+		}
+	}()
+
+	eg, egctx := errgroup.WithContext(ctx)
+	eg.SetLimit(m.concurrency)
+
+	stream, err := m.gateway.StreamListObject(egctx, &payload.Object_List_Request{
+		Collection: m.target.GetName(),
+	}, grpc.WaitForReady(true))
+	if err != nil {
+		return errors.Join(err, eg.Wait())
+	}
+
+	var removed atomic.Uint64
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return errors.Join(errors.ErrStreamListObjectStreamFinishedUnexpectedly(err), eg.Wait())
+		}
+		id := res.GetVector().GetId()
+		if id == "" || m.listed(id, pass) {
+			continue
+		}
+		eg.Go(safety.RecoverFunc(func() error {
+			_, err := m.gateway.Remove(egctx, &payload.Remove_Request{
+				Id: &payload.Object_ID{
+					Id: id,
+				},
+				Config: &payload.Remove_Config{
+					SkipStrictExistCheck: true,
+					Collection:           m.target.GetName(),
+				},
+			})
+			if err != nil {
+				if st, ok := status.FromError(err); ok && st != nil && st.Code() == codes.NotFound {
+					return nil
+				}
+				return errors.Wrapf(err, "failed to remove vector %s from collection %s", id, m.target.GetName())
+			}
+			removed.Add(1)
+			return nil
+		}))
+	}
+	err = eg.Wait()
+	if err != nil {
+		return err
+	}
+	log.Infof("%d vectors removed from collection %s", removed.Load(), m.target.GetName())
+	return nil
+}
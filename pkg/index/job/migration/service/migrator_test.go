@@ -0,0 +1,418 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/vdaas/vald/apis/grpc/v1/payload"
+	"github.com/vdaas/vald/apis/grpc/v1/vald"
+	"github.com/vdaas/vald/internal/client/v1/client/filter/ingress"
+	vc "github.com/vdaas/vald/internal/client/v1/client/vald"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/io"
+	"github.com/vdaas/vald/internal/sync"
+	"github.com/vdaas/vald/internal/test/comparator"
+	"google.golang.org/grpc"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	type test struct {
+		name    string
+		opts    []Option
+		wantErr error
+	}
+	tests := []test{
+		{
+			name: "returns migrator when the target collection is set",
+			opts: []Option{WithSourceCollection("images"), WithTargetCollection("images-v2")},
+		},
+		{
+			name: "returns migrator when the default collection is migrated",
+			opts: []Option{WithTargetCollection("default-v2")},
+		},
+		{
+			name:    "returns error when the target collection is the source collection",
+			opts:    []Option{WithSourceCollection("images"), WithTargetCollection("images")},
+			wantErr: errors.ErrSameMigrationCollection("images"),
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			_, err := New(test.opts...)
+			if !errors.Is(err, test.wantErr) {
+				tt.Errorf("got_error: \"%v\", want: \"%v\"", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestNew_withoutTargetCollection(t *testing.T) {
+	t.Parallel()
+	_, err := New()
+	var critical *errors.ErrCriticalOption
+	if !errors.As(err, &critical) {
+		t.Errorf("got_error: \"%v\", want a wrapped *errors.ErrCriticalOption", err)
+	}
+}
+
+func Test_migrator_claim(t *testing.T) {
+	t.Parallel()
+	type step struct {
+		id   string
+		ts   int64
+		pass int
+		want bool
+	}
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "claims the vector which is not migrated",
+			steps: []step{
+				{id: "a", ts: 1, pass: 0, want: true},
+				{id: "b", ts: 1, pass: 0, want: true},
+			},
+		},
+		{
+			name: "does not claim the replica of the migrated vector",
+			steps: []step{
+				{id: "a", ts: 1, pass: 0, want: true},
+				{id: "a", ts: 1, pass: 0, want: false},
+			},
+		},
+		{
+			name: "claims the vector updated since the last migration",
+			steps: []step{
+				{id: "a", ts: 1, pass: 0, want: true},
+				{id: "a", ts: 2, pass: 1, want: true},
+				{id: "a", ts: 2, pass: 2, want: false},
+			},
+		},
+		{
+			name: "does not claim the older replica of the migrated vector",
+			steps: []step{
+				{id: "a", ts: 2, pass: 0, want: true},
+				{id: "a", ts: 1, pass: 0, want: false},
+			},
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			m := &migrator{
+				migrated: make(map[string]progress),
+			}
+			for i, s := range test.steps {
+				if got := m.claim(s.id, s.ts, s.pass); got != s.want {
+					tt.Errorf("step %d: got: %v, want: %v", i, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func Test_migrator_listed(t *testing.T) {
+	t.Parallel()
+	m := &migrator{
+		migrated: make(map[string]progress),
+	}
+	m.claim("a", 1, 0)
+	m.claim("b", 1, 0)
+	m.claim("c", 1, 0)
+	// "b" is removed from the source collection during the first pass.
+	m.claim("c", 2, 1)
+	m.claim("a", 1, 1)
+
+	for id, want := range map[string]bool{
+		"a": true,
+		"b": false,
+		"c": true,
+		"d": false,
+	} {
+		if got := m.listed(id, 1); got != want {
+			t.Errorf("id %s: got: %v, want: %v", id, got, want)
+		}
+	}
+}
+
+// fakeGateway serves the collections in memory. The writer updates all vectors
+// of the source collection before each listing unless the source is fenced, so
+// the catch-up passes never converge.
+type fakeGateway struct {
+	vc.Client
+	mu          sync.Mutex
+	collections map[string]map[string]*payload.Object_Vector
+	fenced      map[string]bool
+	writer      string
+	removeErr   error
+	switches    []string
+}
+
+func (g *fakeGateway) CreateCollection(
+	_ context.Context, in *payload.Collection_CreateRequest, _ ...grpc.CallOption,
+) (*payload.Empty, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.collections[in.GetConfig().GetName()]; !ok {
+		g.collections[in.GetConfig().GetName()] = make(map[string]*payload.Object_Vector)
+	}
+	return new(payload.Empty), nil
+}
+
+func (g *fakeGateway) StreamListObject(
+	_ context.Context, in *payload.Object_List_Request, _ ...grpc.CallOption,
+) (vald.Object_StreamListObjectClient, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if in.GetCollection() == g.writer && !g.fenced[g.writer] {
+		for _, vec := range g.collections[g.writer] {
+			vec.Timestamp++
+		}
+	}
+	st := new(fakeListStream)
+	for _, vec := range g.collections[in.GetCollection()] {
+		st.res = append(st.res, &payload.Object_List_Response{
+			Payload: &payload.Object_List_Response_Vector{
+				Vector: vec.CloneVT(),
+			},
+		})
+	}
+	return st, nil
+}
+
+func (g *fakeGateway) Upsert(
+	_ context.Context, in *payload.Upsert_Request, _ ...grpc.CallOption,
+) (*payload.Object_Location, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	vec := in.GetVector().CloneVT()
+	vec.Attributes = in.GetConfig().CloneVT().GetAttributes()
+	g.collections[in.GetConfig().GetCollection()][vec.GetId()] = vec
+	return new(payload.Object_Location), nil
+}
+
+func (g *fakeGateway) Remove(
+	_ context.Context, in *payload.Remove_Request, _ ...grpc.CallOption,
+) (*payload.Object_Location, error) {
+	if g.removeErr != nil {
+		return nil, g.removeErr
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.collections[in.GetConfig().GetCollection()], in.GetId().GetId())
+	return new(payload.Object_Location), nil
+}
+
+func (g *fakeGateway) SwitchCollection(
+	_ context.Context, in *payload.Collection_Switch, _ ...grpc.CallOption,
+) (*payload.Empty, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.fenced[in.GetName()] = in.GetFence()
+	g.switches = append(g.switches, fmt.Sprintf("%s->%s fence=%v", in.GetName(), in.GetTarget(), in.GetFence()))
+	return new(payload.Empty), nil
+}
+
+func (g *fakeGateway) ids(name string) []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	ids := make([]string, 0, len(g.collections[name]))
+	for id := range g.collections[name] {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+type fakeListStream struct {
+	grpc.ClientStream
+	res []*payload.Object_List_Response
+}
+
+func (s *fakeListStream) Recv() (*payload.Object_List_Response, error) {
+	if len(s.res) == 0 {
+		return nil, io.EOF
+	}
+	res := s.res[0]
+	s.res = s.res[1:]
+	return res, nil
+}
+
+type fakeTransformer struct {
+	ingress.Client
+}
+
+func (fakeTransformer) GenVector(
+	_ context.Context, in *payload.Object_Blob, _ ...grpc.CallOption,
+) (*payload.Object_Vector, error) {
+	return &payload.Object_Vector{
+		Id:     in.GetId(),
+		Vector: []float32{1, 2},
+	}, nil
+}
+
+func Test_migrator_Start(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		switchSearch bool
+		writer       bool
+		removeErr    error
+		wantIDs      []string
+		wantSwitches []string
+		wantErr      error
+	}{
+		{
+			name:         "fences the source, removes the stale vectors and switches the requests",
+			switchSearch: true,
+			wantIDs:      []string{"a", "b"},
+			wantSwitches: []string{
+				"images-> fence=true",
+				"images->images-v2 fence=false",
+			},
+		},
+		{
+			name:         "fences the source for the final pass when the catch-up passes do not converge",
+			switchSearch: true,
+			writer:       true,
+			wantIDs:      []string{"a", "b"},
+			wantSwitches: []string{
+				"images-> fence=true",
+				"images->images-v2 fence=false",
+			},
+		},
+		{
+			name:    "returns error when the catch-up passes do not converge without the switch",
+			writer:  true,
+			wantIDs: []string{"a", "b", "x"},
+			wantErr: errors.ErrMigrationNotConverged("images", "images-v2", 1),
+		},
+		{
+			name:         "removes the fence when the final pass fails",
+			switchSearch: true,
+			removeErr:    errors.New("remove failed"),
+			wantIDs:      []string{"a", "b", "x"},
+			wantSwitches: []string{
+				"images-> fence=true",
+				"images-> fence=false",
+			},
+			wantErr: errors.New("failed to remove vector x from collection images-v2: remove failed"),
+		},
+	}
+	for _, tc := range tests {
+		test := tc
+		t.Run(test.name, func(tt *testing.T) {
+			tt.Parallel()
+			g := &fakeGateway{
+				collections: map[string]map[string]*payload.Object_Vector{
+					"images": {
+						"a": {Id: "a", Vector: []float32{1}, Timestamp: 1},
+						"b": {Id: "b", Vector: []float32{2}, Timestamp: 1},
+					},
+					// "x" is left by the previous job and removed from the source collection.
+					"images-v2": {
+						"x": {Id: "x", Vector: []float32{1, 2}, Timestamp: 1},
+					},
+				},
+				fenced:    make(map[string]bool),
+				removeErr: test.removeErr,
+			}
+			if test.writer {
+				g.writer = "images"
+			}
+			m, err := New(
+				WithSourceCollection("images"),
+				WithTargetCollection("images-v2"),
+				WithCatchUpPasses(1),
+				WithSwitchSearch(test.switchSearch),
+			)
+			if err != nil {
+				tt.Fatal(err)
+			}
+			mm := m.(*migrator)
+			mm.gateway = g
+			mm.transformer = fakeTransformer{}
+
+			err = m.Start(context.Background())
+			if !errors.Is(err, test.wantErr) {
+				tt.Errorf("got_error: \"%v\", want: \"%v\"", err, test.wantErr)
+			}
+			if diff := comparator.Diff(g.ids("images-v2"), test.wantIDs); diff != "" {
+				tt.Errorf("target ids diff (-got +want):\n%s", diff)
+			}
+			if diff := comparator.Diff(g.switches, test.wantSwitches); diff != "" {
+				tt.Errorf("switches diff (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_migrator_upsert(t *testing.T) {
+	t.Parallel()
+	attrs := map[string]*payload.Attribute_Value{
+		"genre": {
+			Value: &payload.Attribute_Value_StringValue{
+				StringValue: "jazz",
+			},
+		},
+		"year": {
+			Value: &payload.Attribute_Value_IntValue{
+				IntValue: 1959,
+			},
+		},
+	}
+	g := &fakeGateway{
+		collections: map[string]map[string]*payload.Object_Vector{
+			"images-v2": {},
+		},
+	}
+	m, err := New(
+		WithSourceCollection("images"),
+		WithTargetCollection("images-v2"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mm := m.(*migrator)
+	mm.gateway = g
+	mm.transformer = fakeTransformer{}
+
+	err = mm.upsert(context.Background(), &payload.Object_Vector{
+		Id:         "a",
+		Vector:     []float32{1},
+		Timestamp:  3,
+		Attributes: attrs,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &payload.Object_Vector{
+		Id:         "a",
+		Vector:     []float32{1, 2},
+		Timestamp:  3,
+		Attributes: attrs,
+	}
+	if got := g.collections["images-v2"]["a"]; !got.EqualVT(want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2019-2026 vdaas.org vald team <vald@vdaas.org>
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// You may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package service
+
+import (
+	"github.com/vdaas/vald/internal/client/v1/client/filter/ingress"
+	"github.com/vdaas/vald/internal/client/v1/client/vald"
+	"github.com/vdaas/vald/internal/errors"
+	"github.com/vdaas/vald/internal/sync/errgroup"
+)
+
+type Option func(_ *migrator) error
+
+var defaultOpts = []Option{
+	WithConcurrency(200),
+	WithCatchUpPasses(3),
+	WithErrGroup(errgroup.Get()),
+}
+
+// WithConcurrency returns Option that sets the number of the vectors migrated concurrently.
+func WithConcurrency(num int) Option {
+	return func(m *migrator) error {
+		if num <= 0 {
+			return errors.NewErrInvalidOption("concurrency", num)
+		}
+		m.concurrency = num
+		return nil
+	}
+}
+
+// WithCatchUpPasses returns Option that sets the maximum number of the passes after the backfill.
+func WithCatchUpPasses(num int) Option {
+	return func(m *migrator) error {
+		if num < 0 {
+			return errors.NewErrInvalidOption("catchUpPasses", num)
+		}
+		m.catchUpPasses = num
+		return nil
+	}
+}
+
+// WithSourceCollection returns Option that sets the collection to be migrated.
+func WithSourceCollection(name string) Option {
+	return func(m *migrator) error {
+		m.source = name
+		return nil
+	}
+}
+
+// WithTargetCollection returns Option that sets the shadow collection which stores the migrated vectors.
+func WithTargetCollection(name string) Option {
+	return func(m *migrator) error {
+		if name == "" {
+			return errors.NewErrCriticalOption("targetCollection", name)
+		}
+		m.target.Name = name
+		return nil
+	}
+}
+
+// WithTargetDimension returns Option that sets the dimension of the target collection.
+func WithTargetDimension(dim int) Option {
+	return func(m *migrator) error {
+		if dim < 0 {
+			return errors.NewErrInvalidOption("targetDimension", dim)
+		}
+		m.target.Dimension = int32(dim)
+		return nil
+	}
+}
+
+// WithTargetDistanceType returns Option that sets the distance type of the target collection.
+func WithTargetDistanceType(typ string) Option {
+	return func(m *migrator) error {
+		m.target.DistanceType = typ
+		return nil
+	}
+}
+
+// WithTargetObjectType returns Option that sets the object type of the target collection.
+func WithTargetObjectType(typ string) Option {
+	return func(m *migrator) error {
+		m.target.ObjectType = typ
+		return nil
+	}
+}
+
+// WithSwitchSearch returns Option that sets whether to switch the requests to the target collection after the migration.
+func WithSwitchSearch(enabled bool) Option {
+	return func(m *migrator) error {
+		m.switchSearch = enabled
+		return nil
+	}
+}
+
+// WithGateway returns Option that sets gateway client.
+func WithGateway(client vald.Client) Option {
+	return func(m *migrator) error {
+		if client == nil {
+			return errors.NewErrCriticalOption("gateway", client)
+		}
+		m.gateway = client
+		return nil
+	}
+}
+
+// WithTransformer returns Option that sets the ingress filter client which generates the vectors of the target collection.
+func WithTransformer(client ingress.Client) Option {
+	return func(m *migrator) error {
+		if client == nil {
+			return errors.NewErrCriticalOption("transformer", client)
+		}
+		m.transformer = client
+		return nil
+	}
+}
+
+// WithErrGroup returns Option that set errgroup.
+func WithErrGroup(eg errgroup.Group) Option {
+	return func(m *migrator) error {
+		if eg != nil {
+			m.eg = eg
+		}
+		return nil
+	}
+}
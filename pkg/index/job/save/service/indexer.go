@@ -80,6 +80,8 @@ func (idx *index) Start(ctx context.Context) error {
 			span.End()
 		}
 	}()
+	// the scheduled index saving is sent as the low priority not to slow down the user-facing requests.
+	ctx = grpc.WithPriority(ctx, grpc.LowPriority)
 
 	err := idx.doSaveIndex(
 		ctx,